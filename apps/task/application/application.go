@@ -6,15 +6,34 @@ import (
 	"github.com/crazyfrankie/zrpc-todolist/types/consts"
 	"gorm.io/gorm"
 
+	"github.com/crazyfrankie/zrpc-todolist/infra/contract/cache"
+	"github.com/crazyfrankie/zrpc-todolist/infra/contract/eventbus"
 	"github.com/crazyfrankie/zrpc-todolist/infra/contract/idgen"
+	"github.com/crazyfrankie/zrpc-todolist/infra/contract/notify"
+	"github.com/crazyfrankie/zrpc-todolist/infra/contract/pubsub"
+	"github.com/crazyfrankie/zrpc-todolist/infra/contract/search"
+	"github.com/crazyfrankie/zrpc-todolist/infra/contract/storage"
 	"github.com/crazyfrankie/zrpc-todolist/infra/impl/cache/redis"
+	eventbusimpl "github.com/crazyfrankie/zrpc-todolist/infra/impl/eventbus"
 	idgenimpl "github.com/crazyfrankie/zrpc-todolist/infra/impl/idgen"
 	"github.com/crazyfrankie/zrpc-todolist/infra/impl/mysql"
+	notifyimpl "github.com/crazyfrankie/zrpc-todolist/infra/impl/notify"
+	pubsubimpl "github.com/crazyfrankie/zrpc-todolist/infra/impl/pubsub"
+	searchimpl "github.com/crazyfrankie/zrpc-todolist/infra/impl/search"
+	storageimpl "github.com/crazyfrankie/zrpc-todolist/infra/impl/storage"
 )
 
 type BasicServices struct {
-	DB    *gorm.DB
-	IDGen idgen.IDGenerator
+	DB       *gorm.DB
+	IDGen    idgen.IDGenerator
+	Searcher search.Searcher
+	Notifier notify.Notifier
+	PubSub   pubsub.PubSub
+	// EventBus carries the events relayed from the outbox.
+	EventBus eventbus.EventBus
+	Cache    cache.Cmdable
+	// FileOSS stores task attachments and exports.
+	FileOSS storage.Storage
 }
 
 func Init(ctx context.Context) (*BasicServices, error) {
@@ -26,9 +45,19 @@ func Init(ctx context.Context) (*BasicServices, error) {
 		return nil, err
 	}
 
-	cacheCli := redis.New()
+	basic.Cache = redis.New()
 
-	basic.IDGen, err = idgenimpl.New(cacheCli, consts.TaskServiceName)
+	basic.IDGen, err = idgenimpl.New(basic.Cache, consts.TaskServiceName)
+	if err != nil {
+		return nil, err
+	}
+
+	basic.Searcher = searchimpl.New(basic.DB, "task")
+	basic.Notifier = notifyimpl.New()
+	basic.PubSub = pubsubimpl.New()
+	basic.EventBus = eventbusimpl.New()
+
+	basic.FileOSS, err = storageimpl.New(ctx)
 	if err != nil {
 		return nil, err
 	}
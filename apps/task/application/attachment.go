@@ -0,0 +1,75 @@
+package application
+
+import (
+	"context"
+
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/entity"
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/service"
+	langslice "github.com/crazyfrankie/zrpc-todolist/pkg/lang/slice"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/zrpc/ctxutil"
+	"github.com/crazyfrankie/zrpc-todolist/protocol/task"
+)
+
+func (t *TaskApplicationService) UploadAttachment(ctx context.Context, req *task.UploadAttachmentRequest) (*task.UploadAttachmentResponse, error) {
+	userID := ctxutil.MustGetUserIDFromCtx(ctx)
+
+	attachment, err := t.taskDomain.UploadAttachment(ctx, &service.UploadAttachmentRequest{
+		UserID:  userID,
+		TaskID:  req.GetTaskID(),
+		Name:    req.GetName(),
+		Content: req.GetContent(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &task.UploadAttachmentResponse{
+		Data: attachmentDO2DTO(attachment),
+	}, nil
+}
+
+func (t *TaskApplicationService) ListAttachments(ctx context.Context, req *task.ListAttachmentsRequest) (*task.ListAttachmentsResponse, error) {
+	userID := ctxutil.MustGetUserIDFromCtx(ctx)
+
+	attachments, err := t.taskDomain.ListAttachments(ctx, userID, req.GetTaskID())
+	if err != nil {
+		return nil, err
+	}
+
+	return &task.ListAttachmentsResponse{
+		Data: langslice.Transform(attachments, attachmentDO2DTO),
+	}, nil
+}
+
+func (t *TaskApplicationService) GetAttachmentURL(ctx context.Context, req *task.GetAttachmentURLRequest) (*task.GetAttachmentURLResponse, error) {
+	userID := ctxutil.MustGetUserIDFromCtx(ctx)
+
+	url, err := t.taskDomain.GetAttachmentURL(ctx, userID, req.GetAttachmentID())
+	if err != nil {
+		return nil, err
+	}
+
+	return &task.GetAttachmentURLResponse{Url: url}, nil
+}
+
+func (t *TaskApplicationService) DeleteAttachment(ctx context.Context, req *task.DeleteAttachmentRequest) (*task.DeleteAttachmentResponse, error) {
+	userID := ctxutil.MustGetUserIDFromCtx(ctx)
+
+	err := t.taskDomain.DeleteAttachment(ctx, userID, req.GetAttachmentID())
+	if err != nil {
+		return nil, err
+	}
+
+	return &task.DeleteAttachmentResponse{}, nil
+}
+
+func attachmentDO2DTO(attachment *entity.Attachment) *task.Attachment {
+	return &task.Attachment{
+		AttachmentID: attachment.ID,
+		TaskID:       attachment.TaskID,
+		Name:         attachment.Name,
+		ContentType:  attachment.ContentType,
+		Size:         attachment.Size,
+		CreatedAt:    attachment.CreatedAt / 1000,
+	}
+}
@@ -0,0 +1,83 @@
+package application
+
+import (
+	"context"
+	"errors"
+
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/entity"
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/service"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/errorx"
+	langslice "github.com/crazyfrankie/zrpc-todolist/pkg/lang/slice"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/zrpc/ctxutil"
+	"github.com/crazyfrankie/zrpc-todolist/protocol/task"
+)
+
+func (t *TaskApplicationService) BatchCreateTasks(ctx context.Context, req *task.BatchCreateTasksRequest) (*task.BatchCreateTasksResponse, error) {
+	userID := ctxutil.MustGetUserIDFromCtx(ctx)
+
+	results, err := t.taskDomain.BatchCreateTasks(ctx, userID, langslice.Transform(req.GetTasks(),
+		func(r *task.AddTaskRequest) *service.CreateTaskRequest {
+			return createTaskDTO2DO(userID, r)
+		}))
+	if err != nil {
+		return nil, err
+	}
+
+	return &task.BatchCreateTasksResponse{
+		Data: langslice.Transform(results, batchResultDO2DTO),
+	}, nil
+}
+
+func (t *TaskApplicationService) BatchUpdateStatus(ctx context.Context, req *task.BatchUpdateStatusRequest) (*task.BatchUpdateStatusResponse, error) {
+	userID := ctxutil.MustGetUserIDFromCtx(ctx)
+
+	results, err := t.taskDomain.BatchUpdateStatus(ctx, userID, req.GetTaskIds(), entity.Status(req.GetStatus()))
+	if err != nil {
+		return nil, err
+	}
+
+	return &task.BatchUpdateStatusResponse{
+		Data: langslice.Transform(results, batchResultDO2DTO),
+	}, nil
+}
+
+func (t *TaskApplicationService) BatchDelete(ctx context.Context, req *task.BatchDeleteRequest) (*task.BatchDeleteResponse, error) {
+	userID := ctxutil.MustGetUserIDFromCtx(ctx)
+
+	results, err := t.taskDomain.BatchDeleteTasks(ctx, userID, req.GetTaskIds())
+	if err != nil {
+		return nil, err
+	}
+
+	return &task.BatchDeleteResponse{
+		Data: langslice.Transform(results, batchResultDO2DTO),
+	}, nil
+}
+
+func (t *TaskApplicationService) BatchMove(ctx context.Context, req *task.BatchMoveRequest) (*task.BatchMoveResponse, error) {
+	userID := ctxutil.MustGetUserIDFromCtx(ctx)
+
+	results, err := t.taskDomain.BatchMoveTasks(ctx, userID, req.GetTaskIds(), req.GetProjectId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &task.BatchMoveResponse{
+		Data: langslice.Transform(results, batchResultDO2DTO),
+	}, nil
+}
+
+func batchResultDO2DTO(res *service.BatchResult) *task.BatchResult {
+	dto := &task.BatchResult{TaskID: res.TaskID}
+	if res.Task != nil {
+		dto.Data = taskDO2DTO(res.Task)
+	}
+
+	var statusErr errorx.StatusError
+	if errors.As(res.Err, &statusErr) {
+		dto.Code = statusErr.Code()
+		dto.Message = statusErr.Msg()
+	}
+
+	return dto
+}
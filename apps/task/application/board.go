@@ -0,0 +1,119 @@
+package application
+
+import (
+	"context"
+
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/entity"
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/service"
+	langslice "github.com/crazyfrankie/zrpc-todolist/pkg/lang/slice"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/zrpc/ctxutil"
+	"github.com/crazyfrankie/zrpc-todolist/protocol/task"
+)
+
+func (t *TaskApplicationService) GetBoard(ctx context.Context, req *task.GetBoardRequest) (*task.GetBoardResponse, error) {
+	userID := ctxutil.MustGetUserIDFromCtx(ctx)
+
+	board, err := t.boardDomain.GetBoard(ctx, userID, req.GetProjectId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &task.GetBoardResponse{
+		Data: &task.Board{
+			ProjectId: board.ProjectID,
+			Columns:   langslice.Transform(board.Columns, boardColumnDO2DTO),
+		},
+	}, nil
+}
+
+func (t *TaskApplicationService) CreateColumn(ctx context.Context, req *task.CreateColumnRequest) (*task.CreateColumnResponse, error) {
+	userID := ctxutil.MustGetUserIDFromCtx(ctx)
+
+	column, err := t.boardDomain.CreateColumn(ctx, &service.CreateColumnRequest{
+		UserID:    userID,
+		ProjectID: req.GetProjectId(),
+		Name:      req.GetName(),
+		Status:    entity.Status(req.GetStatus()),
+		WIPLimit:  req.GetWipLimit(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &task.CreateColumnResponse{
+		Data: boardColumnDO2DTO(column),
+	}, nil
+}
+
+func (t *TaskApplicationService) UpdateColumn(ctx context.Context, req *task.UpdateColumnRequest) (*task.UpdateColumnResponse, error) {
+	userID := ctxutil.MustGetUserIDFromCtx(ctx)
+
+	column, err := t.boardDomain.UpdateColumn(ctx, &service.UpdateColumnRequest{
+		UserID:   userID,
+		ColumnID: req.GetColumnID(),
+		Name:     req.Name,
+		WIPLimit: req.WipLimit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &task.UpdateColumnResponse{
+		Data: boardColumnDO2DTO(column),
+	}, nil
+}
+
+func (t *TaskApplicationService) ReorderColumns(ctx context.Context, req *task.ReorderColumnsRequest) (*task.ReorderColumnsResponse, error) {
+	userID := ctxutil.MustGetUserIDFromCtx(ctx)
+
+	err := t.boardDomain.ReorderColumns(ctx, userID, req.GetProjectId(), req.GetColumnIds())
+	if err != nil {
+		return nil, err
+	}
+
+	return &task.ReorderColumnsResponse{}, nil
+}
+
+func (t *TaskApplicationService) DeleteColumn(ctx context.Context, req *task.DeleteColumnRequest) (*task.DeleteColumnResponse, error) {
+	userID := ctxutil.MustGetUserIDFromCtx(ctx)
+
+	err := t.boardDomain.DeleteColumn(ctx, userID, req.GetColumnID())
+	if err != nil {
+		return nil, err
+	}
+
+	return &task.DeleteColumnResponse{}, nil
+}
+
+func (t *TaskApplicationService) MoveCard(ctx context.Context, req *task.MoveCardRequest) (*task.MoveCardResponse, error) {
+	userID := ctxutil.MustGetUserIDFromCtx(ctx)
+
+	moved, err := t.boardDomain.MoveCard(ctx, &service.MoveCardRequest{
+		UserID:   userID,
+		TaskID:   req.GetTaskID(),
+		ColumnID: req.GetColumnId(),
+		BeforeID: req.GetBeforeId(),
+		AfterID:  req.GetAfterId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &task.MoveCardResponse{
+		Data: taskDO2DTO(moved),
+	}, nil
+}
+
+func boardColumnDO2DTO(column *entity.BoardColumn) *task.BoardColumn {
+	return &task.BoardColumn{
+		ColumnID:  column.ID,
+		ProjectId: column.ProjectID,
+		Name:      column.Name,
+		Status:    task.TaskStatus(column.Status),
+		Position:  column.Position,
+		WipLimit:  column.WIPLimit,
+		Tasks:     langslice.Transform(column.Tasks, taskDO2DTO),
+		CreatedAt: column.CreatedAt / 1000,
+		UpdatedAt: column.UpdatedAt / 1000,
+	}
+}
@@ -0,0 +1,53 @@
+package application
+
+import (
+	"context"
+
+	"github.com/crazyfrankie/zrpc-todolist/pkg/zrpc/ctxutil"
+	"github.com/crazyfrankie/zrpc-todolist/protocol/task"
+)
+
+func (t *TaskApplicationService) CreateCalendarFeed(ctx context.Context, req *task.CreateCalendarFeedRequest) (*task.CreateCalendarFeedResponse, error) {
+	userID := ctxutil.MustGetUserIDFromCtx(ctx)
+
+	token, err := t.taskDomain.CreateCalendarFeed(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &task.CreateCalendarFeedResponse{Token: token}, nil
+}
+
+func (t *TaskApplicationService) RotateCalendarFeed(ctx context.Context, req *task.RotateCalendarFeedRequest) (*task.RotateCalendarFeedResponse, error) {
+	userID := ctxutil.MustGetUserIDFromCtx(ctx)
+
+	token, err := t.taskDomain.RotateCalendarFeed(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &task.RotateCalendarFeedResponse{Token: token}, nil
+}
+
+func (t *TaskApplicationService) RevokeCalendarFeed(ctx context.Context, req *task.RevokeCalendarFeedRequest) (*task.RevokeCalendarFeedResponse, error) {
+	userID := ctxutil.MustGetUserIDFromCtx(ctx)
+
+	if err := t.taskDomain.RevokeCalendarFeed(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	return &task.RevokeCalendarFeedResponse{}, nil
+}
+
+// GetCalendarFeed is called without a user, the token tells whose feed it is.
+func (t *TaskApplicationService) GetCalendarFeed(ctx context.Context, req *task.GetCalendarFeedRequest) (*task.GetCalendarFeedResponse, error) {
+	feed, err := t.taskDomain.GetCalendarFeed(ctx, req.GetToken())
+	if err != nil {
+		return nil, err
+	}
+
+	return &task.GetCalendarFeedResponse{
+		Content: feed.Content,
+		Etag:    feed.ETag,
+	}, nil
+}
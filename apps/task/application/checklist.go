@@ -0,0 +1,63 @@
+package application
+
+import (
+	"context"
+
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/entity"
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/service"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/zrpc/ctxutil"
+	"github.com/crazyfrankie/zrpc-todolist/protocol/task"
+)
+
+func (t *TaskApplicationService) AddChecklistItem(ctx context.Context, req *task.AddChecklistItemRequest) (*task.AddChecklistItemResponse, error) {
+	userID := ctxutil.MustGetUserIDFromCtx(ctx)
+
+	item, err := t.taskDomain.AddChecklistItem(ctx, userID, req.GetTaskID(), req.GetContent())
+	if err != nil {
+		return nil, err
+	}
+
+	return &task.AddChecklistItemResponse{
+		Data: checklistItemDO2DTO(item),
+	}, nil
+}
+
+func (t *TaskApplicationService) UpdateChecklistItem(ctx context.Context, req *task.UpdateChecklistItemRequest) (*task.UpdateChecklistItemResponse, error) {
+	userID := ctxutil.MustGetUserIDFromCtx(ctx)
+
+	item, err := t.taskDomain.UpdateChecklistItem(ctx, &service.UpdateChecklistItemRequest{
+		UserID:  userID,
+		ItemID:  req.GetItemID(),
+		Content: req.Content,
+		Checked: req.Checked,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &task.UpdateChecklistItemResponse{
+		Data: checklistItemDO2DTO(item),
+	}, nil
+}
+
+func (t *TaskApplicationService) DeleteChecklistItem(ctx context.Context, req *task.DeleteChecklistItemRequest) (*task.DeleteChecklistItemResponse, error) {
+	userID := ctxutil.MustGetUserIDFromCtx(ctx)
+
+	err := t.taskDomain.DeleteChecklistItem(ctx, userID, req.GetItemID())
+	if err != nil {
+		return nil, err
+	}
+
+	return &task.DeleteChecklistItemResponse{}, nil
+}
+
+func checklistItemDO2DTO(item *entity.ChecklistItem) *task.ChecklistItem {
+	return &task.ChecklistItem{
+		ItemID:    item.ID,
+		TaskID:    item.TaskID,
+		Content:   item.Content,
+		Checked:   item.Checked,
+		CreatedAt: item.CreatedAt / 1000,
+		UpdatedAt: item.UpdatedAt / 1000,
+	}
+}
@@ -0,0 +1,79 @@
+package application
+
+import (
+	"context"
+
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/entity"
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/service"
+	langslice "github.com/crazyfrankie/zrpc-todolist/pkg/lang/slice"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/zrpc/ctxutil"
+	"github.com/crazyfrankie/zrpc-todolist/protocol/task"
+)
+
+func (t *TaskApplicationService) AddComment(ctx context.Context, req *task.AddCommentRequest) (*task.AddCommentResponse, error) {
+	userID := ctxutil.MustGetUserIDFromCtx(ctx)
+
+	comment, err := t.taskDomain.AddComment(ctx, userID, req.GetTaskID(), req.GetContent())
+	if err != nil {
+		return nil, err
+	}
+
+	return &task.AddCommentResponse{
+		Data: commentDO2DTO(comment),
+	}, nil
+}
+
+func (t *TaskApplicationService) UpdateComment(ctx context.Context, req *task.UpdateCommentRequest) (*task.UpdateCommentResponse, error) {
+	userID := ctxutil.MustGetUserIDFromCtx(ctx)
+
+	comment, err := t.taskDomain.UpdateComment(ctx, userID, req.GetCommentID(), req.GetContent())
+	if err != nil {
+		return nil, err
+	}
+
+	return &task.UpdateCommentResponse{
+		Data: commentDO2DTO(comment),
+	}, nil
+}
+
+func (t *TaskApplicationService) DeleteComment(ctx context.Context, req *task.DeleteCommentRequest) (*task.DeleteCommentResponse, error) {
+	userID := ctxutil.MustGetUserIDFromCtx(ctx)
+
+	err := t.taskDomain.DeleteComment(ctx, userID, req.GetCommentID())
+	if err != nil {
+		return nil, err
+	}
+
+	return &task.DeleteCommentResponse{}, nil
+}
+
+func (t *TaskApplicationService) ListComments(ctx context.Context, req *task.ListCommentsRequest) (*task.ListCommentsResponse, error) {
+	userID := ctxutil.MustGetUserIDFromCtx(ctx)
+
+	res, err := t.taskDomain.ListComments(ctx, &service.ListCommentsRequest{
+		UserID:   userID,
+		TaskID:   req.GetTaskID(),
+		Cursor:   req.GetCursor(),
+		PageSize: int(req.GetPageSize()),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &task.ListCommentsResponse{
+		Data:       langslice.Transform(res.Comments, commentDO2DTO),
+		NextCursor: res.NextCursor,
+		HasMore:    res.HasMore,
+	}, nil
+}
+
+func commentDO2DTO(comment *entity.Comment) *task.Comment {
+	return &task.Comment{
+		CommentID: comment.ID,
+		TaskID:    comment.TaskID,
+		UserID:    comment.UserID,
+		Content:   comment.Content,
+		CreatedAt: comment.CreatedAt / 1000,
+		UpdatedAt: comment.UpdatedAt / 1000,
+	}
+}
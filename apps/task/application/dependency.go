@@ -0,0 +1,30 @@
+package application
+
+import (
+	"context"
+
+	"github.com/crazyfrankie/zrpc-todolist/pkg/zrpc/ctxutil"
+	"github.com/crazyfrankie/zrpc-todolist/protocol/task"
+)
+
+func (t *TaskApplicationService) AddDependency(ctx context.Context, req *task.AddDependencyRequest) (*task.AddDependencyResponse, error) {
+	userID := ctxutil.MustGetUserIDFromCtx(ctx)
+
+	err := t.taskDomain.AddDependency(ctx, userID, req.GetTaskID(), req.GetBlockerID())
+	if err != nil {
+		return nil, err
+	}
+
+	return &task.AddDependencyResponse{}, nil
+}
+
+func (t *TaskApplicationService) RemoveDependency(ctx context.Context, req *task.RemoveDependencyRequest) (*task.RemoveDependencyResponse, error) {
+	userID := ctxutil.MustGetUserIDFromCtx(ctx)
+
+	err := t.taskDomain.RemoveDependency(ctx, userID, req.GetTaskID(), req.GetBlockerID())
+	if err != nil {
+		return nil, err
+	}
+
+	return &task.RemoveDependencyResponse{}, nil
+}
@@ -0,0 +1,37 @@
+package application
+
+import (
+	"context"
+
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/entity"
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/service"
+	langslice "github.com/crazyfrankie/zrpc-todolist/pkg/lang/slice"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/zrpc/ctxutil"
+	"github.com/crazyfrankie/zrpc-todolist/protocol/task"
+)
+
+func (t *TaskApplicationService) ListTaskEvents(ctx context.Context, req *task.ListTaskEventsRequest) (*task.ListTaskEventsResponse, error) {
+	userID := ctxutil.MustGetUserIDFromCtx(ctx)
+
+	res, err := t.taskDomain.ListTaskEvents(ctx, &service.ListTaskEventsRequest{
+		UserID:  userID,
+		AfterID: req.GetAfterId(),
+		Limit:   int(req.GetLimit()),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &task.ListTaskEventsResponse{
+		Data:    langslice.Transform(res.Events, taskEventDO2DTO),
+		HasMore: res.HasMore,
+	}, nil
+}
+
+func taskEventDO2DTO(event *entity.TaskEvent) *task.TaskEvent {
+	return &task.TaskEvent{
+		Id:     event.ID,
+		Type:   task.TaskEventType(event.Type),
+		TaskID: event.TaskID,
+	}
+}
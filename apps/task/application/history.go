@@ -0,0 +1,84 @@
+package application
+
+import (
+	"context"
+
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/entity"
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/service"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/lang/conv"
+	langslice "github.com/crazyfrankie/zrpc-todolist/pkg/lang/slice"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/zrpc/ctxutil"
+	"github.com/crazyfrankie/zrpc-todolist/protocol/task"
+)
+
+// timeFields are the task fields holding times, they are logged in milliseconds.
+var timeFields = map[string]bool{
+	"due_at":      true,
+	"remind_at":   true,
+	"reminded_at": true,
+}
+
+func (t *TaskApplicationService) GetTaskHistory(ctx context.Context, req *task.GetTaskHistoryRequest) (*task.GetTaskHistoryResponse, error) {
+	userID := ctxutil.MustGetUserIDFromCtx(ctx)
+
+	res, err := t.taskDomain.GetTaskHistory(ctx, &service.TaskHistoryRequest{
+		UserID:   userID,
+		TaskID:   req.GetTaskID(),
+		Cursor:   req.GetCursor(),
+		PageSize: int(req.GetPageSize()),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &task.GetTaskHistoryResponse{
+		Data:       langslice.Transform(res.Activities, activityDO2DTO),
+		NextCursor: res.NextCursor,
+		HasMore:    res.HasMore,
+	}, nil
+}
+
+func (t *TaskApplicationService) RevertTask(ctx context.Context, req *task.RevertTaskRequest) (*task.RevertTaskResponse, error) {
+	userID := ctxutil.MustGetUserIDFromCtx(ctx)
+
+	taskInfo, err := t.taskDomain.RevertTask(ctx, userID, req.GetTaskID(), req.GetVersion())
+	if err != nil {
+		return nil, err
+	}
+
+	return &task.RevertTaskResponse{
+		Data: taskDO2DTO(taskInfo),
+	}, nil
+}
+
+func activityDO2DTO(activity *entity.TaskActivity) *task.TaskActivity {
+	return &task.TaskActivity{
+		ActivityID: activity.ID,
+		TaskID:     activity.TaskID,
+		UserId:     activity.UserID,
+		Action:     task.ActivityAction(activity.Action),
+		Source:     task.ActivitySource(activity.Source),
+		Version:    activity.Version,
+		Changes: langslice.Transform(activity.Changes, func(change *entity.FieldChange) *task.FieldChange {
+			return &task.FieldChange{
+				Field:    change.Field,
+				OldValue: fieldValueDO2DTO(change.Field, change.Old),
+				NewValue: fieldValueDO2DTO(change.Field, change.New),
+			}
+		}),
+		CreatedAt: activity.CreatedAt / 1000,
+	}
+}
+
+func fieldValueDO2DTO(field string, value *string) *string {
+	if value == nil || !timeFields[field] {
+		return value
+	}
+
+	ms, err := conv.StrToInt64(*value)
+	if err != nil {
+		return value
+	}
+	sec := conv.Int64ToStr(ms / 1000)
+	return &sec
+}
@@ -0,0 +1,99 @@
+package application
+
+import (
+	"context"
+
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/entity"
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/service"
+	langslice "github.com/crazyfrankie/zrpc-todolist/pkg/lang/slice"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/zrpc/ctxutil"
+	"github.com/crazyfrankie/zrpc-todolist/protocol/task"
+)
+
+func (t *TaskApplicationService) CreateProject(ctx context.Context, req *task.CreateProjectRequest) (*task.CreateProjectResponse, error) {
+	userID := ctxutil.MustGetUserIDFromCtx(ctx)
+
+	project, err := t.projectDomain.CreateProject(ctx, userID, req.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	return &task.CreateProjectResponse{
+		Data: projectDO2DTO(project),
+	}, nil
+}
+
+func (t *TaskApplicationService) RenameProject(ctx context.Context, req *task.RenameProjectRequest) (*task.RenameProjectResponse, error) {
+	userID := ctxutil.MustGetUserIDFromCtx(ctx)
+
+	project, err := t.projectDomain.RenameProject(ctx, userID, req.GetProjectID(), req.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	return &task.RenameProjectResponse{
+		Data: projectDO2DTO(project),
+	}, nil
+}
+
+func (t *TaskApplicationService) ArchiveProject(ctx context.Context, req *task.ArchiveProjectRequest) (*task.ArchiveProjectResponse, error) {
+	userID := ctxutil.MustGetUserIDFromCtx(ctx)
+
+	err := t.projectDomain.ArchiveProject(ctx, userID, req.GetProjectID(), req.GetArchived())
+	if err != nil {
+		return nil, err
+	}
+
+	return &task.ArchiveProjectResponse{}, nil
+}
+
+func (t *TaskApplicationService) ReorderProjects(ctx context.Context, req *task.ReorderProjectsRequest) (*task.ReorderProjectsResponse, error) {
+	userID := ctxutil.MustGetUserIDFromCtx(ctx)
+
+	err := t.projectDomain.ReorderProjects(ctx, userID, req.GetProjectIds())
+	if err != nil {
+		return nil, err
+	}
+
+	return &task.ReorderProjectsResponse{}, nil
+}
+
+func (t *TaskApplicationService) DeleteProject(ctx context.Context, req *task.DeleteProjectRequest) (*task.DeleteProjectResponse, error) {
+	userID := ctxutil.MustGetUserIDFromCtx(ctx)
+
+	err := t.projectDomain.DeleteProject(ctx, &service.DeleteProjectRequest{
+		UserID:          userID,
+		ProjectID:       req.GetProjectID(),
+		MoveToProjectID: req.GetMoveToProjectId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &task.DeleteProjectResponse{}, nil
+}
+
+func (t *TaskApplicationService) ListProjects(ctx context.Context, req *task.ListProjectsRequest) (*task.ListProjectsResponse, error) {
+	userID := ctxutil.MustGetUserIDFromCtx(ctx)
+
+	projects, err := t.projectDomain.ListProjects(ctx, userID, req.GetIncludeArchived())
+	if err != nil {
+		return nil, err
+	}
+
+	return &task.ListProjectsResponse{
+		Data: langslice.Transform(projects, projectDO2DTO),
+	}, nil
+}
+
+func projectDO2DTO(projectDo *entity.Project) *task.Project {
+	return &task.Project{
+		ProjectID: projectDo.ID,
+		Name:      projectDo.Name,
+		Position:  projectDo.Position,
+		Inbox:     projectDo.Inbox,
+		Archived:  projectDo.Archived,
+		CreatedAt: projectDo.CreatedAt / 1000,
+		UpdatedAt: projectDo.UpdatedAt / 1000,
+	}
+}
@@ -0,0 +1,40 @@
+package application
+
+import (
+	"context"
+
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/entity"
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/service"
+	langslice "github.com/crazyfrankie/zrpc-todolist/pkg/lang/slice"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/zrpc/ctxutil"
+	"github.com/crazyfrankie/zrpc-todolist/protocol/task"
+)
+
+func (t *TaskApplicationService) QuickAddTask(ctx context.Context, req *task.QuickAddTaskRequest) (*task.QuickAddTaskResponse, error) {
+	userID := ctxutil.MustGetUserIDFromCtx(ctx)
+
+	added, err := t.taskDomain.QuickAddTask(ctx, &service.QuickAddRequest{
+		UserID:    userID,
+		Text:      req.GetText(),
+		TimeZone:  req.GetTimeZone(),
+		ProjectID: req.GetProjectId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &task.QuickAddTaskResponse{
+		Data:   taskDO2DTO(added.Task),
+		Tokens: langslice.Transform(added.Tokens, quickAddTokenDO2DTO),
+		AllDay: added.AllDay,
+	}, nil
+}
+
+func quickAddTokenDO2DTO(token *entity.QuickAddToken) *task.QuickAddToken {
+	return &task.QuickAddToken{
+		Kind:  task.QuickAddTokenKind(token.Kind),
+		Start: token.Start,
+		End:   token.End,
+		Text:  token.Text,
+	}
+}
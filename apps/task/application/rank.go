@@ -0,0 +1,43 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/service"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/logs"
+)
+
+const rebalanceInterval = 10 * time.Minute
+
+// RankRebalancer periodically spreads out the task ranks which grew too long
+// from repeated moves into the same gap. Rebalancing keeps the order, so every
+// replica of the task service may run one.
+type RankRebalancer struct {
+	taskDomain service.Task
+}
+
+func NewRankRebalancer(taskDomain service.Task) *RankRebalancer {
+	return &RankRebalancer{taskDomain: taskDomain}
+}
+
+func (r *RankRebalancer) Run(ctx context.Context) {
+	ticker := time.NewTicker(rebalanceInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			rebalanced, err := r.taskDomain.RebalanceRanks(ctx)
+			if err != nil {
+				logs.CtxErrorf(ctx, "rebalance task ranks failed, err=%v", err)
+				continue
+			}
+			if rebalanced > 0 {
+				logs.CtxInfof(ctx, "rebalanced the task ranks of %d users", rebalanced)
+			}
+		}
+	}
+}
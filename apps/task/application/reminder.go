@@ -0,0 +1,51 @@
+package application
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/service"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/logs"
+	"github.com/crazyfrankie/zrpc-todolist/types/consts"
+)
+
+const defaultReminderInterval = 30 * time.Second
+
+// ReminderScheduler periodically dispatches the due task reminders. Every
+// replica of the task service runs one, the domain makes sure each reminder
+// is fired only once.
+type ReminderScheduler struct {
+	taskDomain service.Task
+	interval   time.Duration
+}
+
+func NewReminderScheduler(taskDomain service.Task) *ReminderScheduler {
+	interval, err := time.ParseDuration(os.Getenv(consts.ReminderInterval))
+	if err != nil || interval <= 0 {
+		interval = defaultReminderInterval
+	}
+
+	return &ReminderScheduler{taskDomain: taskDomain, interval: interval}
+}
+
+func (r *ReminderScheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			sent, err := r.taskDomain.DispatchDueReminders(ctx, now)
+			if err != nil {
+				logs.CtxErrorf(ctx, "dispatch task reminders failed, err=%v", err)
+				continue
+			}
+			if sent > 0 {
+				logs.CtxInfof(ctx, "dispatched %d task reminders", sent)
+			}
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package application
+
+import (
+	"context"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/service"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/logs"
+	"github.com/crazyfrankie/zrpc-todolist/types/consts"
+)
+
+const (
+	defaultRetentionDays = 30
+	purgeInterval        = time.Hour
+)
+
+// RecycleBinPurger periodically purges the tasks which have been in the
+// recycle bin longer than the retention period. Purging is idempotent, so
+// every replica of the task service may run one.
+type RecycleBinPurger struct {
+	taskDomain service.Task
+	retention  time.Duration
+}
+
+func NewRecycleBinPurger(taskDomain service.Task) *RecycleBinPurger {
+	days, err := strconv.Atoi(os.Getenv(consts.RecycleBinRetentionDays))
+	if err != nil || days <= 0 {
+		days = defaultRetentionDays
+	}
+
+	return &RecycleBinPurger{taskDomain: taskDomain, retention: time.Duration(days) * 24 * time.Hour}
+}
+
+func (p *RecycleBinPurger) Run(ctx context.Context) {
+	ticker := time.NewTicker(purgeInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			purged, err := p.taskDomain.PurgeExpiredTasks(ctx, now.Add(-p.retention))
+			if err != nil {
+				logs.CtxErrorf(ctx, "purge expired tasks failed, err=%v", err)
+				continue
+			}
+			if purged > 0 {
+				logs.CtxInfof(ctx, "purged %d expired tasks from the recycle bin", purged)
+			}
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package application
+
+import (
+	"context"
+
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/entity"
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/service"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/lang/ptr"
+	langslice "github.com/crazyfrankie/zrpc-todolist/pkg/lang/slice"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/zrpc/ctxutil"
+	"github.com/crazyfrankie/zrpc-todolist/protocol/task"
+)
+
+func (t *TaskApplicationService) SyncTasks(ctx context.Context, req *task.SyncTasksRequest) (*task.SyncTasksResponse, error) {
+	userID := ctxutil.MustGetUserIDFromCtx(ctx)
+
+	res, err := t.taskDomain.SyncTasks(ctx, &service.SyncTasksRequest{
+		UserID:     userID,
+		SinceToken: req.GetSinceToken(),
+		PageSize:   int(req.GetPageSize()),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &task.SyncTasksResponse{
+		Changes:   langslice.Transform(res.Changes, taskChangeDO2DTO),
+		NextToken: res.NextToken,
+		HasMore:   res.HasMore,
+	}, nil
+}
+
+func (t *TaskApplicationService) PushTaskChanges(ctx context.Context, req *task.PushTaskChangesRequest) (*task.PushTaskChangesResponse, error) {
+	userID := ctxutil.MustGetUserIDFromCtx(ctx)
+
+	results, err := t.taskDomain.PushTaskChanges(ctx, userID, langslice.Transform(req.GetChanges(),
+		func(change *task.ClientTaskChange) *service.ClientChange {
+			return clientChangeDTO2DO(userID, change)
+		}))
+	if err != nil {
+		return nil, err
+	}
+
+	return &task.PushTaskChangesResponse{
+		Data: langslice.Transform(results, batchResultDO2DTO),
+	}, nil
+}
+
+func clientChangeDTO2DO(userID int64, change *task.ClientTaskChange) *service.ClientChange {
+	res := &service.ClientChange{
+		Op:          entity.ClientChangeOp(change.GetOp()),
+		TaskID:      change.GetTaskID(),
+		BaseVersion: change.GetBaseVersion(),
+	}
+	if change.Create != nil {
+		res.Create = createTaskDTO2DO(userID, change.GetCreate())
+	}
+	if change.Update != nil {
+		res.Update = updateTaskDTO2DO(userID, change.GetUpdate())
+	}
+	if change.Status != nil {
+		res.Status = ptr.Of(entity.Status(change.GetStatus()))
+	}
+
+	return res
+}
+
+func taskChangeDO2DTO(change *entity.TaskChange) *task.TaskChange {
+	res := &task.TaskChange{
+		TaskID:  change.TaskID,
+		Deleted: change.Deleted,
+	}
+	if change.Task != nil {
+		res.Data = taskDO2DTO(change.Task)
+	}
+
+	return res
+}
@@ -0,0 +1,81 @@
+package application
+
+import (
+	"context"
+
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/entity"
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/service"
+	langslice "github.com/crazyfrankie/zrpc-todolist/pkg/lang/slice"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/zrpc/ctxutil"
+	"github.com/crazyfrankie/zrpc-todolist/protocol/task"
+)
+
+func (t *TaskApplicationService) CreateTag(ctx context.Context, req *task.CreateTagRequest) (*task.CreateTagResponse, error) {
+	userID := ctxutil.MustGetUserIDFromCtx(ctx)
+
+	tag, err := t.tagDomain.CreateTag(ctx, &service.CreateTagRequest{
+		UserID: userID,
+		Name:   req.GetName(),
+		Color:  req.GetColor(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &task.CreateTagResponse{
+		Data: tagDO2DTO(tag),
+	}, nil
+}
+
+func (t *TaskApplicationService) UpdateTag(ctx context.Context, req *task.UpdateTagRequest) (*task.UpdateTagResponse, error) {
+	userID := ctxutil.MustGetUserIDFromCtx(ctx)
+
+	tag, err := t.tagDomain.UpdateTag(ctx, &service.UpdateTagRequest{
+		UserID: userID,
+		TagID:  req.GetTagID(),
+		Name:   req.Name,
+		Color:  req.Color,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &task.UpdateTagResponse{
+		Data: tagDO2DTO(tag),
+	}, nil
+}
+
+func (t *TaskApplicationService) DeleteTag(ctx context.Context, req *task.DeleteTagRequest) (*task.DeleteTagResponse, error) {
+	userID := ctxutil.MustGetUserIDFromCtx(ctx)
+
+	err := t.tagDomain.DeleteTag(ctx, userID, req.GetTagID())
+	if err != nil {
+		return nil, err
+	}
+
+	return &task.DeleteTagResponse{}, nil
+}
+
+func (t *TaskApplicationService) ListTags(ctx context.Context, req *task.ListTagsRequest) (*task.ListTagsResponse, error) {
+	userID := ctxutil.MustGetUserIDFromCtx(ctx)
+
+	tags, err := t.tagDomain.ListTags(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &task.ListTagsResponse{
+		Data: langslice.Transform(tags, tagDO2DTO),
+	}, nil
+}
+
+func tagDO2DTO(tagDo *entity.Tag) *task.Tag {
+	return &task.Tag{
+		TagID:     tagDo.ID,
+		Name:      tagDo.Name,
+		Color:     tagDo.Color,
+		TaskCount: tagDo.TaskCount,
+		CreatedAt: tagDo.CreatedAt / 1000,
+		UpdatedAt: tagDo.UpdatedAt / 1000,
+	}
+}
@@ -2,31 +2,35 @@ package application
 
 import (
 	"context"
+	"time"
 
 	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/entity"
 	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/service"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/errorx"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/lang/ptr"
 	langslice "github.com/crazyfrankie/zrpc-todolist/pkg/lang/slice"
 	"github.com/crazyfrankie/zrpc-todolist/pkg/zrpc/ctxutil"
 	"github.com/crazyfrankie/zrpc-todolist/protocol/task"
+	"github.com/crazyfrankie/zrpc-todolist/types/errno"
 )
 
 type TaskApplicationService struct {
-	taskDomain service.Task
+	taskDomain    service.Task
+	tagDomain     service.Tag
+	projectDomain service.Project
+	boardDomain   service.Board
+	webhookDomain service.Webhook
 	task.UnimplementedTaskServiceServer
 }
 
-func NewTaskApplicationService(taskDomain service.Task) *TaskApplicationService {
-	return &TaskApplicationService{taskDomain: taskDomain}
+func NewTaskApplicationService(taskDomain service.Task, tagDomain service.Tag, projectDomain service.Project, boardDomain service.Board, webhookDomain service.Webhook) *TaskApplicationService {
+	return &TaskApplicationService{taskDomain: taskDomain, tagDomain: tagDomain, projectDomain: projectDomain, boardDomain: boardDomain, webhookDomain: webhookDomain}
 }
 
 func (t *TaskApplicationService) AddTask(ctx context.Context, req *task.AddTaskRequest) (*task.AddTaskResponse, error) {
 	userID := ctxutil.MustGetUserIDFromCtx(ctx)
 
-	newTask, err := t.taskDomain.Create(ctx, &service.CreateTaskRequest{
-		UserID:  userID,
-		Title:   req.GetTitle(),
-		Content: req.GetContent(),
-	})
+	newTask, err := t.taskDomain.Create(ctx, createTaskDTO2DO(userID, req))
 	if err != nil {
 		return nil, err
 	}
@@ -36,27 +40,47 @@ func (t *TaskApplicationService) AddTask(ctx context.Context, req *task.AddTaskR
 	}, nil
 }
 
+func (t *TaskApplicationService) GetTask(ctx context.Context, req *task.GetTaskRequest) (*task.GetTaskResponse, error) {
+	userID := ctxutil.MustGetUserIDFromCtx(ctx)
+
+	taskInfo, err := t.taskDomain.GetTask(ctx, userID, req.GetTaskID())
+	if err != nil {
+		return nil, err
+	}
+
+	return &task.GetTaskResponse{
+		Data: taskDO2DTO(taskInfo),
+	}, nil
+}
+
 func (t *TaskApplicationService) ListTasks(ctx context.Context, req *task.ListTasksRequest) (*task.ListTasksResponse, error) {
 	userID := ctxutil.MustGetUserIDFromCtx(ctx)
 
-	tasks, err := t.taskDomain.GetTaskList(ctx, userID)
+	listReq := listOptionDTO2DO(userID, req.GetOption())
+	listReq.Statuses = statusesDTO2DO(req.GetStatuses())
+	listReq.TagIDs = req.GetTagIds()
+	listReq.ProjectID = req.GetProjectId()
+	listReq.Tree = req.GetTree()
+	listReq.Actionable = req.GetActionable()
+
+	res, err := t.taskDomain.GetTaskList(ctx, listReq)
 	if err != nil {
 		return nil, err
 	}
 
 	return &task.ListTasksResponse{
-		Data: langslice.Transform(tasks, func(task *entity.Task) *task.Task {
+		Data: langslice.Transform(res.Tasks, func(task *entity.Task) *task.Task {
 			return taskDO2DTO(task)
 		}),
+		NextCursor: res.NextCursor,
+		HasMore:    res.HasMore,
 	}, nil
 }
 
 func (t *TaskApplicationService) UpdateTask(ctx context.Context, req *task.UpdateTaskRequest) (*task.UpdateTaskResponse, error) {
-	err := t.taskDomain.UpdateTask(ctx, &service.UpdateTaskRequest{
-		TaskID:  req.GetTaskID(),
-		Content: req.Content,
-		Title:   req.Title,
-	})
+	userID := ctxutil.MustGetUserIDFromCtx(ctx)
+
+	err := t.taskDomain.UpdateTask(ctx, updateTaskDTO2DO(userID, req))
 	if err != nil {
 		return nil, err
 	}
@@ -65,7 +89,9 @@ func (t *TaskApplicationService) UpdateTask(ctx context.Context, req *task.Updat
 }
 
 func (t *TaskApplicationService) UpdateTaskStatus(ctx context.Context, req *task.UpdateTaskStatusRequest) (*task.UpdateTaskStatusResponse, error) {
-	err := t.taskDomain.UpdateTaskStatus(ctx, req.GetTaskID(), req.GetStatus())
+	userID := ctxutil.MustGetUserIDFromCtx(ctx)
+
+	err := t.taskDomain.UpdateTaskStatus(ctx, userID, req.GetTaskID(), entity.Status(req.GetStatus()))
 	if err != nil {
 		return nil, err
 	}
@@ -73,28 +99,317 @@ func (t *TaskApplicationService) UpdateTaskStatus(ctx context.Context, req *task
 	return &task.UpdateTaskStatusResponse{}, nil
 }
 
+func (t *TaskApplicationService) MoveTask(ctx context.Context, req *task.MoveTaskRequest) (*task.MoveTaskResponse, error) {
+	userID := ctxutil.MustGetUserIDFromCtx(ctx)
+
+	taskInfo, err := t.taskDomain.MoveTask(ctx, &service.MoveTaskRequest{
+		UserID:   userID,
+		TaskID:   req.GetTaskID(),
+		BeforeID: req.GetBeforeId(),
+		AfterID:  req.GetAfterId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &task.MoveTaskResponse{
+		Data: taskDO2DTO(taskInfo),
+	}, nil
+}
+
 func (t *TaskApplicationService) RecycleBin(ctx context.Context, req *task.RecycleBinRequest) (*task.RecycleBinResponse, error) {
 	userID := ctxutil.MustGetUserIDFromCtx(ctx)
 
-	tasks, err := t.taskDomain.GetTaskRecycleList(ctx, userID)
+	res, err := t.taskDomain.GetTaskRecycleList(ctx, listOptionDTO2DO(userID, req.GetOption()))
 	if err != nil {
 		return nil, err
 	}
 
 	return &task.RecycleBinResponse{
+		Data: langslice.Transform(res.Tasks, func(task *entity.Task) *task.Task {
+			return taskDO2DTO(task)
+		}),
+		NextCursor: res.NextCursor,
+		HasMore:    res.HasMore,
+	}, nil
+}
+
+func (t *TaskApplicationService) DeleteTask(ctx context.Context, req *task.DeleteTaskRequest) (*task.DeleteTaskResponse, error) {
+	userID := ctxutil.MustGetUserIDFromCtx(ctx)
+
+	err := t.taskDomain.DeleteTask(ctx, userID, req.GetTaskID())
+	if err != nil {
+		return nil, err
+	}
+
+	return &task.DeleteTaskResponse{}, nil
+}
+
+func (t *TaskApplicationService) RestoreTask(ctx context.Context, req *task.RestoreTaskRequest) (*task.RestoreTaskResponse, error) {
+	userID := ctxutil.MustGetUserIDFromCtx(ctx)
+
+	err := t.taskDomain.RestoreTask(ctx, userID, req.GetTaskID())
+	if err != nil {
+		return nil, err
+	}
+
+	return &task.RestoreTaskResponse{}, nil
+}
+
+func (t *TaskApplicationService) PurgeTask(ctx context.Context, req *task.PurgeTaskRequest) (*task.PurgeTaskResponse, error) {
+	userID := ctxutil.MustGetUserIDFromCtx(ctx)
+
+	err := t.taskDomain.PurgeTask(ctx, userID, req.GetTaskID())
+	if err != nil {
+		return nil, err
+	}
+
+	return &task.PurgeTaskResponse{}, nil
+}
+
+func (t *TaskApplicationService) EmptyRecycleBin(ctx context.Context, req *task.EmptyRecycleBinRequest) (*task.EmptyRecycleBinResponse, error) {
+	userID := ctxutil.MustGetUserIDFromCtx(ctx)
+
+	purged, err := t.taskDomain.EmptyRecycleBin(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &task.EmptyRecycleBinResponse{Purged: purged}, nil
+}
+
+func (t *TaskApplicationService) SearchTasks(ctx context.Context, req *task.SearchTasksRequest) (*task.SearchTasksResponse, error) {
+	userID := ctxutil.MustGetUserIDFromCtx(ctx)
+
+	res, err := t.taskDomain.SearchTasks(ctx, &service.SearchTasksRequest{
+		UserID:   userID,
+		Keyword:  req.GetKeyword(),
+		Statuses: statusesDTO2DO(req.GetStatuses()),
+		Page:     int(req.GetPage()),
+		PageSize: int(req.GetPageSize()),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &task.SearchTasksResponse{
+		Data: langslice.Transform(res.Hits, func(hit *service.SearchHit) *task.SearchHit {
+			return &task.SearchHit{
+				Task:             taskDO2DTO(hit.Task),
+				Score:            hit.Score,
+				TitleHighlight:   hit.TitleHighlight,
+				ContentHighlight: hit.ContentHighlight,
+			}
+		}),
+		Total: res.Total,
+	}, nil
+}
+
+func (t *TaskApplicationService) ListDueTasks(ctx context.Context, req *task.ListDueTasksRequest) (*task.ListDueTasksResponse, error) {
+	userID := ctxutil.MustGetUserIDFromCtx(ctx)
+
+	loc, err := time.LoadLocation(req.GetTimeZone())
+	if err != nil {
+		return nil, errorx.WrapByCode(err, errno.ErrTaskInvalidParamCode,
+			errorx.KV("msg", "invalid time zone"))
+	}
+
+	view := entity.OverdueView
+	if req.GetView() == task.DueView_DUE_VIEW_TODAY {
+		view = entity.DueTodayView
+	}
+
+	tasks, err := t.taskDomain.ListDueTasks(ctx, &service.ListDueTasksRequest{
+		UserID:   userID,
+		View:     view,
+		Now:      time.Now(),
+		Location: loc,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &task.ListDueTasksResponse{
 		Data: langslice.Transform(tasks, func(task *entity.Task) *task.Task {
 			return taskDO2DTO(task)
 		}),
 	}, nil
 }
 
+func (t *TaskApplicationService) PreviewOccurrences(ctx context.Context, req *task.PreviewOccurrencesRequest) (*task.PreviewOccurrencesResponse, error) {
+	userID := ctxutil.MustGetUserIDFromCtx(ctx)
+
+	occurrences, err := t.taskDomain.PreviewOccurrences(ctx, &service.PreviewOccurrencesRequest{
+		UserID:     userID,
+		TaskID:     req.GetTaskID(),
+		Recurrence: recurrenceDTO2DO(req.GetRecurrence()),
+		Start:      req.GetStart() * 1000,
+		Count:      int(req.GetCount()),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &task.PreviewOccurrencesResponse{
+		Occurrences: langslice.Transform(occurrences, func(ms int64) int64 {
+			return ms / 1000
+		}),
+	}, nil
+}
+
+func createTaskDTO2DO(userID int64, req *task.AddTaskRequest) *service.CreateTaskRequest {
+	return &service.CreateTaskRequest{
+		UserID:     userID,
+		Title:      req.GetTitle(),
+		Content:    req.GetContent(),
+		DueAt:      secondsPtrToMilli(req.DueAt),
+		RemindAt:   secondsPtrToMilli(req.RemindAt),
+		Recurrence: recurrenceDTO2DO(req.GetRecurrence()),
+		TagIDs:     req.GetTagIds(),
+		ProjectID:  req.GetProjectId(),
+		ParentID:   req.GetParentId(),
+		Priority:   entity.Priority(req.GetPriority()),
+
+		AutoComplete: req.GetAutoComplete(),
+	}
+}
+
+func updateTaskDTO2DO(userID int64, req *task.UpdateTaskRequest) *service.UpdateTaskRequest {
+	scope := entity.ThisOccurrence
+	if req.GetScope() == task.UpdateScope_UPDATE_SCOPE_SERIES {
+		scope = entity.WholeSeries
+	}
+	var priority *entity.Priority
+	if req.Priority != nil {
+		priority = ptr.Of(entity.Priority(req.GetPriority()))
+	}
+
+	return &service.UpdateTaskRequest{
+		UserID:     userID,
+		TaskID:     req.GetTaskID(),
+		Content:    req.Content,
+		Title:      req.Title,
+		DueAt:      secondsPtrToMilli(req.DueAt),
+		RemindAt:   secondsPtrToMilli(req.RemindAt),
+		Recurrence: recurrenceDTO2DO(req.GetRecurrence()),
+		Scope:      scope,
+		Version:    req.Version,
+		ProjectID:  req.ProjectId,
+		ParentID:   req.ParentId,
+		Priority:   priority,
+
+		AutoComplete: req.AutoComplete,
+		AttachTagIDs: req.GetAttachTagIds(),
+		DetachTagIDs: req.GetDetachTagIds(),
+
+		ClearDueAt:      req.GetClearDueAt(),
+		ClearRemindAt:   req.GetClearRemindAt(),
+		ClearRecurrence: req.GetClearRecurrence(),
+	}
+}
+
 func taskDO2DTO(taskDo *entity.Task) *task.Task {
 	return &task.Task{
-		TaskID:    taskDo.ID,
-		Title:     taskDo.Title,
-		Content:   taskDo.Content,
-		Status:    taskDo.Status.String(),
-		CreatedAt: taskDo.CreatedAt / 1000,
-		UpdatedAt: taskDo.UpdatedAt / 1000,
+		TaskID:     taskDo.ID,
+		ProjectId:  taskDo.ProjectID,
+		Title:      taskDo.Title,
+		Content:    taskDo.Content,
+		Status:     task.TaskStatus(taskDo.Status),
+		Priority:   task.TaskPriority(taskDo.Priority),
+		Rank:       taskDo.Rank,
+		CreatedAt:  taskDo.CreatedAt / 1000,
+		UpdatedAt:  taskDo.UpdatedAt / 1000,
+		DueAt:      milliPtrToSeconds(taskDo.DueAt),
+		RemindAt:   milliPtrToSeconds(taskDo.RemindAt),
+		SeriesId:   taskDo.SeriesID,
+		Recurrence: recurrenceDO2DTO(taskDo.Recurrence),
+		Version:    taskDo.Version,
+		Tags:       langslice.Transform(taskDo.Tags, tagDO2DTO),
+		ParentId:   taskDo.ParentID,
+		Checklist:  langslice.Transform(taskDo.Checklist, checklistItemDO2DTO),
+		Children:   langslice.Transform(taskDo.Children, taskDO2DTO),
+		BlockerIds: taskDo.BlockerIDs,
+		Blocked:    taskDo.Blocked,
+
+		AutoComplete:      taskDo.AutoComplete,
+		CompletionPercent: taskDo.Progress,
+		CommentCount:      taskDo.CommentCount,
+	}
+}
+
+func statusesDTO2DO(statuses []task.TaskStatus) []entity.Status {
+	return langslice.Transform(statuses, func(s task.TaskStatus) entity.Status {
+		return entity.Status(s)
+	})
+}
+
+func recurrenceDTO2DO(rec *task.Recurrence) *entity.Recurrence {
+	if rec == nil {
+		return nil
+	}
+	return &entity.Recurrence{
+		Rule:     rec.GetRule(),
+		TimeZone: rec.GetTimeZone(),
+	}
+}
+
+func recurrenceDO2DTO(rec *entity.Recurrence) *task.Recurrence {
+	if rec == nil {
+		return nil
+	}
+	return &task.Recurrence{
+		Rule:     rec.Rule,
+		TimeZone: rec.TimeZone,
+	}
+}
+
+func listOptionDTO2DO(userID int64, opt *task.ListOption) *service.ListTasksRequest {
+	req := &service.ListTasksRequest{
+		UserID:   userID,
+		PageSize: int(opt.GetPageSize()),
+		Cursor:   opt.GetCursor(),
+		SortBy:   entity.SortByCreatedAt,
+		Asc:      opt.GetSortOrder() == task.SortOrder_SORT_ORDER_ASC,
+
+		CreatedAfter:  secondsToMilli(opt.GetCreatedAfter(), false),
+		CreatedBefore: secondsToMilli(opt.GetCreatedBefore(), true),
+		UpdatedAfter:  secondsToMilli(opt.GetUpdatedAfter(), false),
+		UpdatedBefore: secondsToMilli(opt.GetUpdatedBefore(), true),
+	}
+	switch opt.GetSortField() {
+	case task.SortField_SORT_FIELD_UPDATED_AT:
+		req.SortBy = entity.SortByUpdatedAt
+	case task.SortField_SORT_FIELD_PRIORITY:
+		req.SortBy = entity.SortByPriority
+	case task.SortField_SORT_FIELD_RANK:
+		req.SortBy = entity.SortByRank
+	}
+
+	return req
+}
+
+// secondsToMilli converts an inclusive bound in seconds to milliseconds,
+// an upper bound covers the whole second.
+func secondsToMilli(sec int64, upper bool) int64 {
+	if sec <= 0 {
+		return 0
+	}
+	if upper {
+		return sec*1000 + 999
+	}
+	return sec * 1000
+}
+
+func secondsPtrToMilli(sec *int64) *int64 {
+	if sec == nil {
+		return nil
+	}
+	return ptr.Of(*sec * 1000)
+}
+
+func milliPtrToSeconds(ms *int64) *int64 {
+	if ms == nil {
+		return nil
 	}
+	return ptr.Of(*ms / 1000)
 }
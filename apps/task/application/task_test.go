@@ -0,0 +1,143 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/entity"
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/service"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/ctxcache"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/errorx"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/lang/conv"
+	"github.com/crazyfrankie/zrpc-todolist/protocol/task"
+	"github.com/crazyfrankie/zrpc-todolist/types/errno"
+)
+
+// ownedTaskDomain holds a single task of ownerID and, like the task domain,
+// reports it missing to every other user. It records the calls which found
+// the task. The other methods panic.
+type ownedTaskDomain struct {
+	service.Task
+	task  *entity.Task
+	calls []string
+}
+
+func (d *ownedTaskDomain) owned(call string, userID, taskID int64) error {
+	if userID != d.task.UserID || taskID != d.task.ID {
+		return errorx.New(errno.ErrTaskNotFoundCode, errorx.KV("task_id", conv.Int64ToStr(taskID)))
+	}
+	d.calls = append(d.calls, call)
+	return nil
+}
+
+func (d *ownedTaskDomain) GetTask(ctx context.Context, userID, taskID int64) (*entity.Task, error) {
+	if err := d.owned("GetTask", userID, taskID); err != nil {
+		return nil, err
+	}
+	return d.task, nil
+}
+
+func (d *ownedTaskDomain) UpdateTask(ctx context.Context, req *service.UpdateTaskRequest) error {
+	return d.owned("UpdateTask", req.UserID, req.TaskID)
+}
+
+func (d *ownedTaskDomain) UpdateTaskStatus(ctx context.Context, userID, taskID int64, status entity.Status) error {
+	return d.owned("UpdateTaskStatus", userID, taskID)
+}
+
+func (d *ownedTaskDomain) DeleteTask(ctx context.Context, userID, taskID int64) error {
+	return d.owned("DeleteTask", userID, taskID)
+}
+
+func (d *ownedTaskDomain) RestoreTask(ctx context.Context, userID, taskID int64) error {
+	return d.owned("RestoreTask", userID, taskID)
+}
+
+func (d *ownedTaskDomain) PurgeTask(ctx context.Context, userID, taskID int64) error {
+	return d.owned("PurgeTask", userID, taskID)
+}
+
+func userCtx(userID int64) context.Context {
+	ctx := ctxcache.Init(context.Background())
+	ctxcache.Store(ctx, "user_id", []string{conv.Int64ToStr(userID)})
+	return ctx
+}
+
+func TestTaskAccess(t *testing.T) {
+	const (
+		ownerID = 1
+		otherID = 2
+		taskID  = 10
+	)
+
+	calls := []struct {
+		name string
+		call func(svc *TaskApplicationService, ctx context.Context, taskID int64) error
+	}{
+		{"GetTask", func(svc *TaskApplicationService, ctx context.Context, taskID int64) error {
+			_, err := svc.GetTask(ctx, &task.GetTaskRequest{TaskID: taskID})
+			return err
+		}},
+		{"UpdateTask", func(svc *TaskApplicationService, ctx context.Context, taskID int64) error {
+			title := "mine now"
+			_, err := svc.UpdateTask(ctx, &task.UpdateTaskRequest{TaskID: taskID, Title: &title})
+			return err
+		}},
+		{"UpdateTaskStatus", func(svc *TaskApplicationService, ctx context.Context, taskID int64) error {
+			_, err := svc.UpdateTaskStatus(ctx, &task.UpdateTaskStatusRequest{TaskID: taskID, Status: task.TaskStatus_TASK_STATUS_DONE})
+			return err
+		}},
+		{"DeleteTask", func(svc *TaskApplicationService, ctx context.Context, taskID int64) error {
+			_, err := svc.DeleteTask(ctx, &task.DeleteTaskRequest{TaskID: taskID})
+			return err
+		}},
+		{"RestoreTask", func(svc *TaskApplicationService, ctx context.Context, taskID int64) error {
+			_, err := svc.RestoreTask(ctx, &task.RestoreTaskRequest{TaskID: taskID})
+			return err
+		}},
+		{"PurgeTask", func(svc *TaskApplicationService, ctx context.Context, taskID int64) error {
+			_, err := svc.PurgeTask(ctx, &task.PurgeTaskRequest{TaskID: taskID})
+			return err
+		}},
+	}
+
+	// another user's task looks just like a missing one
+	tests := []struct {
+		name   string
+		userID int64
+		taskID int64
+		code   int32
+	}{
+		{"other user", otherID, taskID, errno.ErrTaskNotFoundCode},
+		{"missing task", ownerID, taskID + 1, errno.ErrTaskNotFoundCode},
+		{"owner", ownerID, taskID, 0},
+	}
+	for _, tt := range tests {
+		for _, c := range calls {
+			t.Run(tt.name+"/"+c.name, func(t *testing.T) {
+				domain := &ownedTaskDomain{task: &entity.Task{ID: taskID, UserID: ownerID}}
+				svc := NewTaskApplicationService(domain, nil, nil, nil, nil)
+
+				err := c.call(svc, userCtx(tt.userID), tt.taskID)
+				if tt.code == 0 {
+					if err != nil {
+						t.Fatalf("err = %v, want nil", err)
+					}
+					if len(domain.calls) != 1 || domain.calls[0] != c.name {
+						t.Errorf("domain calls = %v, want a single %s", domain.calls, c.name)
+					}
+					return
+				}
+
+				var statusErr errorx.StatusError
+				if !errors.As(err, &statusErr) || statusErr.Code() != tt.code {
+					t.Fatalf("err = %v, want code %d", err, tt.code)
+				}
+				if len(domain.calls) > 0 {
+					t.Errorf("%v found the task", domain.calls)
+				}
+			})
+		}
+	}
+}
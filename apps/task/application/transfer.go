@@ -0,0 +1,50 @@
+package application
+
+import (
+	"context"
+
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/entity"
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/service"
+	langslice "github.com/crazyfrankie/zrpc-todolist/pkg/lang/slice"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/zrpc/ctxutil"
+	"github.com/crazyfrankie/zrpc-todolist/protocol/task"
+)
+
+func (t *TaskApplicationService) ExportTasks(ctx context.Context, req *task.ExportTasksRequest) (*task.ExportTasksResponse, error) {
+	userID := ctxutil.MustGetUserIDFromCtx(ctx)
+
+	url, err := t.taskDomain.ExportTasks(ctx, userID, entity.TransferFormat(req.GetFormat()))
+	if err != nil {
+		return nil, err
+	}
+
+	return &task.ExportTasksResponse{Url: url}, nil
+}
+
+func (t *TaskApplicationService) ImportTasks(ctx context.Context, req *task.ImportTasksRequest) (*task.ImportTasksResponse, error) {
+	userID := ctxutil.MustGetUserIDFromCtx(ctx)
+
+	report, err := t.taskDomain.ImportTasks(ctx, &service.ImportTasksRequest{
+		UserID:  userID,
+		Format:  entity.TransferFormat(req.GetFormat()),
+		Content: req.GetContent(),
+		DryRun:  req.GetDryRun(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &task.ImportTasksResponse{
+		Total:      report.Total,
+		Created:    report.Created,
+		Duplicates: report.Duplicates,
+		Errors:     langslice.Transform(report.Errors, importRowErrorDO2DTO),
+	}, nil
+}
+
+func importRowErrorDO2DTO(rowErr *entity.ImportRowError) *task.ImportRowError {
+	return &task.ImportRowError{
+		Row:     rowErr.Row,
+		Message: rowErr.Message,
+	}
+}
@@ -0,0 +1,210 @@
+package application
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/entity"
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/service"
+	langslice "github.com/crazyfrankie/zrpc-todolist/pkg/lang/slice"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/logs"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/zrpc/ctxutil"
+	"github.com/crazyfrankie/zrpc-todolist/protocol/task"
+	"github.com/crazyfrankie/zrpc-todolist/types/consts"
+)
+
+const (
+	defaultWebhookDispatchInterval = 5 * time.Second
+	// deliveryRetention is how long the log keeps finished deliveries.
+	deliveryRetention = 30 * 24 * time.Hour
+)
+
+func (t *TaskApplicationService) CreateWebhook(ctx context.Context, req *task.CreateWebhookRequest) (*task.CreateWebhookResponse, error) {
+	userID := ctxutil.MustGetUserIDFromCtx(ctx)
+
+	hook, err := t.webhookDomain.CreateWebhook(ctx, &service.CreateWebhookRequest{
+		UserID:     userID,
+		URL:        req.GetUrl(),
+		EventTypes: eventTypesDTO2DO(req.GetEventTypes()),
+		Secret:     req.GetSecret(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &task.CreateWebhookResponse{
+		Data: webhookDO2DTO(hook),
+	}, nil
+}
+
+func (t *TaskApplicationService) UpdateWebhook(ctx context.Context, req *task.UpdateWebhookRequest) (*task.UpdateWebhookResponse, error) {
+	userID := ctxutil.MustGetUserIDFromCtx(ctx)
+
+	hook, err := t.webhookDomain.UpdateWebhook(ctx, &service.UpdateWebhookRequest{
+		UserID:        userID,
+		WebhookID:     req.GetWebhookID(),
+		URL:           req.Url,
+		EventTypes:    eventTypesDTO2DO(req.GetEventTypes()),
+		AllEventTypes: req.GetAllEventTypes(),
+		Secret:        req.Secret,
+		Enabled:       req.Enabled,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &task.UpdateWebhookResponse{
+		Data: webhookDO2DTO(hook),
+	}, nil
+}
+
+func (t *TaskApplicationService) DeleteWebhook(ctx context.Context, req *task.DeleteWebhookRequest) (*task.DeleteWebhookResponse, error) {
+	userID := ctxutil.MustGetUserIDFromCtx(ctx)
+
+	err := t.webhookDomain.DeleteWebhook(ctx, userID, req.GetWebhookID())
+	if err != nil {
+		return nil, err
+	}
+
+	return &task.DeleteWebhookResponse{}, nil
+}
+
+func (t *TaskApplicationService) ListWebhooks(ctx context.Context, req *task.ListWebhooksRequest) (*task.ListWebhooksResponse, error) {
+	userID := ctxutil.MustGetUserIDFromCtx(ctx)
+
+	hooks, err := t.webhookDomain.ListWebhooks(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &task.ListWebhooksResponse{
+		Data: langslice.Transform(hooks, webhookDO2DTO),
+	}, nil
+}
+
+func (t *TaskApplicationService) ListWebhookDeliveries(ctx context.Context, req *task.ListWebhookDeliveriesRequest) (*task.ListWebhookDeliveriesResponse, error) {
+	userID := ctxutil.MustGetUserIDFromCtx(ctx)
+
+	var status *entity.DeliveryStatus
+	if req.Status != nil {
+		s := entity.DeliveryStatus(req.GetStatus())
+		status = &s
+	}
+	res, err := t.webhookDomain.ListDeliveries(ctx, &service.ListDeliveriesRequest{
+		UserID:    userID,
+		WebhookID: req.GetWebhookID(),
+		Status:    status,
+		Cursor:    req.GetCursor(),
+		PageSize:  int(req.GetPageSize()),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &task.ListWebhookDeliveriesResponse{
+		Data:       langslice.Transform(res.Deliveries, deliveryDO2DTO),
+		NextCursor: res.NextCursor,
+		HasMore:    res.HasMore,
+	}, nil
+}
+
+func (t *TaskApplicationService) ReplayWebhookDelivery(ctx context.Context, req *task.ReplayWebhookDeliveryRequest) (*task.ReplayWebhookDeliveryResponse, error) {
+	userID := ctxutil.MustGetUserIDFromCtx(ctx)
+
+	delivery, err := t.webhookDomain.ReplayDelivery(ctx, userID, req.GetDeliveryID())
+	if err != nil {
+		return nil, err
+	}
+
+	return &task.ReplayWebhookDeliveryResponse{
+		Data: deliveryDO2DTO(delivery),
+	}, nil
+}
+
+// WebhookDispatcher periodically posts the due webhook deliveries and purges
+// the old ones from the log. Every replica of the task service runs one, the
+// domain makes sure each attempt is made only once.
+type WebhookDispatcher struct {
+	webhookDomain service.Webhook
+	interval      time.Duration
+}
+
+func NewWebhookDispatcher(webhookDomain service.Webhook) *WebhookDispatcher {
+	interval, err := time.ParseDuration(os.Getenv(consts.WebhookDispatchInterval))
+	if err != nil || interval <= 0 {
+		interval = defaultWebhookDispatchInterval
+	}
+
+	return &WebhookDispatcher{webhookDomain: webhookDomain, interval: interval}
+}
+
+func (d *WebhookDispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+	purgeTicker := time.NewTicker(purgeInterval)
+	defer purgeTicker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			sent, err := d.webhookDomain.DispatchDueDeliveries(ctx, now)
+			if err != nil {
+				logs.CtxErrorf(ctx, "dispatch webhook deliveries failed, err=%v", err)
+				continue
+			}
+			if sent > 0 {
+				logs.CtxInfof(ctx, "delivered %d webhook events", sent)
+			}
+		case now := <-purgeTicker.C:
+			purged, err := d.webhookDomain.PurgeDeliveries(ctx, now.Add(-deliveryRetention))
+			if err != nil {
+				logs.CtxErrorf(ctx, "purge webhook deliveries failed, err=%v", err)
+				continue
+			}
+			if purged > 0 {
+				logs.CtxInfof(ctx, "purged %d webhook deliveries", purged)
+			}
+		}
+	}
+}
+
+func eventTypesDTO2DO(types []task.TaskEventType) []entity.TaskEventType {
+	return langslice.Transform(types, func(t task.TaskEventType) entity.TaskEventType {
+		return entity.TaskEventType(t)
+	})
+}
+
+func webhookDO2DTO(hook *entity.Webhook) *task.Webhook {
+	return &task.Webhook{
+		WebhookID: hook.ID,
+		Url:       hook.URL,
+		EventTypes: langslice.Transform(hook.EventTypes, func(t entity.TaskEventType) task.TaskEventType {
+			return task.TaskEventType(t)
+		}),
+		Secret:    hook.Secret,
+		Enabled:   hook.Enabled,
+		CreatedAt: hook.CreatedAt,
+		UpdatedAt: hook.UpdatedAt,
+	}
+}
+
+func deliveryDO2DTO(delivery *entity.WebhookDelivery) *task.WebhookDelivery {
+	return &task.WebhookDelivery{
+		DeliveryID:     delivery.ID,
+		WebhookID:      delivery.WebhookID,
+		EventId:        delivery.EventID,
+		EventType:      task.TaskEventType(delivery.EventType),
+		Payload:        delivery.Payload,
+		Status:         task.WebhookDeliveryStatus(delivery.Status),
+		Attempts:       delivery.Attempts,
+		NextAttemptAt:  delivery.NextAttemptAt,
+		ResponseStatus: delivery.ResponseStatus,
+		LastError:      delivery.LastError,
+		CreatedAt:      delivery.CreatedAt,
+		UpdatedAt:      delivery.UpdatedAt,
+		DeliveredAt:    delivery.DeliveredAt,
+	}
+}
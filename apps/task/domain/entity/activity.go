@@ -0,0 +1,43 @@
+package entity
+
+// ActivityAction is what happened to a task, its value is persisted.
+type ActivityAction int32
+
+const (
+	CreatedAction ActivityAction = iota
+	UpdatedAction
+)
+
+// ActivitySource tells what made a change to a task, its value is persisted.
+type ActivitySource int32
+
+const (
+	APISource ActivitySource = iota
+	SchedulerSource
+)
+
+func (s ActivitySource) Int32() int32 {
+	return int32(s)
+}
+
+// TaskActivity is an entry of the append-only activity log of a task. Version
+// is the version the change left the task at, which names the revision.
+type TaskActivity struct {
+	ID      int64
+	TaskID  int64
+	UserID  int64
+	Action  ActivityAction
+	Source  ActivitySource
+	Version int64
+	Changes []*FieldChange
+
+	CreatedAt int64
+}
+
+// FieldChange is a changed task field. Values are formatted as text, times are
+// in milliseconds, and nil stands for an unset value.
+type FieldChange struct {
+	Field string
+	Old   *string
+	New   *string
+}
@@ -0,0 +1,15 @@
+package entity
+
+// Attachment is a file attached to a task, the file itself lives in object storage.
+type Attachment struct {
+	ID     int64
+	TaskID int64
+	UserID int64
+
+	Name        string
+	ContentType string
+	// Size is in bytes.
+	Size int64
+
+	CreatedAt int64
+}
@@ -0,0 +1,29 @@
+package entity
+
+// BoardColumnStatuses are the statuses board columns may be mapped to.
+var BoardColumnStatuses = []Status{ToDoStatus, InProgressStatus, DoneStatus}
+
+// Board is the kanban view of a project.
+type Board struct {
+	ProjectID int64
+	Columns   []*BoardColumn
+}
+
+// BoardColumn shows the tasks of its project in Status. A task is shown in the
+// column it was moved to until its status changes, otherwise in the first column
+// of its status. WIPLimit bounds the tasks moved into the column, zero means no
+// limit. The first column of a status takes the tasks changing their status off
+// the board, so it has no limit.
+type BoardColumn struct {
+	ID        int64
+	ProjectID int64
+	Name      string
+	Status    Status
+	Position  int64
+	WIPLimit  int32
+	// Tasks are in manual order.
+	Tasks []*Task
+
+	CreatedAt int64
+	UpdatedAt int64
+}
@@ -0,0 +1,8 @@
+package entity
+
+// CalendarFeed is the iCalendar rendering of the dated tasks of a user, ETag
+// changes along with Content.
+type CalendarFeed struct {
+	Content []byte
+	ETag    string
+}
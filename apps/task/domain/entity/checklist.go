@@ -0,0 +1,14 @@
+package entity
+
+// ChecklistItem is a lightweight step inside a task, unlike a subtask it has
+// no status, schedule or tags of its own.
+type ChecklistItem struct {
+	ID       int64
+	TaskID   int64
+	Content  string
+	Checked  bool
+	Position int64
+
+	CreatedAt int64
+	UpdatedAt int64
+}
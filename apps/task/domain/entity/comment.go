@@ -0,0 +1,14 @@
+package entity
+
+// Comment is a note left on a task, comments of a task form its discussion
+// thread in creation order.
+type Comment struct {
+	ID     int64
+	TaskID int64
+	UserID int64
+
+	Content string
+
+	CreatedAt int64
+	UpdatedAt int64
+}
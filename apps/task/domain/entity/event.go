@@ -0,0 +1,39 @@
+package entity
+
+// TaskEventType is what happened to a task, as told to the connected clients of
+// its owner.
+type TaskEventType int32
+
+const (
+	TaskCreated TaskEventType = iota
+	TaskUpdated
+	TaskStatusChanged
+	TaskDeleted
+)
+
+func (e TaskEventType) Int32() int32 {
+	return int32(e)
+}
+
+func (e TaskEventType) String() string {
+	switch e {
+	case TaskCreated:
+		return "created"
+	case TaskUpdated:
+		return "updated"
+	case TaskStatusChanged:
+		return "status_changed"
+	case TaskDeleted:
+		return "deleted"
+	default:
+		return "unknown"
+	}
+}
+
+// TaskEvent is a change of a task. ID is the sequence of the change in the change
+// log of the owner, so a client resumes from the last ID it saw.
+type TaskEvent struct {
+	ID     int64
+	Type   TaskEventType
+	TaskID int64
+}
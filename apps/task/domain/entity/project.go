@@ -0,0 +1,15 @@
+package entity
+
+// Project groups the tasks of a user. Every user has an inbox project, which
+// takes the tasks created without a project and can't be archived or deleted.
+type Project struct {
+	ID       int64
+	UserID   int64
+	Name     string
+	Position int64
+	Inbox    bool
+	Archived bool
+
+	CreatedAt int64
+	UpdatedAt int64
+}
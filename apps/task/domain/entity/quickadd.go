@@ -0,0 +1,28 @@
+package entity
+
+// QuickAddTokenKind tells what a phrase of a quick add text stands for.
+type QuickAddTokenKind int32
+
+const (
+	DueToken QuickAddTokenKind = iota
+	TagToken
+	PriorityToken
+	RecurrenceToken
+)
+
+// QuickAddToken is a phrase taken out of a quick add text. Start and End are
+// offsets in runes, End is exclusive.
+type QuickAddToken struct {
+	Kind  QuickAddTokenKind
+	Start int32
+	End   int32
+	Text  string
+}
+
+// QuickAdd is a task created from one line of text along with the phrases
+// taken out of it, AllDay tells the due time was set to the end of a day.
+type QuickAdd struct {
+	Task   *Task
+	AllDay bool
+	Tokens []*QuickAddToken
+}
@@ -0,0 +1,18 @@
+package entity
+
+// TaskChange is the latest state of a task changed since a sync token. Tasks
+// moved to the recycle bin or purged are tombstones without Task.
+type TaskChange struct {
+	TaskID  int64
+	Deleted bool
+	Task    *Task
+}
+
+// ClientChangeOp is what a change pushed by a client does to a task.
+type ClientChangeOp int32
+
+const (
+	CreateChange ClientChangeOp = iota
+	UpdateChange
+	DeleteChange
+)
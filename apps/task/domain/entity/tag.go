@@ -0,0 +1,17 @@
+package entity
+
+// Tag labels the tasks of a user, its name is unique per user.
+type Tag struct {
+	ID     int64
+	UserID int64
+	Name   string
+	// Color is a #RRGGBB hex color, empty means the default one.
+	Color string
+
+	// TaskCount is the number of live tasks carrying the tag, it is only
+	// filled when listing tags.
+	TaskCount int64
+
+	CreatedAt int64
+	UpdatedAt int64
+}
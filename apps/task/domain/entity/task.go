@@ -3,33 +3,202 @@ package entity
 type Task struct {
 	ID     int64
 	UserID int64
+	// ProjectID is the project the task is listed in, zero means the inbox.
+	ProjectID int64
 
-	Title   string
-	Content string
-	Status  Status
+	Title    string
+	Content  string
+	Status   Status
+	Priority Priority
+	// Rank orders the tasks of a user manually, ranks compare as byte strings.
+	Rank string
+
+	// DueAt and RemindAt are optional, in milliseconds.
+	DueAt    *int64
+	RemindAt *int64
+
+	// Recurrence is nil for one-off tasks. Occurrences of a series share
+	// SeriesID, which is the ID of the first occurrence.
+	Recurrence *Recurrence
+	SeriesID   int64
+
+	Tags []*Tag
+
+	// ParentID is the task this one is a subtask of, zero for top level tasks.
+	// An AutoComplete task is finished once all of its subtasks and checklist
+	// items are done.
+	ParentID     int64
+	AutoComplete bool
+	// Progress is the percentage of done subtasks and checked checklist items,
+	// without any it is 100 for done tasks and 0 otherwise.
+	Progress  int32
+	Checklist []*ChecklistItem
+	// Children is only filled when listing task trees.
+	Children []*Task
+
+	// BlockerIDs are the live tasks which have to be done before this one,
+	// Blocked tells whether any of them is still open.
+	BlockerIDs []int64
+	Blocked    bool
+
+	CommentCount int64
+
+	// Version increases on every write of the task.
+	Version int64
 
 	CreatedAt int64
 	UpdatedAt int64
 }
 
+// Status is the state of a task, its value is persisted so new states must
+// only be appended.
 type Status int32
 
 const (
 	ToDoStatus Status = iota
-	FinishedStatus
+	DoneStatus
+	InProgressStatus
+	ArchivedStatus
+	TrashedStatus
 )
 
+// OpenStatuses are the states of tasks which still need to be worked on.
+var OpenStatuses = []Status{ToDoStatus, InProgressStatus}
+
+// statusTransitions lists the states each state may move to.
+var statusTransitions = map[Status][]Status{
+	ToDoStatus:       {InProgressStatus, DoneStatus, ArchivedStatus, TrashedStatus},
+	InProgressStatus: {ToDoStatus, DoneStatus, ArchivedStatus, TrashedStatus},
+	DoneStatus:       {ToDoStatus, ArchivedStatus, TrashedStatus},
+	ArchivedStatus:   {ToDoStatus, TrashedStatus},
+	TrashedStatus:    {ToDoStatus},
+}
+
 func (s Status) String() string {
 	switch s {
 	case ToDoStatus:
 		return "todo"
-	case FinishedStatus:
-		return "finished"
+	case InProgressStatus:
+		return "in_progress"
+	case DoneStatus:
+		return "done"
+	case ArchivedStatus:
+		return "archived"
+	case TrashedStatus:
+		return "trashed"
 	default:
 		return "unknown"
 	}
 }
 
+// ParseStatus returns the status named by String.
+func ParseStatus(name string) (Status, bool) {
+	for s := range statusTransitions {
+		if s.String() == name {
+			return s, true
+		}
+	}
+	return 0, false
+}
+
 func (s Status) Int32() int32 {
 	return int32(s)
 }
+
+func (s Status) IsValid() bool {
+	_, ok := statusTransitions[s]
+	return ok
+}
+
+func (s Status) IsOpen() bool {
+	return s == ToDoStatus || s == InProgressStatus
+}
+
+// CanTransitTo reports whether a task in status s may move to status to.
+func (s Status) CanTransitTo(to Status) bool {
+	for _, next := range statusTransitions[s] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+// Priority values are persisted, higher values are more urgent.
+type Priority int32
+
+const (
+	NoPriority Priority = iota
+	LowPriority
+	MediumPriority
+	HighPriority
+	UrgentPriority
+)
+
+func (p Priority) String() string {
+	switch p {
+	case NoPriority:
+		return "none"
+	case LowPriority:
+		return "low"
+	case MediumPriority:
+		return "medium"
+	case HighPriority:
+		return "high"
+	case UrgentPriority:
+		return "urgent"
+	default:
+		return "unknown"
+	}
+}
+
+// ParsePriority returns the priority named by String.
+func ParsePriority(name string) (Priority, bool) {
+	for p := NoPriority; p <= UrgentPriority; p++ {
+		if p.String() == name {
+			return p, true
+		}
+	}
+	return 0, false
+}
+
+func (p Priority) IsValid() bool {
+	return p >= NoPriority && p <= UrgentPriority
+}
+
+func (p Priority) Int32() int32 {
+	return int32(p)
+}
+
+type SortField int32
+
+const (
+	SortByCreatedAt SortField = iota
+	SortByUpdatedAt
+	// SortByPriority lists the most urgent tasks first in descending order.
+	SortByPriority
+	// SortByRank follows the manual order of the user.
+	SortByRank
+)
+
+type DueView int32
+
+const (
+	OverdueView DueView = iota
+	DueTodayView
+)
+
+// Recurrence is an RFC 5545 RRULE evaluated in TimeZone, an empty zone means UTC.
+type Recurrence struct {
+	Rule     string
+	TimeZone string
+}
+
+// UpdateScope tells whether an update of a recurring task applies to the
+// occurrence only or to every open occurrence of its series.
+type UpdateScope int32
+
+const (
+	ThisOccurrence UpdateScope = iota
+	WholeSeries
+)
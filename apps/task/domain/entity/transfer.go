@@ -0,0 +1,30 @@
+package entity
+
+// TransferFormat is the file format tasks are imported from and exported to.
+type TransferFormat int32
+
+const (
+	JSONFormat TransferFormat = iota
+	CSVFormat
+	ICalFormat
+)
+
+func (f TransferFormat) IsValid() bool {
+	return f >= JSONFormat && f <= ICalFormat
+}
+
+// ImportReport tells what an import created, or would create for a dry run.
+type ImportReport struct {
+	Total   int32
+	Created int32
+	// Duplicates are the rows matching an existing task or an earlier row by
+	// title and due time, they are skipped.
+	Duplicates []int32
+	Errors     []*ImportRowError
+}
+
+// ImportRowError tells why a row was not imported, rows count from 1.
+type ImportRowError struct {
+	Row     int32
+	Message string
+}
@@ -0,0 +1,65 @@
+package entity
+
+// Webhook posts the task events of EventTypes to URL, an empty EventTypes
+// subscribes to every event. The deliveries are signed with Secret, which is
+// only returned when the webhook is created.
+type Webhook struct {
+	ID         int64
+	UserID     int64
+	URL        string
+	EventTypes []TaskEventType
+	Secret     string
+	Enabled    bool
+
+	CreatedAt int64
+	UpdatedAt int64
+}
+
+// Subscribes reports whether the webhook takes events of type t.
+func (w *Webhook) Subscribes(t TaskEventType) bool {
+	if len(w.EventTypes) == 0 {
+		return true
+	}
+	for _, typ := range w.EventTypes {
+		if typ == t {
+			return true
+		}
+	}
+	return false
+}
+
+type DeliveryStatus int32
+
+const (
+	// DeliveryPending deliveries wait for their next attempt.
+	DeliveryPending DeliveryStatus = iota
+	DeliverySucceeded
+	// DeliveryDead deliveries ran out of attempts, they are only sent again
+	// when replayed.
+	DeliveryDead
+)
+
+func (s DeliveryStatus) Int32() int32 {
+	return int32(s)
+}
+
+// WebhookDelivery is a task event sent, or to be sent, to a webhook. Payload
+// is the request body, ResponseStatus and LastError tell how the last attempt
+// went.
+type WebhookDelivery struct {
+	ID            int64
+	WebhookID     int64
+	EventID       string
+	EventType     TaskEventType
+	Payload       string
+	Status        DeliveryStatus
+	Attempts      int32
+	NextAttemptAt int64
+
+	ResponseStatus int32
+	LastError      string
+
+	CreatedAt   int64
+	UpdatedAt   int64
+	DeliveredAt int64
+}
@@ -0,0 +1,270 @@
+package dal
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"time"
+
+	"gorm.io/gen"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/internal/dal/model"
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/internal/dal/query"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/lang/conv"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/lang/slice"
+)
+
+// Activity actions.
+const (
+	ActionCreated int32 = iota
+	ActionUpdated
+)
+
+// Activity sources, writes are made by the API unless the context says otherwise.
+const (
+	SourceAPI int32 = iota
+	SourceScheduler
+)
+
+type activitySourceKey struct{}
+
+// WithActivitySource makes the task writes under ctx recorded with the given source.
+func WithActivitySource(ctx context.Context, source int32) context.Context {
+	return context.WithValue(ctx, activitySourceKey{}, source)
+}
+
+func activitySource(ctx context.Context) int32 {
+	source, _ := ctx.Value(activitySourceKey{}).(int32)
+	return source
+}
+
+// FieldChange is a changed task field, values are formatted as text and nil
+// stands for NULL.
+type FieldChange struct {
+	Field string  `json:"field"`
+	Old   *string `json:"old"`
+	New   *string `json:"new"`
+}
+
+// Activity is an entry of the activity log of a task.
+type Activity struct {
+	*model.TaskActivity
+	Fields []*FieldChange
+}
+
+// trackedFields are the task fields recorded in the activity log.
+var trackedFields = []struct {
+	name  string
+	value func(task *model.Task) *string
+}{
+	{"title", func(task *model.Task) *string { return &task.Title }},
+	{"content", func(task *model.Task) *string { return &task.Content }},
+	{"status", func(task *model.Task) *string { return intText(int64(task.Status)) }},
+	{"priority", func(task *model.Task) *string { return intText(int64(task.Priority)) }},
+	{"due_at", func(task *model.Task) *string { return optionalIntText(task.DueAt) }},
+	{"remind_at", func(task *model.Task) *string { return optionalIntText(task.RemindAt) }},
+	{"reminded_at", func(task *model.Task) *string { return optionalIntText(task.RemindedAt) }},
+	{"recurrence", func(task *model.Task) *string { return &task.Recurrence }},
+	{"time_zone", func(task *model.Task) *string { return &task.TimeZone }},
+	{"project_id", func(task *model.Task) *string { return intText(task.ProjectID) }},
+	{"parent_id", func(task *model.Task) *string { return intText(task.ParentID) }},
+	{"auto_complete", func(task *model.Task) *string {
+		v := strconv.FormatBool(task.AutoComplete)
+		return &v
+	}},
+}
+
+// TrackedValues returns the task fields recorded in the activity log, formatted
+// like in FieldChange.
+func TrackedValues(task *model.Task) map[string]*string {
+	values := make(map[string]*string, len(trackedFields))
+	for _, f := range trackedFields {
+		values[f.name] = f.value(task)
+	}
+
+	return values
+}
+
+type ActivityDao struct {
+	query *query.Query
+}
+
+func NewActivityDao(db *gorm.DB) *ActivityDao {
+	return &ActivityDao{query: query.Use(db)}
+}
+
+// ListActivities returns the activities of the task newest first, starting
+// below the activity beforeID unless it is zero.
+func (a *ActivityDao) ListActivities(ctx context.Context, taskID, beforeID int64, limit int) ([]*Activity, error) {
+	do := a.query.TaskActivity.WithContext(ctx).Where(a.query.TaskActivity.TaskID.Eq(taskID))
+	if beforeID > 0 {
+		do = do.Where(a.query.TaskActivity.ID.Lt(beforeID))
+	}
+
+	activities, err := do.Order(a.query.TaskActivity.ID.Desc()).Limit(limit).Find()
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeActivities(activities)
+}
+
+// ListActivitiesAfter returns the activities of the task which left it at a
+// version above the given one, newest first.
+func (a *ActivityDao) ListActivitiesAfter(ctx context.Context, taskID, version int64) ([]*Activity, error) {
+	activities, err := a.query.TaskActivity.WithContext(ctx).Where(
+		a.query.TaskActivity.TaskID.Eq(taskID),
+		a.query.TaskActivity.Version.Gt(version),
+	).Order(a.query.TaskActivity.ID.Desc()).Find()
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeActivities(activities)
+}
+
+// HasRevision reports whether the activity log of the task reaches back to the
+// given version. Writes to untracked fields such as the tags bump the version
+// without an activity, so every version from the start of the log is one: the
+// creation of the task, or the version before its first logged change for the
+// tasks created before the log.
+func (a *ActivityDao) HasRevision(ctx context.Context, taskID, version int64) (bool, error) {
+	first, err := a.query.TaskActivity.WithContext(ctx).Where(
+		a.query.TaskActivity.TaskID.Eq(taskID),
+	).Order(a.query.TaskActivity.Version, a.query.TaskActivity.ID).First()
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	start := first.Version
+	if first.Action != ActionCreated {
+		start--
+	}
+
+	return version >= start, nil
+}
+
+// updateTasks applies the updates to the tasks matching conds in tx, records
+// the changed fields in their activity logs and logs the tasks as changed. The
+// tasks are locked first, so the recorded old values are the overwritten ones.
+// Soft deleted tasks only match if unscoped is set. updated_at is only changed
+// if the updates set it. It returns the IDs of the updated tasks.
+func updateTasks(ctx context.Context, tx *query.Query, unscoped bool, conds []gen.Condition, updates map[string]any) ([]int64, error) {
+	do := tx.Task.WithContext(ctx)
+	if unscoped {
+		do = do.Unscoped()
+	}
+	before, err := do.Clauses(clause.Locking{Strength: "UPDATE"}).Where(conds...).Find()
+	if err != nil || len(before) == 0 {
+		return nil, err
+	}
+	ids := slice.Transform(before, func(task *model.Task) int64 {
+		return task.ID
+	})
+
+	_, err = tx.Task.WithContext(ctx).Unscoped().Where(tx.Task.ID.In(ids...)).UpdateColumns(updates)
+	if err != nil {
+		return nil, err
+	}
+	after, err := tx.Task.WithContext(ctx).Unscoped().Where(tx.Task.ID.In(ids...)).Find()
+	if err != nil {
+		return nil, err
+	}
+	// only a card move places a task in another column of its new status
+	if _, placed := updates["column_id"]; !placed {
+		if err := leaveColumns(ctx, tx, before, after); err != nil {
+			return nil, err
+		}
+	}
+	logChanges(ctx, after, false)
+
+	return ids, recordActivities(ctx, tx, ActionUpdated, before, after)
+}
+
+// recordActivities logs the changes from the tasks before to the tasks after, a
+// nil before records their creation. Tasks without changes are skipped.
+func recordActivities(ctx context.Context, tx *query.Query, action int32, before, after []*model.Task) error {
+	previous := slice.ToMap(before, func(task *model.Task) (int64, *model.Task) {
+		return task.ID, task
+	})
+
+	now := time.Now().UnixMilli()
+	activities := make([]*model.TaskActivity, 0, len(after))
+	for _, task := range after {
+		fields := diffTask(previous[task.ID], task)
+		if len(fields) == 0 {
+			continue
+		}
+		changes, err := json.Marshal(fields)
+		if err != nil {
+			return err
+		}
+		activities = append(activities, &model.TaskActivity{
+			TaskID:    task.ID,
+			UserID:    task.UserID,
+			Action:    action,
+			Source:    activitySource(ctx),
+			Version:   task.Version,
+			Changes:   string(changes),
+			CreatedAt: now,
+		})
+	}
+	if len(activities) == 0 {
+		return nil
+	}
+
+	return tx.TaskActivity.WithContext(ctx).Create(activities...)
+}
+
+// diffTask returns the tracked fields differing between old and new, a nil old
+// returns the fields set on new.
+func diffTask(old, new *model.Task) []*FieldChange {
+	var res []*FieldChange
+	for _, f := range trackedFields {
+		newValue := f.value(new)
+		var oldValue *string
+		if old != nil {
+			oldValue = f.value(old)
+		}
+		if old == nil && (newValue == nil || *newValue == "") {
+			continue
+		}
+		if (oldValue == nil) == (newValue == nil) && (oldValue == nil || *oldValue == *newValue) {
+			continue
+		}
+		res = append(res, &FieldChange{Field: f.name, Old: oldValue, New: newValue})
+	}
+
+	return res
+}
+
+func decodeActivities(activities []*model.TaskActivity) ([]*Activity, error) {
+	res := make([]*Activity, 0, len(activities))
+	for _, activity := range activities {
+		var fields []*FieldChange
+		if err := json.Unmarshal([]byte(activity.Changes), &fields); err != nil {
+			return nil, err
+		}
+		res = append(res, &Activity{TaskActivity: activity, Fields: fields})
+	}
+
+	return res, nil
+}
+
+func intText(v int64) *string {
+	s := conv.Int64ToStr(v)
+	return &s
+}
+
+func optionalIntText(v *int64) *string {
+	if v == nil {
+		return nil
+	}
+	return intText(*v)
+}
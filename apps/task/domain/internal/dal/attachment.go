@@ -0,0 +1,95 @@
+package dal
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/internal/dal/model"
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/internal/dal/query"
+)
+
+type AttachmentDao struct {
+	query *query.Query
+}
+
+func NewAttachmentDao(db *gorm.DB) *AttachmentDao {
+	return &AttachmentDao{query: query.Use(db)}
+}
+
+// Create saves the attachment if the attachments of its owner stay within quota
+// bytes with it, it returns false otherwise. The attachments of the owner are
+// locked, so concurrent uploads can't overrun the quota.
+func (a *AttachmentDao) Create(ctx context.Context, attachment *model.TaskAttachment, quota int64) (bool, error) {
+	created := false
+	err := a.query.Transaction(func(tx *query.Query) error {
+		used, err := usedBytes(tx.TaskAttachment.WithContext(ctx).Clauses(clause.Locking{Strength: "UPDATE"}), tx, attachment.UserID)
+		if err != nil {
+			return err
+		}
+		if used+attachment.Size > quota {
+			return nil
+		}
+
+		if err := tx.TaskAttachment.WithContext(ctx).Create(attachment); err != nil {
+			return err
+		}
+		created = true
+
+		return nil
+	})
+
+	return created, err
+}
+
+func (a *AttachmentDao) GetAttachmentByID(ctx context.Context, attachmentID int64) (*model.TaskAttachment, bool, error) {
+	attachment, err := a.query.TaskAttachment.WithContext(ctx).Where(
+		a.query.TaskAttachment.ID.Eq(attachmentID),
+	).First()
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	return attachment, true, nil
+}
+
+// ListAttachments returns the attachments of the task oldest first.
+func (a *AttachmentDao) ListAttachments(ctx context.Context, taskID int64) ([]*model.TaskAttachment, error) {
+	return a.query.TaskAttachment.WithContext(ctx).Where(
+		a.query.TaskAttachment.TaskID.Eq(taskID),
+	).Order(a.query.TaskAttachment.ID).Find()
+}
+
+// UsedBytes returns the total size of the attachments of the user.
+func (a *AttachmentDao) UsedBytes(ctx context.Context, userID int64) (int64, error) {
+	return usedBytes(a.query.TaskAttachment.WithContext(ctx), a.query, userID)
+}
+
+// DeleteAttachment deletes the attachment of the user, it returns false if no such attachment exists.
+func (a *AttachmentDao) DeleteAttachment(ctx context.Context, userID, attachmentID int64) (bool, error) {
+	res, err := a.query.TaskAttachment.WithContext(ctx).Where(
+		a.query.TaskAttachment.ID.Eq(attachmentID),
+		a.query.TaskAttachment.UserID.Eq(userID),
+	).Delete()
+	if err != nil {
+		return false, err
+	}
+
+	return res.RowsAffected > 0, nil
+}
+
+func usedBytes(do query.ITaskAttachmentDo, q *query.Query, userID int64) (int64, error) {
+	var res struct {
+		Size int64
+	}
+	err := do.Select(q.TaskAttachment.Size.Sum().IfNull(0).As("size")).
+		Where(q.TaskAttachment.UserID.Eq(userID)).
+		Scan(&res)
+
+	return res.Size, err
+}
@@ -0,0 +1,203 @@
+package dal
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gen"
+
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/internal/dal/model"
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/internal/dal/query"
+)
+
+// StatusChange moves a task out of status From. Next is the following occurrence
+// to create when a recurring task is finished.
+type StatusChange struct {
+	TaskID int64
+	From   int32
+	Next   *model.Task
+}
+
+// BatchCreate creates the tasks in one transaction, tagIDs[i] holds the tags of tasks[i].
+func (t *TaskDao) BatchCreate(ctx context.Context, tasks []*model.Task, tagIDs [][]int64) error {
+	return transaction(ctx, t.query, func(ctx context.Context, tx *query.Query) error {
+		for i, task := range tasks {
+			if err := createTask(ctx, tx, task, tagIDs[i]); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// ImportedTask is a task to create with ImportTasks.
+type ImportedTask struct {
+	Task   *model.Task
+	TagIDs []int64
+	// Project, if set, is a new project to create the task in.
+	Project *model.Project
+	// Tags are new tags the task carries besides TagIDs.
+	Tags []*model.Tag
+}
+
+// ImportTasks creates the tasks of the user in one transaction, together with
+// the new projects and tags they need. Tasks may share a new project or tag,
+// each is created once, new projects go after the other projects of the user.
+func (t *TaskDao) ImportTasks(ctx context.Context, userID int64, tasks []*ImportedTask) error {
+	return transaction(ctx, t.query, func(ctx context.Context, tx *query.Query) error {
+		var res struct {
+			Position int64
+		}
+		err := tx.Project.WithContext(ctx).Select(tx.Project.Position.Max().IfNull(0).As("position")).
+			Where(tx.Project.UserID.Eq(userID)).
+			Scan(&res)
+		if err != nil {
+			return err
+		}
+
+		position := res.Position
+		createdProjects := make(map[*model.Project]bool)
+		createdTags := make(map[*model.Tag]bool)
+		for _, imported := range tasks {
+			if project := imported.Project; project != nil {
+				if !createdProjects[project] {
+					position++
+					project.Position = position
+					if err := tx.Project.WithContext(ctx).Create(project); err != nil {
+						return err
+					}
+					createdProjects[project] = true
+				}
+				imported.Task.ProjectID = project.ID
+			}
+
+			tagIDs := imported.TagIDs
+			for _, tag := range imported.Tags {
+				if !createdTags[tag] {
+					if err := tx.Tag.WithContext(ctx).Create(tag); err != nil {
+						return err
+					}
+					createdTags[tag] = true
+				}
+				tagIDs = append(tagIDs, tag.ID)
+			}
+
+			if err := createTask(ctx, tx, imported.Task, tagIDs); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// BatchUpdateStatus moves the tasks owned by userID to status to in one transaction,
+// changes whose task is no longer in its From status are skipped. It returns the
+// IDs of the moved tasks and the created occurrences.
+func (t *TaskDao) BatchUpdateStatus(ctx context.Context, userID int64, to int32, changes []*StatusChange) ([]int64, []*model.Task, error) {
+	var moved []int64
+	var created []*model.Task
+	err := transaction(ctx, t.query, func(ctx context.Context, tx *query.Query) error {
+		for _, change := range changes {
+			if change.Next != nil {
+				ok, next, err := finishOccurrence(ctx, tx, userID, change.TaskID, change.From, to, change.Next)
+				if err != nil {
+					return err
+				}
+				if ok {
+					moved = append(moved, change.TaskID)
+				}
+				if next {
+					created = append(created, change.Next)
+				}
+				continue
+			}
+
+			ids, err := updateTasks(ctx, tx, false, []gen.Condition{
+				tx.Task.ID.Eq(change.TaskID),
+				tx.Task.UserID.Eq(userID),
+				tx.Task.Status.Eq(change.From),
+			}, bumpVersion(map[string]any{
+				"status":     to,
+				"updated_at": time.Now().UnixMilli(),
+			}))
+			if err != nil {
+				return err
+			}
+			moved = append(moved, ids...)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return moved, created, nil
+}
+
+// BatchTrash moves the live tasks owned by userID to the recycle bin in status,
+// together with their subtasks, in one transaction. It maps each trashed task to
+// the IDs trashed with it, tasks already trashed along with an earlier one map to
+// an empty slice and missing tasks are left out.
+func (t *TaskDao) BatchTrash(ctx context.Context, userID int64, taskIDs []int64, status int32) (map[int64][]int64, error) {
+	res := make(map[int64][]int64, len(taskIDs))
+	err := transaction(ctx, t.query, func(ctx context.Context, tx *query.Query) error {
+		trashed := make(map[int64]bool)
+		for _, taskID := range taskIDs {
+			if trashed[taskID] {
+				res[taskID] = []int64{}
+				continue
+			}
+
+			ids, err := trashTask(ctx, tx, userID, taskID, status)
+			if err != nil {
+				return err
+			}
+			if len(ids) == 0 {
+				continue
+			}
+			res[taskID] = ids
+			for _, id := range ids {
+				trashed[id] = true
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// BatchMove moves the live top level tasks owned by userID, with their subtasks,
+// to the project in one transaction. It returns the IDs of the moved top level tasks.
+func (t *TaskDao) BatchMove(ctx context.Context, userID int64, taskIDs []int64, projectID int64) ([]int64, error) {
+	var moved []int64
+	err := transaction(ctx, t.query, func(ctx context.Context, tx *query.Query) error {
+		updates := map[string]any{
+			"project_id": projectID,
+			"updated_at": time.Now().UnixMilli(),
+		}
+
+		ids, err := updateTasks(ctx, tx, false, []gen.Condition{
+			tx.Task.ID.In(taskIDs...),
+			tx.Task.UserID.Eq(userID),
+			tx.Task.ParentID.Eq(0),
+		}, bumpVersion(updates))
+		if err != nil || len(ids) == 0 {
+			return err
+		}
+		moved = ids
+
+		return moveSubtasks(ctx, tx, ids, updates)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return moved, nil
+}
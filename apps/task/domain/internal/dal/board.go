@@ -0,0 +1,305 @@
+package dal
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	"gorm.io/gen"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/internal/dal/model"
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/internal/dal/query"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/lang/slice"
+)
+
+// ColumnCards selects the live tasks shown in a board column: the tasks of the
+// board in the status of the column which are placed in it. The default column
+// of a status also shows the tasks of the status not placed in any of its other
+// columns.
+type ColumnCards struct {
+	UserID     int64
+	ProjectIDs []int64
+	Status     int32
+	ColumnID   int64
+	Default    bool
+	// OtherColumnIDs are the other columns of the status, only read for the default column.
+	OtherColumnIDs []int64
+}
+
+// MoveCardStatus tells how a card move ended.
+type MoveCardStatus int
+
+const (
+	CardMoved MoveCardStatus = iota
+	// CardNotFound means the task is gone or left the status it was moved from.
+	CardNotFound
+	ColumnNotFound
+	// ColumnFull means the column reached its WIP limit.
+	ColumnFull
+)
+
+// MoveCardResult is the outcome of a card move.
+type MoveCardResult struct {
+	Status MoveCardStatus
+	// WIPLimit is the limit of the column, as read under lock.
+	WIPLimit int32
+	// NextCreated reports whether the next occurrence of the task was created.
+	NextCreated bool
+}
+
+type BoardDao struct {
+	query *query.Query
+}
+
+func NewBoardDao(db *gorm.DB) *BoardDao {
+	return &BoardDao{query: query.Use(db)}
+}
+
+func (b *BoardDao) CreateColumn(ctx context.Context, column *model.BoardColumn) error {
+	return b.query.BoardColumn.WithContext(ctx).Create(column)
+}
+
+// CreateDefaultColumns creates the given columns unless the project already has
+// columns. The project is locked, so concurrent calls create them once.
+func (b *BoardDao) CreateDefaultColumns(ctx context.Context, projectID int64, columns []*model.BoardColumn) error {
+	return b.query.Transaction(func(tx *query.Query) error {
+		_, err := tx.Project.WithContext(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where(tx.Project.ID.Eq(projectID)).
+			First()
+		if err != nil {
+			return err
+		}
+
+		count, err := tx.BoardColumn.WithContext(ctx).Where(tx.BoardColumn.ProjectID.Eq(projectID)).Count()
+		if err != nil || count > 0 {
+			return err
+		}
+
+		return tx.BoardColumn.WithContext(ctx).Create(columns...)
+	})
+}
+
+func (b *BoardDao) GetColumnByID(ctx context.Context, columnID int64) (*model.BoardColumn, bool, error) {
+	column, err := b.query.BoardColumn.WithContext(ctx).Where(
+		b.query.BoardColumn.ID.Eq(columnID),
+	).First()
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	return column, true, nil
+}
+
+// ListColumns returns the columns of the project in display order.
+func (b *BoardDao) ListColumns(ctx context.Context, projectID int64) ([]*model.BoardColumn, error) {
+	return b.query.BoardColumn.WithContext(ctx).Where(
+		b.query.BoardColumn.ProjectID.Eq(projectID),
+	).Order(b.query.BoardColumn.Position, b.query.BoardColumn.ID).Find()
+}
+
+// MaxPosition returns the largest position among the columns of the project.
+func (b *BoardDao) MaxPosition(ctx context.Context, projectID int64) (int64, error) {
+	var res struct {
+		Position int64
+	}
+	err := b.query.BoardColumn.WithContext(ctx).Select(b.query.BoardColumn.Position.Max().IfNull(0).As("position")).
+		Where(b.query.BoardColumn.ProjectID.Eq(projectID)).
+		Scan(&res)
+
+	return res.Position, err
+}
+
+// UpdateColumn updates the column owned by userID, it returns false if no such column exists.
+func (b *BoardDao) UpdateColumn(ctx context.Context, userID, columnID int64, updates map[string]any) (bool, error) {
+	res, err := b.query.BoardColumn.WithContext(ctx).Where(
+		b.query.BoardColumn.ID.Eq(columnID),
+		b.query.BoardColumn.UserID.Eq(userID),
+	).Updates(updates)
+	if err != nil {
+		return false, err
+	}
+
+	return res.RowsAffected > 0, nil
+}
+
+// ReorderColumns puts the given columns of the project in the given order. They
+// take over the positions they held among themselves, so the columns left out
+// keep their places. It returns false unless every column belongs to the project.
+func (b *BoardDao) ReorderColumns(ctx context.Context, projectID int64, columnIDs []int64) (bool, error) {
+	ok := false
+	err := b.query.Transaction(func(tx *query.Query) error {
+		columns, err := tx.BoardColumn.WithContext(ctx).Where(
+			tx.BoardColumn.ID.In(columnIDs...),
+			tx.BoardColumn.ProjectID.Eq(projectID),
+		).Find()
+		if err != nil {
+			return err
+		}
+		if len(columns) != len(columnIDs) {
+			return nil
+		}
+		ok = true
+
+		positions := make([]int64, 0, len(columns))
+		for _, column := range columns {
+			positions = append(positions, column.Position)
+		}
+		sort.Slice(positions, func(i, j int) bool { return positions[i] < positions[j] })
+
+		now := time.Now().UnixMilli()
+		for i, id := range columnIDs {
+			_, err := tx.BoardColumn.WithContext(ctx).Where(tx.BoardColumn.ID.Eq(id)).Updates(map[string]any{
+				"position":   positions[i],
+				"updated_at": now,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return ok, nil
+}
+
+// DeleteColumn deletes the column owned by userID in one transaction, its tasks
+// fall back to the default column of their status. It returns false if no such
+// column exists.
+func (b *BoardDao) DeleteColumn(ctx context.Context, userID, columnID int64) (bool, error) {
+	deleted := false
+	err := b.query.Transaction(func(tx *query.Query) error {
+		res, err := tx.BoardColumn.WithContext(ctx).Where(
+			tx.BoardColumn.ID.Eq(columnID),
+			tx.BoardColumn.UserID.Eq(userID),
+		).Delete()
+		if err != nil {
+			return err
+		}
+		if res.RowsAffected == 0 {
+			return nil
+		}
+		deleted = true
+
+		// the placement is not part of the task, so its version stays
+		_, err = tx.Task.WithContext(ctx).Unscoped().Where(
+			tx.Task.UserID.Eq(userID),
+			tx.Task.ColumnID.Eq(columnID),
+		).UpdateColumn(tx.Task.ColumnID, 0)
+		return err
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return deleted, nil
+}
+
+// MoveCard moves the task from status from into the column, in the status of
+// the column, and applies the updates with it in one transaction, provided the
+// column stays within its WIP limit. The column is locked and its limit read
+// under the lock, so concurrent moves can't overfill it. next, if set, is the
+// following occurrence of the series of the task, created like FinishOccurrence
+// does once the move finishes the task.
+func (b *BoardDao) MoveCard(ctx context.Context, taskID int64, from int32, cards *ColumnCards, updates map[string]any, next *model.Task) (*MoveCardResult, error) {
+	result := &MoveCardResult{Status: CardMoved}
+	err := transaction(ctx, b.query, func(ctx context.Context, tx *query.Query) error {
+		column, err := tx.BoardColumn.WithContext(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where(tx.BoardColumn.ID.Eq(cards.ColumnID)).
+			First()
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			result.Status = ColumnNotFound
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		result.WIPLimit = column.WipLimit
+		if column.WipLimit > 0 {
+			count, err := tx.Task.WithContext(ctx).Where(columnCardConds(tx, cards, taskID)...).Count()
+			if err != nil {
+				return err
+			}
+			if count >= int64(column.WipLimit) {
+				result.Status = ColumnFull
+				return nil
+			}
+		}
+
+		updates["status"] = cards.Status
+		updates["column_id"] = cards.ColumnID
+		ids, err := updateTasks(ctx, tx, false, []gen.Condition{
+			tx.Task.ID.Eq(taskID),
+			tx.Task.UserID.Eq(cards.UserID),
+			tx.Task.Status.Eq(from),
+		}, bumpVersion(updates))
+		if err != nil {
+			return err
+		}
+		if len(ids) == 0 {
+			result.Status = CardNotFound
+			return nil
+		}
+
+		if next != nil {
+			result.NextCreated, err = createOccurrence(ctx, tx, taskID, next)
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// leaveColumns takes the tasks which changed their status from before to after
+// off the board columns they were placed in, so they show in the default
+// column of their new status. It updates the tasks after to match.
+func leaveColumns(ctx context.Context, tx *query.Query, before, after []*model.Task) error {
+	previous := slice.ToMap(before, func(task *model.Task) (int64, *model.Task) {
+		return task.ID, task
+	})
+
+	var moved []int64
+	for _, task := range after {
+		if task.ColumnID != 0 && task.Status != previous[task.ID].Status {
+			moved = append(moved, task.ID)
+			task.ColumnID = 0
+		}
+	}
+	if len(moved) == 0 {
+		return nil
+	}
+
+	// the placement is not part of the task, so its version stays
+	_, err := tx.Task.WithContext(ctx).Unscoped().Where(tx.Task.ID.In(moved...)).UpdateColumn(tx.Task.ColumnID, 0)
+	return err
+}
+
+func columnCardConds(q *query.Query, cards *ColumnCards, exceptID int64) []gen.Condition {
+	conds := []gen.Condition{
+		q.Task.UserID.Eq(cards.UserID),
+		q.Task.ProjectID.In(cards.ProjectIDs...),
+		q.Task.Status.Eq(cards.Status),
+		q.Task.ID.Neq(exceptID),
+	}
+	switch {
+	case !cards.Default:
+		conds = append(conds, q.Task.ColumnID.Eq(cards.ColumnID))
+	case len(cards.OtherColumnIDs) > 0:
+		conds = append(conds, q.Task.ColumnID.NotIn(cards.OtherColumnIDs...))
+	}
+
+	return conds
+}
@@ -0,0 +1,71 @@
+package dal
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/internal/dal/model"
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/internal/dal/query"
+)
+
+type CalendarDao struct {
+	query *query.Query
+}
+
+func NewCalendarDao(db *gorm.DB) *CalendarDao {
+	return &CalendarDao{query: query.Use(db)}
+}
+
+// CreateFeed adds the feed, it returns false if the user already has one.
+func (c *CalendarDao) CreateFeed(ctx context.Context, feed *model.CalendarFeed) (bool, error) {
+	res := c.query.CalendarFeed.WithContext(ctx).UnderlyingDB().
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(feed)
+	if res.Error != nil {
+		return false, res.Error
+	}
+
+	return res.RowsAffected > 0, nil
+}
+
+// UpdateFeedToken replaces the token of the feed of the user, it returns false
+// if the user has no feed.
+func (c *CalendarDao) UpdateFeedToken(ctx context.Context, userID int64, tokenHash string) (bool, error) {
+	res, err := c.query.CalendarFeed.WithContext(ctx).Where(
+		c.query.CalendarFeed.UserID.Eq(userID),
+	).Update(c.query.CalendarFeed.TokenHash, tokenHash)
+	if err != nil {
+		return false, err
+	}
+
+	return res.RowsAffected > 0, nil
+}
+
+// DeleteFeed deletes the feed of the user, it returns false if the user has no feed.
+func (c *CalendarDao) DeleteFeed(ctx context.Context, userID int64) (bool, error) {
+	res, err := c.query.CalendarFeed.WithContext(ctx).Where(
+		c.query.CalendarFeed.UserID.Eq(userID),
+	).Delete()
+	if err != nil {
+		return false, err
+	}
+
+	return res.RowsAffected > 0, nil
+}
+
+func (c *CalendarDao) GetFeedByTokenHash(ctx context.Context, tokenHash string) (*model.CalendarFeed, bool, error) {
+	feed, err := c.query.CalendarFeed.WithContext(ctx).Where(
+		c.query.CalendarFeed.TokenHash.Eq(tokenHash),
+	).First()
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	return feed, true, nil
+}
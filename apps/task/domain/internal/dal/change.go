@@ -0,0 +1,234 @@
+package dal
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sort"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/internal/dal/model"
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/internal/dal/query"
+	"github.com/crazyfrankie/zrpc-todolist/infra/contract/eventbus"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/lang/conv"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/outbox"
+	"github.com/crazyfrankie/zrpc-todolist/types/consts"
+)
+
+type changeSetKey struct{}
+
+// changeSet collects the tasks written by a transaction, keyed by task ID, so a
+// task written several times is logged once.
+type changeSet map[int64]*model.TaskChange
+
+// transaction runs fn in a transaction of q and appends the tasks it wrote to the
+// change logs of their owners, and their events to the outbox, right before
+// committing. The sequence row of an owner is the last row the transaction
+// locks, so writers can't deadlock on it, and the changes of a user are
+// committed in sequence order.
+func transaction(ctx context.Context, q *query.Query, fn func(ctx context.Context, tx *query.Query) error) error {
+	changes := make(changeSet)
+	ctx = context.WithValue(ctx, changeSetKey{}, changes)
+
+	return q.Transaction(func(tx *query.Query) error {
+		if err := fn(ctx, tx); err != nil {
+			return err
+		}
+		return changes.flush(ctx, tx)
+	})
+}
+
+// Task event types, they match entity.TaskEventType.
+const (
+	EventCreated int32 = iota
+	EventUpdated
+	EventStatusChanged
+	EventDeleted
+)
+
+// TaskEvent is the payload of the task events written to the outbox along with
+// the changes, ID is the sequence of the change.
+type TaskEvent struct {
+	ID     int64 `json:"id"`
+	Type   int32 `json:"type"`
+	UserID int64 `json:"user_id"`
+	TaskID int64 `json:"task_id"`
+}
+
+type eventTypeKey struct{}
+
+type eventType struct {
+	typ     int32
+	taskIDs map[int64]bool
+}
+
+// WithEventType names the events of the task changes committed under ctx. The
+// changes of taskIDs, or of all tasks if there are none, are of type typ, the
+// others are updates and tombstones are deletions.
+func WithEventType(ctx context.Context, typ int32, taskIDs ...int64) context.Context {
+	e := &eventType{typ: typ, taskIDs: make(map[int64]bool, len(taskIDs))}
+	for _, id := range taskIDs {
+		e.taskIDs[id] = true
+	}
+	return context.WithValue(ctx, eventTypeKey{}, e)
+}
+
+func changeEventType(ctx context.Context, change *model.TaskChange) int32 {
+	if change.Deleted {
+		return EventDeleted
+	}
+	e, ok := ctx.Value(eventTypeKey{}).(*eventType)
+	if ok && (len(e.taskIDs) == 0 || e.taskIDs[change.TaskID]) {
+		return e.typ
+	}
+	return EventUpdated
+}
+
+// logChanges adds the tasks to the change set of the transaction under ctx. Soft
+// deleted tasks are logged as tombstones, and so are all of them if purged is set.
+func logChanges(ctx context.Context, tasks []*model.Task, purged bool) {
+	changes, _ := ctx.Value(changeSetKey{}).(changeSet)
+	if changes == nil {
+		return
+	}
+	for _, task := range tasks {
+		changes[task.ID] = &model.TaskChange{
+			UserID:  task.UserID,
+			TaskID:  task.ID,
+			Deleted: purged || task.DeletedAt.Valid,
+		}
+	}
+}
+
+// touchTasks logs a change of the given tasks, for writes to the rows embedded
+// in a task such as its tags.
+func touchTasks(ctx context.Context, tx *query.Query, taskIDs []int64) error {
+	if len(taskIDs) == 0 {
+		return nil
+	}
+	tasks, err := tx.Task.WithContext(ctx).Unscoped().Where(tx.Task.ID.In(taskIDs...)).Find()
+	if err != nil {
+		return err
+	}
+	logChanges(ctx, tasks, false)
+
+	return nil
+}
+
+func (c changeSet) flush(ctx context.Context, tx *query.Query) error {
+	if len(c) == 0 {
+		return nil
+	}
+
+	byUser := make(map[int64][]*model.TaskChange)
+	for _, change := range c {
+		byUser[change.UserID] = append(byUser[change.UserID], change)
+	}
+	userIDs := make([]int64, 0, len(byUser))
+	for userID := range byUser {
+		userIDs = append(userIDs, userID)
+	}
+	// lock the sequence rows in a fixed order
+	sort.Slice(userIDs, func(i, j int) bool { return userIDs[i] < userIDs[j] })
+
+	now := time.Now().UnixMilli()
+	for _, userID := range userIDs {
+		changes := byUser[userID]
+		sort.Slice(changes, func(i, j int) bool { return changes[i].TaskID < changes[j].TaskID })
+
+		last, err := reserveSeqs(ctx, tx, userID, len(changes))
+		if err != nil {
+			return err
+		}
+		first := last - int64(len(changes)) + 1
+		for i, change := range changes {
+			change.Seq = first + int64(i)
+			change.CreatedAt = now
+		}
+		if err := tx.TaskChange.WithContext(ctx).Create(changes...); err != nil {
+			return err
+		}
+		if err := addEvents(ctx, tx, changes); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// addEvents writes the task events of the changes to the outbox.
+func addEvents(ctx context.Context, tx *query.Query, changes []*model.TaskChange) error {
+	events := make([]*eventbus.Event, 0, len(changes))
+	for _, change := range changes {
+		payload, err := json.Marshal(&TaskEvent{
+			ID:     change.Seq,
+			Type:   changeEventType(ctx, change),
+			UserID: change.UserID,
+			TaskID: change.TaskID,
+		})
+		if err != nil {
+			return err
+		}
+		events = append(events, &eventbus.Event{
+			Topic:   consts.TaskEventTopic,
+			Key:     conv.Int64ToStr(change.UserID),
+			Payload: payload,
+		})
+	}
+
+	return outbox.Add(tx.TaskChange.WithContext(ctx).UnderlyingDB(), events...)
+}
+
+// reserveSeqs advances the change sequence of the user by n and returns its new
+// value, the sequence row stays locked until the transaction ends.
+func reserveSeqs(ctx context.Context, tx *query.Query, userID int64, n int) (int64, error) {
+	// gen refuses expressions in ON CONFLICT assignments, hence the underlying DB
+	err := tx.TaskChangeSeq.WithContext(ctx).UnderlyingDB().Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}},
+		DoUpdates: clause.Assignments(map[string]any{"seq": gorm.Expr("seq + ?", n)}),
+	}).Create(&model.TaskChangeSeq{UserID: userID, Seq: int64(n)}).Error
+	if err != nil {
+		return 0, err
+	}
+
+	row, err := tx.TaskChangeSeq.WithContext(ctx).Where(tx.TaskChangeSeq.UserID.Eq(userID)).First()
+	if err != nil {
+		return 0, err
+	}
+
+	return row.Seq, nil
+}
+
+type ChangeDao struct {
+	query *query.Query
+}
+
+func NewChangeDao(db *gorm.DB) *ChangeDao {
+	return &ChangeDao{query: query.Use(db)}
+}
+
+// ListChanges returns the changes of the user with sequences above afterSeq, in
+// sequence order.
+func (c *ChangeDao) ListChanges(ctx context.Context, userID, afterSeq int64, limit int) ([]*model.TaskChange, error) {
+	return c.query.TaskChange.WithContext(ctx).Where(
+		c.query.TaskChange.UserID.Eq(userID),
+		c.query.TaskChange.Seq.Gt(afterSeq),
+	).Order(c.query.TaskChange.Seq).Limit(limit).Find()
+}
+
+// LastSeq returns the sequence of the latest committed change of the user, zero
+// if there is none.
+func (c *ChangeDao) LastSeq(ctx context.Context, userID int64) (int64, error) {
+	row, err := c.query.TaskChangeSeq.WithContext(ctx).Where(c.query.TaskChangeSeq.UserID.Eq(userID)).First()
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return row.Seq, nil
+}
@@ -0,0 +1,103 @@
+package dal
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gen"
+	"gorm.io/gorm"
+
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/internal/dal/model"
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/internal/dal/query"
+)
+
+type ChecklistDao struct {
+	query *query.Query
+}
+
+func NewChecklistDao(db *gorm.DB) *ChecklistDao {
+	return &ChecklistDao{query: query.Use(db)}
+}
+
+func (c *ChecklistDao) Create(ctx context.Context, item *model.ChecklistItem) error {
+	return transaction(ctx, c.query, func(ctx context.Context, tx *query.Query) error {
+		if err := tx.ChecklistItem.WithContext(ctx).Create(item); err != nil {
+			return err
+		}
+
+		return touchTasks(ctx, tx, []int64{item.TaskID})
+	})
+}
+
+func (c *ChecklistDao) GetItemByID(ctx context.Context, itemID int64) (*model.ChecklistItem, bool, error) {
+	item, err := c.query.ChecklistItem.WithContext(ctx).Where(
+		c.query.ChecklistItem.ID.Eq(itemID),
+	).First()
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	return item, true, nil
+}
+
+// ListItems returns the checklist items of the given tasks in display order.
+func (c *ChecklistDao) ListItems(ctx context.Context, taskIDs []int64) ([]*model.ChecklistItem, error) {
+	return c.query.ChecklistItem.WithContext(ctx).Where(
+		c.query.ChecklistItem.TaskID.In(taskIDs...),
+	).Order(c.query.ChecklistItem.Position, c.query.ChecklistItem.ID).Find()
+}
+
+// MaxPosition returns the largest position among the checklist items of the task.
+func (c *ChecklistDao) MaxPosition(ctx context.Context, taskID int64) (int64, error) {
+	var res struct {
+		Position int64
+	}
+	err := c.query.ChecklistItem.WithContext(ctx).Select(c.query.ChecklistItem.Position.Max().IfNull(0).As("position")).
+		Where(c.query.ChecklistItem.TaskID.Eq(taskID)).
+		Scan(&res)
+
+	return res.Position, err
+}
+
+// UpdateItem updates the checklist item of the task, it returns false if no such item exists.
+func (c *ChecklistDao) UpdateItem(ctx context.Context, taskID, itemID int64, updates map[string]any) (bool, error) {
+	return c.writeItem(ctx, taskID, func(ctx context.Context, tx *query.Query) (gen.ResultInfo, error) {
+		return tx.ChecklistItem.WithContext(ctx).Where(
+			tx.ChecklistItem.ID.Eq(itemID),
+			tx.ChecklistItem.TaskID.Eq(taskID),
+		).Updates(updates)
+	})
+}
+
+// DeleteItem deletes the checklist item of the task, it returns false if no such item exists.
+func (c *ChecklistDao) DeleteItem(ctx context.Context, taskID, itemID int64) (bool, error) {
+	return c.writeItem(ctx, taskID, func(ctx context.Context, tx *query.Query) (gen.ResultInfo, error) {
+		return tx.ChecklistItem.WithContext(ctx).Where(
+			tx.ChecklistItem.ID.Eq(itemID),
+			tx.ChecklistItem.TaskID.Eq(taskID),
+		).Delete()
+	})
+}
+
+// writeItem runs the write to a checklist item of the task and logs the task as
+// changed if it matched a row.
+func (c *ChecklistDao) writeItem(ctx context.Context, taskID int64, write func(ctx context.Context, tx *query.Query) (gen.ResultInfo, error)) (bool, error) {
+	ok := false
+	err := transaction(ctx, c.query, func(ctx context.Context, tx *query.Query) error {
+		res, err := write(ctx, tx)
+		if err != nil || res.RowsAffected == 0 {
+			return err
+		}
+		ok = true
+
+		return touchTasks(ctx, tx, []int64{taskID})
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return ok, nil
+}
@@ -0,0 +1,93 @@
+package dal
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/internal/dal/model"
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/internal/dal/query"
+)
+
+// CommentCount is the number of comments on a task.
+type CommentCount struct {
+	TaskID int64
+	Count  int64
+}
+
+type CommentDao struct {
+	query *query.Query
+}
+
+func NewCommentDao(db *gorm.DB) *CommentDao {
+	return &CommentDao{query: query.Use(db)}
+}
+
+func (c *CommentDao) Create(ctx context.Context, comment *model.TaskComment) error {
+	return c.query.TaskComment.WithContext(ctx).Create(comment)
+}
+
+func (c *CommentDao) GetCommentByID(ctx context.Context, commentID int64) (*model.TaskComment, bool, error) {
+	comment, err := c.query.TaskComment.WithContext(ctx).Where(
+		c.query.TaskComment.ID.Eq(commentID),
+	).First()
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	return comment, true, nil
+}
+
+// ListComments returns the comments of the task oldest first, starting above
+// the comment afterID unless it is zero.
+func (c *CommentDao) ListComments(ctx context.Context, taskID, afterID int64, limit int) ([]*model.TaskComment, error) {
+	do := c.query.TaskComment.WithContext(ctx).Where(c.query.TaskComment.TaskID.Eq(taskID))
+	if afterID > 0 {
+		do = do.Where(c.query.TaskComment.ID.Gt(afterID))
+	}
+
+	return do.Order(c.query.TaskComment.ID).Limit(limit).Find()
+}
+
+// CountComments returns the number of comments on each of the given tasks,
+// tasks without comments are left out.
+func (c *CommentDao) CountComments(ctx context.Context, taskIDs []int64) ([]*CommentCount, error) {
+	var counts []*CommentCount
+	err := c.query.TaskComment.WithContext(ctx).
+		Select(c.query.TaskComment.TaskID, c.query.TaskComment.ID.Count().As("count")).
+		Where(c.query.TaskComment.TaskID.In(taskIDs...)).
+		Group(c.query.TaskComment.TaskID).
+		Scan(&counts)
+
+	return counts, err
+}
+
+// UpdateComment updates the comment of the author, it returns false if no such comment exists.
+func (c *CommentDao) UpdateComment(ctx context.Context, userID, commentID int64, updates map[string]any) (bool, error) {
+	res, err := c.query.TaskComment.WithContext(ctx).Where(
+		c.query.TaskComment.ID.Eq(commentID),
+		c.query.TaskComment.UserID.Eq(userID),
+	).Updates(updates)
+	if err != nil {
+		return false, err
+	}
+
+	return res.RowsAffected > 0, nil
+}
+
+// DeleteComment deletes the comment of the author, it returns false if no such comment exists.
+func (c *CommentDao) DeleteComment(ctx context.Context, userID, commentID int64) (bool, error) {
+	res, err := c.query.TaskComment.WithContext(ctx).Where(
+		c.query.TaskComment.ID.Eq(commentID),
+		c.query.TaskComment.UserID.Eq(userID),
+	).Delete()
+	if err != nil {
+		return false, err
+	}
+
+	return res.RowsAffected > 0, nil
+}
@@ -0,0 +1,122 @@
+package dal
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/internal/dal/model"
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/internal/dal/query"
+)
+
+// Blocker is a live task blocking TaskID.
+type Blocker struct {
+	TaskID    int64
+	BlockerID int64
+	Status    int32
+}
+
+type DependencyDao struct {
+	query *query.Query
+}
+
+func NewDependencyDao(db *gorm.DB) *DependencyDao {
+	return &DependencyDao{query: query.Use(db)}
+}
+
+// AddDependency makes blockerID block taskID in one transaction, it returns false
+// if taskID already blocks blockerID directly or through other tasks. After both
+// tasks, the change sequence row of their owner is locked, so the additions of
+// the user run one after another and concurrent edges can't close a cycle.
+func (d *DependencyDao) AddDependency(ctx context.Context, userID, taskID, blockerID int64) (bool, error) {
+	ok := false
+	err := transaction(ctx, d.query, func(ctx context.Context, tx *query.Query) error {
+		var locked []int64
+		err := tx.Task.WithContext(ctx).Unscoped().Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where(tx.Task.ID.In(taskID, blockerID)).
+			Order(tx.Task.ID).
+			Pluck(tx.Task.ID, &locked)
+		if err != nil {
+			return err
+		}
+		// serialize the additions of the user, in the lock order of flush
+		if _, err := reserveSeqs(ctx, tx, userID, 0); err != nil {
+			return err
+		}
+
+		// walk the blockers of blockerID, reaching taskID closes a cycle
+		seen := map[int64]bool{blockerID: true}
+		frontier := []int64{blockerID}
+		for len(frontier) > 0 {
+			var next []int64
+			err := tx.TaskDependency.WithContext(ctx).Where(
+				tx.TaskDependency.TaskID.In(frontier...),
+			).Pluck(tx.TaskDependency.BlockerID, &next)
+			if err != nil {
+				return err
+			}
+
+			frontier = frontier[:0]
+			for _, id := range next {
+				if id == taskID {
+					return nil
+				}
+				if !seen[id] {
+					seen[id] = true
+					frontier = append(frontier, id)
+				}
+			}
+		}
+		ok = true
+
+		err = tx.TaskDependency.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&model.TaskDependency{
+			TaskID:    taskID,
+			BlockerID: blockerID,
+		})
+		if err != nil {
+			return err
+		}
+
+		return touchTasks(ctx, tx, []int64{taskID})
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return ok, nil
+}
+
+func (d *DependencyDao) RemoveDependency(ctx context.Context, taskID, blockerID int64) error {
+	return transaction(ctx, d.query, func(ctx context.Context, tx *query.Query) error {
+		res, err := tx.TaskDependency.WithContext(ctx).Where(
+			tx.TaskDependency.TaskID.Eq(taskID),
+			tx.TaskDependency.BlockerID.Eq(blockerID),
+		).Delete()
+		if err != nil || res.RowsAffected == 0 {
+			return err
+		}
+
+		return touchTasks(ctx, tx, []int64{taskID})
+	})
+}
+
+func (d *DependencyDao) CountBlockers(ctx context.Context, taskID int64) (int64, error) {
+	return d.query.TaskDependency.WithContext(ctx).Where(
+		d.query.TaskDependency.TaskID.Eq(taskID),
+	).Count()
+}
+
+// ListBlockers returns the live tasks blocking the given tasks.
+func (d *DependencyDao) ListBlockers(ctx context.Context, taskIDs []int64) ([]*Blocker, error) {
+	dep, task := d.query.TaskDependency, d.query.Task
+
+	var res []*Blocker
+	err := dep.WithContext(ctx).Select(dep.TaskID, dep.BlockerID, task.Status).
+		Join(task, task.ID.EqCol(dep.BlockerID)).
+		Where(dep.TaskID.In(taskIDs...), task.DeletedAt.IsNull()).
+		Order(dep.CreatedAt).
+		Scan(&res)
+
+	return res, err
+}
@@ -4,17 +4,37 @@
 
 package model
 
+import (
+	"gorm.io/gorm"
+)
+
 const TableNameTask = "task"
 
 // Task Task Table
 type Task struct {
-	ID        int64  `gorm:"column:id;primaryKey;autoIncrement:true;comment:Task ID" json:"id"`                                      // Task ID
-	UserID    int64  `gorm:"column:user_id;not null;comment:Task OwnerID" json:"user_id"`                                            // Task OwnerID
-	Title     string `gorm:"column:title;not null;comment:Task Title" json:"title"`                                                  // Task Title
-	Content   string `gorm:"column:content;not null;comment:Task Content" json:"content"`                                            // Task Content
-	Status    int32  `gorm:"column:status;not null;comment:Task Status" json:"status"`                                               // Task Status
-	CreatedAt int64  `gorm:"column:created_at;not null;autoCreateTime:milli;comment:Creation Time (Milliseconds)" json:"created_at"` // Creation Time (Milliseconds)
-	UpdatedAt int64  `gorm:"column:updated_at;not null;autoUpdateTime:milli;comment:Update Time (Milliseconds)" json:"updated_at"`   // Update Time (Milliseconds)
+	ID           int64          `gorm:"column:id;primaryKey;autoIncrement:true;comment:Task ID" json:"id"`                                      // Task ID
+	UserID       int64          `gorm:"column:user_id;not null;comment:Task OwnerID" json:"user_id"`                                            // Task OwnerID
+	Title        string         `gorm:"column:title;not null;comment:Task Title" json:"title"`                                                  // Task Title
+	Content      string         `gorm:"column:content;not null;comment:Task Content" json:"content"`                                            // Task Content
+	Status       int32          `gorm:"column:status;not null;comment:Task Status" json:"status"`                                               // Task Status
+	CreatedAt    int64          `gorm:"column:created_at;not null;autoCreateTime:milli;comment:Creation Time (Milliseconds)" json:"created_at"` // Creation Time (Milliseconds)
+	UpdatedAt    int64          `gorm:"column:updated_at;not null;autoUpdateTime:milli;comment:Update Time (Milliseconds)" json:"updated_at"`   // Update Time (Milliseconds)
+	DueAt        *int64         `gorm:"column:due_at;comment:Due Time (Milliseconds)" json:"due_at"`                                            // Due Time (Milliseconds)
+	RemindAt     *int64         `gorm:"column:remind_at;comment:Reminder Time (Milliseconds)" json:"remind_at"`                                 // Reminder Time (Milliseconds)
+	RemindedAt   *int64         `gorm:"column:reminded_at;comment:Reminder Sent Time (Milliseconds)" json:"reminded_at"`                        // Reminder Sent Time (Milliseconds)
+	Recurrence   string         `gorm:"column:recurrence;not null;comment:Recurrence Rule (RFC 5545 RRULE)" json:"recurrence"`                  // Recurrence Rule (RFC 5545 RRULE)
+	TimeZone     string         `gorm:"column:time_zone;not null;comment:Recurrence Time Zone" json:"time_zone"`                                // Recurrence Time Zone
+	SeriesID     int64          `gorm:"column:series_id;not null;comment:Recurring Series ID" json:"series_id"`                                 // Recurring Series ID
+	SeriesStart  int64          `gorm:"column:series_start;not null;comment:Series Start Time (Milliseconds)" json:"series_start"`              // Series Start Time (Milliseconds)
+	OccurrenceAt int64          `gorm:"column:occurrence_at;not null;comment:Scheduled Occurrence Time (Milliseconds)" json:"occurrence_at"`    // Scheduled Occurrence Time (Milliseconds)
+	DeletedAt    gorm.DeletedAt `gorm:"column:deleted_at;comment:Deletion Time" json:"deleted_at"`                                              // Deletion Time
+	Version      int64          `gorm:"column:version;not null;comment:Optimistic Lock Version" json:"version"`                                 // Optimistic Lock Version
+	ProjectID    int64          `gorm:"column:project_id;not null;comment:Project ID, 0 Means Inbox" json:"project_id"`                         // Project ID, 0 Means Inbox
+	ParentID     int64          `gorm:"column:parent_id;not null;comment:Parent Task ID, 0 For Top Level Tasks" json:"parent_id"`               // Parent Task ID, 0 For Top Level Tasks
+	AutoComplete bool           `gorm:"column:auto_complete;not null;comment:Complete Once All Subtasks Are Done" json:"auto_complete"`         // Complete Once All Subtasks Are Done
+	Priority     int32          `gorm:"column:priority;not null;comment:Task Priority" json:"priority"`                                         // Task Priority
+	SortKey      string         `gorm:"column:sort_key;not null;comment:Manual Order Rank" json:"sort_key"`                                     // Manual Order Rank
+	ColumnID     int64          `gorm:"column:column_id;not null;comment:Board Column ID, 0 For The Default Column" json:"column_id"`           // Board Column ID, 0 For The Default Column
 }
 
 // TableName Task's table name
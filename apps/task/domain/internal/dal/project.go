@@ -0,0 +1,199 @@
+package dal
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	"gorm.io/gen"
+	"gorm.io/gorm"
+
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/internal/dal/model"
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/internal/dal/query"
+)
+
+type ProjectDao struct {
+	query *query.Query
+}
+
+func NewProjectDao(db *gorm.DB) *ProjectDao {
+	return &ProjectDao{query: query.Use(db)}
+}
+
+func (p *ProjectDao) Create(ctx context.Context, project *model.Project) error {
+	return p.query.Project.WithContext(ctx).Create(project)
+}
+
+func (p *ProjectDao) GetProjectByID(ctx context.Context, projectID int64) (*model.Project, bool, error) {
+	project, err := p.query.Project.WithContext(ctx).Where(
+		p.query.Project.ID.Eq(projectID),
+	).First()
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	return project, true, nil
+}
+
+func (p *ProjectDao) GetInbox(ctx context.Context, userID int64) (*model.Project, bool, error) {
+	project, err := p.query.Project.WithContext(ctx).Where(
+		p.query.Project.UserID.Eq(userID),
+		p.query.Project.IsInbox.Is(true),
+	).First()
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	return project, true, nil
+}
+
+// ListProjects returns the projects of the user in display order.
+func (p *ProjectDao) ListProjects(ctx context.Context, userID int64, includeArchived bool) ([]*model.Project, error) {
+	do := p.query.Project.WithContext(ctx).Where(p.query.Project.UserID.Eq(userID))
+	if !includeArchived {
+		do = do.Where(p.query.Project.ArchivedAt.IsNull())
+	}
+
+	return do.Order(p.query.Project.Position, p.query.Project.ID).Find()
+}
+
+// MaxPosition returns the largest position among the projects of the user.
+func (p *ProjectDao) MaxPosition(ctx context.Context, userID int64) (int64, error) {
+	var res struct {
+		Position int64
+	}
+	err := p.query.Project.WithContext(ctx).Select(p.query.Project.Position.Max().IfNull(0).As("position")).
+		Where(p.query.Project.UserID.Eq(userID)).
+		Scan(&res)
+
+	return res.Position, err
+}
+
+// UpdateProject updates the project owned by userID, it returns false if no such project exists.
+func (p *ProjectDao) UpdateProject(ctx context.Context, userID, projectID int64, updates map[string]any) (bool, error) {
+	res, err := p.query.Project.WithContext(ctx).Where(
+		p.query.Project.ID.Eq(projectID),
+		p.query.Project.UserID.Eq(userID),
+	).Updates(updates)
+	if err != nil {
+		return false, err
+	}
+
+	return res.RowsAffected > 0, nil
+}
+
+// ReorderProjects puts the given projects of the user in the given order. They
+// take over the positions they held among themselves, so the projects left out
+// keep their places. It returns false unless every project belongs to the user.
+func (p *ProjectDao) ReorderProjects(ctx context.Context, userID int64, projectIDs []int64) (bool, error) {
+	ok := false
+	err := p.query.Transaction(func(tx *query.Query) error {
+		projects, err := tx.Project.WithContext(ctx).Where(
+			tx.Project.ID.In(projectIDs...),
+			tx.Project.UserID.Eq(userID),
+		).Find()
+		if err != nil {
+			return err
+		}
+		if len(projects) != len(projectIDs) {
+			return nil
+		}
+		ok = true
+
+		positions := make([]int64, 0, len(projects))
+		for _, project := range projects {
+			positions = append(positions, project.Position)
+		}
+		sort.Slice(positions, func(i, j int) bool { return positions[i] < positions[j] })
+
+		now := time.Now().UnixMilli()
+		for i, id := range projectIDs {
+			_, err := tx.Project.WithContext(ctx).Where(tx.Project.ID.Eq(id)).Updates(map[string]any{
+				"position":   positions[i],
+				"updated_at": now,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return ok, nil
+}
+
+// DeleteProject deletes the project owned by userID and its board columns in one
+// transaction. Its tasks, including the ones in the recycle bin, move to project
+// moveTo, or if moveTo is zero go to the recycle bin in trashedStatus and fall
+// back to the inbox once restored. It returns the IDs of the newly trashed tasks,
+// and false if no such project exists.
+func (p *ProjectDao) DeleteProject(ctx context.Context, userID, projectID, moveTo int64, trashedStatus int32) ([]int64, bool, error) {
+	var (
+		trashed []int64
+		deleted bool
+	)
+	err := transaction(ctx, p.query, func(ctx context.Context, tx *query.Query) error {
+		res, err := tx.Project.WithContext(ctx).Where(
+			tx.Project.ID.Eq(projectID),
+			tx.Project.UserID.Eq(userID),
+		).Delete()
+		if err != nil {
+			return err
+		}
+		if res.RowsAffected == 0 {
+			return nil
+		}
+		deleted = true
+
+		_, err = tx.BoardColumn.WithContext(ctx).Where(tx.BoardColumn.ProjectID.Eq(projectID)).Delete()
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		if moveTo == 0 {
+			err := tx.Task.WithContext(ctx).Where(
+				tx.Task.UserID.Eq(userID),
+				tx.Task.ProjectID.Eq(projectID),
+			).Pluck(tx.Task.ID, &trashed)
+			if err != nil {
+				return err
+			}
+			if len(trashed) > 0 {
+				_, err = updateTasks(ctx, tx, false, []gen.Condition{tx.Task.ID.In(trashed...)}, bumpVersion(map[string]any{
+					"status":     trashedStatus,
+					"deleted_at": now,
+					"updated_at": now.UnixMilli(),
+				}))
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		_, err = updateTasks(ctx, tx, true, []gen.Condition{
+			tx.Task.UserID.Eq(userID),
+			tx.Task.ProjectID.Eq(projectID),
+		}, bumpVersion(map[string]any{
+			"project_id": moveTo,
+			"updated_at": now.UnixMilli(),
+		}))
+		return err
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	return trashed, deleted, nil
+}
@@ -16,34 +16,104 @@ import (
 )
 
 var (
-	Q    = new(Query)
-	Task *task
+	Q               = new(Query)
+	BoardColumn     *boardColumn
+	CalendarFeed    *calendarFeed
+	ChecklistItem   *checklistItem
+	Project         *project
+	Tag             *tag
+	Task            *task
+	TaskActivity    *taskActivity
+	TaskAttachment  *taskAttachment
+	TaskChange      *taskChange
+	TaskChangeSeq   *taskChangeSeq
+	TaskComment     *taskComment
+	TaskDependency  *taskDependency
+	TaskTag         *taskTag
+	Webhook         *webhook
+	WebhookDelivery *webhookDelivery
 )
 
 func SetDefault(db *gorm.DB, opts ...gen.DOOption) {
 	*Q = *Use(db, opts...)
+	BoardColumn = &Q.BoardColumn
+	CalendarFeed = &Q.CalendarFeed
+	ChecklistItem = &Q.ChecklistItem
+	Project = &Q.Project
+	Tag = &Q.Tag
 	Task = &Q.Task
+	TaskActivity = &Q.TaskActivity
+	TaskAttachment = &Q.TaskAttachment
+	TaskChange = &Q.TaskChange
+	TaskChangeSeq = &Q.TaskChangeSeq
+	TaskComment = &Q.TaskComment
+	TaskDependency = &Q.TaskDependency
+	TaskTag = &Q.TaskTag
+	Webhook = &Q.Webhook
+	WebhookDelivery = &Q.WebhookDelivery
 }
 
 func Use(db *gorm.DB, opts ...gen.DOOption) *Query {
 	return &Query{
-		db:   db,
-		Task: newTask(db, opts...),
+		db:              db,
+		BoardColumn:     newBoardColumn(db, opts...),
+		CalendarFeed:    newCalendarFeed(db, opts...),
+		ChecklistItem:   newChecklistItem(db, opts...),
+		Project:         newProject(db, opts...),
+		Tag:             newTag(db, opts...),
+		Task:            newTask(db, opts...),
+		TaskActivity:    newTaskActivity(db, opts...),
+		TaskAttachment:  newTaskAttachment(db, opts...),
+		TaskChange:      newTaskChange(db, opts...),
+		TaskChangeSeq:   newTaskChangeSeq(db, opts...),
+		TaskComment:     newTaskComment(db, opts...),
+		TaskDependency:  newTaskDependency(db, opts...),
+		TaskTag:         newTaskTag(db, opts...),
+		Webhook:         newWebhook(db, opts...),
+		WebhookDelivery: newWebhookDelivery(db, opts...),
 	}
 }
 
 type Query struct {
 	db *gorm.DB
 
-	Task task
+	BoardColumn     boardColumn
+	CalendarFeed    calendarFeed
+	ChecklistItem   checklistItem
+	Project         project
+	Tag             tag
+	Task            task
+	TaskActivity    taskActivity
+	TaskAttachment  taskAttachment
+	TaskChange      taskChange
+	TaskChangeSeq   taskChangeSeq
+	TaskComment     taskComment
+	TaskDependency  taskDependency
+	TaskTag         taskTag
+	Webhook         webhook
+	WebhookDelivery webhookDelivery
 }
 
 func (q *Query) Available() bool { return q.db != nil }
 
 func (q *Query) clone(db *gorm.DB) *Query {
 	return &Query{
-		db:   db,
-		Task: q.Task.clone(db),
+		db:              db,
+		BoardColumn:     q.BoardColumn.clone(db),
+		CalendarFeed:    q.CalendarFeed.clone(db),
+		ChecklistItem:   q.ChecklistItem.clone(db),
+		Project:         q.Project.clone(db),
+		Tag:             q.Tag.clone(db),
+		Task:            q.Task.clone(db),
+		TaskActivity:    q.TaskActivity.clone(db),
+		TaskAttachment:  q.TaskAttachment.clone(db),
+		TaskChange:      q.TaskChange.clone(db),
+		TaskChangeSeq:   q.TaskChangeSeq.clone(db),
+		TaskComment:     q.TaskComment.clone(db),
+		TaskDependency:  q.TaskDependency.clone(db),
+		TaskTag:         q.TaskTag.clone(db),
+		Webhook:         q.Webhook.clone(db),
+		WebhookDelivery: q.WebhookDelivery.clone(db),
 	}
 }
 
@@ -57,18 +127,60 @@ func (q *Query) WriteDB() *Query {
 
 func (q *Query) ReplaceDB(db *gorm.DB) *Query {
 	return &Query{
-		db:   db,
-		Task: q.Task.replaceDB(db),
+		db:              db,
+		BoardColumn:     q.BoardColumn.replaceDB(db),
+		CalendarFeed:    q.CalendarFeed.replaceDB(db),
+		ChecklistItem:   q.ChecklistItem.replaceDB(db),
+		Project:         q.Project.replaceDB(db),
+		Tag:             q.Tag.replaceDB(db),
+		Task:            q.Task.replaceDB(db),
+		TaskActivity:    q.TaskActivity.replaceDB(db),
+		TaskAttachment:  q.TaskAttachment.replaceDB(db),
+		TaskChange:      q.TaskChange.replaceDB(db),
+		TaskChangeSeq:   q.TaskChangeSeq.replaceDB(db),
+		TaskComment:     q.TaskComment.replaceDB(db),
+		TaskDependency:  q.TaskDependency.replaceDB(db),
+		TaskTag:         q.TaskTag.replaceDB(db),
+		Webhook:         q.Webhook.replaceDB(db),
+		WebhookDelivery: q.WebhookDelivery.replaceDB(db),
 	}
 }
 
 type queryCtx struct {
-	Task ITaskDo
+	BoardColumn     IBoardColumnDo
+	CalendarFeed    ICalendarFeedDo
+	ChecklistItem   IChecklistItemDo
+	Project         IProjectDo
+	Tag             ITagDo
+	Task            ITaskDo
+	TaskActivity    ITaskActivityDo
+	TaskAttachment  ITaskAttachmentDo
+	TaskChange      ITaskChangeDo
+	TaskChangeSeq   ITaskChangeSeqDo
+	TaskComment     ITaskCommentDo
+	TaskDependency  ITaskDependencyDo
+	TaskTag         ITaskTagDo
+	Webhook         IWebhookDo
+	WebhookDelivery IWebhookDeliveryDo
 }
 
 func (q *Query) WithContext(ctx context.Context) *queryCtx {
 	return &queryCtx{
-		Task: q.Task.WithContext(ctx),
+		BoardColumn:     q.BoardColumn.WithContext(ctx),
+		CalendarFeed:    q.CalendarFeed.WithContext(ctx),
+		ChecklistItem:   q.ChecklistItem.WithContext(ctx),
+		Project:         q.Project.WithContext(ctx),
+		Tag:             q.Tag.WithContext(ctx),
+		Task:            q.Task.WithContext(ctx),
+		TaskActivity:    q.TaskActivity.WithContext(ctx),
+		TaskAttachment:  q.TaskAttachment.WithContext(ctx),
+		TaskChange:      q.TaskChange.WithContext(ctx),
+		TaskChangeSeq:   q.TaskChangeSeq.WithContext(ctx),
+		TaskComment:     q.TaskComment.WithContext(ctx),
+		TaskDependency:  q.TaskDependency.WithContext(ctx),
+		TaskTag:         q.TaskTag.WithContext(ctx),
+		Webhook:         q.Webhook.WithContext(ctx),
+		WebhookDelivery: q.WebhookDelivery.WithContext(ctx),
 	}
 }
 
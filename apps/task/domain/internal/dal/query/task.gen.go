@@ -35,6 +35,22 @@ func newTask(db *gorm.DB, opts ...gen.DOOption) task {
 	_task.Status = field.NewInt32(tableName, "status")
 	_task.CreatedAt = field.NewInt64(tableName, "created_at")
 	_task.UpdatedAt = field.NewInt64(tableName, "updated_at")
+	_task.DueAt = field.NewInt64(tableName, "due_at")
+	_task.RemindAt = field.NewInt64(tableName, "remind_at")
+	_task.RemindedAt = field.NewInt64(tableName, "reminded_at")
+	_task.Recurrence = field.NewString(tableName, "recurrence")
+	_task.TimeZone = field.NewString(tableName, "time_zone")
+	_task.SeriesID = field.NewInt64(tableName, "series_id")
+	_task.SeriesStart = field.NewInt64(tableName, "series_start")
+	_task.OccurrenceAt = field.NewInt64(tableName, "occurrence_at")
+	_task.DeletedAt = field.NewField(tableName, "deleted_at")
+	_task.Version = field.NewInt64(tableName, "version")
+	_task.ProjectID = field.NewInt64(tableName, "project_id")
+	_task.ParentID = field.NewInt64(tableName, "parent_id")
+	_task.AutoComplete = field.NewBool(tableName, "auto_complete")
+	_task.Priority = field.NewInt32(tableName, "priority")
+	_task.SortKey = field.NewString(tableName, "sort_key")
+	_task.ColumnID = field.NewInt64(tableName, "column_id")
 
 	_task.fillFieldMap()
 
@@ -45,14 +61,30 @@ func newTask(db *gorm.DB, opts ...gen.DOOption) task {
 type task struct {
 	taskDo
 
-	ALL       field.Asterisk
-	ID        field.Int64  // Task ID
-	UserID    field.Int64  // Task OwnerID
-	Title     field.String // Task Title
-	Content   field.String // Task Content
-	Status    field.Int32  // Task Status
-	CreatedAt field.Int64  // Creation Time (Milliseconds)
-	UpdatedAt field.Int64  // Update Time (Milliseconds)
+	ALL          field.Asterisk
+	ID           field.Int64  // Task ID
+	UserID       field.Int64  // Task OwnerID
+	Title        field.String // Task Title
+	Content      field.String // Task Content
+	Status       field.Int32  // Task Status
+	CreatedAt    field.Int64  // Creation Time (Milliseconds)
+	UpdatedAt    field.Int64  // Update Time (Milliseconds)
+	DueAt        field.Int64  // Due Time (Milliseconds)
+	RemindAt     field.Int64  // Reminder Time (Milliseconds)
+	RemindedAt   field.Int64  // Reminder Sent Time (Milliseconds)
+	Recurrence   field.String // Recurrence Rule (RFC 5545 RRULE)
+	TimeZone     field.String // Recurrence Time Zone
+	SeriesID     field.Int64  // Recurring Series ID
+	SeriesStart  field.Int64  // Series Start Time (Milliseconds)
+	OccurrenceAt field.Int64  // Scheduled Occurrence Time (Milliseconds)
+	DeletedAt    field.Field  // Deletion Time
+	Version      field.Int64  // Optimistic Lock Version
+	ProjectID    field.Int64  // Project ID, 0 Means Inbox
+	ParentID     field.Int64  // Parent Task ID, 0 For Top Level Tasks
+	AutoComplete field.Bool   // Complete Once All Subtasks Are Done
+	Priority     field.Int32  // Task Priority
+	SortKey      field.String // Manual Order Rank
+	ColumnID     field.Int64  // Board Column ID, 0 For The Default Column
 
 	fieldMap map[string]field.Expr
 }
@@ -76,6 +108,22 @@ func (t *task) updateTableName(table string) *task {
 	t.Status = field.NewInt32(table, "status")
 	t.CreatedAt = field.NewInt64(table, "created_at")
 	t.UpdatedAt = field.NewInt64(table, "updated_at")
+	t.DueAt = field.NewInt64(table, "due_at")
+	t.RemindAt = field.NewInt64(table, "remind_at")
+	t.RemindedAt = field.NewInt64(table, "reminded_at")
+	t.Recurrence = field.NewString(table, "recurrence")
+	t.TimeZone = field.NewString(table, "time_zone")
+	t.SeriesID = field.NewInt64(table, "series_id")
+	t.SeriesStart = field.NewInt64(table, "series_start")
+	t.OccurrenceAt = field.NewInt64(table, "occurrence_at")
+	t.DeletedAt = field.NewField(table, "deleted_at")
+	t.Version = field.NewInt64(table, "version")
+	t.ProjectID = field.NewInt64(table, "project_id")
+	t.ParentID = field.NewInt64(table, "parent_id")
+	t.AutoComplete = field.NewBool(table, "auto_complete")
+	t.Priority = field.NewInt32(table, "priority")
+	t.SortKey = field.NewString(table, "sort_key")
+	t.ColumnID = field.NewInt64(table, "column_id")
 
 	t.fillFieldMap()
 
@@ -92,7 +140,7 @@ func (t *task) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
 }
 
 func (t *task) fillFieldMap() {
-	t.fieldMap = make(map[string]field.Expr, 7)
+	t.fieldMap = make(map[string]field.Expr, 23)
 	t.fieldMap["id"] = t.ID
 	t.fieldMap["user_id"] = t.UserID
 	t.fieldMap["title"] = t.Title
@@ -100,6 +148,22 @@ func (t *task) fillFieldMap() {
 	t.fieldMap["status"] = t.Status
 	t.fieldMap["created_at"] = t.CreatedAt
 	t.fieldMap["updated_at"] = t.UpdatedAt
+	t.fieldMap["due_at"] = t.DueAt
+	t.fieldMap["remind_at"] = t.RemindAt
+	t.fieldMap["reminded_at"] = t.RemindedAt
+	t.fieldMap["recurrence"] = t.Recurrence
+	t.fieldMap["time_zone"] = t.TimeZone
+	t.fieldMap["series_id"] = t.SeriesID
+	t.fieldMap["series_start"] = t.SeriesStart
+	t.fieldMap["occurrence_at"] = t.OccurrenceAt
+	t.fieldMap["deleted_at"] = t.DeletedAt
+	t.fieldMap["version"] = t.Version
+	t.fieldMap["project_id"] = t.ProjectID
+	t.fieldMap["parent_id"] = t.ParentID
+	t.fieldMap["auto_complete"] = t.AutoComplete
+	t.fieldMap["priority"] = t.Priority
+	t.fieldMap["sort_key"] = t.SortKey
+	t.fieldMap["column_id"] = t.ColumnID
 }
 
 func (t task) clone(db *gorm.DB) task {
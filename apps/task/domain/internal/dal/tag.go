@@ -0,0 +1,177 @@
+package dal
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/internal/dal/model"
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/internal/dal/query"
+)
+
+// TagCount is the number of live tasks carrying a tag.
+type TagCount struct {
+	TagID int64
+	Count int64
+}
+
+type TagDao struct {
+	query *query.Query
+}
+
+func NewTagDao(db *gorm.DB) *TagDao {
+	return &TagDao{query: query.Use(db)}
+}
+
+func (t *TagDao) Create(ctx context.Context, tag *model.Tag) error {
+	return t.query.Tag.WithContext(ctx).Create(tag)
+}
+
+func (t *TagDao) GetTagByID(ctx context.Context, tagID int64) (*model.Tag, bool, error) {
+	tag, err := t.query.Tag.WithContext(ctx).Where(
+		t.query.Tag.ID.Eq(tagID),
+	).First()
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	return tag, true, nil
+}
+
+func (t *TagDao) GetTagsByIDs(ctx context.Context, tagIDs []int64) ([]*model.Tag, error) {
+	return t.query.Tag.WithContext(ctx).Where(
+		t.query.Tag.ID.In(tagIDs...),
+	).Find()
+}
+
+func (t *TagDao) ListTags(ctx context.Context, userID int64) ([]*model.Tag, error) {
+	return t.query.Tag.WithContext(ctx).Where(
+		t.query.Tag.UserID.Eq(userID),
+	).Order(t.query.Tag.Name).Find()
+}
+
+func (t *TagDao) CheckTagNameExist(ctx context.Context, userID int64, name string) (bool, error) {
+	_, err := t.query.Tag.WithContext(ctx).Select(t.query.Tag.ID).Where(
+		t.query.Tag.UserID.Eq(userID),
+		t.query.Tag.Name.Eq(name),
+	).First()
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// UpdateTag updates the tag owned by userID, it returns false if no such tag exists.
+func (t *TagDao) UpdateTag(ctx context.Context, userID, tagID int64, updates map[string]any) (bool, error) {
+	res, err := t.query.Tag.WithContext(ctx).Where(
+		t.query.Tag.ID.Eq(tagID),
+		t.query.Tag.UserID.Eq(userID),
+	).Updates(updates)
+	if err != nil {
+		return false, err
+	}
+
+	return res.RowsAffected > 0, nil
+}
+
+// DeleteTag deletes the tag owned by userID and detaches it from its tasks,
+// the tasks themselves are kept. It returns false if no such tag exists.
+func (t *TagDao) DeleteTag(ctx context.Context, userID, tagID int64) (bool, error) {
+	deleted := false
+	err := transaction(ctx, t.query, func(ctx context.Context, tx *query.Query) error {
+		res, err := tx.Tag.WithContext(ctx).Where(
+			tx.Tag.ID.Eq(tagID),
+			tx.Tag.UserID.Eq(userID),
+		).Delete()
+		if err != nil {
+			return err
+		}
+		if res.RowsAffected == 0 {
+			return nil
+		}
+		deleted = true
+
+		var taskIDs []int64
+		err = tx.TaskTag.WithContext(ctx).Where(tx.TaskTag.TagID.Eq(tagID)).Pluck(tx.TaskTag.TaskID, &taskIDs)
+		if err != nil {
+			return err
+		}
+		if _, err := tx.TaskTag.WithContext(ctx).Where(tx.TaskTag.TagID.Eq(tagID)).Delete(); err != nil {
+			return err
+		}
+
+		return touchTasks(ctx, tx, taskIDs)
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return deleted, nil
+}
+
+// CountTasks returns the number of live tasks carrying each of the given tags,
+// tags without tasks are left out.
+func (t *TagDao) CountTasks(ctx context.Context, tagIDs []int64) ([]*TagCount, error) {
+	tt, task := t.query.TaskTag, t.query.Task
+
+	var counts []*TagCount
+	err := tt.WithContext(ctx).Select(tt.TagID, tt.TaskID.Count().As("count")).
+		Join(task, task.ID.EqCol(tt.TaskID)).
+		Where(tt.TagID.In(tagIDs...), task.DeletedAt.IsNull()).
+		Group(tt.TagID).
+		Scan(&counts)
+
+	return counts, err
+}
+
+func (t *TagDao) ListTaskTags(ctx context.Context, taskIDs []int64) ([]*model.TaskTag, error) {
+	return t.query.TaskTag.WithContext(ctx).Where(
+		t.query.TaskTag.TaskID.In(taskIDs...),
+	).Order(t.query.TaskTag.CreatedAt).Find()
+}
+
+// TagChanges lists the tags to attach to and detach from a task.
+type TagChanges struct {
+	Attach []int64
+	Detach []int64
+}
+
+// applyTagChanges attaches and detaches the tags of the given tasks within tx,
+// tags a task already carries are skipped.
+func applyTagChanges(ctx context.Context, tx *query.Query, taskIDs []int64, changes *TagChanges) error {
+	if changes == nil {
+		return nil
+	}
+
+	if len(changes.Detach) > 0 {
+		_, err := tx.TaskTag.WithContext(ctx).Where(
+			tx.TaskTag.TaskID.In(taskIDs...),
+			tx.TaskTag.TagID.In(changes.Detach...),
+		).Delete()
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(changes.Attach) == 0 {
+		return nil
+	}
+	now := time.Now().UnixMilli()
+	relations := make([]*model.TaskTag, 0, len(taskIDs)*len(changes.Attach))
+	for _, taskID := range taskIDs {
+		for _, tagID := range changes.Attach {
+			relations = append(relations, &model.TaskTag{TaskID: taskID, TagID: tagID, CreatedAt: now})
+		}
+	}
+
+	return tx.TaskTag.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(relations...)
+}
@@ -2,14 +2,73 @@ package dal
 
 import (
 	"context"
+	"errors"
 	"time"
 
+	"gorm.io/gen"
+	"gorm.io/gen/field"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/internal/dal/model"
 	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/internal/dal/query"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/lang/slice"
 )
 
+// ListTasksParams describes a keyset paginated query over a user's tasks.
+// Time bounds are milliseconds and inclusive, zero means unbounded.
+type ListTasksParams struct {
+	UserID   int64
+	Statuses []int32
+	// Deleted lists the soft deleted tasks instead of the live ones.
+	Deleted bool
+	// TagIDs keeps the tasks carrying any of the tags.
+	TagIDs []int64
+	// ProjectIDs keeps the tasks in any of the projects.
+	ProjectIDs []int64
+	// RootOnly keeps the top level tasks.
+	RootOnly bool
+	// BlockingStatuses drops the tasks blocked by a live task in any of the statuses.
+	BlockingStatuses []int32
+	Limit            int
+
+	SortBy SortColumn
+	Asc    bool
+	Cursor *ListCursor
+
+	CreatedAfter  int64
+	CreatedBefore int64
+	UpdatedAfter  int64
+	UpdatedBefore int64
+}
+
+// ChildStatus is the status of a subtask.
+type ChildStatus struct {
+	ParentID int64
+	Status   int32
+}
+
+// maxTreeDepth bounds the walks over task trees.
+const maxTreeDepth = 16
+
+// SortColumn is the column task lists are ordered by, ties are broken by ID.
+type SortColumn int
+
+const (
+	SortByCreatedAt SortColumn = iota
+	SortByUpdatedAt
+	SortByPriority
+	SortBySortKey
+)
+
+// ListCursor is the position of the last row of the previous page, Key holds
+// the sort key when sorting by it and Value the sort column otherwise.
+type ListCursor struct {
+	Value int64
+	Key   string
+	ID    int64
+}
+
 type TaskDao struct {
 	query *query.Query
 }
@@ -18,30 +77,679 @@ func NewTaskDao(db *gorm.DB) *TaskDao {
 	return &TaskDao{query: query.Use(db)}
 }
 
-func (t *TaskDao) Create(ctx context.Context, task *model.Task) error {
-	return t.query.Task.WithContext(ctx).Create(task)
+// Create creates the task carrying the given tags and records its creation.
+func (t *TaskDao) Create(ctx context.Context, task *model.Task, tagIDs []int64) error {
+	return transaction(ctx, t.query, func(ctx context.Context, tx *query.Query) error {
+		return createTask(ctx, tx, task, tagIDs)
+	})
 }
 
-func (t *TaskDao) UpdateTask(ctx context.Context, taskID int64, updates map[string]any) error {
-	_, err := t.query.Task.WithContext(ctx).Where(
+// GetTaskByID returns the task even if it is soft deleted.
+func (t *TaskDao) GetTaskByID(ctx context.Context, taskID int64) (*model.Task, bool, error) {
+	task, err := t.query.Task.WithContext(ctx).Unscoped().Where(
 		t.query.Task.ID.Eq(taskID),
-	).Updates(updates)
-	return err
+	).First()
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	return task, true, nil
 }
 
-func (t *TaskDao) UpdateTaskStatus(ctx context.Context, taskID int64, status int32) error {
-	_, err := t.query.Task.WithContext(ctx).Where(
-		t.query.Task.ID.Eq(taskID),
-	).Updates(map[string]any{
-		"status":     status,
-		"updated_at": time.Now().UnixMilli(),
-	})
-	return err
+// ListChildren returns the live subtasks of the given tasks.
+func (t *TaskDao) ListChildren(ctx context.Context, parentIDs []int64) ([]*model.Task, error) {
+	return t.query.Task.WithContext(ctx).Where(
+		t.query.Task.ParentID.In(parentIDs...),
+	).Order(t.query.Task.CreatedAt, t.query.Task.ID).Find()
 }
 
-func (t *TaskDao) GetTasksByID(ctx context.Context, userID int64, status int32) ([]*model.Task, error) {
+// ListChildStatuses returns the statuses of the live subtasks of the given tasks.
+func (t *TaskDao) ListChildStatuses(ctx context.Context, parentIDs []int64) ([]*ChildStatus, error) {
+	var res []*ChildStatus
+	err := t.query.Task.WithContext(ctx).Select(t.query.Task.ParentID, t.query.Task.Status).
+		Where(t.query.Task.ParentID.In(parentIDs...)).
+		Scan(&res)
+
+	return res, err
+}
+
+func (t *TaskDao) GetTasksByIDs(ctx context.Context, userID int64, taskIDs []int64) ([]*model.Task, error) {
 	return t.query.Task.WithContext(ctx).Where(
+		t.query.Task.ID.In(taskIDs...),
+		t.query.Task.UserID.Eq(userID),
+	).Find()
+}
+
+// ListTasksByDueRange returns the tasks due in [from, to), a zero from means unbounded.
+func (t *TaskDao) ListTasksByDueRange(ctx context.Context, userID int64, statuses []int32, from, to int64, limit int) ([]*model.Task, error) {
+	conds := []gen.Condition{
+		t.query.Task.UserID.Eq(userID),
+		t.query.Task.Status.In(statuses...),
+		t.query.Task.DueAt.IsNotNull(),
+		t.query.Task.DueAt.Lt(to),
+	}
+	if from > 0 {
+		conds = append(conds, t.query.Task.DueAt.Gte(from))
+	}
+
+	return t.query.Task.WithContext(ctx).Where(conds...).
+		Order(t.query.Task.DueAt, t.query.Task.ID).
+		Limit(limit).
+		Find()
+}
+
+// ListDueReminders returns the tasks whose reminder is due and not sent yet.
+func (t *TaskDao) ListDueReminders(ctx context.Context, statuses []int32, now int64, limit int) ([]*model.Task, error) {
+	return t.query.Task.WithContext(ctx).Where(
+		t.query.Task.RemindAt.Lte(now),
+		t.query.Task.RemindedAt.IsNull(),
+		t.query.Task.Status.In(statuses...),
+	).Order(t.query.Task.RemindAt).Limit(limit).Find()
+}
+
+// MarkReminded records that the reminder at remindAt has been sent, it leaves
+// updated_at untouched since the task content didn't change.
+func (t *TaskDao) MarkReminded(ctx context.Context, taskID, remindAt, sentAt int64) (bool, error) {
+	var ids []int64
+	err := transaction(ctx, t.query, func(ctx context.Context, tx *query.Query) error {
+		var err error
+		ids, err = updateTasks(ctx, tx, false, []gen.Condition{
+			tx.Task.ID.Eq(taskID),
+			tx.Task.RemindAt.Eq(remindAt),
+			tx.Task.RemindedAt.IsNull(),
+		}, map[string]any{"reminded_at": sentAt})
+		return err
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return len(ids) > 0, nil
+}
+
+// UpdateTask updates the task owned by userID and its tags if its version still
+// equals the given one, a nil version skips the check. It returns false if no such
+// task exists.
+func (t *TaskDao) UpdateTask(ctx context.Context, userID, taskID int64, version *int64, updates map[string]any, tags *TagChanges) (bool, error) {
+	updated := false
+	err := transaction(ctx, t.query, func(ctx context.Context, tx *query.Query) error {
+		conds := []gen.Condition{
+			tx.Task.ID.Eq(taskID),
+			tx.Task.UserID.Eq(userID),
+		}
+		if version != nil {
+			conds = append(conds, tx.Task.Version.Eq(*version))
+		}
+
+		ids, err := updateTasks(ctx, tx, false, conds, bumpVersion(updates))
+		if err != nil || len(ids) == 0 {
+			return err
+		}
+		updated = true
+
+		if err := moveSubtasks(ctx, tx, []int64{taskID}, updates); err != nil {
+			return err
+		}
+
+		return applyTagChanges(ctx, tx, []int64{taskID}, tags)
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return updated, nil
+}
+
+// UpdateTaskStatus moves the task owned by userID from status from to status to,
+// it returns false if no such task is in status from.
+func (t *TaskDao) UpdateTaskStatus(ctx context.Context, userID, taskID int64, from, to int32) (bool, error) {
+	var ids []int64
+	err := transaction(ctx, t.query, func(ctx context.Context, tx *query.Query) error {
+		var err error
+		ids, err = updateTasks(ctx, tx, false, []gen.Condition{
+			tx.Task.ID.Eq(taskID),
+			tx.Task.UserID.Eq(userID),
+			tx.Task.Status.Eq(from),
+		}, bumpVersion(map[string]any{
+			"status":     to,
+			"updated_at": time.Now().UnixMilli(),
+		}))
+		return err
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return len(ids) > 0, nil
+}
+
+// FinishOccurrence moves the task from status from to status to and creates next,
+// the following occurrence of its series, in one transaction. next is skipped if
+// the task wasn't in status from or the series already has that occurrence, the
+// returned bool reports whether next was created.
+func (t *TaskDao) FinishOccurrence(ctx context.Context, userID, taskID int64, from, to int32, next *model.Task) (bool, error) {
+	created := false
+	err := transaction(ctx, t.query, func(ctx context.Context, tx *query.Query) error {
+		var err error
+		_, created, err = finishOccurrence(ctx, tx, userID, taskID, from, to, next)
+		return err
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return created, nil
+}
+
+// UpdateSeries applies taskUpdates to the task and seriesUpdates to the other tasks
+// of the series in the given statuses, in one transaction. The tag changes apply to
+// all of them, the version is checked like in UpdateTask. It returns the IDs of all
+// updated tasks, or nil if the task owned by userID doesn't exist.
+func (t *TaskDao) UpdateSeries(ctx context.Context, userID, taskID, seriesID int64, version *int64, statuses []int32, taskUpdates, seriesUpdates map[string]any, tags *TagChanges) ([]int64, error) {
+	var ids []int64
+	err := transaction(ctx, t.query, func(ctx context.Context, tx *query.Query) error {
+		conds := []gen.Condition{
+			tx.Task.ID.Eq(taskID),
+			tx.Task.UserID.Eq(userID),
+		}
+		if version != nil {
+			conds = append(conds, tx.Task.Version.Eq(*version))
+		}
+
+		updated, err := updateTasks(ctx, tx, false, conds, bumpVersion(taskUpdates))
+		if err != nil || len(updated) == 0 {
+			return err
+		}
+
+		ids, err = updateTasks(ctx, tx, false, []gen.Condition{
+			tx.Task.SeriesID.Eq(seriesID),
+			tx.Task.UserID.Eq(userID),
+			tx.Task.Status.In(statuses...),
+			tx.Task.ID.Neq(taskID),
+		}, bumpVersion(seriesUpdates))
+		if err != nil {
+			return err
+		}
+		if len(ids) > 0 {
+			if err := moveSubtasks(ctx, tx, ids, seriesUpdates); err != nil {
+				return err
+			}
+		}
+		if err := moveSubtasks(ctx, tx, []int64{taskID}, taskUpdates); err != nil {
+			return err
+		}
+		ids = append(ids, taskID)
+
+		return applyTagChanges(ctx, tx, ids, tags)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+// TrashTask soft deletes the live task owned by userID together with its subtasks
+// and moves them to status in one transaction. It returns the IDs of the trashed
+// tasks, or nil if no such task exists.
+func (t *TaskDao) TrashTask(ctx context.Context, userID, taskID int64, status int32) ([]int64, error) {
+	var ids []int64
+	err := transaction(ctx, t.query, func(ctx context.Context, tx *query.Query) error {
+		var err error
+		ids, err = trashTask(ctx, tx, userID, taskID, status)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+// RestoreTask brings back the soft deleted task owned by userID in status together
+// with the subtasks trashed along with it, in one transaction. The task becomes a
+// top level task if its parent is gone. It returns the IDs of the restored tasks,
+// or nil if no such task exists.
+func (t *TaskDao) RestoreTask(ctx context.Context, userID, taskID int64, status int32) ([]int64, error) {
+	var ids []int64
+	err := transaction(ctx, t.query, func(ctx context.Context, tx *query.Query) error {
+		task, err := tx.Task.WithContext(ctx).Unscoped().Where(
+			tx.Task.ID.Eq(taskID),
+			tx.Task.UserID.Eq(userID),
+			tx.Task.DeletedAt.IsNotNull(),
+		).First()
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		updates := map[string]any{
+			"status":     status,
+			"deleted_at": nil,
+			"updated_at": time.Now().UnixMilli(),
+		}
+
+		descendants, err := descendantIDs(ctx, tx, []int64{taskID}, true, tx.Task.DeletedAt.Eq(task.DeletedAt))
+		if err != nil {
+			return err
+		}
+		if len(descendants) > 0 {
+			_, err := updateTasks(ctx, tx, true, []gen.Condition{tx.Task.ID.In(descendants...)}, bumpVersion(updates))
+			if err != nil {
+				return err
+			}
+		}
+
+		if task.ParentID != 0 {
+			parents, err := tx.Task.WithContext(ctx).Where(tx.Task.ID.Eq(task.ParentID)).Count()
+			if err != nil {
+				return err
+			}
+			if parents == 0 {
+				updates["parent_id"] = 0
+			}
+		}
+		_, err = updateTasks(ctx, tx, true, []gen.Condition{tx.Task.ID.Eq(taskID)}, bumpVersion(updates))
+		if err != nil {
+			return err
+		}
+		ids = append([]int64{taskID}, descendants...)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+// ListDeletedTaskIDs returns the IDs of the soft deleted tasks of userID.
+func (t *TaskDao) ListDeletedTaskIDs(ctx context.Context, userID int64, limit int) ([]int64, error) {
+	var ids []int64
+	err := t.query.Task.WithContext(ctx).Unscoped().Where(
+		t.query.Task.UserID.Eq(userID),
+		t.query.Task.DeletedAt.IsNotNull(),
+	).Limit(limit).Pluck(t.query.Task.ID, &ids)
+
+	return ids, err
+}
+
+// ListAllTasks returns the tasks of userID with IDs above afterID in ID order,
+// including the ones in the recycle bin.
+func (t *TaskDao) ListAllTasks(ctx context.Context, userID, afterID int64, limit int) ([]*model.Task, error) {
+	return t.query.Task.WithContext(ctx).Unscoped().Where(
 		t.query.Task.UserID.Eq(userID),
-		t.query.Task.Status.Eq(status),
-	).Order(t.query.Task.CreatedAt.Desc()).Find()
+		t.query.Task.ID.Gt(afterID),
+	).Order(t.query.Task.ID).Limit(limit).Find()
+}
+
+// ListExpiredTaskIDs returns the IDs of the tasks soft deleted before the given time.
+func (t *TaskDao) ListExpiredTaskIDs(ctx context.Context, before time.Time, limit int) ([]int64, error) {
+	var ids []int64
+	err := t.query.Task.WithContext(ctx).Unscoped().Where(
+		t.query.Task.DeletedAt.Lt(gorm.DeletedAt{Time: before, Valid: true}),
+	).Limit(limit).Pluck(t.query.Task.ID, &ids)
+
+	return ids, err
+}
+
+// PurgedTasks lists what PurgeTasks removed, the stored objects of the
+// attachments are left for the caller to delete.
+type PurgedTasks struct {
+	TaskIDs        []int64
+	AttachmentKeys []string
+}
+
+// PurgeTasks permanently deletes the given tasks and their subtasks in the recycle
+// bin, with their tags, checklists, dependencies, activity logs, comments and
+// attachments. Live tasks are left untouched, live subtasks of purged tasks become
+// top level tasks.
+func (t *TaskDao) PurgeTasks(ctx context.Context, taskIDs []int64) (*PurgedTasks, error) {
+	purged := &PurgedTasks{}
+	err := transaction(ctx, t.query, func(ctx context.Context, tx *query.Query) error {
+		var ids []int64
+		err := tx.Task.WithContext(ctx).Unscoped().Where(
+			tx.Task.ID.In(taskIDs...),
+			tx.Task.DeletedAt.IsNotNull(),
+		).Pluck(tx.Task.ID, &ids)
+		if err != nil || len(ids) == 0 {
+			return err
+		}
+		descendants, err := descendantIDs(ctx, tx, ids, true, tx.Task.DeletedAt.IsNotNull())
+		if err != nil {
+			return err
+		}
+		ids = slice.Unique(append(ids, descendants...))
+
+		// purged tasks leave tombstones in the change logs
+		tasks, err := tx.Task.WithContext(ctx).Unscoped().Where(tx.Task.ID.In(ids...)).Find()
+		if err != nil {
+			return err
+		}
+		logChanges(ctx, tasks, true)
+		if _, err := tx.Task.WithContext(ctx).Unscoped().Where(tx.Task.ID.In(ids...)).Delete(); err != nil {
+			return err
+		}
+		purged.TaskIDs = ids
+
+		if _, err := tx.TaskTag.WithContext(ctx).Where(tx.TaskTag.TaskID.In(ids...)).Delete(); err != nil {
+			return err
+		}
+		if _, err := tx.ChecklistItem.WithContext(ctx).Where(tx.ChecklistItem.TaskID.In(ids...)).Delete(); err != nil {
+			return err
+		}
+		if _, err := tx.TaskActivity.WithContext(ctx).Where(tx.TaskActivity.TaskID.In(ids...)).Delete(); err != nil {
+			return err
+		}
+		if _, err := tx.TaskComment.WithContext(ctx).Where(tx.TaskComment.TaskID.In(ids...)).Delete(); err != nil {
+			return err
+		}
+		err = tx.TaskAttachment.WithContext(ctx).Where(tx.TaskAttachment.TaskID.In(ids...)).
+			Pluck(tx.TaskAttachment.ObjectKey, &purged.AttachmentKeys)
+		if err != nil {
+			return err
+		}
+		if _, err := tx.TaskAttachment.WithContext(ctx).Where(tx.TaskAttachment.TaskID.In(ids...)).Delete(); err != nil {
+			return err
+		}
+		_, err = tx.TaskDependency.WithContext(ctx).Where(field.Or(
+			tx.TaskDependency.TaskID.In(ids...),
+			tx.TaskDependency.BlockerID.In(ids...),
+		)).Delete()
+		if err != nil {
+			return err
+		}
+
+		_, err = updateTasks(ctx, tx, true, []gen.Condition{tx.Task.ParentID.In(ids...)},
+			bumpVersion(map[string]any{"parent_id": 0, "updated_at": time.Now().UnixMilli()}))
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return purged, nil
+}
+
+// MaxSortKey returns the largest sort key among the tasks of the user, including
+// the ones in the recycle bin.
+func (t *TaskDao) MaxSortKey(ctx context.Context, userID int64) (string, error) {
+	var keys []string
+	err := t.query.Task.WithContext(ctx).Unscoped().Where(t.query.Task.UserID.Eq(userID)).
+		Order(t.query.Task.SortKey.Desc()).
+		Limit(1).
+		Pluck(t.query.Task.SortKey, &keys)
+	if err != nil || len(keys) == 0 {
+		return "", err
+	}
+
+	return keys[0], nil
+}
+
+// ListUnbalancedUsers returns the users having tasks without a sort key or with
+// one longer than maxLength.
+func (t *TaskDao) ListUnbalancedUsers(ctx context.Context, maxLength, limit int) ([]int64, error) {
+	var ids []int64
+	err := t.query.Task.WithContext(ctx).Unscoped().Distinct(t.query.Task.UserID).Where(field.Or(
+		t.query.Task.SortKey.Eq(""),
+		t.query.Task.SortKey.Substring(maxLength+1).Neq(""),
+	)).Limit(limit).Pluck(t.query.Task.UserID, &ids)
+
+	return ids, err
+}
+
+// RebalanceSortKeys gives the tasks of the user, including the ones in the recycle
+// bin, the sort keys returned by spread in one transaction, keeping their order.
+// Tasks without a sort key come first. The keys only change in value, not in
+// order, so the tasks keep their version, but synced clients get the new keys.
+func (t *TaskDao) RebalanceSortKeys(ctx context.Context, userID int64, spread func(n int) []string) error {
+	return transaction(ctx, t.query, func(ctx context.Context, tx *query.Query) error {
+		var ids []int64
+		err := tx.Task.WithContext(ctx).Unscoped().Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where(tx.Task.UserID.Eq(userID)).
+			Order(tx.Task.SortKey, tx.Task.ID).
+			Pluck(tx.Task.ID, &ids)
+		if err != nil {
+			return err
+		}
+
+		keys := spread(len(ids))
+		for i, id := range ids {
+			_, err := tx.Task.WithContext(ctx).Unscoped().Where(tx.Task.ID.Eq(id)).UpdateColumn(tx.Task.SortKey, keys[i])
+			if err != nil {
+				return err
+			}
+		}
+
+		return touchTasks(ctx, tx, ids)
+	})
+}
+
+func (t *TaskDao) ListTasks(ctx context.Context, params *ListTasksParams) ([]*model.Task, error) {
+	table := t.query.Task
+
+	sortField := table.CreatedAt
+	switch params.SortBy {
+	case SortByUpdatedAt:
+		sortField = table.UpdatedAt
+	case SortByPriority:
+		// read the priority as int64 to share the cursor conditions
+		sortField = field.NewInt64(table.TableName(), table.Priority.ColumnName().String())
+	}
+	asc, desc := field.Expr(sortField), sortField.Desc()
+	if params.SortBy == SortBySortKey {
+		asc, desc = table.SortKey, table.SortKey.Desc()
+	}
+
+	conds := []gen.Condition{
+		table.UserID.Eq(params.UserID),
+	}
+	if len(params.Statuses) > 0 {
+		conds = append(conds, table.Status.In(params.Statuses...))
+	}
+	if params.CreatedAfter > 0 {
+		conds = append(conds, table.CreatedAt.Gte(params.CreatedAfter))
+	}
+	if params.CreatedBefore > 0 {
+		conds = append(conds, table.CreatedAt.Lte(params.CreatedBefore))
+	}
+	if params.UpdatedAfter > 0 {
+		conds = append(conds, table.UpdatedAt.Gte(params.UpdatedAfter))
+	}
+	if params.UpdatedBefore > 0 {
+		conds = append(conds, table.UpdatedAt.Lte(params.UpdatedBefore))
+	}
+	if params.RootOnly {
+		conds = append(conds, table.ParentID.Eq(0))
+	}
+	if len(params.ProjectIDs) > 0 {
+		conds = append(conds, table.ProjectID.In(params.ProjectIDs...))
+	}
+	if len(params.TagIDs) > 0 {
+		tagged := t.query.TaskTag.WithContext(ctx).Select(t.query.TaskTag.TaskID).
+			Where(t.query.TaskTag.TagID.In(params.TagIDs...))
+		conds = append(conds, table.WithContext(ctx).Columns(table.ID).In(tagged))
+	}
+	if len(params.BlockingStatuses) > 0 {
+		dep, blocker := t.query.TaskDependency, t.query.Task.As("blocker")
+		blocked := dep.WithContext(ctx).Select(dep.TaskID).
+			Join(blocker, blocker.ID.EqCol(dep.BlockerID)).
+			Where(blocker.Status.In(params.BlockingStatuses...), blocker.DeletedAt.IsNull())
+		conds = append(conds, table.WithContext(ctx).Columns(table.ID).NotIn(blocked))
+	}
+
+	if c := params.Cursor; c != nil {
+		var beyond, level, tie field.Expr
+		switch {
+		case params.SortBy == SortBySortKey && params.Asc:
+			beyond, level, tie = table.SortKey.Gt(c.Key), table.SortKey.Eq(c.Key), table.ID.Gt(c.ID)
+		case params.SortBy == SortBySortKey:
+			beyond, level, tie = table.SortKey.Lt(c.Key), table.SortKey.Eq(c.Key), table.ID.Lt(c.ID)
+		case params.Asc:
+			beyond, level, tie = sortField.Gt(c.Value), sortField.Eq(c.Value), table.ID.Gt(c.ID)
+		default:
+			beyond, level, tie = sortField.Lt(c.Value), sortField.Eq(c.Value), table.ID.Lt(c.ID)
+		}
+		conds = append(conds, field.Or(beyond, field.And(level, tie)))
+	}
+
+	do := table.WithContext(ctx)
+	if params.Deleted {
+		do = do.Unscoped()
+		conds = append(conds, table.DeletedAt.IsNotNull())
+	}
+	do = do.Where(conds...)
+	if params.Asc {
+		do = do.Order(asc, table.ID)
+	} else {
+		do = do.Order(desc, table.ID.Desc())
+	}
+
+	return do.Limit(params.Limit).Find()
+}
+
+// bumpVersion adds the version increment which every write of a task carries.
+func bumpVersion(updates map[string]any) map[string]any {
+	updates["version"] = gorm.Expr("version + 1")
+	return updates
+}
+
+// createTask creates the task carrying the given tags and records its creation.
+func createTask(ctx context.Context, tx *query.Query, task *model.Task, tagIDs []int64) error {
+	if err := tx.Task.WithContext(ctx).Create(task); err != nil {
+		return err
+	}
+	logChanges(ctx, []*model.Task{task}, false)
+	if err := recordActivities(ctx, tx, ActionCreated, nil, []*model.Task{task}); err != nil {
+		return err
+	}
+
+	return applyTagChanges(ctx, tx, []int64{task.ID}, &TagChanges{Attach: tagIDs})
+}
+
+// finishOccurrence moves the task from status from to status to and creates next
+// unless the series already has that occurrence. It reports whether the task was
+// moved and whether next was created.
+func finishOccurrence(ctx context.Context, tx *query.Query, userID, taskID int64, from, to int32, next *model.Task) (bool, bool, error) {
+	ids, err := updateTasks(ctx, tx, false, []gen.Condition{
+		tx.Task.ID.Eq(taskID),
+		tx.Task.UserID.Eq(userID),
+		tx.Task.Status.Eq(from),
+	}, bumpVersion(map[string]any{
+		"status":     to,
+		"updated_at": time.Now().UnixMilli(),
+	}))
+	if err != nil || len(ids) == 0 {
+		return false, false, err
+	}
+
+	created, err := createOccurrence(ctx, tx, taskID, next)
+	return true, created, err
+}
+
+// createOccurrence creates next, the occurrence following the finished task,
+// unless the series already has it. It reports whether next was created.
+func createOccurrence(ctx context.Context, tx *query.Query, taskID int64, next *model.Task) (bool, error) {
+	exist, err := tx.Task.WithContext(ctx).Where(
+		tx.Task.SeriesID.Eq(next.SeriesID),
+		tx.Task.OccurrenceAt.Eq(next.OccurrenceAt),
+	).Count()
+	if err != nil || exist > 0 {
+		return false, err
+	}
+
+	// the next occurrence carries the tags of the finished one
+	var tagIDs []int64
+	err = tx.TaskTag.WithContext(ctx).Where(tx.TaskTag.TaskID.Eq(taskID)).Pluck(tx.TaskTag.TagID, &tagIDs)
+	if err != nil {
+		return false, err
+	}
+	if err := createTask(ctx, tx, next, tagIDs); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// trashTask soft deletes the live task owned by userID together with its subtasks
+// and moves them to status. It returns the IDs of the trashed tasks, or nil if no
+// such task exists.
+func trashTask(ctx context.Context, tx *query.Query, userID, taskID int64, status int32) ([]int64, error) {
+	now := time.Now()
+	updates := map[string]any{
+		"status":     status,
+		"deleted_at": now,
+		"updated_at": now.UnixMilli(),
+	}
+
+	trashed, err := updateTasks(ctx, tx, false, []gen.Condition{
+		tx.Task.ID.Eq(taskID),
+		tx.Task.UserID.Eq(userID),
+	}, bumpVersion(updates))
+	if err != nil || len(trashed) == 0 {
+		return nil, err
+	}
+
+	descendants, err := descendantIDs(ctx, tx, []int64{taskID}, false)
+	if err != nil {
+		return nil, err
+	}
+	if len(descendants) > 0 {
+		_, err := updateTasks(ctx, tx, false, []gen.Condition{tx.Task.ID.In(descendants...)}, bumpVersion(updates))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return append([]int64{taskID}, descendants...), nil
+}
+
+// moveSubtasks moves the live subtasks of the given tasks along if the updates
+// change the project of the tasks.
+func moveSubtasks(ctx context.Context, tx *query.Query, taskIDs []int64, updates map[string]any) error {
+	projectID, ok := updates["project_id"]
+	if !ok {
+		return nil
+	}
+
+	descendants, err := descendantIDs(ctx, tx, taskIDs, false)
+	if err != nil || len(descendants) == 0 {
+		return err
+	}
+
+	_, err = updateTasks(ctx, tx, false, []gen.Condition{tx.Task.ID.In(descendants...)}, bumpVersion(map[string]any{
+		"project_id": projectID,
+		"updated_at": updates["updated_at"],
+	}))
+	return err
+}
+
+// descendantIDs returns the IDs of the subtasks of the given tasks down the tree,
+// the walk stops at subtasks not matching conds. Soft deleted tasks are only
+// considered if unscoped is set.
+func descendantIDs(ctx context.Context, tx *query.Query, taskIDs []int64, unscoped bool, conds ...gen.Condition) ([]int64, error) {
+	var res []int64
+	level := taskIDs
+	for depth := 0; len(level) > 0 && depth < maxTreeDepth; depth++ {
+		do := tx.Task.WithContext(ctx)
+		if unscoped {
+			do = do.Unscoped()
+		}
+
+		var children []int64
+		err := do.Where(append([]gen.Condition{tx.Task.ParentID.In(level...)}, conds...)...).Pluck(tx.Task.ID, &children)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, children...)
+		level = children
+	}
+
+	return res, nil
 }
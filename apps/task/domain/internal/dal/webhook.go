@@ -0,0 +1,178 @@
+package dal
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/internal/dal/model"
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/internal/dal/query"
+)
+
+// Webhook delivery statuses, they match entity.DeliveryStatus.
+const (
+	DeliveryPending int32 = iota
+	DeliverySucceeded
+	DeliveryDead
+)
+
+type WebhookDao struct {
+	query *query.Query
+}
+
+func NewWebhookDao(db *gorm.DB) *WebhookDao {
+	return &WebhookDao{query: query.Use(db)}
+}
+
+func (w *WebhookDao) Create(ctx context.Context, webhook *model.Webhook) error {
+	return w.query.Webhook.WithContext(ctx).Create(webhook)
+}
+
+func (w *WebhookDao) GetWebhookByID(ctx context.Context, webhookID int64) (*model.Webhook, bool, error) {
+	webhook, err := w.query.Webhook.WithContext(ctx).Where(
+		w.query.Webhook.ID.Eq(webhookID),
+	).First()
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	return webhook, true, nil
+}
+
+// ListWebhooks returns the webhooks of the user oldest first.
+func (w *WebhookDao) ListWebhooks(ctx context.Context, userID int64) ([]*model.Webhook, error) {
+	return w.query.Webhook.WithContext(ctx).Where(
+		w.query.Webhook.UserID.Eq(userID),
+	).Order(w.query.Webhook.ID).Find()
+}
+
+func (w *WebhookDao) CountWebhooks(ctx context.Context, userID int64) (int64, error) {
+	return w.query.Webhook.WithContext(ctx).Where(w.query.Webhook.UserID.Eq(userID)).Count()
+}
+
+// UpdateWebhook updates the webhook of the user, it returns false if no such webhook exists.
+func (w *WebhookDao) UpdateWebhook(ctx context.Context, userID, webhookID int64, updates map[string]any) (bool, error) {
+	res, err := w.query.Webhook.WithContext(ctx).Where(
+		w.query.Webhook.ID.Eq(webhookID),
+		w.query.Webhook.UserID.Eq(userID),
+	).Updates(updates)
+	if err != nil {
+		return false, err
+	}
+
+	return res.RowsAffected > 0, nil
+}
+
+// DeleteWebhook deletes the webhook of the user along with its deliveries, it
+// returns false if no such webhook exists.
+func (w *WebhookDao) DeleteWebhook(ctx context.Context, userID, webhookID int64) (bool, error) {
+	var deleted bool
+	err := w.query.Transaction(func(tx *query.Query) error {
+		res, err := tx.Webhook.WithContext(ctx).Where(
+			tx.Webhook.ID.Eq(webhookID),
+			tx.Webhook.UserID.Eq(userID),
+		).Delete()
+		if err != nil || res.RowsAffected == 0 {
+			return err
+		}
+		deleted = true
+
+		_, err = tx.WebhookDelivery.WithContext(ctx).Where(tx.WebhookDelivery.WebhookID.Eq(webhookID)).Delete()
+		return err
+	})
+
+	return deleted, err
+}
+
+// CreateDeliveries adds the deliveries, skipping those of an event the webhook
+// already has a delivery of.
+func (w *WebhookDao) CreateDeliveries(ctx context.Context, deliveries []*model.WebhookDelivery) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	return w.query.WebhookDelivery.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(deliveries...)
+}
+
+func (w *WebhookDao) GetDeliveryByID(ctx context.Context, deliveryID int64) (*model.WebhookDelivery, bool, error) {
+	delivery, err := w.query.WebhookDelivery.WithContext(ctx).Where(
+		w.query.WebhookDelivery.ID.Eq(deliveryID),
+	).First()
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	return delivery, true, nil
+}
+
+// ListDeliveries returns the deliveries of the webhook newest first, starting
+// below the delivery beforeID unless it is zero. A negative status matches
+// every status.
+func (w *WebhookDao) ListDeliveries(ctx context.Context, webhookID, beforeID int64, status int32, limit int) ([]*model.WebhookDelivery, error) {
+	d := w.query.WebhookDelivery
+	do := d.WithContext(ctx).Where(d.WebhookID.Eq(webhookID))
+	if beforeID > 0 {
+		do = do.Where(d.ID.Lt(beforeID))
+	}
+	if status >= 0 {
+		do = do.Where(d.Status.Eq(status))
+	}
+
+	return do.Order(d.ID.Desc()).Limit(limit).Find()
+}
+
+// ListDueDeliveries returns the pending deliveries whose next attempt is due.
+func (w *WebhookDao) ListDueDeliveries(ctx context.Context, now int64, limit int) ([]*model.WebhookDelivery, error) {
+	d := w.query.WebhookDelivery
+	return d.WithContext(ctx).Where(
+		d.Status.Eq(DeliveryPending),
+		d.NextAttemptAt.Lte(now),
+	).Order(d.NextAttemptAt).Limit(limit).Find()
+}
+
+// ClaimDelivery pushes the next attempt of the pending delivery from
+// nextAttemptAt back to leaseUntil, it returns false if another dispatcher
+// claimed it first.
+func (w *WebhookDao) ClaimDelivery(ctx context.Context, deliveryID, nextAttemptAt, leaseUntil int64) (bool, error) {
+	d := w.query.WebhookDelivery
+	res, err := d.WithContext(ctx).Where(
+		d.ID.Eq(deliveryID),
+		d.Status.Eq(DeliveryPending),
+		d.NextAttemptAt.Eq(nextAttemptAt),
+	).Update(d.NextAttemptAt, leaseUntil)
+	if err != nil {
+		return false, err
+	}
+
+	return res.RowsAffected > 0, nil
+}
+
+func (w *WebhookDao) UpdateDelivery(ctx context.Context, deliveryID int64, updates map[string]any) error {
+	_, err := w.query.WebhookDelivery.WithContext(ctx).Where(
+		w.query.WebhookDelivery.ID.Eq(deliveryID),
+	).Updates(updates)
+	return err
+}
+
+// PurgeDeliveries deletes at most limit finished deliveries last updated
+// before the given time and returns how many it deleted.
+func (w *WebhookDao) PurgeDeliveries(ctx context.Context, before int64, limit int) (int64, error) {
+	d := w.query.WebhookDelivery
+	res, err := d.WithContext(ctx).Where(
+		d.Status.In(DeliverySucceeded, DeliveryDead),
+		d.UpdatedAt.Lt(before),
+	).Limit(limit).Delete()
+	if err != nil {
+		return 0, err
+	}
+
+	return res.RowsAffected, nil
+}
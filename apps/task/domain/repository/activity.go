@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/internal/dal"
+)
+
+// ActivityRepository reads the activity logs of tasks, they are written along
+// with the tasks by TaskRepository.
+type ActivityRepository interface {
+	ListActivities(ctx context.Context, taskID, beforeID int64, limit int) ([]*dal.Activity, error)
+	ListActivitiesAfter(ctx context.Context, taskID, version int64) ([]*dal.Activity, error)
+	HasRevision(ctx context.Context, taskID, version int64) (bool, error)
+}
+
+func NewActivityRepository(db *gorm.DB) ActivityRepository {
+	return dal.NewActivityDao(db)
+}
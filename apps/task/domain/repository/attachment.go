@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/internal/dal"
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/internal/dal/model"
+)
+
+type AttachmentRepository interface {
+	Create(ctx context.Context, attachment *model.TaskAttachment, quota int64) (bool, error)
+	GetAttachmentByID(ctx context.Context, attachmentID int64) (*model.TaskAttachment, bool, error)
+	ListAttachments(ctx context.Context, taskID int64) ([]*model.TaskAttachment, error)
+	UsedBytes(ctx context.Context, userID int64) (int64, error)
+	DeleteAttachment(ctx context.Context, userID, attachmentID int64) (bool, error)
+}
+
+func NewAttachmentRepository(db *gorm.DB) AttachmentRepository {
+	return dal.NewAttachmentDao(db)
+}
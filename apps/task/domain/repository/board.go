@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/internal/dal"
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/internal/dal/model"
+)
+
+type BoardRepository interface {
+	CreateColumn(ctx context.Context, column *model.BoardColumn) error
+	CreateDefaultColumns(ctx context.Context, projectID int64, columns []*model.BoardColumn) error
+	GetColumnByID(ctx context.Context, columnID int64) (*model.BoardColumn, bool, error)
+	ListColumns(ctx context.Context, projectID int64) ([]*model.BoardColumn, error)
+	MaxPosition(ctx context.Context, projectID int64) (int64, error)
+	UpdateColumn(ctx context.Context, userID, columnID int64, updates map[string]any) (bool, error)
+	ReorderColumns(ctx context.Context, projectID int64, columnIDs []int64) (bool, error)
+	DeleteColumn(ctx context.Context, userID, columnID int64) (bool, error)
+	MoveCard(ctx context.Context, taskID int64, from int32, cards *dal.ColumnCards, updates map[string]any, next *model.Task) (*dal.MoveCardResult, error)
+}
+
+func NewBoardRepository(db *gorm.DB) BoardRepository {
+	return dal.NewBoardDao(db)
+}
@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/internal/dal"
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/internal/dal/model"
+)
+
+// CalendarRepository holds the calendar feeds of users, a feed is found by the
+// hash of its token.
+type CalendarRepository interface {
+	CreateFeed(ctx context.Context, feed *model.CalendarFeed) (bool, error)
+	UpdateFeedToken(ctx context.Context, userID int64, tokenHash string) (bool, error)
+	DeleteFeed(ctx context.Context, userID int64) (bool, error)
+	GetFeedByTokenHash(ctx context.Context, tokenHash string) (*model.CalendarFeed, bool, error)
+}
+
+func NewCalendarRepository(db *gorm.DB) CalendarRepository {
+	return dal.NewCalendarDao(db)
+}
@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/internal/dal"
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/internal/dal/model"
+)
+
+// ChangeRepository reads the change logs of users, they are written along with
+// the tasks by the other repositories.
+type ChangeRepository interface {
+	ListChanges(ctx context.Context, userID, afterSeq int64, limit int) ([]*model.TaskChange, error)
+	LastSeq(ctx context.Context, userID int64) (int64, error)
+}
+
+func NewChangeRepository(db *gorm.DB) ChangeRepository {
+	return dal.NewChangeDao(db)
+}
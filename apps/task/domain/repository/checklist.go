@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/internal/dal"
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/internal/dal/model"
+)
+
+type ChecklistRepository interface {
+	Create(ctx context.Context, item *model.ChecklistItem) error
+	GetItemByID(ctx context.Context, itemID int64) (*model.ChecklistItem, bool, error)
+	ListItems(ctx context.Context, taskIDs []int64) ([]*model.ChecklistItem, error)
+	MaxPosition(ctx context.Context, taskID int64) (int64, error)
+	UpdateItem(ctx context.Context, taskID, itemID int64, updates map[string]any) (bool, error)
+	DeleteItem(ctx context.Context, taskID, itemID int64) (bool, error)
+}
+
+func NewChecklistRepository(db *gorm.DB) ChecklistRepository {
+	return dal.NewChecklistDao(db)
+}
@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/internal/dal"
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/internal/dal/model"
+)
+
+type CommentRepository interface {
+	Create(ctx context.Context, comment *model.TaskComment) error
+	GetCommentByID(ctx context.Context, commentID int64) (*model.TaskComment, bool, error)
+	ListComments(ctx context.Context, taskID, afterID int64, limit int) ([]*model.TaskComment, error)
+	CountComments(ctx context.Context, taskIDs []int64) ([]*dal.CommentCount, error)
+	UpdateComment(ctx context.Context, userID, commentID int64, updates map[string]any) (bool, error)
+	DeleteComment(ctx context.Context, userID, commentID int64) (bool, error)
+}
+
+func NewCommentRepository(db *gorm.DB) CommentRepository {
+	return dal.NewCommentDao(db)
+}
@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/internal/dal"
+)
+
+type DependencyRepository interface {
+	AddDependency(ctx context.Context, userID, taskID, blockerID int64) (bool, error)
+	RemoveDependency(ctx context.Context, taskID, blockerID int64) error
+	CountBlockers(ctx context.Context, taskID int64) (int64, error)
+	ListBlockers(ctx context.Context, taskIDs []int64) ([]*dal.Blocker, error)
+}
+
+func NewDependencyRepository(db *gorm.DB) DependencyRepository {
+	return dal.NewDependencyDao(db)
+}
@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/internal/dal"
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/internal/dal/model"
+)
+
+type ProjectRepository interface {
+	Create(ctx context.Context, project *model.Project) error
+	GetProjectByID(ctx context.Context, projectID int64) (*model.Project, bool, error)
+	GetInbox(ctx context.Context, userID int64) (*model.Project, bool, error)
+	ListProjects(ctx context.Context, userID int64, includeArchived bool) ([]*model.Project, error)
+	MaxPosition(ctx context.Context, userID int64) (int64, error)
+	UpdateProject(ctx context.Context, userID, projectID int64, updates map[string]any) (bool, error)
+	ReorderProjects(ctx context.Context, userID int64, projectIDs []int64) (bool, error)
+	DeleteProject(ctx context.Context, userID, projectID, moveTo int64, trashedStatus int32) ([]int64, bool, error)
+}
+
+func NewProjectRepository(db *gorm.DB) ProjectRepository {
+	return dal.NewProjectDao(db)
+}
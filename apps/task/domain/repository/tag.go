@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/internal/dal"
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/internal/dal/model"
+)
+
+type TagRepository interface {
+	Create(ctx context.Context, tag *model.Tag) error
+	GetTagByID(ctx context.Context, tagID int64) (*model.Tag, bool, error)
+	GetTagsByIDs(ctx context.Context, tagIDs []int64) ([]*model.Tag, error)
+	ListTags(ctx context.Context, userID int64) ([]*model.Tag, error)
+	CheckTagNameExist(ctx context.Context, userID int64, name string) (bool, error)
+	UpdateTag(ctx context.Context, userID, tagID int64, updates map[string]any) (bool, error)
+	DeleteTag(ctx context.Context, userID, tagID int64) (bool, error)
+	CountTasks(ctx context.Context, tagIDs []int64) ([]*dal.TagCount, error)
+	ListTaskTags(ctx context.Context, taskIDs []int64) ([]*model.TaskTag, error)
+}
+
+func NewTagRepository(db *gorm.DB) TagRepository {
+	return dal.NewTagDao(db)
+}
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"gorm.io/gorm"
 	
@@ -10,10 +11,33 @@ import (
 )
 
 type TaskRepository interface {
-	Create(ctx context.Context, task *model.Task) error
-	UpdateTask(ctx context.Context, taskID int64, updates map[string]any) error
-	UpdateTaskStatus(ctx context.Context, taskID int64, status int32) error
-	GetTasksByID(ctx context.Context, userID int64, status int32) ([]*model.Task, error)
+	Create(ctx context.Context, task *model.Task, tagIDs []int64) error
+	GetTaskByID(ctx context.Context, taskID int64) (*model.Task, bool, error)
+	GetTasksByIDs(ctx context.Context, userID int64, taskIDs []int64) ([]*model.Task, error)
+	ListChildren(ctx context.Context, parentIDs []int64) ([]*model.Task, error)
+	ListChildStatuses(ctx context.Context, parentIDs []int64) ([]*dal.ChildStatus, error)
+	UpdateTask(ctx context.Context, userID, taskID int64, version *int64, updates map[string]any, tags *dal.TagChanges) (bool, error)
+	UpdateTaskStatus(ctx context.Context, userID, taskID int64, from, to int32) (bool, error)
+	FinishOccurrence(ctx context.Context, userID, taskID int64, from, to int32, next *model.Task) (bool, error)
+	UpdateSeries(ctx context.Context, userID, taskID, seriesID int64, version *int64, statuses []int32, taskUpdates, seriesUpdates map[string]any, tags *dal.TagChanges) ([]int64, error)
+	TrashTask(ctx context.Context, userID, taskID int64, status int32) ([]int64, error)
+	RestoreTask(ctx context.Context, userID, taskID int64, status int32) ([]int64, error)
+	ListDeletedTaskIDs(ctx context.Context, userID int64, limit int) ([]int64, error)
+	ListAllTasks(ctx context.Context, userID, afterID int64, limit int) ([]*model.Task, error)
+	ListExpiredTaskIDs(ctx context.Context, before time.Time, limit int) ([]int64, error)
+	PurgeTasks(ctx context.Context, taskIDs []int64) (*dal.PurgedTasks, error)
+	ListTasks(ctx context.Context, params *dal.ListTasksParams) ([]*model.Task, error)
+	MaxSortKey(ctx context.Context, userID int64) (string, error)
+	ListUnbalancedUsers(ctx context.Context, maxLength, limit int) ([]int64, error)
+	RebalanceSortKeys(ctx context.Context, userID int64, spread func(n int) []string) error
+	ListTasksByDueRange(ctx context.Context, userID int64, statuses []int32, from, to int64, limit int) ([]*model.Task, error)
+	ListDueReminders(ctx context.Context, statuses []int32, now int64, limit int) ([]*model.Task, error)
+	MarkReminded(ctx context.Context, taskID, remindAt, sentAt int64) (bool, error)
+	BatchCreate(ctx context.Context, tasks []*model.Task, tagIDs [][]int64) error
+	ImportTasks(ctx context.Context, userID int64, tasks []*dal.ImportedTask) error
+	BatchUpdateStatus(ctx context.Context, userID int64, to int32, changes []*dal.StatusChange) ([]int64, []*model.Task, error)
+	BatchTrash(ctx context.Context, userID int64, taskIDs []int64, status int32) (map[int64][]int64, error)
+	BatchMove(ctx context.Context, userID int64, taskIDs []int64, projectID int64) ([]int64, error)
 }
 
 func NewTaskRepository(db *gorm.DB) TaskRepository {
@@ -0,0 +1,32 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/internal/dal"
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/internal/dal/model"
+)
+
+// WebhookRepository holds the webhooks of users and the log of their deliveries.
+type WebhookRepository interface {
+	Create(ctx context.Context, webhook *model.Webhook) error
+	GetWebhookByID(ctx context.Context, webhookID int64) (*model.Webhook, bool, error)
+	ListWebhooks(ctx context.Context, userID int64) ([]*model.Webhook, error)
+	CountWebhooks(ctx context.Context, userID int64) (int64, error)
+	UpdateWebhook(ctx context.Context, userID, webhookID int64, updates map[string]any) (bool, error)
+	DeleteWebhook(ctx context.Context, userID, webhookID int64) (bool, error)
+
+	CreateDeliveries(ctx context.Context, deliveries []*model.WebhookDelivery) error
+	GetDeliveryByID(ctx context.Context, deliveryID int64) (*model.WebhookDelivery, bool, error)
+	ListDeliveries(ctx context.Context, webhookID, beforeID int64, status int32, limit int) ([]*model.WebhookDelivery, error)
+	ListDueDeliveries(ctx context.Context, now int64, limit int) ([]*model.WebhookDelivery, error)
+	ClaimDelivery(ctx context.Context, deliveryID, nextAttemptAt, leaseUntil int64) (bool, error)
+	UpdateDelivery(ctx context.Context, deliveryID int64, updates map[string]any) error
+	PurgeDeliveries(ctx context.Context, before int64, limit int) (int64, error)
+}
+
+func NewWebhookRepository(db *gorm.DB) WebhookRepository {
+	return dal.NewWebhookDao(db)
+}
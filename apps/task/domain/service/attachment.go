@@ -0,0 +1,207 @@
+package service
+
+import (
+	"context"
+	"mime"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/entity"
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/internal/dal/model"
+	"github.com/crazyfrankie/zrpc-todolist/infra/contract/storage"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/errorx"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/lang/conv"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/lang/slice"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/logs"
+	"github.com/crazyfrankie/zrpc-todolist/types/errno"
+)
+
+const (
+	// maxAttachmentSize stays below the 5MB message limit of the RPC server.
+	maxAttachmentSize    = 4 << 20
+	attachmentQuota      = 100 << 20
+	maxAttachmentName    = 255
+	attachmentURLExpires = 15 * 60 // seconds
+)
+
+// attachmentTypes maps the accepted content types to the extension of their objects.
+var attachmentTypes = map[string]string{
+	"image/jpeg":      "jpg",
+	"image/png":       "png",
+	"image/gif":       "gif",
+	"image/webp":      "webp",
+	"application/pdf": "pdf",
+	"text/plain":      "txt",
+}
+
+func (t *taskImpl) UploadAttachment(ctx context.Context, req *UploadAttachmentRequest) (*entity.Attachment, error) {
+	name, err := normalizeAttachmentName(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	size := int64(len(req.Content))
+	if size == 0 {
+		return nil, errorx.New(errno.ErrTaskInvalidParamCode, errorx.KV("msg", "attachment is empty"))
+	}
+	if size > maxAttachmentSize {
+		return nil, errorx.New(errno.ErrTaskInvalidParamCode, errorx.KV("msg", "attachment is too large"))
+	}
+	// trust the content rather than the type claimed by the client
+	contentType, _, _ := mime.ParseMediaType(http.DetectContentType(req.Content))
+	ext, ok := attachmentTypes[contentType]
+	if !ok {
+		return nil, errorx.New(errno.ErrTaskInvalidParamCode, errorx.KV("msg", "unsupported attachment type"))
+	}
+
+	if _, err := t.getLiveTask(ctx, req.UserID, req.TaskID); err != nil {
+		return nil, err
+	}
+	// checked again when saving, this saves uploading files which can't fit
+	used, err := t.AttachmentRepo.UsedBytes(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if used+size > attachmentQuota {
+		return nil, quotaExceededError()
+	}
+
+	id, err := t.IDGen.GenID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	key := "task_attachment/" + conv.Int64ToStr(req.UserID) + "/" + conv.Int64ToStr(req.TaskID) + "/" +
+		conv.Int64ToStr(id) + "." + ext
+	err = t.FileOSS.PutObject(ctx, key, req.Content,
+		storage.WithContentType(contentType),
+		storage.WithObjectSize(size),
+		storage.WithContentDisposition(mime.FormatMediaType("attachment", map[string]string{"filename": name})),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	attachment := &model.TaskAttachment{
+		ID:          id,
+		TaskID:      req.TaskID,
+		UserID:      req.UserID,
+		Name:        name,
+		ContentType: contentType,
+		Size:        size,
+		ObjectKey:   key,
+		CreatedAt:   time.Now().UnixMilli(),
+	}
+	created, err := t.AttachmentRepo.Create(ctx, attachment, attachmentQuota)
+	if err != nil || !created {
+		t.deleteAttachmentObject(ctx, key)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !created {
+		return nil, quotaExceededError()
+	}
+
+	return attachmentPO2DO(attachment), nil
+}
+
+func (t *taskImpl) ListAttachments(ctx context.Context, userID, taskID int64) ([]*entity.Attachment, error) {
+	if _, err := t.getOwnedTask(ctx, userID, taskID); err != nil {
+		return nil, err
+	}
+
+	attachments, err := t.AttachmentRepo.ListAttachments(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	return slice.Transform(attachments, attachmentPO2DO), nil
+}
+
+func (t *taskImpl) GetAttachmentURL(ctx context.Context, userID, attachmentID int64) (string, error) {
+	attachment, err := t.getOwnedAttachment(ctx, userID, attachmentID, false)
+	if err != nil {
+		return "", err
+	}
+
+	return t.FileOSS.GetObjectUrl(ctx, attachment.ObjectKey, storage.WithExpire(attachmentURLExpires))
+}
+
+func (t *taskImpl) DeleteAttachment(ctx context.Context, userID, attachmentID int64) error {
+	attachment, err := t.getOwnedAttachment(ctx, userID, attachmentID, true)
+	if err != nil {
+		return err
+	}
+
+	deleted, err := t.AttachmentRepo.DeleteAttachment(ctx, userID, attachmentID)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return errorx.New(errno.ErrAttachmentNotFoundCode, errorx.KV("attachment_id", conv.Int64ToStr(attachmentID)))
+	}
+	t.deleteAttachmentObject(ctx, attachment.ObjectKey)
+
+	return nil
+}
+
+// deleteAttachmentObject removes a stored file whose record is gone or was never
+// saved, failures only leave an orphaned object behind so they are logged.
+func (t *taskImpl) deleteAttachmentObject(ctx context.Context, key string) {
+	if err := t.FileOSS.DeleteObject(ctx, key); err != nil {
+		logs.CtxWarnf(ctx, "delete attachment object failed, key=%s, err=%v", key, err)
+	}
+}
+
+// getOwnedAttachment returns the attachment if it is on a task of the user,
+// attachments follow the access rules of their task. Writes need a live task.
+func (t *taskImpl) getOwnedAttachment(ctx context.Context, userID, attachmentID int64, write bool) (*model.TaskAttachment, error) {
+	attachment, exist, err := t.AttachmentRepo.GetAttachmentByID(ctx, attachmentID)
+	if err != nil {
+		return nil, err
+	}
+	if !exist || attachment.UserID != userID {
+		return nil, errorx.New(errno.ErrAttachmentNotFoundCode, errorx.KV("attachment_id", conv.Int64ToStr(attachmentID)))
+	}
+
+	taskModel, exist, err := t.TaskRepo.GetTaskByID(ctx, attachment.TaskID)
+	if err != nil {
+		return nil, err
+	}
+	if !exist || taskModel.UserID != userID || (write && taskModel.DeletedAt.Valid) {
+		return nil, errorx.New(errno.ErrAttachmentNotFoundCode, errorx.KV("attachment_id", conv.Int64ToStr(attachmentID)))
+	}
+
+	return attachment, nil
+}
+
+func quotaExceededError() error {
+	return errorx.New(errno.ErrAttachmentQuotaExceededCode, errorx.KV("quota", conv.Int64ToStr(attachmentQuota)))
+}
+
+// normalizeAttachmentName keeps the base name of the uploaded file.
+func normalizeAttachmentName(name string) (string, error) {
+	name = strings.TrimSpace(path.Base(strings.ReplaceAll(name, "\\", "/")))
+	if name == "" || name == "." || name == "/" {
+		return "", errorx.New(errno.ErrTaskInvalidParamCode, errorx.KV("msg", "attachment name is empty"))
+	}
+	if utf8.RuneCountInString(name) > maxAttachmentName {
+		return "", errorx.New(errno.ErrTaskInvalidParamCode, errorx.KV("msg", "attachment name is too long"))
+	}
+
+	return name, nil
+}
+
+func attachmentPO2DO(attachment *model.TaskAttachment) *entity.Attachment {
+	return &entity.Attachment{
+		ID:          attachment.ID,
+		TaskID:      attachment.TaskID,
+		UserID:      attachment.UserID,
+		Name:        attachment.Name,
+		ContentType: attachment.ContentType,
+		Size:        attachment.Size,
+		CreatedAt:   attachment.CreatedAt,
+	}
+}
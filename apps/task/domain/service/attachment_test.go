@@ -0,0 +1,214 @@
+package service
+
+import (
+	"context"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/internal/dal/model"
+	"github.com/crazyfrankie/zrpc-todolist/infra/contract/storage"
+	"github.com/crazyfrankie/zrpc-todolist/types/errno"
+)
+
+// memStorage keeps objects in memory, beforePut runs ahead of every put.
+type memStorage struct {
+	mu        sync.Mutex
+	objects   map[string][]byte
+	beforePut func()
+}
+
+func (s *memStorage) PutObject(ctx context.Context, objectKey string, content []byte, opts ...storage.PutOptFn) error {
+	if s.beforePut != nil {
+		s.beforePut()
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.objects == nil {
+		s.objects = make(map[string][]byte)
+	}
+	s.objects[objectKey] = content
+	return nil
+}
+
+func (s *memStorage) PutObjectWithReader(ctx context.Context, objectKey string, content io.Reader, opts ...storage.PutOptFn) error {
+	b, err := io.ReadAll(content)
+	if err != nil {
+		return err
+	}
+	return s.PutObject(ctx, objectKey, b, opts...)
+}
+
+func (s *memStorage) GetObject(ctx context.Context, objectKey string) ([]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.objects[objectKey], nil
+}
+
+func (s *memStorage) DeleteObject(ctx context.Context, objectKey string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.objects, objectKey)
+	return nil
+}
+
+func (s *memStorage) GetObjectUrl(ctx context.Context, objectKey string, opts ...storage.GetOptFn) (string, error) {
+	return "mem://" + objectKey, nil
+}
+
+func (s *memStorage) len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.objects)
+}
+
+// newAttachmentTest returns the task domain storing files in memory, with a task
+// of the owner and one of the other user.
+func newAttachmentTest(t *testing.T) (Task, *Components, *memStorage, int64, int64) {
+	t.Helper()
+
+	ctx := context.Background()
+	c := newTestComponents(t)
+	oss := &memStorage{}
+	c.FileOSS = oss
+	d := NewTaskDomain(c)
+
+	task, err := d.Create(ctx, &CreateTaskRequest{UserID: ownerID, Title: "taxes"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := d.Create(ctx, &CreateTaskRequest{UserID: otherID, Title: "not mine"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return d, c, oss, task.ID, other.ID
+}
+
+// fillQuota saves an attachment record without a file, so the attachments of
+// the user take all but free bytes of the quota.
+func fillQuota(t *testing.T, c *Components, userID, taskID, free int64) {
+	t.Helper()
+
+	ctx := context.Background()
+	used, err := c.AttachmentRepo.UsedBytes(ctx, userID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	id, err := c.IDGen.GenID(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	filler := &model.TaskAttachment{ID: id, TaskID: taskID, UserID: userID, Name: "filler.pdf", Size: attachmentQuota - used - free}
+	if created, err := c.AttachmentRepo.Create(ctx, filler, attachmentQuota); err != nil || !created {
+		t.Fatalf("Create() = %v, %v", created, err)
+	}
+}
+
+func textFile(n int) []byte {
+	return []byte(strings.Repeat("a", n))
+}
+
+func TestAttachmentQuota(t *testing.T) {
+	ctx := context.Background()
+	d, c, oss, taskID, otherTaskID := newAttachmentTest(t)
+
+	first, err := d.UploadAttachment(ctx, &UploadAttachmentRequest{UserID: ownerID, TaskID: taskID, Name: "notes.txt", Content: textFile(20)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	fillQuota(t, c, ownerID, taskID, 10)
+
+	// one byte over the quota is refused before the file is stored
+	_, err = d.UploadAttachment(ctx, &UploadAttachmentRequest{UserID: ownerID, TaskID: taskID, Name: "big.txt", Content: textFile(11)})
+	assertCode(t, err, errno.ErrAttachmentQuotaExceededCode)
+	if n := oss.len(); n != 1 {
+		t.Errorf("storage holds %d objects, want 1", n)
+	}
+
+	// the quota is per user
+	if _, err := d.UploadAttachment(ctx, &UploadAttachmentRequest{UserID: otherID, TaskID: otherTaskID, Name: "big.txt", Content: textFile(11)}); err != nil {
+		t.Fatal(err)
+	}
+
+	// an upload may use the quota up exactly
+	if _, err := d.UploadAttachment(ctx, &UploadAttachmentRequest{UserID: ownerID, TaskID: taskID, Name: "fits.txt", Content: textFile(10)}); err != nil {
+		t.Fatal(err)
+	}
+	_, err = d.UploadAttachment(ctx, &UploadAttachmentRequest{UserID: ownerID, TaskID: taskID, Name: "one.txt", Content: textFile(1)})
+	assertCode(t, err, errno.ErrAttachmentQuotaExceededCode)
+
+	// deleting an attachment frees its bytes
+	if err := d.DeleteAttachment(ctx, ownerID, first.ID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := d.UploadAttachment(ctx, &UploadAttachmentRequest{UserID: ownerID, TaskID: taskID, Name: "again.txt", Content: textFile(20)}); err != nil {
+		t.Fatal(err)
+	}
+	used, err := c.AttachmentRepo.UsedBytes(ctx, ownerID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if used != attachmentQuota {
+		t.Errorf("used bytes = %d, want %d", used, attachmentQuota)
+	}
+}
+
+func TestAttachmentQuotaCheckedOnSave(t *testing.T) {
+	ctx := context.Background()
+	d, c, oss, taskID, _ := newAttachmentTest(t)
+
+	// another upload takes the room while the file is stored
+	fillQuota(t, c, ownerID, taskID, 20)
+	oss.beforePut = func() {
+		oss.beforePut = nil
+		fillQuota(t, c, ownerID, taskID, 10)
+	}
+
+	_, err := d.UploadAttachment(ctx, &UploadAttachmentRequest{UserID: ownerID, TaskID: taskID, Name: "late.txt", Content: textFile(20)})
+	assertCode(t, err, errno.ErrAttachmentQuotaExceededCode)
+	// the stored file of the refused upload is removed
+	if n := oss.len(); n != 0 {
+		t.Errorf("storage holds %d objects, want none", n)
+	}
+	attachments, err := d.ListAttachments(ctx, ownerID, taskID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(attachments) != 2 {
+		t.Errorf("task has %d attachments, want the 2 fillers", len(attachments))
+	}
+}
+
+func TestUploadAttachmentLimits(t *testing.T) {
+	ctx := context.Background()
+	d, _, oss, taskID, otherTaskID := newAttachmentTest(t)
+
+	tests := []struct {
+		name string
+		req  *UploadAttachmentRequest
+		code int32
+	}{
+		{"empty", &UploadAttachmentRequest{TaskID: taskID, Name: "empty.txt"}, errno.ErrTaskInvalidParamCode},
+		{"too large", &UploadAttachmentRequest{TaskID: taskID, Name: "big.txt", Content: textFile(maxAttachmentSize + 1)}, errno.ErrTaskInvalidParamCode},
+		{"unsupported type", &UploadAttachmentRequest{TaskID: taskID, Name: "a.zip", Content: []byte("PK\x03\x04")}, errno.ErrTaskInvalidParamCode},
+		{"no name", &UploadAttachmentRequest{TaskID: taskID, Name: " ", Content: textFile(1)}, errno.ErrTaskInvalidParamCode},
+		{"task of another user", &UploadAttachmentRequest{TaskID: otherTaskID, Name: "a.txt", Content: textFile(1)}, errno.ErrTaskNotFoundCode},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.req.UserID = ownerID
+			_, err := d.UploadAttachment(ctx, tt.req)
+			assertCode(t, err, tt.code)
+		})
+	}
+	if n := oss.len(); n != 0 {
+		t.Errorf("storage holds %d objects, want none", n)
+	}
+
+	// the largest file fits
+	if _, err := d.UploadAttachment(ctx, &UploadAttachmentRequest{UserID: ownerID, TaskID: taskID, Name: "max.txt", Content: textFile(maxAttachmentSize)}); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,388 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/entity"
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/internal/dal"
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/internal/dal/model"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/errorx"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/lang/conv"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/lang/slice"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/logs"
+	"github.com/crazyfrankie/zrpc-todolist/types/errno"
+)
+
+// maxBatchSize bounds the items of a batch, it also stays within the batch size
+// suggested by the ID generator.
+const maxBatchSize = 100
+
+func (t *taskImpl) BatchCreateTasks(ctx context.Context, userID int64, reqs []*CreateTaskRequest) ([]*BatchResult, error) {
+	ctx = dal.WithEventType(ctx, entity.TaskCreated.Int32())
+
+	if err := checkBatchSize(len(reqs)); err != nil {
+		return nil, err
+	}
+
+	ids, err := t.IDGen.GenMultiIDs(ctx, len(reqs))
+	if err != nil {
+		return nil, fmt.Errorf("generate ids error: %w", err)
+	}
+	rank, err := t.lastRank(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*BatchResult, len(reqs))
+	var tasks []*model.Task
+	var tagIDs [][]int64
+	for i, req := range reqs {
+		results[i] = &BatchResult{}
+		req.UserID = userID
+
+		newTask, tags, err := t.newTask(ctx, req, ids[i], rank)
+		if err != nil {
+			if !isItemError(err) {
+				return nil, err
+			}
+			results[i].Err = err
+			continue
+		}
+		// the tasks are appended in request order
+		rank = midRank(rank, "")
+		results[i].TaskID = newTask.ID
+		tasks = append(tasks, newTask)
+		tagIDs = append(tagIDs, tags)
+	}
+	if len(tasks) == 0 {
+		return results, nil
+	}
+
+	if err := t.TaskRepo.BatchCreate(ctx, tasks, tagIDs); err != nil {
+		return nil, err
+	}
+
+	created := make([]*entity.Task, 0, len(tasks))
+	for _, newTask := range tasks {
+		if err := t.Searcher.Index(ctx, taskPO2Document(newTask)); err != nil {
+			logs.CtxWarnf(ctx, "index task failed, taskID=%d, err=%v", newTask.ID, err)
+		}
+		created = append(created, taskPO2DO(newTask))
+	}
+	if err := t.fillTaskDetails(ctx, created); err != nil {
+		return nil, err
+	}
+	byID := slice.ToMap(created, func(task *entity.Task) (int64, *entity.Task) {
+		return task.ID, task
+	})
+	for _, res := range results {
+		if res.Err == nil {
+			res.Task = byID[res.TaskID]
+		}
+	}
+
+	return results, nil
+}
+
+func (t *taskImpl) BatchUpdateStatus(ctx context.Context, userID int64, taskIDs []int64, status entity.Status) ([]*BatchResult, error) {
+	ctx = dal.WithEventType(ctx, entity.TaskStatusChanged.Int32(), taskIDs...)
+
+	if !status.IsValid() {
+		return nil, errorx.New(errno.ErrTaskInvalidParamCode, errorx.KV("msg", "invalid status"))
+	}
+	if status == entity.TrashedStatus {
+		return nil, errorx.New(errno.ErrTaskInvalidParamCode, errorx.KV("msg", "trash tasks with a batch delete"))
+	}
+	results, byID, err := t.batchTasks(ctx, userID, taskIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make(map[int64]*dal.StatusChange)
+	for _, res := range results {
+		taskModel := byID[res.TaskID]
+		if res.Err != nil || entity.Status(taskModel.Status) == status {
+			continue
+		}
+
+		from := entity.Status(taskModel.Status)
+		if !from.CanTransitTo(status) {
+			res.Err = errorx.New(errno.ErrTaskInvalidStatusTransitionCode,
+				errorx.KV("from", from.String()), errorx.KV("to", status.String()))
+			continue
+		}
+		changes[res.TaskID] = &dal.StatusChange{TaskID: res.TaskID, From: from.Int32()}
+	}
+	if status == entity.DoneStatus {
+		if err := t.checkBatchBlockers(ctx, results, changes); err != nil {
+			return nil, err
+		}
+		for _, res := range results {
+			change, ok := changes[res.TaskID]
+			if !ok || byID[res.TaskID].Recurrence == "" {
+				continue
+			}
+			change.Next, err = t.nextOccurrence(ctx, byID[res.TaskID])
+			if err != nil {
+				if !isItemError(err) {
+					return nil, err
+				}
+				res.Err = err
+				delete(changes, res.TaskID)
+			}
+		}
+	}
+	if len(changes) == 0 {
+		return results, nil
+	}
+
+	ordered := make([]*dal.StatusChange, 0, len(changes))
+	for _, res := range results {
+		if change, ok := changes[res.TaskID]; ok {
+			ordered = append(ordered, change)
+		}
+	}
+	moved, created, err := t.TaskRepo.BatchUpdateStatus(ctx, userID, status.Int32(), ordered)
+	if err != nil {
+		return nil, err
+	}
+
+	movedSet := slice.ToMap(moved, func(id int64) (int64, bool) {
+		return id, true
+	})
+	for _, res := range results {
+		change, ok := changes[res.TaskID]
+		if !ok {
+			continue
+		}
+		// the task changed since it was loaded
+		if !movedSet[res.TaskID] {
+			res.Err = errorx.New(errno.ErrTaskInvalidStatusTransitionCode,
+				errorx.KV("from", entity.Status(change.From).String()), errorx.KV("to", status.String()))
+		}
+	}
+	for _, id := range moved {
+		t.syncSearchIndex(ctx, id)
+	}
+	for _, next := range created {
+		if err := t.Searcher.Index(ctx, taskPO2Document(next)); err != nil {
+			logs.CtxWarnf(ctx, "index task failed, taskID=%d, err=%v", next.ID, err)
+		}
+	}
+	// finishing subtasks may complete their parents
+	if status == entity.DoneStatus {
+		t.autoCompleteParents(ctx, userID, moved, byID)
+	}
+
+	return results, nil
+}
+
+func (t *taskImpl) BatchDeleteTasks(ctx context.Context, userID int64, taskIDs []int64) ([]*BatchResult, error) {
+	ctx = dal.WithEventType(ctx, entity.TaskDeleted.Int32())
+
+	if err := checkBatchIDs(taskIDs); err != nil {
+		return nil, err
+	}
+	taskModels, err := t.TaskRepo.GetTasksByIDs(ctx, userID, taskIDs)
+	if err != nil {
+		return nil, err
+	}
+	byID := slice.ToMap(taskModels, func(m *model.Task) (int64, *model.Task) {
+		return m.ID, m
+	})
+
+	results := make([]*BatchResult, 0, len(taskIDs))
+	var live []int64
+	for _, id := range taskIDs {
+		results = append(results, &BatchResult{TaskID: id})
+		if _, ok := byID[id]; ok {
+			live = append(live, id)
+		}
+	}
+
+	var trashed map[int64][]int64
+	if len(live) > 0 {
+		trashed, err = t.TaskRepo.BatchTrash(ctx, userID, live, entity.TrashedStatus.Int32())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var deleted []int64
+	for _, res := range results {
+		if ids, ok := trashed[res.TaskID]; ok {
+			for _, id := range ids {
+				t.syncSearchIndex(ctx, id)
+			}
+			deleted = append(deleted, res.TaskID)
+			continue
+		}
+		// tasks already in the recycle bin are left there
+		taskModel, err := t.getOwnedTask(ctx, userID, res.TaskID)
+		if err != nil {
+			if !isItemError(err) {
+				return nil, err
+			}
+			res.Err = err
+			continue
+		}
+		if !taskModel.DeletedAt.Valid {
+			res.Err = errorx.New(errno.ErrTaskNotFoundCode, errorx.KV("task_id", conv.Int64ToStr(res.TaskID)))
+		}
+	}
+	// the remaining subtasks of the parents may all be done now
+	t.autoCompleteParents(ctx, userID, deleted, byID)
+
+	return results, nil
+}
+
+func (t *taskImpl) BatchMoveTasks(ctx context.Context, userID int64, taskIDs []int64, projectID int64) ([]*BatchResult, error) {
+	ctx = dal.WithEventType(ctx, entity.TaskUpdated.Int32())
+
+	projectID, err := t.taskProject(ctx, userID, projectID)
+	if err != nil {
+		return nil, err
+	}
+	results, byID, err := t.batchTasks(ctx, userID, taskIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []int64
+	for _, res := range results {
+		if res.Err != nil {
+			continue
+		}
+		if byID[res.TaskID].ParentID != 0 {
+			res.Err = errorx.New(errno.ErrTaskInvalidParamCode,
+				errorx.KV("msg", "subtasks move with their parent, move the parent instead"))
+			continue
+		}
+		ids = append(ids, res.TaskID)
+	}
+	if len(ids) == 0 {
+		return results, nil
+	}
+
+	moved, err := t.TaskRepo.BatchMove(ctx, userID, ids, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	movedSet := slice.ToMap(moved, func(id int64) (int64, bool) {
+		return id, true
+	})
+	for _, res := range results {
+		if res.Err == nil && !movedSet[res.TaskID] {
+			res.Err = errorx.New(errno.ErrTaskNotFoundCode, errorx.KV("task_id", conv.Int64ToStr(res.TaskID)))
+		}
+	}
+	for _, id := range moved {
+		t.syncSearchIndex(ctx, id)
+	}
+
+	return results, nil
+}
+
+// batchTasks loads the live tasks of a batch, the results of missing tasks carry
+// their error already.
+func (t *taskImpl) batchTasks(ctx context.Context, userID int64, taskIDs []int64) ([]*BatchResult, map[int64]*model.Task, error) {
+	if err := checkBatchIDs(taskIDs); err != nil {
+		return nil, nil, err
+	}
+	taskModels, err := t.TaskRepo.GetTasksByIDs(ctx, userID, taskIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+	byID := slice.ToMap(taskModels, func(m *model.Task) (int64, *model.Task) {
+		return m.ID, m
+	})
+
+	results := make([]*BatchResult, 0, len(taskIDs))
+	for _, id := range taskIDs {
+		res := &BatchResult{TaskID: id}
+		if _, ok := byID[id]; !ok {
+			res.Err = errorx.New(errno.ErrTaskNotFoundCode, errorx.KV("task_id", conv.Int64ToStr(id)))
+		}
+		results = append(results, res)
+	}
+
+	return results, byID, nil
+}
+
+// checkBatchBlockers drops the changes of the tasks blocked by open tasks, unless
+// the blockers are finished by the same batch.
+func (t *taskImpl) checkBatchBlockers(ctx context.Context, results []*BatchResult, changes map[int64]*dal.StatusChange) error {
+	blockers := make(map[int64][]int64, len(changes))
+	for taskID := range changes {
+		open, err := t.openBlockers(ctx, taskID)
+		if err != nil {
+			return err
+		}
+		blockers[taskID] = open
+	}
+
+	// dropping a change may leave the tasks it blocks blocked too
+	for dropped := true; dropped; {
+		dropped = false
+		for _, res := range results {
+			if _, ok := changes[res.TaskID]; !ok {
+				continue
+			}
+			for _, blockerID := range blockers[res.TaskID] {
+				if _, ok := changes[blockerID]; !ok {
+					res.Err = blockedError(res.TaskID, blockers[res.TaskID])
+					delete(changes, res.TaskID)
+					dropped = true
+					break
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// autoCompleteParents completes the parents of the tasks which ask for it.
+func (t *taskImpl) autoCompleteParents(ctx context.Context, userID int64, taskIDs []int64, byID map[int64]*model.Task) {
+	done := make(map[int64]bool)
+	for _, id := range taskIDs {
+		parentID := byID[id].ParentID
+		if parentID != 0 && !done[parentID] {
+			done[parentID] = true
+			t.autoComplete(ctx, userID, parentID)
+		}
+	}
+}
+
+func checkBatchSize(n int) error {
+	if n == 0 {
+		return errorx.New(errno.ErrTaskInvalidParamCode, errorx.KV("msg", "batch is empty"))
+	}
+	if n > maxBatchSize {
+		return errorx.New(errno.ErrTaskInvalidParamCode,
+			errorx.KVf("msg", "batch holds more than %d items", maxBatchSize))
+	}
+
+	return nil
+}
+
+func checkBatchIDs(taskIDs []int64) error {
+	if err := checkBatchSize(len(taskIDs)); err != nil {
+		return err
+	}
+	if len(slice.Unique(taskIDs)) != len(taskIDs) {
+		return errorx.New(errno.ErrTaskInvalidParamCode, errorx.KV("msg", "batch holds duplicate task ids"))
+	}
+
+	return nil
+}
+
+// isItemError tells the errors rejecting a single item of a batch apart from the
+// ones failing the whole batch.
+func isItemError(err error) bool {
+	var statusErr errorx.StatusError
+	return errors.As(err, &statusErr) && statusErr.Code() != 0
+}
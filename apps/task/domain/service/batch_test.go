@@ -0,0 +1,278 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/entity"
+	"github.com/crazyfrankie/zrpc-todolist/types/errno"
+)
+
+// newBatchTest returns the task domain with the tasks created for the owner.
+func newBatchTest(t *testing.T, titles ...string) (Task, []int64) {
+	t.Helper()
+
+	ctx := context.Background()
+	d := NewTaskDomain(newTestComponents(t))
+
+	var ids []int64
+	for _, title := range titles {
+		task, err := d.Create(ctx, &CreateTaskRequest{UserID: ownerID, Title: title})
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, task.ID)
+	}
+
+	return d, ids
+}
+
+// assertResults checks the results are in request order and carry the error
+// code of each item, zero meaning the item was applied.
+func assertResults(t *testing.T, results []*BatchResult, ids []int64, codes []int32) {
+	t.Helper()
+
+	if len(results) != len(codes) {
+		t.Fatalf("got %d results, want %d", len(results), len(codes))
+	}
+	for i, res := range results {
+		if ids != nil && res.TaskID != ids[i] {
+			t.Errorf("result %d is for task %d, want %d", i, res.TaskID, ids[i])
+		}
+		if codes[i] == 0 {
+			if res.Err != nil {
+				t.Errorf("result %d err = %v", i, res.Err)
+			}
+			continue
+		}
+		assertCode(t, res.Err, codes[i])
+	}
+}
+
+func TestBatchLimits(t *testing.T) {
+	ctx := context.Background()
+	d, ids := newBatchTest(t, "a", "b")
+
+	tooMany := make([]int64, maxBatchSize+1)
+	for i := range tooMany {
+		tooMany[i] = int64(i + 1)
+	}
+	duplicate := []int64{ids[0], ids[1], ids[0]}
+
+	tests := []struct {
+		name string
+		call func() error
+	}{
+		{"create empty", func() error {
+			_, err := d.BatchCreateTasks(ctx, ownerID, nil)
+			return err
+		}},
+		{"create too many", func() error {
+			reqs := make([]*CreateTaskRequest, maxBatchSize+1)
+			for i := range reqs {
+				reqs[i] = &CreateTaskRequest{Title: "task"}
+			}
+			_, err := d.BatchCreateTasks(ctx, ownerID, reqs)
+			return err
+		}},
+		{"status empty", func() error {
+			_, err := d.BatchUpdateStatus(ctx, ownerID, nil, entity.DoneStatus)
+			return err
+		}},
+		{"status too many", func() error {
+			_, err := d.BatchUpdateStatus(ctx, ownerID, tooMany, entity.DoneStatus)
+			return err
+		}},
+		{"status duplicate", func() error {
+			_, err := d.BatchUpdateStatus(ctx, ownerID, duplicate, entity.DoneStatus)
+			return err
+		}},
+		{"status trashed", func() error {
+			_, err := d.BatchUpdateStatus(ctx, ownerID, ids, entity.TrashedStatus)
+			return err
+		}},
+		{"delete too many", func() error {
+			_, err := d.BatchDeleteTasks(ctx, ownerID, tooMany)
+			return err
+		}},
+		{"delete duplicate", func() error {
+			_, err := d.BatchDeleteTasks(ctx, ownerID, duplicate)
+			return err
+		}},
+		{"move duplicate", func() error {
+			_, err := d.BatchMoveTasks(ctx, ownerID, duplicate, 0)
+			return err
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertCode(t, tt.call(), errno.ErrTaskInvalidParamCode)
+		})
+	}
+
+	// a rejected batch changes nothing
+	for _, id := range ids {
+		task, err := d.GetTask(ctx, ownerID, id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if task.Status != entity.ToDoStatus {
+			t.Errorf("task %d status = %v, want %v", id, task.Status, entity.ToDoStatus)
+		}
+	}
+
+	// a full batch is fine
+	reqs := make([]*CreateTaskRequest, maxBatchSize)
+	for i := range reqs {
+		reqs[i] = &CreateTaskRequest{Title: "task"}
+	}
+	results, err := d.BatchCreateTasks(ctx, ownerID, reqs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertResults(t, results, nil, make([]int32, maxBatchSize))
+}
+
+func TestBatchCreateTasks(t *testing.T) {
+	ctx := context.Background()
+	d, _ := newBatchTest(t)
+
+	results, err := d.BatchCreateTasks(ctx, ownerID, []*CreateTaskRequest{
+		{Title: "first"},
+		{Title: "bad priority", Priority: entity.UrgentPriority + 1},
+		{Title: "unknown tag", TagIDs: []int64{404}},
+		{Title: "last", UserID: otherID},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertResults(t, results, nil, []int32{0, errno.ErrTaskInvalidParamCode, errno.ErrTagNotFoundCode, 0})
+
+	// the created tasks belong to the caller
+	first, last := results[0].Task, results[3].Task
+	if first == nil || first.Title != "first" || last == nil || last.Title != "last" {
+		t.Fatalf("created tasks = %+v, %+v", first, last)
+	}
+	if _, err := d.GetTask(ctx, ownerID, last.ID); err != nil {
+		t.Errorf("GetTask() = %v", err)
+	}
+	for _, res := range results[1:3] {
+		if res.Task != nil {
+			t.Errorf("rejected item created task %d", res.Task.ID)
+		}
+	}
+}
+
+func TestBatchUpdateStatus(t *testing.T) {
+	ctx := context.Background()
+	d, ids := newBatchTest(t, "open", "archived", "blocked", "blocker", "blocked by the batch", "done")
+
+	foreign, err := d.Create(ctx, &CreateTaskRequest{UserID: otherID, Title: "not mine"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := d.UpdateTaskStatus(ctx, ownerID, ids[1], entity.ArchivedStatus); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.UpdateTaskStatus(ctx, ownerID, ids[5], entity.DoneStatus); err != nil {
+		t.Fatal(err)
+	}
+	// the blocker of the third task stays open, the one of the fifth is done by the batch
+	if err := d.AddDependency(ctx, ownerID, ids[2], ids[3]); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.AddDependency(ctx, ownerID, ids[4], ids[0]); err != nil {
+		t.Fatal(err)
+	}
+
+	batch := []int64{ids[0], ids[1], ids[2], foreign.ID, 404, ids[4], ids[5]}
+	results, err := d.BatchUpdateStatus(ctx, ownerID, batch, entity.DoneStatus)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertResults(t, results, batch, []int32{
+		0,
+		errno.ErrTaskInvalidStatusTransitionCode,
+		errno.ErrTaskBlockedCode,
+		errno.ErrTaskNotFoundCode,
+		errno.ErrTaskNotFoundCode,
+		0,
+		0,
+	})
+
+	want := map[int64]entity.Status{
+		ids[0]: entity.DoneStatus,
+		ids[1]: entity.ArchivedStatus,
+		ids[2]: entity.ToDoStatus,
+		ids[3]: entity.ToDoStatus,
+		ids[4]: entity.DoneStatus,
+	}
+	for id, status := range want {
+		task, err := d.GetTask(ctx, ownerID, id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if task.Status != status {
+			t.Errorf("task %d status = %v, want %v", id, task.Status, status)
+		}
+	}
+	task, err := d.GetTask(ctx, otherID, foreign.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if task.Status != entity.ToDoStatus {
+		t.Errorf("foreign task status = %v, want %v", task.Status, entity.ToDoStatus)
+	}
+}
+
+func TestBatchDeleteTasks(t *testing.T) {
+	ctx := context.Background()
+	d, ids := newBatchTest(t, "live", "trashed")
+
+	if err := d.DeleteTask(ctx, ownerID, ids[1]); err != nil {
+		t.Fatal(err)
+	}
+	foreign, err := d.Create(ctx, &CreateTaskRequest{UserID: otherID, Title: "not mine"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	batch := []int64{ids[0], ids[1], foreign.ID, 404}
+	results, err := d.BatchDeleteTasks(ctx, ownerID, batch)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// tasks already in the recycle bin count as deleted
+	assertResults(t, results, batch, []int32{0, 0, errno.ErrTaskNotFoundCode, errno.ErrTaskNotFoundCode})
+
+	bin, err := d.GetTaskRecycleList(ctx, &ListTasksRequest{UserID: ownerID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(bin.Tasks) != 2 {
+		t.Errorf("recycle bin holds %d tasks, want 2", len(bin.Tasks))
+	}
+	if _, err := d.GetTask(ctx, otherID, foreign.ID); err != nil {
+		t.Errorf("foreign task: %v", err)
+	}
+}
+
+func TestBatchMoveTasks(t *testing.T) {
+	ctx := context.Background()
+	d, ids := newBatchTest(t, "parent", "sibling")
+
+	sub, err := d.Create(ctx, &CreateTaskRequest{UserID: ownerID, Title: "subtask", ParentID: ids[0]})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	batch := []int64{ids[0], sub.ID, 404, ids[1]}
+	results, err := d.BatchMoveTasks(ctx, ownerID, batch, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertResults(t, results, batch, []int32{0, errno.ErrTaskInvalidParamCode, errno.ErrTaskNotFoundCode, 0})
+
+	_, err = d.BatchMoveTasks(ctx, ownerID, ids, 404)
+	assertCode(t, err, errno.ErrProjectNotFoundCode)
+}
@@ -0,0 +1,50 @@
+package service
+
+import (
+	"context"
+
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/entity"
+)
+
+type CreateColumnRequest struct {
+	UserID int64
+	// ProjectID zero means the inbox.
+	ProjectID int64
+	Name      string
+	Status    entity.Status
+	WIPLimit  int32
+}
+
+type UpdateColumnRequest struct {
+	UserID   int64
+	ColumnID int64
+	Name     *string
+	WIPLimit *int32
+}
+
+// MoveCardRequest moves the task into the column, between BeforeID and AfterID
+// in the manual order. Zero for both puts it at the end of the column.
+type MoveCardRequest struct {
+	UserID   int64
+	TaskID   int64
+	ColumnID int64
+	BeforeID int64
+	AfterID  int64
+}
+
+type Board interface {
+	// GetBoard returns every column of the project with its tasks, a board
+	// without columns gets the default ones. ProjectID zero means the inbox.
+	GetBoard(ctx context.Context, userID, projectID int64) (*entity.Board, error)
+	// CreateColumn, UpdateColumn, ReorderColumns and DeleteColumn refuse to
+	// leave a WIP limit on the first column of a status.
+	CreateColumn(ctx context.Context, req *CreateColumnRequest) (*entity.BoardColumn, error)
+	UpdateColumn(ctx context.Context, req *UpdateColumnRequest) (*entity.BoardColumn, error)
+	// ReorderColumns puts the given columns in the given order, the others keep their places.
+	ReorderColumns(ctx context.Context, userID, projectID int64, columnIDs []int64) error
+	// DeleteColumn deletes the column, its tasks move to the first column of their status.
+	DeleteColumn(ctx context.Context, userID, columnID int64) error
+	// MoveCard moves the task into the column, changing its status to the one of
+	// the column. The move fails once the column reached its WIP limit.
+	MoveCard(ctx context.Context, req *MoveCardRequest) (*entity.Task, error)
+}
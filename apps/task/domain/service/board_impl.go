@@ -0,0 +1,562 @@
+package service
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/entity"
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/internal/dal"
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/internal/dal/model"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/errorx"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/lang/conv"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/lang/slice"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/logs"
+	"github.com/crazyfrankie/zrpc-todolist/types/errno"
+)
+
+const (
+	maxBoardColumns     = 20
+	maxColumnNameLength = 64
+	maxWIPLimit         = 1000
+	// maxBoardTasks bounds the tasks returned with a board.
+	maxBoardTasks = 1000
+)
+
+// defaultColumns are the columns a board starts with.
+var defaultColumns = []struct {
+	name   string
+	status entity.Status
+}{
+	{"To Do", entity.ToDoStatus},
+	{"In Progress", entity.InProgressStatus},
+	{"Done", entity.DoneStatus},
+}
+
+// boardImpl works on tasks, so it builds on the task domain.
+type boardImpl struct {
+	*taskImpl
+}
+
+func NewBoardDomain(c *Components) Board {
+	return &boardImpl{&taskImpl{c}}
+}
+
+func (b *boardImpl) GetBoard(ctx context.Context, userID, projectID int64) (*entity.Board, error) {
+	projectID, err := b.boardProject(ctx, userID, projectID)
+	if err != nil {
+		return nil, err
+	}
+	columns, err := b.ensureColumns(ctx, userID, projectID)
+	if err != nil {
+		return nil, err
+	}
+	projectIDs, err := b.projectFilter(ctx, userID, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	layout := newBoardLayout(columns)
+	taskModels, err := b.TaskRepo.ListTasks(ctx, &dal.ListTasksParams{
+		UserID:     userID,
+		Statuses:   layout.statuses(),
+		ProjectIDs: projectIDs,
+		Limit:      maxBoardTasks,
+		SortBy:     dal.SortBySortKey,
+		Asc:        true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	tasks := slice.Transform(taskModels, taskPO2DO)
+	if err := b.fillTaskDetails(ctx, tasks); err != nil {
+		return nil, err
+	}
+
+	board := &entity.Board{
+		ProjectID: projectID,
+		Columns:   slice.Transform(columns, boardColumnPO2DO),
+	}
+	byID := slice.ToMap(board.Columns, func(column *entity.BoardColumn) (int64, *entity.BoardColumn) {
+		return column.ID, column
+	})
+	for i, taskModel := range taskModels {
+		if column, ok := byID[layout.columnOf(taskModel)]; ok {
+			column.Tasks = append(column.Tasks, tasks[i])
+		}
+	}
+
+	return board, nil
+}
+
+func (b *boardImpl) CreateColumn(ctx context.Context, req *CreateColumnRequest) (*entity.BoardColumn, error) {
+	name, err := normalizeColumnName(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	if !slices.Contains(entity.BoardColumnStatuses, req.Status) {
+		return nil, errorx.New(errno.ErrTaskInvalidParamCode, errorx.KV("msg", "columns can't show tasks in this status"))
+	}
+	if err := checkWIPLimit(req.WIPLimit); err != nil {
+		return nil, err
+	}
+
+	projectID, err := b.boardProject(ctx, req.UserID, req.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+	// the default columns always come first
+	columns, err := b.ensureColumns(ctx, req.UserID, projectID)
+	if err != nil {
+		return nil, err
+	}
+	if len(columns) >= maxBoardColumns {
+		return nil, errorx.New(errno.ErrTaskInvalidParamCode, errorx.KV("msg", "too many board columns"))
+	}
+	position, err := b.BoardRepo.MaxPosition(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	newColumn := &model.BoardColumn{
+		UserID:    req.UserID,
+		ProjectID: projectID,
+		Name:      name,
+		Status:    req.Status.Int32(),
+		Position:  position + 1,
+		WipLimit:  req.WIPLimit,
+	}
+	if err := checkDefaultLimits(append(columns, newColumn)); err != nil {
+		return nil, err
+	}
+	if err := b.BoardRepo.CreateColumn(ctx, newColumn); err != nil {
+		return nil, err
+	}
+
+	return boardColumnPO2DO(newColumn), nil
+}
+
+func (b *boardImpl) UpdateColumn(ctx context.Context, req *UpdateColumnRequest) (*entity.BoardColumn, error) {
+	column, err := b.getOwnedColumn(ctx, req.UserID, req.ColumnID)
+	if err != nil {
+		return nil, err
+	}
+
+	updates := map[string]any{
+		"updated_at": time.Now().UnixMilli(),
+	}
+	if req.Name != nil {
+		name, err := normalizeColumnName(*req.Name)
+		if err != nil {
+			return nil, err
+		}
+		updates["name"] = name
+		column.Name = name
+	}
+	if req.WIPLimit != nil {
+		if err := checkWIPLimit(*req.WIPLimit); err != nil {
+			return nil, err
+		}
+		updates["wip_limit"] = *req.WIPLimit
+		column.WipLimit = *req.WIPLimit
+
+		columns, err := b.BoardRepo.ListColumns(ctx, column.ProjectID)
+		if err != nil {
+			return nil, err
+		}
+		for i, other := range columns {
+			if other.ID == column.ID {
+				columns[i] = column
+			}
+		}
+		if err := checkDefaultLimits(columns); err != nil {
+			return nil, err
+		}
+	}
+
+	ok, err := b.BoardRepo.UpdateColumn(ctx, req.UserID, req.ColumnID, updates)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, errorx.New(errno.ErrBoardColumnNotFoundCode, errorx.KV("column_id", conv.Int64ToStr(req.ColumnID)))
+	}
+	column.UpdatedAt = updates["updated_at"].(int64)
+
+	return boardColumnPO2DO(column), nil
+}
+
+func (b *boardImpl) ReorderColumns(ctx context.Context, userID, projectID int64, columnIDs []int64) error {
+	if len(columnIDs) == 0 {
+		return nil
+	}
+	if len(slice.Unique(columnIDs)) != len(columnIDs) {
+		return errorx.New(errno.ErrTaskInvalidParamCode, errorx.KV("msg", "duplicate column ids"))
+	}
+
+	projectID, err := b.boardProject(ctx, userID, projectID)
+	if err != nil {
+		return err
+	}
+	columns, err := b.BoardRepo.ListColumns(ctx, projectID)
+	if err != nil {
+		return err
+	}
+	if err := checkDefaultLimits(reorderedColumns(columns, columnIDs)); err != nil {
+		return err
+	}
+
+	ok, err := b.BoardRepo.ReorderColumns(ctx, projectID, columnIDs)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errorx.New(errno.ErrTaskInvalidParamCode, errorx.KV("msg", "unknown column ids"))
+	}
+
+	return nil
+}
+
+func (b *boardImpl) DeleteColumn(ctx context.Context, userID, columnID int64) error {
+	column, err := b.getOwnedColumn(ctx, userID, columnID)
+	if err != nil {
+		return err
+	}
+	columns, err := b.BoardRepo.ListColumns(ctx, column.ProjectID)
+	if err != nil {
+		return err
+	}
+	if len(columns) <= 1 {
+		return errorx.New(errno.ErrTaskInvalidParamCode, errorx.KV("msg", "a board needs at least one column"))
+	}
+	rest := slices.DeleteFunc(columns, func(other *model.BoardColumn) bool { return other.ID == columnID })
+	if err := checkDefaultLimits(rest); err != nil {
+		return err
+	}
+
+	ok, err := b.BoardRepo.DeleteColumn(ctx, userID, columnID)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errorx.New(errno.ErrBoardColumnNotFoundCode, errorx.KV("column_id", conv.Int64ToStr(columnID)))
+	}
+
+	return nil
+}
+
+func (b *boardImpl) MoveCard(ctx context.Context, req *MoveCardRequest) (*entity.Task, error) {
+	if req.BeforeID == req.TaskID || req.AfterID == req.TaskID {
+		return nil, errorx.New(errno.ErrTaskInvalidParamCode, errorx.KV("msg", "a task can't be moved next to itself"))
+	}
+
+	column, err := b.getOwnedColumn(ctx, req.UserID, req.ColumnID)
+	if err != nil {
+		return nil, err
+	}
+	taskModel, err := b.getLiveTask(ctx, req.UserID, req.TaskID)
+	if err != nil {
+		return nil, err
+	}
+	projectIDs, err := b.projectFilter(ctx, req.UserID, column.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+	if !slices.Contains(projectIDs, taskModel.ProjectID) {
+		return nil, errorx.New(errno.ErrTaskInvalidParamCode, errorx.KV("msg", "the task is not on the board of the column"))
+	}
+	columns, err := b.BoardRepo.ListColumns(ctx, column.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+	cards := newBoardLayout(columns).cards(column, req.UserID, projectIDs)
+
+	var rank string
+	if req.BeforeID == 0 && req.AfterID == 0 {
+		rank, err = b.lastRank(ctx, req.UserID)
+	} else {
+		rank, err = b.rankBetween(ctx, &MoveTaskRequest{
+			UserID:   req.UserID,
+			TaskID:   req.TaskID,
+			BeforeID: req.BeforeID,
+			AfterID:  req.AfterID,
+		})
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// the status changes together with the placement, once the column is
+	// known to have room
+	from, status := entity.Status(taskModel.Status), entity.Status(column.Status)
+	var next *model.Task
+	if from != status {
+		ctx = dal.WithEventType(ctx, entity.TaskStatusChanged.Int32(), req.TaskID)
+		next, err = b.checkStatusChange(ctx, taskModel, status)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		ctx = dal.WithEventType(ctx, entity.TaskUpdated.Int32())
+	}
+
+	result, err := b.BoardRepo.MoveCard(ctx, req.TaskID, from.Int32(), cards, map[string]any{
+		"sort_key":   rank,
+		"updated_at": time.Now().UnixMilli(),
+	}, next)
+	if err != nil {
+		return nil, err
+	}
+	switch result.Status {
+	case dal.ColumnNotFound:
+		return nil, errorx.New(errno.ErrBoardColumnNotFoundCode, errorx.KV("column_id", conv.Int64ToStr(column.ID)))
+	case dal.ColumnFull:
+		column.WipLimit = result.WIPLimit
+		return nil, wipLimitError(column)
+	case dal.CardNotFound:
+		// the task changed its status or was deleted meanwhile
+		return nil, errorx.New(errno.ErrTaskVersionConflictCode, errorx.KV("task_id", conv.Int64ToStr(req.TaskID)))
+	}
+
+	if from != status {
+		b.syncSearchIndex(ctx, req.TaskID)
+		if result.NextCreated {
+			if err := b.Searcher.Index(ctx, taskPO2Document(next)); err != nil {
+				logs.CtxWarnf(ctx, "index task failed, taskID=%d, err=%v", next.ID, err)
+			}
+		}
+		if status == entity.DoneStatus {
+			b.autoComplete(ctx, req.UserID, taskModel.ParentID)
+		}
+	}
+
+	return b.GetTask(ctx, req.UserID, req.TaskID)
+}
+
+// checkStatusChange checks that a card move may take the task to status like
+// UpdateTaskStatus would. Finishing a recurring task returns the occurrence
+// following it, to be created with the move.
+func (b *boardImpl) checkStatusChange(ctx context.Context, taskModel *model.Task, status entity.Status) (*model.Task, error) {
+	from := entity.Status(taskModel.Status)
+	if !from.CanTransitTo(status) {
+		return nil, errorx.New(errno.ErrTaskInvalidStatusTransitionCode,
+			errorx.KV("from", from.String()), errorx.KV("to", status.String()))
+	}
+	if status != entity.DoneStatus {
+		return nil, nil
+	}
+
+	if err := b.checkBlockers(ctx, taskModel.ID); err != nil {
+		return nil, err
+	}
+	if taskModel.Recurrence == "" {
+		return nil, nil
+	}
+
+	return b.nextOccurrence(ctx, taskModel)
+}
+
+// boardProject resolves the project of a board, zero means the inbox.
+func (b *boardImpl) boardProject(ctx context.Context, userID, projectID int64) (int64, error) {
+	if projectID == 0 {
+		inbox, err := b.ensureInbox(ctx, userID)
+		if err != nil {
+			return 0, err
+		}
+		return inbox.ID, nil
+	}
+
+	projectModel, exist, err := b.ProjectRepo.GetProjectByID(ctx, projectID)
+	if err != nil {
+		return 0, err
+	}
+	if !exist || projectModel.UserID != userID {
+		return 0, errorx.New(errno.ErrProjectNotFoundCode, errorx.KV("project_id", conv.Int64ToStr(projectID)))
+	}
+
+	return projectID, nil
+}
+
+// ensureColumns returns the columns of the project, creating the default ones
+// if it has none.
+func (b *boardImpl) ensureColumns(ctx context.Context, userID, projectID int64) ([]*model.BoardColumn, error) {
+	columns, err := b.BoardRepo.ListColumns(ctx, projectID)
+	if err != nil || len(columns) > 0 {
+		return columns, err
+	}
+
+	columns = make([]*model.BoardColumn, 0, len(defaultColumns))
+	for i, c := range defaultColumns {
+		columns = append(columns, &model.BoardColumn{
+			UserID:    userID,
+			ProjectID: projectID,
+			Name:      c.name,
+			Status:    c.status.Int32(),
+			Position:  int64(i + 1),
+		})
+	}
+	if err := b.BoardRepo.CreateDefaultColumns(ctx, projectID, columns); err != nil {
+		return nil, err
+	}
+
+	return b.BoardRepo.ListColumns(ctx, projectID)
+}
+
+func (b *boardImpl) getOwnedColumn(ctx context.Context, userID, columnID int64) (*model.BoardColumn, error) {
+	column, exist, err := b.BoardRepo.GetColumnByID(ctx, columnID)
+	if err != nil {
+		return nil, err
+	}
+	if !exist || column.UserID != userID {
+		return nil, errorx.New(errno.ErrBoardColumnNotFoundCode, errorx.KV("column_id", conv.Int64ToStr(columnID)))
+	}
+
+	return column, nil
+}
+
+// boardLayout tells which column of a board shows a task.
+type boardLayout struct {
+	columns []*model.BoardColumn
+	// defaults maps each status to its first column.
+	defaults map[int32]int64
+	// statusOf maps each column to its status.
+	statusOf map[int64]int32
+}
+
+func newBoardLayout(columns []*model.BoardColumn) *boardLayout {
+	l := &boardLayout{
+		columns:  columns,
+		defaults: make(map[int32]int64),
+		statusOf: make(map[int64]int32, len(columns)),
+	}
+	for _, column := range columns {
+		if _, ok := l.defaults[column.Status]; !ok {
+			l.defaults[column.Status] = column.ID
+		}
+		l.statusOf[column.ID] = column.Status
+	}
+
+	return l
+}
+
+func (l *boardLayout) statuses() []int32 {
+	statuses := make([]int32, 0, len(l.defaults))
+	for status := range l.defaults {
+		statuses = append(statuses, status)
+	}
+
+	return statuses
+}
+
+// columnOf returns the column showing the task, zero if the board has no column
+// for its status.
+func (l *boardLayout) columnOf(taskModel *model.Task) int64 {
+	if status, ok := l.statusOf[taskModel.ColumnID]; ok && status == taskModel.Status {
+		return taskModel.ColumnID
+	}
+
+	return l.defaults[taskModel.Status]
+}
+
+// cards selects the tasks shown in the column.
+func (l *boardLayout) cards(column *model.BoardColumn, userID int64, projectIDs []int64) *dal.ColumnCards {
+	cards := &dal.ColumnCards{
+		UserID:     userID,
+		ProjectIDs: projectIDs,
+		Status:     column.Status,
+		ColumnID:   column.ID,
+		Default:    l.defaults[column.Status] == column.ID,
+	}
+	for _, other := range l.columns {
+		if other.Status == column.Status && other.ID != column.ID {
+			cards.OtherColumnIDs = append(cards.OtherColumnIDs, other.ID)
+		}
+	}
+
+	return cards
+}
+
+func normalizeColumnName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", errorx.New(errno.ErrTaskInvalidParamCode, errorx.KV("msg", "column name is empty"))
+	}
+	if utf8.RuneCountInString(name) > maxColumnNameLength {
+		return "", errorx.New(errno.ErrTaskInvalidParamCode, errorx.KV("msg", "column name is too long"))
+	}
+
+	return name, nil
+}
+
+func checkWIPLimit(limit int32) error {
+	if limit < 0 || limit > maxWIPLimit {
+		return errorx.New(errno.ErrTaskInvalidParamCode, errorx.KV("msg", "invalid wip limit"))
+	}
+
+	return nil
+}
+
+// checkDefaultLimits refuses the columns, in display order, if the first column
+// of a status has a WIP limit. Tasks changing their status outside the board
+// land in that column, so it can't bound them.
+func checkDefaultLimits(columns []*model.BoardColumn) error {
+	defaults := newBoardLayout(columns).defaults
+	for _, column := range columns {
+		if column.WipLimit > 0 && defaults[column.Status] == column.ID {
+			return errorx.New(errno.ErrTaskInvalidParamCode,
+				errorx.KV("msg", "the first column of a status can't have a wip limit"))
+		}
+	}
+
+	return nil
+}
+
+// reorderedColumns returns the columns in display order once ReorderColumns put
+// the given ones in the given order, columns is left unchanged.
+func reorderedColumns(columns []*model.BoardColumn, columnIDs []int64) []*model.BoardColumn {
+	positions := make([]int64, 0, len(columnIDs))
+	for _, column := range columns {
+		if slices.Contains(columnIDs, column.ID) {
+			positions = append(positions, column.Position)
+		}
+	}
+	slices.Sort(positions)
+
+	reordered := make([]*model.BoardColumn, 0, len(columns))
+	for _, column := range columns {
+		moved := *column
+		if i := slices.Index(columnIDs, column.ID); i >= 0 && i < len(positions) {
+			moved.Position = positions[i]
+		}
+		reordered = append(reordered, &moved)
+	}
+	slices.SortFunc(reordered, func(a, b *model.BoardColumn) int {
+		if a.Position != b.Position {
+			return cmp.Compare(a.Position, b.Position)
+		}
+		return cmp.Compare(a.ID, b.ID)
+	})
+
+	return reordered
+}
+
+func wipLimitError(column *model.BoardColumn) error {
+	return errorx.New(errno.ErrWipLimitReachedCode,
+		errorx.KV("column_id", conv.Int64ToStr(column.ID)), errorx.KV("wip_limit", conv.Int64ToStr(int64(column.WipLimit))))
+}
+
+func boardColumnPO2DO(column *model.BoardColumn) *entity.BoardColumn {
+	return &entity.BoardColumn{
+		ID:        column.ID,
+		ProjectID: column.ProjectID,
+		Name:      column.Name,
+		Status:    entity.Status(column.Status),
+		Position:  column.Position,
+		WIPLimit:  column.WipLimit,
+		CreatedAt: column.CreatedAt,
+		UpdatedAt: column.UpdatedAt,
+	}
+}
@@ -0,0 +1,174 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/entity"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/lang/ptr"
+	"github.com/crazyfrankie/zrpc-todolist/types/errno"
+)
+
+// newBoardTest returns the board and task domains over the same components,
+// with the default columns of the inbox board, one per status, and tasks
+// created in the inbox.
+func newBoardTest(t *testing.T, titles ...string) (Board, Task, map[entity.Status]int64, []int64) {
+	t.Helper()
+
+	ctx := context.Background()
+	c := newTestComponents(t)
+	b, d := NewBoardDomain(c), NewTaskDomain(c)
+
+	board, err := b.GetBoard(ctx, ownerID, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defaults := make(map[entity.Status]int64)
+	for _, column := range board.Columns {
+		defaults[column.Status] = column.ID
+	}
+
+	var ids []int64
+	for _, title := range titles {
+		task, err := d.Create(ctx, &CreateTaskRequest{UserID: ownerID, Title: title})
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, task.ID)
+	}
+
+	return b, d, defaults, ids
+}
+
+// columnTasks returns the IDs of the tasks the inbox board shows in the column.
+func columnTasks(t *testing.T, b Board, columnID int64) []int64 {
+	t.Helper()
+
+	board, err := b.GetBoard(context.Background(), ownerID, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ids []int64
+	for _, column := range board.Columns {
+		if column.ID == columnID {
+			for _, task := range column.Tasks {
+				ids = append(ids, task.ID)
+			}
+		}
+	}
+	return ids
+}
+
+func TestMoveCardWIPLimit(t *testing.T) {
+	ctx := context.Background()
+	b, d, _, ids := newBoardTest(t, "review", "deploy")
+
+	column, err := b.CreateColumn(ctx, &CreateColumnRequest{UserID: ownerID, Name: "Review", Status: entity.InProgressStatus, WIPLimit: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	moved, err := b.MoveCard(ctx, &MoveCardRequest{UserID: ownerID, TaskID: ids[0], ColumnID: column.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if moved.Status != entity.InProgressStatus {
+		t.Errorf("moved task status = %v, want %v", moved.Status, entity.InProgressStatus)
+	}
+
+	// the full column leaves the status of the task alone
+	_, err = b.MoveCard(ctx, &MoveCardRequest{UserID: ownerID, TaskID: ids[1], ColumnID: column.ID})
+	assertCode(t, err, errno.ErrWipLimitReachedCode)
+	task, err := d.GetTask(ctx, ownerID, ids[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if task.Status != entity.ToDoStatus {
+		t.Errorf("refused task status = %v, want %v", task.Status, entity.ToDoStatus)
+	}
+	if got := columnTasks(t, b, column.ID); len(got) != 1 || got[0] != ids[0] {
+		t.Errorf("column tasks = %v, want [%d]", got, ids[0])
+	}
+}
+
+func TestStatusChangeLeavesColumn(t *testing.T) {
+	ctx := context.Background()
+	b, d, defaults, ids := newBoardTest(t, "review", "deploy")
+
+	column, err := b.CreateColumn(ctx, &CreateColumnRequest{UserID: ownerID, Name: "Review", Status: entity.InProgressStatus, WIPLimit: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := b.MoveCard(ctx, &MoveCardRequest{UserID: ownerID, TaskID: ids[0], ColumnID: column.ID}); err != nil {
+		t.Fatal(err)
+	}
+
+	// back in the status of the column, the task shows in the first column
+	for _, status := range []entity.Status{entity.ToDoStatus, entity.InProgressStatus} {
+		if err := d.UpdateTaskStatus(ctx, ownerID, ids[0], status); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := d.UpdateTaskStatus(ctx, ownerID, ids[1], entity.InProgressStatus); err != nil {
+		t.Fatal(err)
+	}
+	if got := columnTasks(t, b, column.ID); len(got) != 0 {
+		t.Errorf("limited column tasks = %v, want none", got)
+	}
+	if got := columnTasks(t, b, defaults[entity.InProgressStatus]); len(got) != 2 {
+		t.Errorf("first column tasks = %v, want both tasks", got)
+	}
+
+	// so the limited column has room again
+	if _, err := b.MoveCard(ctx, &MoveCardRequest{UserID: ownerID, TaskID: ids[1], ColumnID: column.ID}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDefaultColumnWIPLimit(t *testing.T) {
+	ctx := context.Background()
+	b, _, defaults, _ := newBoardTest(t)
+
+	review, err := b.CreateColumn(ctx, &CreateColumnRequest{UserID: ownerID, Name: "Review", Status: entity.InProgressStatus, WIPLimit: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	inProgress := defaults[entity.InProgressStatus]
+
+	tests := []struct {
+		name string
+		call func() error
+	}{
+		{"UpdateColumn", func() error {
+			_, err := b.UpdateColumn(ctx, &UpdateColumnRequest{UserID: ownerID, ColumnID: inProgress, WIPLimit: ptr.Of(int32(3))})
+			return err
+		}},
+		{"ReorderColumns", func() error {
+			return b.ReorderColumns(ctx, ownerID, 0, []int64{review.ID, inProgress})
+		}},
+		{"DeleteColumn", func() error {
+			return b.DeleteColumn(ctx, ownerID, inProgress)
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertCode(t, tt.call(), errno.ErrTaskInvalidParamCode)
+		})
+	}
+
+	board, err := b.GetBoard(ctx, ownerID, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(board.Columns) != 4 || board.Columns[1].ID != inProgress || board.Columns[1].WIPLimit != 0 {
+		t.Errorf("board columns changed: %+v", board.Columns)
+	}
+
+	// a column without a limit may come first
+	if _, err := b.UpdateColumn(ctx, &UpdateColumnRequest{UserID: ownerID, ColumnID: review.ID, WIPLimit: ptr.Of(int32(0))}); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.ReorderColumns(ctx, ownerID, 0, []int64{review.ID, inProgress}); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,213 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/entity"
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/internal/dal/model"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/errorx"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/ical"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/lang/slice"
+	"github.com/crazyfrankie/zrpc-todolist/types/errno"
+)
+
+const (
+	calendarTokenBytes = 32
+	calendarName       = "Tasks"
+	// calendarRefresh asks calendar apps to poll the feed hourly.
+	calendarRefresh = "PT1H"
+	// The feed spans the tasks due from calendarPast ago to calendarAhead from
+	// now, which keeps it small for apps fetching it over and over.
+	calendarPast     = 90 * 24 * time.Hour
+	calendarAhead    = 2 * 365 * 24 * time.Hour
+	maxCalendarTasks = 2000
+)
+
+// calendarStatuses leaves out the archived tasks, trashed ones are deleted.
+var calendarStatuses = []entity.Status{entity.ToDoStatus, entity.InProgressStatus, entity.DoneStatus}
+
+func (t *taskImpl) CreateCalendarFeed(ctx context.Context, userID int64) (string, error) {
+	token, err := generateCalendarToken()
+	if err != nil {
+		return "", err
+	}
+
+	created, err := t.CalendarRepo.CreateFeed(ctx, &model.CalendarFeed{
+		UserID:    userID,
+		TokenHash: hashCalendarToken(token),
+	})
+	if err != nil {
+		return "", err
+	}
+	if !created {
+		return "", errorx.New(errno.ErrCalendarFeedExistsCode)
+	}
+
+	return token, nil
+}
+
+func (t *taskImpl) RotateCalendarFeed(ctx context.Context, userID int64) (string, error) {
+	token, err := generateCalendarToken()
+	if err != nil {
+		return "", err
+	}
+
+	ok, err := t.CalendarRepo.UpdateFeedToken(ctx, userID, hashCalendarToken(token))
+	if err != nil {
+		return "", err
+	}
+	if !ok {
+		return "", errorx.New(errno.ErrCalendarFeedNotFoundCode)
+	}
+
+	return token, nil
+}
+
+func (t *taskImpl) RevokeCalendarFeed(ctx context.Context, userID int64) error {
+	ok, err := t.CalendarRepo.DeleteFeed(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errorx.New(errno.ErrCalendarFeedNotFoundCode)
+	}
+
+	return nil
+}
+
+func (t *taskImpl) GetCalendarFeed(ctx context.Context, token string) (*entity.CalendarFeed, error) {
+	if token == "" {
+		return nil, errorx.New(errno.ErrCalendarFeedNotFoundCode)
+	}
+	feed, ok, err := t.CalendarRepo.GetFeedByTokenHash(ctx, hashCalendarToken(token))
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, errorx.New(errno.ErrCalendarFeedNotFoundCode)
+	}
+
+	now := time.Now()
+	taskModels, err := t.TaskRepo.ListTasksByDueRange(ctx, feed.UserID, statusesToInt32(calendarStatuses),
+		now.Add(-calendarPast).UnixMilli(), now.Add(calendarAhead).UnixMilli(), maxCalendarTasks)
+	if err != nil {
+		return nil, err
+	}
+	tags, err := t.taskTagNames(ctx, feed.UserID, taskModels)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	w := ical.NewWriter(&buf, icalProdID)
+	w.SetProperty("X-WR-CALNAME", calendarName)
+	w.SetProperty("REFRESH-INTERVAL;VALUE=DURATION", calendarRefresh)
+	w.SetProperty("X-PUBLISHED-TTL", calendarRefresh)
+	for _, taskModel := range taskModels {
+		if err := writeCalendarTask(w, taskModel, tags[taskModel.ID]); err != nil {
+			return nil, err
+		}
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	// the components are stamped with the last change of their task, so the
+	// content only changes along with the tasks
+	sum := sha256.Sum256(buf.Bytes())
+	return &entity.CalendarFeed{
+		Content: buf.Bytes(),
+		ETag:    `"` + hex.EncodeToString(sum[:16]) + `"`,
+	}, nil
+}
+
+// taskTagNames returns the names of the tags of each task.
+func (t *taskImpl) taskTagNames(ctx context.Context, userID int64, taskModels []*model.Task) (map[int64][]string, error) {
+	if len(taskModels) == 0 {
+		return nil, nil
+	}
+	tagModels, err := t.TagRepo.ListTags(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	tagNames := slice.ToMap(tagModels, func(tagModel *model.Tag) (int64, string) {
+		return tagModel.ID, tagModel.Name
+	})
+	taskTags, err := t.TagRepo.ListTaskTags(ctx, slice.Transform(taskModels, func(taskModel *model.Task) int64 {
+		return taskModel.ID
+	}))
+	if err != nil {
+		return nil, err
+	}
+
+	tags := make(map[int64][]string)
+	for _, taskTag := range taskTags {
+		if name, ok := tagNames[taskTag.TagID]; ok {
+			tags[taskTag.TaskID] = append(tags[taskTag.TaskID], name)
+		}
+	}
+	return tags, nil
+}
+
+// writeCalendarTask writes the task as a VTODO, and as a VEVENT at its due time
+// for the calendar apps which don't show todos. Occurrences of a series are
+// tasks of their own, so no RRULE is written.
+func writeCalendarTask(w *ical.Writer, taskModel *model.Task, tags []string) error {
+	due := time.UnixMilli(*taskModel.DueAt)
+	var alarm time.Time
+	if taskModel.RemindAt != nil {
+		alarm = time.UnixMilli(*taskModel.RemindAt)
+	}
+	created, modified := time.UnixMilli(taskModel.CreatedAt), time.UnixMilli(taskModel.UpdatedAt)
+
+	err := w.Write(&ical.Todo{
+		UID:          fmt.Sprintf("task-%d", taskModel.ID),
+		Summary:      taskModel.Title,
+		Description:  taskModel.Content,
+		Status:       icalStatus(entity.Status(taskModel.Status)),
+		Priority:     icalPriority(entity.Priority(taskModel.Priority)),
+		Due:          due,
+		TimeZone:     taskModel.TimeZone,
+		Alarm:        alarm,
+		Categories:   tags,
+		Created:      created,
+		LastModified: modified,
+	})
+	if err != nil {
+		return err
+	}
+
+	return w.WriteEvent(&ical.Event{
+		UID:          fmt.Sprintf("task-%d-due", taskModel.ID),
+		Summary:      taskModel.Title,
+		Description:  taskModel.Content,
+		Start:        due,
+		TimeZone:     taskModel.TimeZone,
+		Alarm:        alarm,
+		Categories:   tags,
+		Created:      created,
+		LastModified: modified,
+	})
+}
+
+// generateCalendarToken returns a URL safe random token, only its hash is stored.
+func generateCalendarToken() (string, error) {
+	b := make([]byte, calendarTokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func hashCalendarToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
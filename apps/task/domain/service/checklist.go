@@ -0,0 +1,159 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/entity"
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/internal/dal"
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/internal/dal/model"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/errorx"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/lang/conv"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/lang/ptr"
+	"github.com/crazyfrankie/zrpc-todolist/types/errno"
+)
+
+const (
+	maxChecklistItems         = 100
+	maxChecklistContentLength = 255
+)
+
+func (t *taskImpl) AddChecklistItem(ctx context.Context, userID, taskID int64, content string) (*entity.ChecklistItem, error) {
+	ctx = dal.WithEventType(ctx, entity.TaskUpdated.Int32())
+
+	content, err := normalizeChecklistContent(content)
+	if err != nil {
+		return nil, err
+	}
+
+	taskModel, err := t.getOwnedTask(ctx, userID, taskID)
+	if err != nil {
+		return nil, err
+	}
+	if taskModel.DeletedAt.Valid {
+		return nil, errorx.New(errno.ErrTaskNotFoundCode, errorx.KV("task_id", conv.Int64ToStr(taskID)))
+	}
+
+	items, err := t.ChecklistRepo.ListItems(ctx, []int64{taskID})
+	if err != nil {
+		return nil, err
+	}
+	if len(items) >= maxChecklistItems {
+		return nil, errorx.New(errno.ErrTaskInvalidParamCode, errorx.KV("msg", "too many checklist items"))
+	}
+	position, err := t.ChecklistRepo.MaxPosition(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UnixMilli()
+	newItem := &model.ChecklistItem{
+		TaskID:    taskID,
+		Content:   content,
+		Position:  position + 1,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := t.ChecklistRepo.Create(ctx, newItem); err != nil {
+		return nil, err
+	}
+
+	return checklistItemPO2DO(newItem), nil
+}
+
+func (t *taskImpl) UpdateChecklistItem(ctx context.Context, req *UpdateChecklistItemRequest) (*entity.ChecklistItem, error) {
+	ctx = dal.WithEventType(ctx, entity.TaskUpdated.Int32())
+
+	item, err := t.getOwnedChecklistItem(ctx, req.UserID, req.ItemID)
+	if err != nil {
+		return nil, err
+	}
+
+	updates := map[string]any{
+		"updated_at": time.Now().UnixMilli(),
+	}
+	if req.Content != nil {
+		content, err := normalizeChecklistContent(ptr.From(req.Content))
+		if err != nil {
+			return nil, err
+		}
+		updates["content"] = content
+		item.Content = content
+	}
+	if req.Checked != nil {
+		updates["checked"] = ptr.From(req.Checked)
+		item.Checked = ptr.From(req.Checked)
+	}
+
+	ok, err := t.ChecklistRepo.UpdateItem(ctx, item.TaskID, item.ID, updates)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, errorx.New(errno.ErrChecklistItemNotFoundCode, errorx.KV("item_id", conv.Int64ToStr(req.ItemID)))
+	}
+	item.UpdatedAt = updates["updated_at"].(int64)
+
+	if ptr.From(req.Checked) {
+		t.autoComplete(ctx, req.UserID, item.TaskID)
+	}
+
+	return checklistItemPO2DO(item), nil
+}
+
+func (t *taskImpl) DeleteChecklistItem(ctx context.Context, userID, itemID int64) error {
+	ctx = dal.WithEventType(ctx, entity.TaskUpdated.Int32())
+
+	item, err := t.getOwnedChecklistItem(ctx, userID, itemID)
+	if err != nil {
+		return err
+	}
+
+	ok, err := t.ChecklistRepo.DeleteItem(ctx, item.TaskID, item.ID)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errorx.New(errno.ErrChecklistItemNotFoundCode, errorx.KV("item_id", conv.Int64ToStr(itemID)))
+	}
+
+	// the remaining items may all be checked now
+	t.autoComplete(ctx, userID, item.TaskID)
+
+	return nil
+}
+
+// getOwnedChecklistItem loads the checklist item of a live task owned by userID.
+func (t *taskImpl) getOwnedChecklistItem(ctx context.Context, userID, itemID int64) (*model.ChecklistItem, error) {
+	item, exist, err := t.ChecklistRepo.GetItemByID(ctx, itemID)
+	if err != nil {
+		return nil, err
+	}
+	if !exist {
+		return nil, errorx.New(errno.ErrChecklistItemNotFoundCode, errorx.KV("item_id", conv.Int64ToStr(itemID)))
+	}
+
+	taskModel, exist, err := t.TaskRepo.GetTaskByID(ctx, item.TaskID)
+	if err != nil {
+		return nil, err
+	}
+	if !exist || taskModel.UserID != userID || taskModel.DeletedAt.Valid {
+		return nil, errorx.New(errno.ErrChecklistItemNotFoundCode, errorx.KV("item_id", conv.Int64ToStr(itemID)))
+	}
+
+	return item, nil
+}
+
+func normalizeChecklistContent(content string) (string, error) {
+	content = strings.TrimSpace(content)
+	if content == "" {
+		return "", errorx.New(errno.ErrTaskInvalidParamCode, errorx.KV("msg", "checklist item is empty"))
+	}
+	if utf8.RuneCountInString(content) > maxChecklistContentLength {
+		return "", errorx.New(errno.ErrTaskInvalidParamCode, errorx.KV("msg", "checklist item is too long"))
+	}
+
+	return content, nil
+}
@@ -0,0 +1,195 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/entity"
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/internal/dal"
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/internal/dal/model"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/errorx"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/lang/conv"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/lang/slice"
+	"github.com/crazyfrankie/zrpc-todolist/types/errno"
+)
+
+const maxCommentLength = 2000
+
+func (t *taskImpl) AddComment(ctx context.Context, userID, taskID int64, content string) (*entity.Comment, error) {
+	content, err := normalizeCommentContent(content)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := t.getLiveTask(ctx, userID, taskID); err != nil {
+		return nil, err
+	}
+
+	id, err := t.IDGen.GenID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now().UnixMilli()
+	comment := &model.TaskComment{
+		ID:        id,
+		TaskID:    taskID,
+		UserID:    userID,
+		Content:   content,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := t.CommentRepo.Create(ctx, comment); err != nil {
+		return nil, err
+	}
+
+	return commentPO2DO(comment), nil
+}
+
+func (t *taskImpl) UpdateComment(ctx context.Context, userID, commentID int64, content string) (*entity.Comment, error) {
+	content, err := normalizeCommentContent(content)
+	if err != nil {
+		return nil, err
+	}
+	comment, err := t.getOwnedComment(ctx, userID, commentID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UnixMilli()
+	updated, err := t.CommentRepo.UpdateComment(ctx, userID, commentID, map[string]any{
+		"content":    content,
+		"updated_at": now,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !updated {
+		return nil, errorx.New(errno.ErrCommentNotFoundCode, errorx.KV("comment_id", conv.Int64ToStr(commentID)))
+	}
+	comment.Content = content
+	comment.UpdatedAt = now
+
+	return commentPO2DO(comment), nil
+}
+
+func (t *taskImpl) DeleteComment(ctx context.Context, userID, commentID int64) error {
+	if _, err := t.getOwnedComment(ctx, userID, commentID); err != nil {
+		return err
+	}
+
+	deleted, err := t.CommentRepo.DeleteComment(ctx, userID, commentID)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return errorx.New(errno.ErrCommentNotFoundCode, errorx.KV("comment_id", conv.Int64ToStr(commentID)))
+	}
+
+	return nil
+}
+
+func (t *taskImpl) ListComments(ctx context.Context, req *ListCommentsRequest) (*ListCommentsResponse, error) {
+	if _, err := t.getOwnedTask(ctx, req.UserID, req.TaskID); err != nil {
+		return nil, err
+	}
+
+	var afterID int64
+	if req.Cursor != "" {
+		id, err := conv.StrToInt64(req.Cursor)
+		if err != nil || id <= 0 {
+			return nil, errorx.New(errno.ErrTaskInvalidParamCode, errorx.KV("msg", "invalid cursor"))
+		}
+		afterID = id
+	}
+	pageSize := req.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+
+	comments, err := t.CommentRepo.ListComments(ctx, req.TaskID, afterID, pageSize+1)
+	if err != nil {
+		return nil, err
+	}
+	hasMore := len(comments) > pageSize
+	if hasMore {
+		comments = comments[:pageSize]
+	}
+
+	resp := &ListCommentsResponse{
+		Comments: slice.Transform(comments, commentPO2DO),
+		HasMore:  hasMore,
+	}
+	if hasMore {
+		resp.NextCursor = conv.Int64ToStr(comments[len(comments)-1].ID)
+	}
+
+	return resp, nil
+}
+
+// fillCommentCounts loads the number of comments on each of the tasks.
+func (t *taskImpl) fillCommentCounts(ctx context.Context, tasks []*entity.Task) error {
+	counts, err := t.CommentRepo.CountComments(ctx, slice.Transform(tasks, func(task *entity.Task) int64 {
+		return task.ID
+	}))
+	if err != nil {
+		return err
+	}
+
+	byTask := slice.ToMap(counts, func(c *dal.CommentCount) (int64, int64) {
+		return c.TaskID, c.Count
+	})
+	for _, task := range tasks {
+		task.CommentCount = byTask[task.ID]
+	}
+
+	return nil
+}
+
+// getOwnedComment returns the comment if it is on a live task of the user,
+// comments follow the access rules of their task.
+func (t *taskImpl) getOwnedComment(ctx context.Context, userID, commentID int64) (*model.TaskComment, error) {
+	comment, exist, err := t.CommentRepo.GetCommentByID(ctx, commentID)
+	if err != nil {
+		return nil, err
+	}
+	if !exist || comment.UserID != userID {
+		return nil, errorx.New(errno.ErrCommentNotFoundCode, errorx.KV("comment_id", conv.Int64ToStr(commentID)))
+	}
+
+	taskModel, exist, err := t.TaskRepo.GetTaskByID(ctx, comment.TaskID)
+	if err != nil {
+		return nil, err
+	}
+	if !exist || taskModel.UserID != userID || taskModel.DeletedAt.Valid {
+		return nil, errorx.New(errno.ErrCommentNotFoundCode, errorx.KV("comment_id", conv.Int64ToStr(commentID)))
+	}
+
+	return comment, nil
+}
+
+func normalizeCommentContent(content string) (string, error) {
+	content = strings.TrimSpace(content)
+	if content == "" {
+		return "", errorx.New(errno.ErrTaskInvalidParamCode, errorx.KV("msg", "comment is empty"))
+	}
+	if utf8.RuneCountInString(content) > maxCommentLength {
+		return "", errorx.New(errno.ErrTaskInvalidParamCode, errorx.KV("msg", "comment is too long"))
+	}
+
+	return content, nil
+}
+
+func commentPO2DO(comment *model.TaskComment) *entity.Comment {
+	return &entity.Comment{
+		ID:        comment.ID,
+		TaskID:    comment.TaskID,
+		UserID:    comment.UserID,
+		Content:   comment.Content,
+		CreatedAt: comment.CreatedAt,
+		UpdatedAt: comment.UpdatedAt,
+	}
+}
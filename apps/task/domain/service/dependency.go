@@ -0,0 +1,118 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/entity"
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/internal/dal"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/errorx"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/lang/conv"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/lang/slice"
+	"github.com/crazyfrankie/zrpc-todolist/types/errno"
+)
+
+const maxTaskBlockers = 50
+
+func (t *taskImpl) AddDependency(ctx context.Context, userID, taskID, blockerID int64) error {
+	ctx = dal.WithEventType(ctx, entity.TaskUpdated.Int32())
+
+	if taskID == blockerID {
+		return errorx.New(errno.ErrTaskInvalidParamCode, errorx.KV("msg", "a task can't block itself"))
+	}
+	for _, id := range []int64{taskID, blockerID} {
+		taskModel, err := t.getOwnedTask(ctx, userID, id)
+		if err != nil {
+			return err
+		}
+		if taskModel.DeletedAt.Valid {
+			return errorx.New(errno.ErrTaskNotFoundCode, errorx.KV("task_id", conv.Int64ToStr(id)))
+		}
+	}
+
+	count, err := t.DependencyRepo.CountBlockers(ctx, taskID)
+	if err != nil {
+		return err
+	}
+	if count >= maxTaskBlockers {
+		return errorx.New(errno.ErrTaskInvalidParamCode, errorx.KV("msg", "too many blockers"))
+	}
+
+	ok, err := t.DependencyRepo.AddDependency(ctx, userID, taskID, blockerID)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errorx.New(errno.ErrDependencyCycleCode,
+			errorx.KV("task_id", conv.Int64ToStr(taskID)), errorx.KV("blocker_id", conv.Int64ToStr(blockerID)))
+	}
+
+	return nil
+}
+
+func (t *taskImpl) RemoveDependency(ctx context.Context, userID, taskID, blockerID int64) error {
+	ctx = dal.WithEventType(ctx, entity.TaskUpdated.Int32())
+
+	if _, err := t.getOwnedTask(ctx, userID, taskID); err != nil {
+		return err
+	}
+
+	return t.DependencyRepo.RemoveDependency(ctx, taskID, blockerID)
+}
+
+// checkBlockers refuses to finish a task while any of its blockers is open.
+func (t *taskImpl) checkBlockers(ctx context.Context, taskID int64) error {
+	blockers, err := t.openBlockers(ctx, taskID)
+	if err != nil {
+		return err
+	}
+	if len(blockers) > 0 {
+		return blockedError(taskID, blockers)
+	}
+
+	return nil
+}
+
+func blockedError(taskID int64, blockerIDs []int64) error {
+	return errorx.New(errno.ErrTaskBlockedCode, errorx.KV("task_id", conv.Int64ToStr(taskID)),
+		errorx.KV("blocker_ids", strings.Join(slice.Transform(blockerIDs, conv.Int64ToStr), ",")))
+}
+
+// openBlockers returns the IDs of the open tasks blocking the task.
+func (t *taskImpl) openBlockers(ctx context.Context, taskID int64) ([]int64, error) {
+	blockers, err := t.DependencyRepo.ListBlockers(ctx, []int64{taskID})
+	if err != nil {
+		return nil, err
+	}
+
+	var open []int64
+	for _, b := range blockers {
+		if entity.Status(b.Status).IsOpen() {
+			open = append(open, b.BlockerID)
+		}
+	}
+
+	return open, nil
+}
+
+func (t *taskImpl) fillBlockers(ctx context.Context, tasks []*entity.Task) error {
+	blockers, err := t.DependencyRepo.ListBlockers(ctx, slice.Transform(tasks, func(task *entity.Task) int64 {
+		return task.ID
+	}))
+	if err != nil {
+		return err
+	}
+
+	byTask := slice.ToMap(tasks, func(task *entity.Task) (int64, *entity.Task) {
+		return task.ID, task
+	})
+	for _, b := range blockers {
+		task := byTask[b.TaskID]
+		task.BlockerIDs = append(task.BlockerIDs, b.BlockerID)
+		if entity.Status(b.Status).IsOpen() {
+			task.Blocked = true
+		}
+	}
+
+	return nil
+}
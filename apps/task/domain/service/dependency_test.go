@@ -0,0 +1,57 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/crazyfrankie/zrpc-todolist/types/errno"
+)
+
+func TestAddDependencyCycle(t *testing.T) {
+	// edges are [task, blocker] pairs between the tasks a, b and c
+	type edge [2]int
+	const a, b, c = 0, 1, 2
+
+	tests := []struct {
+		name  string
+		edges []edge
+		add   edge
+		code  int32
+	}{
+		{name: "self", add: edge{a, a}, code: errno.ErrTaskInvalidParamCode},
+		{name: "direct", edges: []edge{{a, b}}, add: edge{b, a}, code: errno.ErrDependencyCycleCode},
+		{name: "transitive", edges: []edge{{a, b}, {b, c}}, add: edge{c, a}, code: errno.ErrDependencyCycleCode},
+		{name: "chain", edges: []edge{{a, b}}, add: edge{b, c}},
+		{name: "shared blocker", edges: []edge{{a, c}}, add: edge{b, c}},
+		{name: "again", edges: []edge{{a, b}}, add: edge{a, b}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			d := NewTaskDomain(newTestComponents(t))
+
+			var ids []int64
+			for _, title := range []string{"a", "b", "c"} {
+				task, err := d.Create(ctx, &CreateTaskRequest{UserID: ownerID, Title: title})
+				if err != nil {
+					t.Fatal(err)
+				}
+				ids = append(ids, task.ID)
+			}
+			for _, e := range tt.edges {
+				if err := d.AddDependency(ctx, ownerID, ids[e[0]], ids[e[1]]); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			err := d.AddDependency(ctx, ownerID, ids[tt.add[0]], ids[tt.add[1]])
+			if tt.code == 0 {
+				if err != nil {
+					t.Fatalf("AddDependency() = %v", err)
+				}
+				return
+			}
+			assertCode(t, err, tt.code)
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/entity"
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/internal/dal"
+	"github.com/crazyfrankie/zrpc-todolist/infra/contract/eventbus"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/lang/conv"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/logs"
+	"github.com/crazyfrankie/zrpc-todolist/types/consts"
+)
+
+// taskEventMessage is the payload of a task event on the channel of its owner.
+type taskEventMessage struct {
+	ID     int64  `json:"id"`
+	Type   string `json:"type"`
+	TaskID int64  `json:"task_id"`
+}
+
+func (t *taskImpl) BroadcastTaskEvent(ctx context.Context, event *eventbus.Event) error {
+	var payload dal.TaskEvent
+	if err := json.Unmarshal(event.Payload, &payload); err != nil {
+		// a malformed event won't get better on retry
+		logs.CtxWarnf(ctx, "decode task event failed, id=%s, err=%v", event.ID, err)
+		return nil
+	}
+
+	msg, err := json.Marshal(&taskEventMessage{
+		ID:     payload.ID,
+		Type:   entity.TaskEventType(payload.Type).String(),
+		TaskID: payload.TaskID,
+	})
+	if err != nil {
+		return err
+	}
+
+	return t.PubSub.Publish(ctx, consts.TaskEventChannelPrefix+conv.Int64ToStr(payload.UserID), msg)
+}
+
+func (t *taskImpl) ListTaskEvents(ctx context.Context, req *ListTaskEventsRequest) (*ListTaskEventsResponse, error) {
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultSyncPageSize
+	}
+	if limit > maxSyncPageSize {
+		limit = maxSyncPageSize
+	}
+
+	changes, err := t.ChangeRepo.ListChanges(ctx, req.UserID, req.AfterID, limit+1)
+	if err != nil {
+		return nil, err
+	}
+	hasMore := len(changes) > limit
+	if hasMore {
+		changes = changes[:limit]
+	}
+
+	// only the last change of a task within the page is replayed
+	last := make(map[int64]int, len(changes))
+	for i, change := range changes {
+		last[change.TaskID] = i
+	}
+	events := make([]*entity.TaskEvent, 0, len(last))
+	for i, change := range changes {
+		if last[change.TaskID] != i {
+			continue
+		}
+		event := &entity.TaskEvent{ID: change.Seq, Type: entity.TaskUpdated, TaskID: change.TaskID}
+		if change.Deleted {
+			event.Type = entity.TaskDeleted
+		}
+		events = append(events, event)
+	}
+
+	return &ListTaskEventsResponse{Events: events, HasMore: hasMore}, nil
+}
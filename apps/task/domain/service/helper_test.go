@@ -0,0 +1,102 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/internal/dal/model"
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/repository"
+	"github.com/crazyfrankie/zrpc-todolist/infra/impl/search/memory"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/errorx"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/outbox"
+)
+
+// newTestDB opens a private in-memory SQLite database with the task tables.
+func newTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{
+		Logger: logger.Discard,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// every connection to :memory: opens a database of its own
+	sqlDB.SetMaxOpenConns(1)
+	t.Cleanup(func() { _ = sqlDB.Close() })
+
+	err = db.AutoMigrate(
+		&model.BoardColumn{}, &model.CalendarFeed{}, &model.ChecklistItem{}, &model.Project{},
+		&model.Tag{}, &model.Task{}, &model.TaskActivity{}, &model.TaskAttachment{},
+		&model.TaskChange{}, &model.TaskChangeSeq{}, &model.TaskComment{}, &model.TaskDependency{},
+		&model.TaskTag{}, &model.Webhook{}, &model.WebhookDelivery{},
+		&outbox.Message{}, &outbox.Consumption{},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return db
+}
+
+// newTestComponents returns the components of the task domain backed by a
+// fresh database and an in-memory search index.
+func newTestComponents(t *testing.T) *Components {
+	t.Helper()
+
+	db := newTestDB(t)
+	return &Components{
+		TaskRepo:       repository.NewTaskRepository(db),
+		TagRepo:        repository.NewTagRepository(db),
+		ProjectRepo:    repository.NewProjectRepository(db),
+		ChecklistRepo:  repository.NewChecklistRepository(db),
+		DependencyRepo: repository.NewDependencyRepository(db),
+		BoardRepo:      repository.NewBoardRepository(db),
+		ActivityRepo:   repository.NewActivityRepository(db),
+		CommentRepo:    repository.NewCommentRepository(db),
+		AttachmentRepo: repository.NewAttachmentRepository(db),
+		ChangeRepo:     repository.NewChangeRepository(db),
+		WebhookRepo:    repository.NewWebhookRepository(db),
+		CalendarRepo:   repository.NewCalendarRepository(db),
+		IDGen:          &seqIDGen{},
+		Searcher:       memory.New(),
+	}
+}
+
+type seqIDGen struct {
+	last atomic.Int64
+}
+
+func (g *seqIDGen) GenID(ctx context.Context) (int64, error) {
+	return g.last.Add(1), nil
+}
+
+func (g *seqIDGen) GenMultiIDs(ctx context.Context, counts int) ([]int64, error) {
+	ids := make([]int64, counts)
+	for i := range ids {
+		ids[i] = g.last.Add(1)
+	}
+	return ids, nil
+}
+
+func assertCode(t *testing.T, err error, code int32) {
+	t.Helper()
+
+	var statusErr errorx.StatusError
+	if !errors.As(err, &statusErr) {
+		t.Fatalf("err = %v, want code %d", err, code)
+	}
+	if statusErr.Code() != code {
+		t.Fatalf("err code = %d, want %d: %v", statusErr.Code(), code, err)
+	}
+}
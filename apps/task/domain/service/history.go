@@ -0,0 +1,240 @@
+package service
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/entity"
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/internal/dal"
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/internal/dal/model"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/errorx"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/lang/conv"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/lang/ptr"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/lang/slice"
+	"github.com/crazyfrankie/zrpc-todolist/types/errno"
+)
+
+func (t *taskImpl) GetTaskHistory(ctx context.Context, req *TaskHistoryRequest) (*TaskHistoryResponse, error) {
+	if _, err := t.getOwnedTask(ctx, req.UserID, req.TaskID); err != nil {
+		return nil, err
+	}
+
+	var beforeID int64
+	if req.Cursor != "" {
+		id, err := conv.StrToInt64(req.Cursor)
+		if err != nil || id <= 0 {
+			return nil, errorx.New(errno.ErrTaskInvalidParamCode, errorx.KV("msg", "invalid cursor"))
+		}
+		beforeID = id
+	}
+	pageSize := req.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+
+	activities, err := t.ActivityRepo.ListActivities(ctx, req.TaskID, beforeID, pageSize+1)
+	if err != nil {
+		return nil, err
+	}
+	hasMore := len(activities) > pageSize
+	if hasMore {
+		activities = activities[:pageSize]
+	}
+
+	resp := &TaskHistoryResponse{
+		Activities: slice.Transform(activities, activityPO2DO),
+		HasMore:    hasMore,
+	}
+	if hasMore {
+		resp.NextCursor = conv.Int64ToStr(activities[len(activities)-1].ID)
+	}
+
+	return resp, nil
+}
+
+func (t *taskImpl) RevertTask(ctx context.Context, userID, taskID, version int64) (*entity.Task, error) {
+	ctx = dal.WithEventType(ctx, entity.TaskUpdated.Int32())
+
+	taskModel, err := t.getLiveTask(ctx, userID, taskID)
+	if err != nil {
+		return nil, err
+	}
+	if version == taskModel.Version {
+		return t.GetTask(ctx, userID, taskID)
+	}
+	exist := false
+	if version >= 0 && version < taskModel.Version {
+		exist, err = t.ActivityRepo.HasRevision(ctx, taskID, version)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if !exist {
+		return nil, errorx.New(errno.ErrTaskRevisionNotFoundCode,
+			errorx.KV("task_id", conv.Int64ToStr(taskID)), errorx.KV("version", conv.Int64ToStr(version)))
+	}
+
+	// undo the later changes newest first, leaving the values of the revision
+	activities, err := t.ActivityRepo.ListActivitiesAfter(ctx, taskID, version)
+	if err != nil {
+		return nil, err
+	}
+	revision := make(map[string]*string)
+	for _, activity := range activities {
+		for _, change := range activity.Fields {
+			revision[change.Field] = change.Old
+		}
+	}
+
+	current := dal.TrackedValues(taskModel)
+	req := &UpdateTaskRequest{
+		UserID:  userID,
+		TaskID:  taskID,
+		Version: &taskModel.Version,
+	}
+	var status *entity.Status
+	changed := false
+	for field, value := range revision {
+		if sameValue(value, current[field]) {
+			continue
+		}
+		if err := revertField(req, &status, field, value); err != nil {
+			return nil, err
+		}
+		changed = true
+	}
+	if !changed {
+		return t.GetTask(ctx, userID, taskID)
+	}
+	// validated up front, the fields and the status are written together
+	if status != nil {
+		if err := t.checkRevertStatus(ctx, taskModel, *status); err != nil {
+			return nil, err
+		}
+	}
+
+	updates, oldParentID, err := t.taskUpdates(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if status != nil {
+		updates["status"] = status.Int32()
+	}
+	if err := t.updateTask(ctx, req, updates, nil); err != nil {
+		return nil, err
+	}
+
+	if oldParentID != 0 && oldParentID != ptr.From(req.ParentID) {
+		t.autoComplete(ctx, userID, oldParentID)
+	}
+	if ptr.From(req.AutoComplete) {
+		t.autoComplete(ctx, userID, taskID)
+	}
+	if status != nil && *status == entity.DoneStatus {
+		parentID := taskModel.ParentID
+		if req.ParentID != nil {
+			parentID = *req.ParentID
+		}
+		t.autoComplete(ctx, userID, parentID)
+	}
+
+	return t.GetTask(ctx, userID, taskID)
+}
+
+// checkRevertStatus checks that the live task may move back to status. Reverting
+// never trashes a task, and a recurring task finished again keeps the occurrence
+// created when it was first finished.
+func (t *taskImpl) checkRevertStatus(ctx context.Context, taskModel *model.Task, status entity.Status) error {
+	from := entity.Status(taskModel.Status)
+	if status == entity.TrashedStatus || !from.CanTransitTo(status) {
+		return errorx.New(errno.ErrTaskInvalidStatusTransitionCode,
+			errorx.KV("from", from.String()), errorx.KV("to", status.String()))
+	}
+	if status == entity.DoneStatus {
+		return t.checkBlockers(ctx, taskModel.ID)
+	}
+
+	return nil
+}
+
+// revertField sets the field of the revision on the update, the recurrence of a
+// task and its reminder state are not reverted.
+func revertField(req *UpdateTaskRequest, status **entity.Status, field string, value *string) error {
+	text := ptr.From(value)
+	var num int64
+	switch field {
+	case "status", "priority", "due_at", "remind_at", "project_id", "parent_id":
+		if value != nil {
+			var err error
+			if num, err = conv.StrToInt64(text); err != nil {
+				return err
+			}
+		}
+	}
+
+	switch field {
+	case "title":
+		req.Title = &text
+	case "content":
+		req.Content = &text
+	case "status":
+		s := entity.Status(num)
+		*status = &s
+	case "priority":
+		p := entity.Priority(num)
+		req.Priority = &p
+	case "due_at":
+		if value == nil {
+			req.ClearDueAt = true
+		} else {
+			req.DueAt = &num
+		}
+	case "remind_at":
+		if value == nil {
+			req.ClearRemindAt = true
+		} else {
+			req.RemindAt = &num
+		}
+	case "project_id":
+		req.ProjectID = &num
+	case "parent_id":
+		req.ParentID = &num
+	case "auto_complete":
+		autoComplete, err := strconv.ParseBool(text)
+		if err != nil {
+			return err
+		}
+		req.AutoComplete = &autoComplete
+	}
+
+	return nil
+}
+
+func sameValue(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+func activityPO2DO(activity *dal.Activity) *entity.TaskActivity {
+	return &entity.TaskActivity{
+		ID:      activity.ID,
+		TaskID:  activity.TaskID,
+		UserID:  activity.UserID,
+		Action:  entity.ActivityAction(activity.Action),
+		Source:  entity.ActivitySource(activity.Source),
+		Version: activity.Version,
+		Changes: slice.Transform(activity.Fields, func(change *dal.FieldChange) *entity.FieldChange {
+			return &entity.FieldChange{
+				Field: change.Field,
+				Old:   change.Old,
+				New:   change.New,
+			}
+		}),
+		CreatedAt: activity.CreatedAt,
+	}
+}
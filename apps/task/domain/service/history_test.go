@@ -0,0 +1,193 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/entity"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/lang/ptr"
+	"github.com/crazyfrankie/zrpc-todolist/types/errno"
+)
+
+// historyOf returns the activities of the task, newest first.
+func historyOf(t *testing.T, d Task, taskID int64) []*entity.TaskActivity {
+	t.Helper()
+
+	resp, err := d.GetTaskHistory(context.Background(), &TaskHistoryRequest{UserID: ownerID, TaskID: taskID, PageSize: maxPageSize})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return resp.Activities
+}
+
+func TestRevertTask(t *testing.T) {
+	ctx := context.Background()
+	d := NewTaskDomain(newTestComponents(t))
+
+	task, err := d.Create(ctx, &CreateTaskRequest{UserID: ownerID, Title: "draft", Content: "first"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	created := task.Version
+	if err := d.UpdateTask(ctx, &UpdateTaskRequest{UserID: ownerID, TaskID: task.ID, Title: ptr.Of("final")}); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.UpdateTaskStatus(ctx, ownerID, task.ID, entity.DoneStatus); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.UpdateTask(ctx, &UpdateTaskRequest{UserID: ownerID, TaskID: task.ID, Content: ptr.Of("second")}); err != nil {
+		t.Fatal(err)
+	}
+	before := historyOf(t, d, task.ID)
+
+	got, err := d.RevertTask(ctx, ownerID, task.ID, created)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Title != "draft" || got.Content != "first" || got.Status != entity.ToDoStatus {
+		t.Errorf("reverted task = %q %q %v, want %q %q %v", got.Title, got.Content, got.Status, "draft", "first", entity.ToDoStatus)
+	}
+
+	// the fields and the status are one write
+	after := historyOf(t, d, task.ID)
+	if len(after) != len(before)+1 {
+		t.Fatalf("revert recorded %d activities, want 1", len(after)-len(before))
+	}
+	if want := before[0].Version + 1; got.Version != want || after[0].Version != want {
+		t.Errorf("version = %d, activity version = %d, want %d", got.Version, after[0].Version, want)
+	}
+	fields := make(map[string]bool)
+	for _, change := range after[0].Changes {
+		fields[change.Field] = true
+	}
+	for _, field := range []string{"title", "content", "status"} {
+		if !fields[field] {
+			t.Errorf("revert activity misses the %s change, got %v", field, fields)
+		}
+	}
+
+	// reverting to the current version writes nothing
+	if _, err := d.RevertTask(ctx, ownerID, task.ID, got.Version); err != nil {
+		t.Fatal(err)
+	}
+	if n := len(historyOf(t, d, task.ID)); n != len(after) {
+		t.Errorf("no-op revert recorded %d activities", n-len(after))
+	}
+}
+
+func TestRevertTaskRejectedStatus(t *testing.T) {
+	ctx := context.Background()
+	d := NewTaskDomain(newTestComponents(t))
+
+	tests := []struct {
+		name  string
+		setup func(t *testing.T, taskID int64) int64
+		code  int32
+	}{
+		{
+			name: "invalid transition",
+			setup: func(t *testing.T, taskID int64) int64 {
+				if err := d.UpdateTaskStatus(ctx, ownerID, taskID, entity.InProgressStatus); err != nil {
+					t.Fatal(err)
+				}
+				version := historyOf(t, d, taskID)[0].Version
+				// done tasks never move back to in progress
+				if err := d.UpdateTaskStatus(ctx, ownerID, taskID, entity.DoneStatus); err != nil {
+					t.Fatal(err)
+				}
+				return version
+			},
+			code: errno.ErrTaskInvalidStatusTransitionCode,
+		},
+		{
+			name: "open blocker",
+			setup: func(t *testing.T, taskID int64) int64 {
+				if err := d.UpdateTaskStatus(ctx, ownerID, taskID, entity.DoneStatus); err != nil {
+					t.Fatal(err)
+				}
+				version := historyOf(t, d, taskID)[0].Version
+				if err := d.UpdateTaskStatus(ctx, ownerID, taskID, entity.ToDoStatus); err != nil {
+					t.Fatal(err)
+				}
+				blocker, err := d.Create(ctx, &CreateTaskRequest{UserID: ownerID, Title: "blocker"})
+				if err != nil {
+					t.Fatal(err)
+				}
+				if err := d.AddDependency(ctx, ownerID, taskID, blocker.ID); err != nil {
+					t.Fatal(err)
+				}
+				return version
+			},
+			code: errno.ErrTaskBlockedCode,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task, err := d.Create(ctx, &CreateTaskRequest{UserID: ownerID, Title: "draft"})
+			if err != nil {
+				t.Fatal(err)
+			}
+			version := tt.setup(t, task.ID)
+			if err := d.UpdateTask(ctx, &UpdateTaskRequest{UserID: ownerID, TaskID: task.ID, Title: ptr.Of("final")}); err != nil {
+				t.Fatal(err)
+			}
+			before, err := d.GetTask(ctx, ownerID, task.ID)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			_, err = d.RevertTask(ctx, ownerID, task.ID, version)
+			assertCode(t, err, tt.code)
+
+			// nothing of the revert was written
+			got, err := d.GetTask(ctx, ownerID, task.ID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.Title != "final" || got.Status != before.Status || got.Version != before.Version {
+				t.Errorf("task = %q %v v%d, want %q %v v%d", got.Title, got.Status, got.Version, "final", before.Status, before.Version)
+			}
+		})
+	}
+}
+
+func TestRevertTaskTagOnlyRevision(t *testing.T) {
+	ctx := context.Background()
+	c := newTestComponents(t)
+	d := NewTaskDomain(c)
+
+	task, err := d.Create(ctx, &CreateTaskRequest{UserID: ownerID, Title: "draft"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tag, err := NewTagDomain(c).CreateTag(ctx, &CreateTagRequest{UserID: ownerID, Name: "home"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the tags aren't logged, so this version has no activity
+	if err := d.UpdateTask(ctx, &UpdateTaskRequest{UserID: ownerID, TaskID: task.ID, AttachTagIDs: []int64{tag.ID}}); err != nil {
+		t.Fatal(err)
+	}
+	tagged, err := d.GetTask(ctx, ownerID, task.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tagged.Version != task.Version+1 {
+		t.Fatalf("version after tagging = %d, want %d", tagged.Version, task.Version+1)
+	}
+	if err := d.UpdateTask(ctx, &UpdateTaskRequest{UserID: ownerID, TaskID: task.ID, Title: ptr.Of("final")}); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := d.RevertTask(ctx, ownerID, task.ID, tagged.Version)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Title != "draft" {
+		t.Errorf("reverted title = %q, want %q", got.Title, "draft")
+	}
+
+	// the log starts with the creation of the task
+	_, err = d.RevertTask(ctx, ownerID, task.ID, task.Version-1)
+	assertCode(t, err, errno.ErrTaskRevisionNotFoundCode)
+}
@@ -0,0 +1,112 @@
+package service
+
+import (
+	"encoding/base64"
+	"encoding/json"
+
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/entity"
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/internal/dal"
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/internal/dal/model"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/errorx"
+	"github.com/crazyfrankie/zrpc-todolist/types/errno"
+)
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
+// listCursor is the opaque pagination token handed out to clients. It pins
+// the sort options so that a token can't be replayed against another ordering.
+type listCursor struct {
+	SortBy entity.SortField `json:"s"`
+	Asc    bool             `json:"a"`
+	Value  int64            `json:"v"`
+	Key    string           `json:"k,omitempty"`
+	ID     int64            `json:"i"`
+}
+
+var sortColumns = map[entity.SortField]dal.SortColumn{
+	entity.SortByCreatedAt: dal.SortByCreatedAt,
+	entity.SortByUpdatedAt: dal.SortByUpdatedAt,
+	entity.SortByPriority:  dal.SortByPriority,
+	entity.SortByRank:      dal.SortBySortKey,
+}
+
+func buildListParams(req *ListTasksRequest, statuses []entity.Status) (*dal.ListTasksParams, error) {
+	pageSize := req.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+	sortBy, ok := sortColumns[req.SortBy]
+	if !ok {
+		return nil, errorx.New(errno.ErrTaskInvalidParamCode, errorx.KV("msg", "invalid sort field"))
+	}
+
+	params := &dal.ListTasksParams{
+		UserID:   req.UserID,
+		Statuses: statusesToInt32(statuses),
+		// fetch one more row to find out whether there is a next page
+		Limit:         pageSize + 1,
+		SortBy:        sortBy,
+		Asc:           req.Asc,
+		CreatedAfter:  req.CreatedAfter,
+		CreatedBefore: req.CreatedBefore,
+		UpdatedAfter:  req.UpdatedAfter,
+		UpdatedBefore: req.UpdatedBefore,
+	}
+
+	if req.Cursor != "" {
+		c, err := decodeCursor(req.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		if c.SortBy != req.SortBy || c.Asc != req.Asc {
+			return nil, errorx.New(errno.ErrTaskInvalidParamCode, errorx.KV("msg", "cursor does not match sort options"))
+		}
+		params.Cursor = &dal.ListCursor{Value: c.Value, Key: c.Key, ID: c.ID}
+	}
+
+	return params, nil
+}
+
+func nextCursor(req *ListTasksRequest, last *model.Task) string {
+	c := &listCursor{
+		SortBy: req.SortBy,
+		Asc:    req.Asc,
+		Value:  last.CreatedAt,
+		ID:     last.ID,
+	}
+	switch req.SortBy {
+	case entity.SortByUpdatedAt:
+		c.Value = last.UpdatedAt
+	case entity.SortByPriority:
+		c.Value = int64(last.Priority)
+	case entity.SortByRank:
+		c.Value, c.Key = 0, last.SortKey
+	}
+
+	return encodeCursor(c)
+}
+
+func encodeCursor(c *listCursor) string {
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodeCursor(s string) (*listCursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, errorx.WrapByCode(err, errno.ErrTaskInvalidParamCode, errorx.KV("msg", "invalid cursor"))
+	}
+
+	c := &listCursor{}
+	if err := json.Unmarshal(b, c); err != nil {
+		return nil, errorx.WrapByCode(err, errno.ErrTaskInvalidParamCode, errorx.KV("msg", "invalid cursor"))
+	}
+
+	return c, nil
+}
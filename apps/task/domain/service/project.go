@@ -0,0 +1,27 @@
+package service
+
+import (
+	"context"
+
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/entity"
+)
+
+// DeleteProjectRequest deletes a project, its tasks move to MoveToProjectID,
+// or go to the recycle bin if it is zero.
+type DeleteProjectRequest struct {
+	UserID          int64
+	ProjectID       int64
+	MoveToProjectID int64
+}
+
+type Project interface {
+	CreateProject(ctx context.Context, userID int64, name string) (*entity.Project, error)
+	RenameProject(ctx context.Context, userID, projectID int64, name string) (*entity.Project, error)
+	ArchiveProject(ctx context.Context, userID, projectID int64, archived bool) error
+	// ReorderProjects puts the given projects in the given order, the others keep their places.
+	ReorderProjects(ctx context.Context, userID int64, projectIDs []int64) error
+	DeleteProject(ctx context.Context, req *DeleteProjectRequest) error
+	// ListProjects returns the projects of the user in display order, the inbox
+	// is created on first use.
+	ListProjects(ctx context.Context, userID int64, includeArchived bool) ([]*entity.Project, error)
+}
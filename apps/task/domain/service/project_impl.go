@@ -0,0 +1,281 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/entity"
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/internal/dal/model"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/errorx"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/lang/conv"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/lang/ptr"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/lang/slice"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/logs"
+	"github.com/crazyfrankie/zrpc-todolist/types/errno"
+)
+
+const (
+	inboxName            = "Inbox"
+	maxProjectNameLength = 128
+)
+
+type projectImpl struct {
+	*Components
+}
+
+func NewProjectDomain(c *Components) Project {
+	return &projectImpl{c}
+}
+
+func (p *projectImpl) CreateProject(ctx context.Context, userID int64, name string) (*entity.Project, error) {
+	name, err := normalizeProjectName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	// the inbox always comes first
+	if _, err := p.ensureInbox(ctx, userID); err != nil {
+		return nil, err
+	}
+	position, err := p.ProjectRepo.MaxPosition(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	newProject := &model.Project{
+		UserID:   userID,
+		Name:     name,
+		Position: position + 1,
+	}
+	if err := p.ProjectRepo.Create(ctx, newProject); err != nil {
+		return nil, err
+	}
+
+	return projectPO2DO(newProject), nil
+}
+
+func (p *projectImpl) RenameProject(ctx context.Context, userID, projectID int64, name string) (*entity.Project, error) {
+	name, err := normalizeProjectName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	projectModel, err := p.getOwnedProject(ctx, userID, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UnixMilli()
+	ok, err := p.ProjectRepo.UpdateProject(ctx, userID, projectID, map[string]any{
+		"name":       name,
+		"updated_at": now,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, errorx.New(errno.ErrProjectNotFoundCode, errorx.KV("project_id", conv.Int64ToStr(projectID)))
+	}
+	projectModel.Name, projectModel.UpdatedAt = name, now
+
+	return projectPO2DO(projectModel), nil
+}
+
+func (p *projectImpl) ArchiveProject(ctx context.Context, userID, projectID int64, archived bool) error {
+	projectModel, err := p.getOwnedProject(ctx, userID, projectID)
+	if err != nil {
+		return err
+	}
+	if ptr.From(projectModel.IsInbox) {
+		return errorx.New(errno.ErrTaskInvalidParamCode, errorx.KV("msg", "the inbox can't be archived"))
+	}
+	if (projectModel.ArchivedAt != nil) == archived {
+		return nil
+	}
+
+	now := time.Now().UnixMilli()
+	updates := map[string]any{
+		"archived_at": nil,
+		"updated_at":  now,
+	}
+	if archived {
+		updates["archived_at"] = now
+	}
+
+	ok, err := p.ProjectRepo.UpdateProject(ctx, userID, projectID, updates)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errorx.New(errno.ErrProjectNotFoundCode, errorx.KV("project_id", conv.Int64ToStr(projectID)))
+	}
+
+	return nil
+}
+
+func (p *projectImpl) ReorderProjects(ctx context.Context, userID int64, projectIDs []int64) error {
+	if len(projectIDs) == 0 {
+		return nil
+	}
+	if len(slice.Unique(projectIDs)) != len(projectIDs) {
+		return errorx.New(errno.ErrTaskInvalidParamCode, errorx.KV("msg", "duplicate project ids"))
+	}
+
+	inbox, err := p.ensureInbox(ctx, userID)
+	if err != nil {
+		return err
+	}
+	for _, id := range projectIDs {
+		if id == inbox.ID {
+			return errorx.New(errno.ErrTaskInvalidParamCode, errorx.KV("msg", "the inbox can't be reordered"))
+		}
+	}
+
+	ok, err := p.ProjectRepo.ReorderProjects(ctx, userID, projectIDs)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errorx.New(errno.ErrTaskInvalidParamCode, errorx.KV("msg", "unknown project ids"))
+	}
+
+	return nil
+}
+
+func (p *projectImpl) DeleteProject(ctx context.Context, req *DeleteProjectRequest) error {
+	projectModel, err := p.getOwnedProject(ctx, req.UserID, req.ProjectID)
+	if err != nil {
+		return err
+	}
+	if ptr.From(projectModel.IsInbox) {
+		return errorx.New(errno.ErrTaskInvalidParamCode, errorx.KV("msg", "the inbox can't be deleted"))
+	}
+
+	if req.MoveToProjectID != 0 {
+		if req.MoveToProjectID == req.ProjectID {
+			return errorx.New(errno.ErrTaskInvalidParamCode, errorx.KV("msg", "can't move tasks into the deleted project"))
+		}
+		if _, err := p.taskProject(ctx, req.UserID, req.MoveToProjectID); err != nil {
+			return err
+		}
+	}
+
+	trashed, ok, err := p.ProjectRepo.DeleteProject(ctx, req.UserID, req.ProjectID, req.MoveToProjectID,
+		entity.TrashedStatus.Int32())
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errorx.New(errno.ErrProjectNotFoundCode, errorx.KV("project_id", conv.Int64ToStr(req.ProjectID)))
+	}
+
+	// tasks in the recycle bin are not searchable
+	for _, id := range trashed {
+		if err := p.Searcher.Delete(ctx, id); err != nil {
+			logs.CtxWarnf(ctx, "delete task from search index failed, taskID=%d, err=%v", id, err)
+		}
+	}
+
+	return nil
+}
+
+func (p *projectImpl) ListProjects(ctx context.Context, userID int64, includeArchived bool) ([]*entity.Project, error) {
+	if _, err := p.ensureInbox(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	projectModels, err := p.ProjectRepo.ListProjects(ctx, userID, includeArchived)
+	if err != nil {
+		return nil, err
+	}
+
+	return slice.Transform(projectModels, projectPO2DO), nil
+}
+
+func (p *projectImpl) getOwnedProject(ctx context.Context, userID, projectID int64) (*model.Project, error) {
+	projectModel, exist, err := p.ProjectRepo.GetProjectByID(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	if !exist || projectModel.UserID != userID {
+		return nil, errorx.New(errno.ErrProjectNotFoundCode, errorx.KV("project_id", conv.Int64ToStr(projectID)))
+	}
+
+	return projectModel, nil
+}
+
+// ensureInbox returns the inbox of the user, creating it on first use.
+func (c *Components) ensureInbox(ctx context.Context, userID int64) (*model.Project, error) {
+	inbox, exist, err := c.ProjectRepo.GetInbox(ctx, userID)
+	if err != nil || exist {
+		return inbox, err
+	}
+
+	inbox = &model.Project{
+		UserID:  userID,
+		Name:    inboxName,
+		IsInbox: ptr.Of(true),
+	}
+	if err := c.ProjectRepo.Create(ctx, inbox); err != nil {
+		// a concurrent request may have created it first
+		existing, exist, getErr := c.ProjectRepo.GetInbox(ctx, userID)
+		if getErr == nil && exist {
+			return existing, nil
+		}
+		return nil, err
+	}
+
+	return inbox, nil
+}
+
+// taskProject resolves the project tasks of the user are placed in, zero means
+// the inbox. Archived projects take no tasks.
+func (c *Components) taskProject(ctx context.Context, userID, projectID int64) (int64, error) {
+	if projectID == 0 {
+		inbox, err := c.ensureInbox(ctx, userID)
+		if err != nil {
+			return 0, err
+		}
+		return inbox.ID, nil
+	}
+
+	projectModel, exist, err := c.ProjectRepo.GetProjectByID(ctx, projectID)
+	if err != nil {
+		return 0, err
+	}
+	if !exist || projectModel.UserID != userID {
+		return 0, errorx.New(errno.ErrProjectNotFoundCode, errorx.KV("project_id", conv.Int64ToStr(projectID)))
+	}
+	if projectModel.ArchivedAt != nil {
+		return 0, errorx.New(errno.ErrTaskInvalidParamCode, errorx.KV("msg", "project is archived"))
+	}
+
+	return projectID, nil
+}
+
+func normalizeProjectName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", errorx.New(errno.ErrTaskInvalidParamCode, errorx.KV("msg", "project name is empty"))
+	}
+	if utf8.RuneCountInString(name) > maxProjectNameLength {
+		return "", errorx.New(errno.ErrTaskInvalidParamCode, errorx.KV("msg", "project name is too long"))
+	}
+
+	return name, nil
+}
+
+func projectPO2DO(projectModel *model.Project) *entity.Project {
+	return &entity.Project{
+		ID:        projectModel.ID,
+		UserID:    projectModel.UserID,
+		Name:      projectModel.Name,
+		Position:  projectModel.Position,
+		Inbox:     ptr.From(projectModel.IsInbox),
+		Archived:  projectModel.ArchivedAt != nil,
+		CreatedAt: projectModel.CreatedAt,
+		UpdatedAt: projectModel.UpdatedAt,
+	}
+}
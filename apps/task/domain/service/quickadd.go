@@ -0,0 +1,118 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/entity"
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/internal/dal/model"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/errorx"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/lang/ptr"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/lang/slice"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/quickadd"
+	"github.com/crazyfrankie/zrpc-todolist/types/errno"
+)
+
+// maxQuickAddLength bounds the text of a quick add in runes.
+const maxQuickAddLength = 500
+
+var quickAddTokenKinds = map[quickadd.Kind]entity.QuickAddTokenKind{
+	quickadd.Due:        entity.DueToken,
+	quickadd.Tag:        entity.TagToken,
+	quickadd.Priority:   entity.PriorityToken,
+	quickadd.Recurrence: entity.RecurrenceToken,
+}
+
+func (t *taskImpl) QuickAddTask(ctx context.Context, req *QuickAddRequest) (*entity.QuickAdd, error) {
+	if len([]rune(req.Text)) > maxQuickAddLength {
+		return nil, errorx.New(errno.ErrTaskInvalidParamCode, errorx.KV("msg", "text is too long"))
+	}
+	loc, err := time.LoadLocation(req.TimeZone)
+	if err != nil {
+		return nil, errorx.WrapByCode(err, errno.ErrTaskInvalidParamCode, errorx.KV("msg", "invalid time zone"))
+	}
+
+	parsed := quickadd.Parse(req.Text, time.Now().In(loc))
+	if parsed.Title == "" {
+		return nil, errorx.New(errno.ErrTaskInvalidParamCode, errorx.KV("msg", "title is empty"))
+	}
+
+	createReq := &CreateTaskRequest{
+		UserID:    req.UserID,
+		Title:     parsed.Title,
+		ProjectID: req.ProjectID,
+	}
+	if parsed.Priority != "" {
+		createReq.Priority, _ = entity.ParsePriority(parsed.Priority)
+	}
+	if !parsed.Due.IsZero() {
+		createReq.DueAt = ptr.Of(parsed.Due.UnixMilli())
+	}
+	if parsed.Rule != nil {
+		createReq.Recurrence = &entity.Recurrence{Rule: parsed.Rule.String(), TimeZone: loc.String()}
+	}
+	if createReq.TagIDs, err = t.quickAddTags(ctx, req.UserID, parsed.Tags); err != nil {
+		return nil, err
+	}
+
+	task, err := t.Create(ctx, createReq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.QuickAdd{
+		Task:   task,
+		AllDay: parsed.AllDay,
+		Tokens: slice.Transform(parsed.Tokens, func(token quickadd.Token) *entity.QuickAddToken {
+			return &entity.QuickAddToken{
+				Kind:  quickAddTokenKinds[token.Kind],
+				Start: int32(token.Start),
+				End:   int32(token.End),
+				Text:  token.Text,
+			}
+		}),
+	}, nil
+}
+
+// quickAddTags returns the IDs of the named tags of the user, creating the
+// missing ones. Names are checked before any tag is created.
+func (t *taskImpl) quickAddTags(ctx context.Context, userID int64, names []string) ([]int64, error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+	if len(names) > maxTaskTags {
+		return nil, errorx.New(errno.ErrTaskInvalidParamCode, errorx.KV("msg", "too many tags"))
+	}
+	for i, name := range names {
+		normalized, err := normalizeTagName(name)
+		if err != nil {
+			return nil, err
+		}
+		names[i] = normalized
+	}
+
+	tagModels, err := t.TagRepo.ListTags(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	// names are compared case insensitively
+	existing := slice.ToMap(tagModels, func(tagModel *model.Tag) (string, int64) {
+		return strings.ToLower(tagModel.Name), tagModel.ID
+	})
+
+	tagIDs := make([]int64, 0, len(names))
+	for _, name := range names {
+		if id, ok := existing[strings.ToLower(name)]; ok {
+			tagIDs = append(tagIDs, id)
+			continue
+		}
+		tag, err := (&tagImpl{t.Components}).CreateTag(ctx, &CreateTagRequest{UserID: userID, Name: name})
+		if err != nil {
+			return nil, err
+		}
+		tagIDs = append(tagIDs, tag.ID)
+	}
+
+	return tagIDs, nil
+}
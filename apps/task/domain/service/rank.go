@@ -0,0 +1,210 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/entity"
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/internal/dal"
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/internal/dal/model"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/errorx"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/lang/conv"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/logs"
+	"github.com/crazyfrankie/zrpc-todolist/types/errno"
+)
+
+// Ranks are base 36 fractions without the leading "0.", so a rank between any
+// two others can be found without touching the rest of the list. They never
+// end with '0', which keeps a free rank between any two of them.
+const (
+	rankDigits = "0123456789abcdefghijklmnopqrstuvwxyz"
+	// maxRankLength is the length beyond which the ranks of a user are rebalanced.
+	maxRankLength = 32
+	// rankRebalanceBatch bounds the users rebalanced by one run.
+	rankRebalanceBatch = 100
+)
+
+func (t *taskImpl) MoveTask(ctx context.Context, req *MoveTaskRequest) (*entity.Task, error) {
+	ctx = dal.WithEventType(ctx, entity.TaskUpdated.Int32())
+
+	if req.BeforeID == 0 && req.AfterID == 0 {
+		return nil, errorx.New(errno.ErrTaskInvalidParamCode, errorx.KV("msg", "before_id or after_id is required"))
+	}
+	if req.BeforeID == req.TaskID || req.AfterID == req.TaskID {
+		return nil, errorx.New(errno.ErrTaskInvalidParamCode, errorx.KV("msg", "a task can't be moved next to itself"))
+	}
+
+	rank, err := t.rankBetween(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	ok, err := t.TaskRepo.UpdateTask(ctx, req.UserID, req.TaskID, nil, map[string]any{
+		"sort_key":   rank,
+		"updated_at": time.Now().UnixMilli(),
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, errorx.New(errno.ErrTaskNotFoundCode, errorx.KV("task_id", conv.Int64ToStr(req.TaskID)))
+	}
+
+	return t.GetTask(ctx, req.UserID, req.TaskID)
+}
+
+// rankBetween finds a rank between the neighbours of the move. Neighbours without
+// a rank or sharing one get fresh ranks first.
+func (t *taskImpl) rankBetween(ctx context.Context, req *MoveTaskRequest) (string, error) {
+	if _, err := t.getLiveTask(ctx, req.UserID, req.TaskID); err != nil {
+		return "", err
+	}
+
+	for rebalanced := false; ; rebalanced = true {
+		before, err := t.neighbourRank(ctx, req.UserID, req.BeforeID)
+		if err != nil {
+			return "", err
+		}
+		after, err := t.neighbourRank(ctx, req.UserID, req.AfterID)
+		if err != nil {
+			return "", err
+		}
+
+		valid := (req.BeforeID == 0 || before != "") && (req.AfterID == 0 || after != "") &&
+			(req.BeforeID == 0 || req.AfterID == 0 || before < after)
+		if valid {
+			return midRank(before, after), nil
+		}
+		if rebalanced {
+			return "", errorx.New(errno.ErrTaskInvalidParamCode, errorx.KV("msg", "after_id must follow before_id"))
+		}
+		if err := t.TaskRepo.RebalanceSortKeys(ctx, req.UserID, spreadRanks); err != nil {
+			return "", err
+		}
+	}
+}
+
+func (t *taskImpl) neighbourRank(ctx context.Context, userID, taskID int64) (string, error) {
+	if taskID == 0 {
+		return "", nil
+	}
+
+	taskModel, err := t.getLiveTask(ctx, userID, taskID)
+	if err != nil {
+		return "", err
+	}
+
+	return taskModel.SortKey, nil
+}
+
+func (t *taskImpl) getLiveTask(ctx context.Context, userID, taskID int64) (*model.Task, error) {
+	taskModel, err := t.getOwnedTask(ctx, userID, taskID)
+	if err != nil {
+		return nil, err
+	}
+	if taskModel.DeletedAt.Valid {
+		return nil, errorx.New(errno.ErrTaskNotFoundCode, errorx.KV("task_id", conv.Int64ToStr(taskID)))
+	}
+
+	return taskModel, nil
+}
+
+// lastRank returns a rank after every task of the user.
+func (t *taskImpl) lastRank(ctx context.Context, userID int64) (string, error) {
+	last, err := t.TaskRepo.MaxSortKey(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+
+	return midRank(last, ""), nil
+}
+
+func (t *taskImpl) RebalanceRanks(ctx context.Context) (int, error) {
+	userIDs, err := t.TaskRepo.ListUnbalancedUsers(ctx, maxRankLength, rankRebalanceBatch)
+	if err != nil {
+		return 0, err
+	}
+
+	rebalanced := 0
+	for _, userID := range userIDs {
+		if err := t.TaskRepo.RebalanceSortKeys(ctx, userID, spreadRanks); err != nil {
+			logs.CtxWarnf(ctx, "rebalance task ranks failed, userID=%d, err=%v", userID, err)
+			continue
+		}
+		rebalanced++
+	}
+
+	return rebalanced, nil
+}
+
+// midRank returns a rank between a and b, an empty a or b means the start or the
+// end of the list. a must be less than b.
+func midRank(a, b string) string {
+	if b != "" {
+		// keep the common prefix, a is padded with '0'
+		n := 0
+		for n < len(b) && rankDigitAt(a, n) == b[n] {
+			n++
+		}
+		if n > 0 {
+			return b[:n] + midRank(rankTail(a, n), b[n:])
+		}
+	}
+
+	digitA := 0
+	if a != "" {
+		digitA = strings.IndexByte(rankDigits, a[0])
+	}
+	digitB := len(rankDigits)
+	if b != "" {
+		digitB = strings.IndexByte(rankDigits, b[0])
+	}
+	if digitB-digitA > 1 {
+		return string(rankDigits[(digitA+digitB+1)/2])
+	}
+
+	// the first digits are consecutive
+	if len(b) > 1 {
+		return b[:1]
+	}
+	return string(rankDigits[digitA]) + midRank(rankTail(a, 1), "")
+}
+
+func rankDigitAt(rank string, i int) byte {
+	if i < len(rank) {
+		return rank[i]
+	}
+	return rankDigits[0]
+}
+
+func rankTail(rank string, i int) string {
+	if i < len(rank) {
+		return rank[i:]
+	}
+	return ""
+}
+
+// spreadRanks returns n ascending ranks of equal length spread evenly over the
+// rank space, so that ranks can be found between them for a long time.
+func spreadRanks(n int) []string {
+	width, space := 1, len(rankDigits)
+	for space < (n+1)*len(rankDigits) {
+		width++
+		space *= len(rankDigits)
+	}
+
+	step := space / (n + 1)
+	ranks := make([]string, n)
+	for i := range ranks {
+		v := (i + 1) * step
+		digits := make([]byte, width)
+		for j := width - 1; j >= 0; j-- {
+			digits[j] = rankDigits[v%len(rankDigits)]
+			v /= len(rankDigits)
+		}
+		ranks[i] = strings.TrimRight(string(digits), rankDigits[:1])
+	}
+
+	return ranks
+}
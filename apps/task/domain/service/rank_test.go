@@ -0,0 +1,159 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/internal/dal"
+)
+
+func TestMidRank(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b string
+		want string
+	}{
+		{"empty list", "", "", "i"},
+		{"head", "", "i", "9"},
+		{"head before the smallest rank", "", "01", "00i"},
+		{"tail", "i", "", "r"},
+		{"tail after the largest digit", "z", "", "zi"},
+		{"tail after a long rank", "zz", "", "zzi"},
+		{"free digit between", "a", "c", "b"},
+		{"adjacent", "a", "b", "ai"},
+		{"adjacent with a longer b", "a", "a1", "a0i"},
+		{"longer a", "az", "b", "azi"},
+		{"prefix of a longer b", "a5", "b3", "b"},
+		{"deep common prefix", "1", "1001", "1000i"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := midRank(tt.a, tt.b)
+			if got != tt.want {
+				t.Errorf("midRank(%q, %q) = %q, want %q", tt.a, tt.b, got, tt.want)
+			}
+			if got <= tt.a || (tt.b != "" && got >= tt.b) {
+				t.Errorf("midRank(%q, %q) = %q, not between them", tt.a, tt.b, got)
+			}
+			if strings.HasSuffix(got, "0") {
+				t.Errorf("midRank(%q, %q) = %q ends with 0", tt.a, tt.b, got)
+			}
+		})
+	}
+}
+
+func TestMidRankRepeatedInsert(t *testing.T) {
+	// inserting at the head over and over grows the ranks until they pass
+	// maxRankLength, which is what triggers a rebalance
+	head, tail := "", "i"
+	inserts := 0
+	for len(tail) <= maxRankLength {
+		rank := midRank(head, tail)
+		if rank <= head || rank >= tail {
+			t.Fatalf("insert %d: midRank(%q, %q) = %q", inserts, head, tail, rank)
+		}
+		if len(rank) > len(tail)+1 {
+			t.Fatalf("insert %d: midRank(%q, %q) = %q grew by more than a digit", inserts, head, tail, rank)
+		}
+		tail = rank
+		inserts++
+	}
+	if inserts <= maxRankLength {
+		t.Errorf("rank passed %d digits after %d inserts", maxRankLength, inserts)
+	}
+}
+
+func TestSpreadRanks(t *testing.T) {
+	for _, n := range []int{1, 3, 35, 36, 1000} {
+		ranks := spreadRanks(n)
+		if len(ranks) != n {
+			t.Fatalf("spreadRanks(%d) returned %d ranks", n, len(ranks))
+		}
+		for i, rank := range ranks {
+			if rank == "" || strings.HasSuffix(rank, "0") {
+				t.Fatalf("spreadRanks(%d)[%d] = %q", n, i, rank)
+			}
+			if i > 0 && ranks[i-1] >= rank {
+				t.Fatalf("spreadRanks(%d) not ascending at %d: %q, %q", n, i, ranks[i-1], rank)
+			}
+		}
+	}
+}
+
+func TestRebalanceRanks(t *testing.T) {
+	ctx := context.Background()
+	c := newTestComponents(t)
+	d := NewTaskDomain(c)
+
+	var ids []int64
+	for _, title := range []string{"first", "second", "third"} {
+		task, err := d.Create(ctx, &CreateTaskRequest{UserID: ownerID, Title: title})
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, task.ID)
+	}
+
+	if n, err := d.RebalanceRanks(ctx); err != nil || n != 0 {
+		t.Fatalf("RebalanceRanks() = %d, %v, want nothing to do", n, err)
+	}
+
+	// the second task got a rank just past the limit
+	long := strings.Repeat("i", maxRankLength) + "1"
+	if _, err := c.TaskRepo.UpdateTask(ctx, ownerID, ids[1], nil, map[string]any{"sort_key": long}, nil); err != nil {
+		t.Fatal(err)
+	}
+	if n, err := d.RebalanceRanks(ctx); err != nil || n != 1 {
+		t.Fatalf("RebalanceRanks() = %d, %v, want 1 user", n, err)
+	}
+
+	tasks, err := c.TaskRepo.ListTasks(ctx, &dal.ListTasksParams{UserID: ownerID, Limit: 10, SortBy: dal.SortBySortKey, Asc: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, task := range tasks {
+		if task.ID != ids[i] {
+			t.Fatalf("task %d after rebalancing is %d, want %d", i, task.ID, ids[i])
+		}
+		if len(task.SortKey) > 1 {
+			t.Errorf("rank of task %d = %q, want a short one", task.ID, task.SortKey)
+		}
+	}
+}
+
+func TestMoveTaskBetweenSharedRanks(t *testing.T) {
+	ctx := context.Background()
+	c := newTestComponents(t)
+	d := NewTaskDomain(c)
+
+	var ids []int64
+	for _, title := range []string{"a", "b", "c"} {
+		task, err := d.Create(ctx, &CreateTaskRequest{UserID: ownerID, Title: title})
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, task.ID)
+	}
+	// the neighbours share a rank, there is no rank between them
+	for _, id := range ids[:2] {
+		if _, err := c.TaskRepo.UpdateTask(ctx, ownerID, id, nil, map[string]any{"sort_key": "i"}, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	moved, err := d.MoveTask(ctx, &MoveTaskRequest{UserID: ownerID, TaskID: ids[2], BeforeID: ids[0], AfterID: ids[1]})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tasks, err := c.TaskRepo.ListTasks(ctx, &dal.ListTasksParams{UserID: ownerID, Limit: 10, SortBy: dal.SortBySortKey, Asc: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tasks) != 3 || tasks[1].ID != moved.ID {
+		t.Fatalf("order after the move = %v, want the moved task in the middle", tasks)
+	}
+	if tasks[0].SortKey >= tasks[1].SortKey || tasks[1].SortKey >= tasks[2].SortKey {
+		t.Errorf("ranks %q, %q, %q are not ascending", tasks[0].SortKey, tasks[1].SortKey, tasks[2].SortKey)
+	}
+}
@@ -0,0 +1,293 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/entity"
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/internal/dal"
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/internal/dal/model"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/errorx"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/lang/conv"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/lang/ptr"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/logs"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/rrule"
+	"github.com/crazyfrankie/zrpc-todolist/types/errno"
+)
+
+const (
+	defaultPreviewOccurrences = 10
+	maxPreviewOccurrences     = 50
+)
+
+func (t *taskImpl) PreviewOccurrences(ctx context.Context, req *PreviewOccurrencesRequest) ([]int64, error) {
+	count := req.Count
+	if count <= 0 {
+		count = defaultPreviewOccurrences
+	}
+	if count > maxPreviewOccurrences {
+		count = maxPreviewOccurrences
+	}
+
+	var (
+		rec          *entity.Recurrence
+		start, after int64
+	)
+	if req.TaskID != 0 {
+		taskModel, err := t.getLiveTask(ctx, req.UserID, req.TaskID)
+		if err != nil {
+			return nil, err
+		}
+		if taskModel.Recurrence == "" {
+			return nil, errorx.New(errno.ErrTaskInvalidParamCode, errorx.KV("msg", "task is not recurring"))
+		}
+		rec, start, after = taskRecurrence(taskModel), taskModel.SeriesStart, taskModel.OccurrenceAt
+	} else {
+		if req.Recurrence == nil || req.Start <= 0 {
+			return nil, errorx.New(errno.ErrTaskInvalidParamCode, errorx.KV("msg", "recurrence and start are required"))
+		}
+		// include the start itself
+		rec, start, after = req.Recurrence, req.Start, req.Start-1
+	}
+
+	rule, loc, err := parseRecurrence(rec)
+	if err != nil {
+		return nil, err
+	}
+
+	occurrences := rule.After(time.UnixMilli(start).In(loc), time.UnixMilli(after), count)
+	res := make([]int64, 0, len(occurrences))
+	for _, o := range occurrences {
+		res = append(res, o.UnixMilli())
+	}
+
+	return res, nil
+}
+
+// startSeries makes the new task the first occurrence of a series repeating
+// from its due time.
+func startSeries(taskModel *model.Task, rec *entity.Recurrence) error {
+	if taskModel.DueAt == nil {
+		return errorx.New(errno.ErrTaskInvalidParamCode, errorx.KV("msg", "recurrence requires due_at"))
+	}
+
+	rule, _, err := parseRecurrence(rec)
+	if err != nil {
+		return err
+	}
+
+	taskModel.Recurrence = rule.String()
+	taskModel.TimeZone = rec.TimeZone
+	taskModel.SeriesID = taskModel.ID
+	taskModel.SeriesStart = ptr.From(taskModel.DueAt)
+	taskModel.OccurrenceAt = ptr.From(taskModel.DueAt)
+
+	return nil
+}
+
+// updateRecurringTask handles the updates which change the recurrence of a task
+// or apply to its whole series. Title, content, project, parent, priority and
+// recurrence changes of a series apply to all of its open occurrences, due and
+// reminder changes only to the task.
+func (t *taskImpl) updateRecurringTask(ctx context.Context, req *UpdateTaskRequest, updates map[string]any, tags *dal.TagChanges) error {
+	taskModel, err := t.getOwnedTask(ctx, req.UserID, req.TaskID)
+	if err != nil {
+		return err
+	}
+
+	inSeries := taskModel.Recurrence != ""
+	if inSeries && req.Scope != entity.WholeSeries && (req.Recurrence != nil || req.ClearRecurrence) {
+		return errorx.New(errno.ErrTaskInvalidParamCode,
+			errorx.KV("msg", "recurrence can only be changed for the whole series"))
+	}
+
+	seriesUpdates := map[string]any{
+		"updated_at": updates["updated_at"],
+	}
+	for _, key := range []string{"title", "content", "project_id", "parent_id", "auto_complete", "priority"} {
+		if v, ok := updates[key]; ok {
+			seriesUpdates[key] = v
+		}
+	}
+
+	seriesID := taskModel.SeriesID
+	switch {
+	case req.ClearRecurrence:
+		for _, u := range []map[string]any{updates, seriesUpdates} {
+			u["recurrence"] = ""
+			u["time_zone"] = ""
+		}
+
+	case req.Recurrence != nil:
+		dueAt := taskModel.DueAt
+		if req.ClearDueAt {
+			dueAt = nil
+		} else if req.DueAt != nil {
+			dueAt = req.DueAt
+		}
+		if dueAt == nil {
+			return errorx.New(errno.ErrTaskInvalidParamCode, errorx.KV("msg", "recurrence requires due_at"))
+		}
+
+		rule, _, err := parseRecurrence(req.Recurrence)
+		if err != nil {
+			return err
+		}
+		if !inSeries {
+			// a fresh series ID keeps the new occurrences apart from any
+			// finished ones of a former series
+			seriesID, err = t.IDGen.GenID(ctx)
+			if err != nil {
+				return err
+			}
+		}
+
+		// the series restarts from this occurrence
+		for _, u := range []map[string]any{updates, seriesUpdates} {
+			u["recurrence"] = rule.String()
+			u["time_zone"] = req.Recurrence.TimeZone
+			u["series_id"] = seriesID
+			u["series_start"] = ptr.From(dueAt)
+		}
+		updates["occurrence_at"] = ptr.From(dueAt)
+
+	case inSeries && req.DueAt != nil:
+		// moving an occurrence of the whole series shifts the series
+		delta := ptr.From(req.DueAt) - taskModel.OccurrenceAt
+		seriesUpdates["series_start"] = taskModel.SeriesStart + delta
+		updates["series_start"] = taskModel.SeriesStart + delta
+		updates["occurrence_at"] = ptr.From(req.DueAt)
+	}
+
+	var ids []int64
+	if req.Scope == entity.WholeSeries && seriesID != 0 {
+		ids, err = t.TaskRepo.UpdateSeries(ctx, req.UserID, req.TaskID, seriesID, req.Version,
+			statusesToInt32(entity.OpenStatuses), updates, seriesUpdates, tags)
+		if err != nil {
+			return err
+		}
+	} else {
+		ok, err := t.TaskRepo.UpdateTask(ctx, req.UserID, req.TaskID, req.Version, updates, tags)
+		if err != nil {
+			return err
+		}
+		if ok {
+			ids = []int64{req.TaskID}
+		}
+	}
+	if len(ids) == 0 {
+		return t.updateMissed(ctx, req.UserID, req.TaskID, req.Version)
+	}
+
+	for _, id := range ids {
+		t.syncSearchIndex(ctx, id)
+	}
+
+	return nil
+}
+
+// finishOccurrence marks an occurrence of a recurring task as done and schedules
+// the next one, it returns false once the series has ended.
+func (t *taskImpl) finishOccurrence(ctx context.Context, taskModel *model.Task) (bool, error) {
+	next, err := t.nextOccurrence(ctx, taskModel)
+	if err != nil || next == nil {
+		return false, err
+	}
+
+	created, err := t.TaskRepo.FinishOccurrence(ctx, taskModel.UserID, taskModel.ID,
+		taskModel.Status, entity.DoneStatus.Int32(), next)
+	if err != nil {
+		return false, err
+	}
+
+	t.syncSearchIndex(ctx, taskModel.ID)
+	if created {
+		if err := t.Searcher.Index(ctx, taskPO2Document(next)); err != nil {
+			logs.CtxWarnf(ctx, "index task failed, taskID=%d, err=%v", next.ID, err)
+		}
+	}
+
+	return true, nil
+}
+
+// nextOccurrence builds the occurrence following taskModel, or nil once the series has ended.
+func (t *taskImpl) nextOccurrence(ctx context.Context, taskModel *model.Task) (*model.Task, error) {
+	rule, loc, err := parseRecurrence(taskRecurrence(taskModel))
+	if err != nil {
+		return nil, err
+	}
+
+	start := time.UnixMilli(taskModel.SeriesStart).In(loc)
+	occurrences := rule.After(start, time.UnixMilli(taskModel.OccurrenceAt), 1)
+	if len(occurrences) == 0 {
+		return nil, nil
+	}
+
+	id, err := t.IDGen.GenID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	occurrenceAt := occurrences[0].UnixMilli()
+	next := &model.Task{
+		ID:           id,
+		UserID:       taskModel.UserID,
+		ProjectID:    taskModel.ProjectID,
+		ParentID:     taskModel.ParentID,
+		AutoComplete: taskModel.AutoComplete,
+		Priority:     taskModel.Priority,
+		SortKey:      taskModel.SortKey,
+		Title:        taskModel.Title,
+		Content:      taskModel.Content,
+		Status:       entity.ToDoStatus.Int32(),
+		DueAt:        ptr.Of(occurrenceAt),
+		Recurrence:   taskModel.Recurrence,
+		TimeZone:     taskModel.TimeZone,
+		SeriesID:     taskModel.SeriesID,
+		SeriesStart:  taskModel.SeriesStart,
+		OccurrenceAt: occurrenceAt,
+	}
+	// keep the reminder lead time of the finished occurrence
+	if taskModel.DueAt != nil && taskModel.RemindAt != nil {
+		next.RemindAt = ptr.Of(occurrenceAt - (ptr.From(taskModel.DueAt) - ptr.From(taskModel.RemindAt)))
+	}
+
+	return next, nil
+}
+
+func (t *taskImpl) getOwnedTask(ctx context.Context, userID, taskID int64) (*model.Task, error) {
+	taskModel, exist, err := t.TaskRepo.GetTaskByID(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+	if !exist || taskModel.UserID != userID {
+		return nil, errorx.New(errno.ErrTaskNotFoundCode, errorx.KV("task_id", conv.Int64ToStr(taskID)))
+	}
+
+	return taskModel, nil
+}
+
+func parseRecurrence(rec *entity.Recurrence) (*rrule.Rule, *time.Location, error) {
+	rule, err := rrule.Parse(rec.Rule)
+	if err != nil {
+		return nil, nil, errorx.WrapByCode(err, errno.ErrTaskInvalidParamCode, errorx.KV("msg", err.Error()))
+	}
+
+	loc, err := time.LoadLocation(rec.TimeZone)
+	if err != nil {
+		return nil, nil, errorx.WrapByCode(err, errno.ErrTaskInvalidParamCode,
+			errorx.KV("msg", "invalid time zone"))
+	}
+
+	return rule, loc, nil
+}
+
+func taskRecurrence(taskModel *model.Task) *entity.Recurrence {
+	if taskModel.Recurrence == "" {
+		return nil
+	}
+	return &entity.Recurrence{
+		Rule:     taskModel.Recurrence,
+		TimeZone: taskModel.TimeZone,
+	}
+}
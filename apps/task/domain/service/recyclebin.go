@@ -0,0 +1,152 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/entity"
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/internal/dal"
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/internal/dal/model"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/errorx"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/lang/conv"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/logs"
+	"github.com/crazyfrankie/zrpc-todolist/types/errno"
+)
+
+const purgeBatchSize = 500
+
+func (t *taskImpl) DeleteTask(ctx context.Context, userID, taskID int64) error {
+	taskModel, err := t.getOwnedTask(ctx, userID, taskID)
+	if err != nil {
+		return err
+	}
+	if taskModel.DeletedAt.Valid {
+		return nil
+	}
+
+	ctx = dal.WithEventType(ctx, entity.TaskDeleted.Int32())
+
+	return t.trashTask(ctx, taskModel)
+}
+
+func (t *taskImpl) RestoreTask(ctx context.Context, userID, taskID int64) error {
+	taskModel, err := t.getOwnedTask(ctx, userID, taskID)
+	if err != nil {
+		return err
+	}
+	if !taskModel.DeletedAt.Valid {
+		return errorx.New(errno.ErrTaskInvalidParamCode, errorx.KV("msg", "task is not in the recycle bin"))
+	}
+
+	ctx = dal.WithEventType(ctx, entity.TaskStatusChanged.Int32(), taskID)
+
+	return t.restoreTask(ctx, taskModel, entity.ToDoStatus)
+}
+
+func (t *taskImpl) PurgeTask(ctx context.Context, userID, taskID int64) error {
+	taskModel, err := t.getOwnedTask(ctx, userID, taskID)
+	if err != nil {
+		return err
+	}
+	if !taskModel.DeletedAt.Valid {
+		return errorx.New(errno.ErrTaskInvalidParamCode, errorx.KV("msg", "task is not in the recycle bin"))
+	}
+
+	_, err = t.purgeTasks(ctx, []int64{taskID})
+	return err
+}
+
+func (t *taskImpl) EmptyRecycleBin(ctx context.Context, userID int64) (int64, error) {
+	return t.purgeInBatches(ctx, func() ([]int64, error) {
+		return t.TaskRepo.ListDeletedTaskIDs(ctx, userID, purgeBatchSize)
+	})
+}
+
+func (t *taskImpl) PurgeExpiredTasks(ctx context.Context, before time.Time) (int64, error) {
+	// expired tasks are purged by the scheduler
+	ctx = dal.WithActivitySource(ctx, entity.SchedulerSource.Int32())
+	return t.purgeInBatches(ctx, func() ([]int64, error) {
+		return t.TaskRepo.ListExpiredTaskIDs(ctx, before, purgeBatchSize)
+	})
+}
+
+// trashTask moves the task and its subtasks to the recycle bin, they stay there
+// in trashed status until they are restored or purged.
+func (t *taskImpl) trashTask(ctx context.Context, taskModel *model.Task) error {
+	ids, err := t.TaskRepo.TrashTask(ctx, taskModel.UserID, taskModel.ID, entity.TrashedStatus.Int32())
+	if err != nil {
+		return err
+	}
+	if len(ids) == 0 {
+		return errorx.New(errno.ErrTaskNotFoundCode, errorx.KV("task_id", conv.Int64ToStr(taskModel.ID)))
+	}
+
+	for _, id := range ids {
+		t.syncSearchIndex(ctx, id)
+	}
+	// the remaining subtasks of the parent may all be done now
+	t.autoComplete(ctx, taskModel.UserID, taskModel.ParentID)
+
+	return nil
+}
+
+// restoreTask brings the task back from the recycle bin together with the
+// subtasks trashed along with it.
+func (t *taskImpl) restoreTask(ctx context.Context, taskModel *model.Task, status entity.Status) error {
+	ids, err := t.TaskRepo.RestoreTask(ctx, taskModel.UserID, taskModel.ID, status.Int32())
+	if err != nil {
+		return err
+	}
+	if len(ids) == 0 {
+		return errorx.New(errno.ErrTaskNotFoundCode, errorx.KV("task_id", conv.Int64ToStr(taskModel.ID)))
+	}
+
+	for _, id := range ids {
+		t.syncSearchIndex(ctx, id)
+	}
+
+	return nil
+}
+
+// purgeInBatches purges the tasks returned by next until it runs dry.
+func (t *taskImpl) purgeInBatches(ctx context.Context, next func() ([]int64, error)) (int64, error) {
+	var total int64
+	for {
+		ids, err := next()
+		if err != nil {
+			return total, err
+		}
+		if len(ids) == 0 {
+			return total, nil
+		}
+
+		n, err := t.purgeTasks(ctx, ids)
+		total += n
+		if err != nil {
+			return total, err
+		}
+		if len(ids) < purgeBatchSize {
+			return total, nil
+		}
+	}
+}
+
+func (t *taskImpl) purgeTasks(ctx context.Context, taskIDs []int64) (int64, error) {
+	purged, err := t.TaskRepo.PurgeTasks(ctx, taskIDs)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, id := range purged.TaskIDs {
+		if err := t.Searcher.Delete(ctx, id); err != nil {
+			logs.CtxWarnf(ctx, "delete task from search index failed, taskID=%d, err=%v", id, err)
+		}
+	}
+	for _, key := range purged.AttachmentKeys {
+		if err := t.FileOSS.DeleteObject(ctx, key); err != nil {
+			logs.CtxWarnf(ctx, "delete attachment object failed, key=%s, err=%v", key, err)
+		}
+	}
+
+	return int64(len(purged.TaskIDs)), nil
+}
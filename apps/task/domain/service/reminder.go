@@ -0,0 +1,102 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/entity"
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/internal/dal"
+	"github.com/crazyfrankie/zrpc-todolist/infra/contract/notify"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/lang/conv"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/lang/ptr"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/logs"
+)
+
+const (
+	maxDueTasks       = 500
+	reminderBatchSize = 100
+	// reminderLockTTL must outlive a dispatch round, so that a replica which
+	// read the reminder before it was marked as sent can't fire it again.
+	reminderLockTTL = 10 * time.Minute
+)
+
+func (t *taskImpl) ListDueTasks(ctx context.Context, req *ListDueTasksRequest) ([]*entity.Task, error) {
+	loc := req.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+	now := req.Now.In(loc)
+
+	var from, to int64
+	switch req.View {
+	case entity.DueTodayView:
+		start := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc)
+		from, to = start.UnixMilli(), start.AddDate(0, 0, 1).UnixMilli()
+	default:
+		to = now.UnixMilli()
+	}
+
+	taskModels, err := t.TaskRepo.ListTasksByDueRange(ctx, req.UserID, statusesToInt32(entity.OpenStatuses), from, to, maxDueTasks)
+	if err != nil {
+		return nil, err
+	}
+
+	tasks := make([]*entity.Task, 0, len(taskModels))
+	for _, taskModel := range taskModels {
+		tasks = append(tasks, taskPO2DO(taskModel))
+	}
+	if err := t.fillTaskDetails(ctx, tasks); err != nil {
+		return nil, err
+	}
+
+	return tasks, nil
+}
+
+func (t *taskImpl) DispatchDueReminders(ctx context.Context, now time.Time) (int, error) {
+	// reminders are sent by the scheduler
+	ctx = dal.WithActivitySource(ctx, entity.SchedulerSource.Int32())
+	taskModels, err := t.TaskRepo.ListDueReminders(ctx, statusesToInt32(entity.OpenStatuses), now.UnixMilli(), reminderBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	sent := 0
+	for _, taskModel := range taskModels {
+		remindAt := ptr.From(taskModel.RemindAt)
+
+		// only one replica may fire a given reminder
+		lockKey := fmt.Sprintf("task_reminder_lock:%d:%d", taskModel.ID, remindAt)
+		locked, err := t.Cache.SetNX(ctx, lockKey, 1, reminderLockTTL).Result()
+		if err != nil {
+			return sent, err
+		}
+		if !locked {
+			continue
+		}
+
+		err = t.Notifier.Notify(ctx, &notify.Notification{
+			UserID: taskModel.UserID,
+			Title:  "Task reminder",
+			Body:   taskModel.Title,
+			Data: map[string]string{
+				"task_id":   conv.Int64ToStr(taskModel.ID),
+				"remind_at": conv.Int64ToStr(remindAt),
+			},
+		})
+		if err != nil {
+			logs.CtxWarnf(ctx, "notify task reminder failed, taskID=%d, err=%v", taskModel.ID, err)
+			// release the lock so the reminder is retried in the next round
+			_, _ = t.Cache.Del(ctx, lockKey).Result()
+			continue
+		}
+
+		_, err = t.TaskRepo.MarkReminded(ctx, taskModel.ID, remindAt, now.UnixMilli())
+		if err != nil {
+			return sent, err
+		}
+		sent++
+	}
+
+	return sent, nil
+}
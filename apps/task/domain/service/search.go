@@ -0,0 +1,120 @@
+package service
+
+import (
+	"context"
+	"slices"
+
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/entity"
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/internal/dal/model"
+	"github.com/crazyfrankie/zrpc-todolist/infra/contract/search"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/errorx"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/lang/slice"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/logs"
+	"github.com/crazyfrankie/zrpc-todolist/types/errno"
+)
+
+func (t *taskImpl) SearchTasks(ctx context.Context, req *SearchTasksRequest) (*SearchTasksResponse, error) {
+	if req.Keyword == "" {
+		return nil, errorx.New(errno.ErrTaskInvalidParamCode, errorx.KV("msg", "keyword is empty"))
+	}
+
+	page, pageSize := req.Page, req.PageSize
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+
+	// tasks in the recycle bin are not searchable
+	statuses := slices.DeleteFunc(slices.Clone(req.Statuses), func(s entity.Status) bool {
+		return s == entity.TrashedStatus
+	})
+	if len(statuses) == 0 {
+		if len(req.Statuses) > 0 {
+			return &SearchTasksResponse{}, nil
+		}
+		statuses = []entity.Status{entity.ToDoStatus, entity.InProgressStatus, entity.DoneStatus, entity.ArchivedStatus}
+	}
+
+	res, err := t.Searcher.Search(ctx, &search.Request{
+		OwnerID:  req.UserID,
+		Keyword:  req.Keyword,
+		Statuses: statusesToInt32(statuses),
+		Offset:   (page - 1) * pageSize,
+		Limit:    pageSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &SearchTasksResponse{Total: res.Total}
+	if len(res.Hits) == 0 {
+		return resp, nil
+	}
+
+	taskModels, err := t.TaskRepo.GetTasksByIDs(ctx, req.UserID, slice.Transform(res.Hits, func(h *search.Hit) int64 {
+		return h.ID
+	}))
+	if err != nil {
+		return nil, err
+	}
+	tasks := slice.ToMap(taskModels, func(m *model.Task) (int64, *model.Task) {
+		return m.ID, m
+	})
+
+	// keep the relevance order of the searcher, skip hits whose task is gone
+	var found []*entity.Task
+	for _, hit := range res.Hits {
+		taskModel, ok := tasks[hit.ID]
+		if !ok {
+			continue
+		}
+		task := taskPO2DO(taskModel)
+		found = append(found, task)
+		resp.Hits = append(resp.Hits, &SearchHit{
+			Task:             task,
+			Score:            hit.Score,
+			TitleHighlight:   hit.TitleHighlight,
+			ContentHighlight: hit.ContentHighlight,
+		})
+	}
+	if err := t.fillTaskDetails(ctx, found); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// syncSearchIndex refreshes the search document of the task. The index is
+// secondary data, so failures are logged rather than failing the write.
+func (t *taskImpl) syncSearchIndex(ctx context.Context, taskID int64) {
+	taskModel, exist, err := t.TaskRepo.GetTaskByID(ctx, taskID)
+	if err != nil {
+		logs.CtxWarnf(ctx, "load task for search index failed, taskID=%d, err=%v", taskID, err)
+		return
+	}
+	// tasks in the recycle bin are not searchable
+	if !exist || taskModel.DeletedAt.Valid {
+		err = t.Searcher.Delete(ctx, taskID)
+	} else {
+		err = t.Searcher.Index(ctx, taskPO2Document(taskModel))
+	}
+	if err != nil {
+		logs.CtxWarnf(ctx, "sync search index failed, taskID=%d, err=%v", taskID, err)
+	}
+}
+
+func taskPO2Document(taskModel *model.Task) *search.Document {
+	return &search.Document{
+		ID:        taskModel.ID,
+		OwnerID:   taskModel.UserID,
+		Status:    taskModel.Status,
+		Title:     taskModel.Title,
+		Content:   taskModel.Content,
+		UpdatedAt: taskModel.UpdatedAt,
+	}
+}
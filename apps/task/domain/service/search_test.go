@@ -0,0 +1,117 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/entity"
+	"github.com/crazyfrankie/zrpc-todolist/types/errno"
+)
+
+func TestSearchTasks(t *testing.T) {
+	ctx := context.Background()
+	d := NewTaskDomain(newTestComponents(t))
+
+	create := func(userID int64, title, content string) int64 {
+		t.Helper()
+		task, err := d.Create(ctx, &CreateTaskRequest{UserID: userID, Title: title, Content: content})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return task.ID
+	}
+	titleOnly := create(ownerID, "Write report", "for the board")
+	contentOnly := create(ownerID, "Groceries", "print the report first")
+	both := create(ownerID, "Weekly report", "report the numbers")
+	create(ownerID, "Read a book", "")
+	others := create(otherID, "Report of another user", "")
+	trashed := create(ownerID, "Old report", "")
+	if err := d.DeleteTask(ctx, ownerID, trashed); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name  string
+		req   *SearchTasksRequest
+		want  []int64
+		total int64
+	}{
+		{
+			name:  "ranked by relevance",
+			req:   &SearchTasksRequest{UserID: ownerID, Keyword: "report"},
+			want:  []int64{both, titleOnly, contentOnly},
+			total: 3,
+		},
+		{
+			name:  "first page",
+			req:   &SearchTasksRequest{UserID: ownerID, Keyword: "report", PageSize: 2},
+			want:  []int64{both, titleOnly},
+			total: 3,
+		},
+		{
+			name:  "second page",
+			req:   &SearchTasksRequest{UserID: ownerID, Keyword: "report", Page: 2, PageSize: 2},
+			want:  []int64{contentOnly},
+			total: 3,
+		},
+		{
+			name:  "status filter",
+			req:   &SearchTasksRequest{UserID: ownerID, Keyword: "report", Statuses: []entity.Status{entity.DoneStatus}},
+			want:  nil,
+			total: 0,
+		},
+		{
+			name:  "recycle bin is not searchable",
+			req:   &SearchTasksRequest{UserID: ownerID, Keyword: "old", Statuses: []entity.Status{entity.TrashedStatus}},
+			want:  nil,
+			total: 0,
+		},
+		{
+			name:  "other users",
+			req:   &SearchTasksRequest{UserID: otherID, Keyword: "report"},
+			want:  []int64{others},
+			total: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := d.SearchTasks(ctx, tt.req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []int64
+			for _, hit := range res.Hits {
+				got = append(got, hit.Task.ID)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("hits = %v, want %v", got, tt.want)
+			}
+			if res.Total != tt.total {
+				t.Errorf("total = %d, want %d", res.Total, tt.total)
+			}
+		})
+	}
+
+	t.Run("highlights", func(t *testing.T) {
+		res, err := d.SearchTasks(ctx, &SearchTasksRequest{UserID: ownerID, Keyword: "report"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := map[int64][2]string{
+			both:        {"Weekly <em>report</em>", "<em>report</em> the numbers"},
+			titleOnly:   {"Write <em>report</em>", ""},
+			contentOnly: {"", "print the <em>report</em> first"},
+		}
+		for _, hit := range res.Hits {
+			if got := [2]string{hit.TitleHighlight, hit.ContentHighlight}; got != want[hit.Task.ID] {
+				t.Errorf("task %d highlights = %q, want %q", hit.Task.ID, got, want[hit.Task.ID])
+			}
+		}
+	})
+
+	t.Run("empty keyword", func(t *testing.T) {
+		_, err := d.SearchTasks(ctx, &SearchTasksRequest{UserID: ownerID})
+		assertCode(t, err, errno.ErrTaskInvalidParamCode)
+	})
+}
@@ -0,0 +1,298 @@
+package service
+
+import (
+	"context"
+
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/entity"
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/internal/dal/model"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/errorx"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/lang/conv"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/lang/slice"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/logs"
+	"github.com/crazyfrankie/zrpc-todolist/types/errno"
+)
+
+// maxTaskDepth is the number of levels a task tree may have.
+const maxTaskDepth = 3
+
+// progressCount counts the done parts of a task.
+type progressCount struct {
+	done  int
+	total int
+}
+
+// checkParent makes sure that a subtree of the given height, rooted at taskID or
+// at a new task if taskID is zero, may be placed under parentID.
+func (t *taskImpl) checkParent(ctx context.Context, userID, parentID, taskID int64, height int) (*model.Task, error) {
+	if parentID == taskID {
+		return nil, errorx.New(errno.ErrTaskInvalidParamCode, errorx.KV("msg", "a task can't be its own subtask"))
+	}
+
+	parent, err := t.getOwnedTask(ctx, userID, parentID)
+	if err != nil {
+		return nil, err
+	}
+	if parent.DeletedAt.Valid {
+		return nil, errorx.New(errno.ErrTaskNotFoundCode, errorx.KV("task_id", conv.Int64ToStr(parentID)))
+	}
+
+	// walk up from the parent, the task must not be one of its ancestors
+	depth := 1
+	for ancestorID := parent.ParentID; ancestorID != 0 && depth <= maxTaskDepth; depth++ {
+		if ancestorID == taskID {
+			return nil, errorx.New(errno.ErrTaskInvalidParamCode,
+				errorx.KV("msg", "a task can't be moved under its own subtask"))
+		}
+		ancestor, exist, err := t.TaskRepo.GetTaskByID(ctx, ancestorID)
+		if err != nil {
+			return nil, err
+		}
+		if !exist {
+			break
+		}
+		ancestorID = ancestor.ParentID
+	}
+	if depth+height > maxTaskDepth {
+		return nil, errorx.New(errno.ErrTaskInvalidParamCode,
+			errorx.KV("msg", "subtasks can be nested at most "+conv.Int64ToStr(maxTaskDepth)+" levels deep"))
+	}
+
+	return parent, nil
+}
+
+// subtreeHeight returns the number of levels of the tree rooted at the task.
+func (t *taskImpl) subtreeHeight(ctx context.Context, taskID int64) (int, error) {
+	height := 1
+	level := []int64{taskID}
+	for height <= maxTaskDepth {
+		children, err := t.TaskRepo.ListChildren(ctx, level)
+		if err != nil {
+			return 0, err
+		}
+		if len(children) == 0 {
+			break
+		}
+		height++
+		level = slice.Transform(children, func(m *model.Task) int64 {
+			return m.ID
+		})
+	}
+
+	return height, nil
+}
+
+// loadSubtrees fills the children of the tasks down their trees, it returns all
+// tasks of the trees.
+func (t *taskImpl) loadSubtrees(ctx context.Context, roots []*entity.Task) ([]*entity.Task, error) {
+	all := roots
+	level := roots
+	for depth := 1; depth < maxTaskDepth && len(level) > 0; depth++ {
+		parents := slice.ToMap(level, func(task *entity.Task) (int64, *entity.Task) {
+			return task.ID, task
+		})
+		children, err := t.TaskRepo.ListChildren(ctx, slice.Transform(level, func(task *entity.Task) int64 {
+			return task.ID
+		}))
+		if err != nil {
+			return nil, err
+		}
+
+		level = make([]*entity.Task, 0, len(children))
+		for _, child := range children {
+			task := taskPO2DO(child)
+			parents[child.ParentID].Children = append(parents[child.ParentID].Children, task)
+			level = append(level, task)
+		}
+		all = append(all, level...)
+	}
+
+	return all, nil
+}
+
+// fillTaskDetails loads the tags, blockers, checklists, progress and comment
+// counts of the tasks.
+func (t *taskImpl) fillTaskDetails(ctx context.Context, tasks []*entity.Task) error {
+	if len(tasks) == 0 {
+		return nil
+	}
+	if err := t.fillTags(ctx, tasks); err != nil {
+		return err
+	}
+	if err := t.fillBlockers(ctx, tasks); err != nil {
+		return err
+	}
+	if err := t.fillCommentCounts(ctx, tasks); err != nil {
+		return err
+	}
+
+	counts, checklists, err := t.countProgress(ctx, slice.Transform(tasks, func(task *entity.Task) int64 {
+		return task.ID
+	}))
+	if err != nil {
+		return err
+	}
+	for _, task := range tasks {
+		task.Checklist = checklists[task.ID]
+		task.Progress = progressPercent(task.Status, counts[task.ID])
+	}
+
+	return nil
+}
+
+// countProgress counts the done subtasks and checked checklist items of the tasks,
+// archived subtasks are left out.
+func (t *taskImpl) countProgress(ctx context.Context, taskIDs []int64) (map[int64]*progressCount, map[int64][]*entity.ChecklistItem, error) {
+	children, err := t.TaskRepo.ListChildStatuses(ctx, taskIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+	items, err := t.ChecklistRepo.ListItems(ctx, taskIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	counts := make(map[int64]*progressCount, len(taskIDs))
+	count := func(taskID int64, done bool) {
+		c, ok := counts[taskID]
+		if !ok {
+			c = &progressCount{}
+			counts[taskID] = c
+		}
+		c.total++
+		if done {
+			c.done++
+		}
+	}
+	for _, child := range children {
+		if entity.Status(child.Status) != entity.ArchivedStatus {
+			count(child.ParentID, entity.Status(child.Status) == entity.DoneStatus)
+		}
+	}
+
+	checklists := make(map[int64][]*entity.ChecklistItem, len(taskIDs))
+	for _, item := range items {
+		count(item.TaskID, item.Checked)
+		checklists[item.TaskID] = append(checklists[item.TaskID], checklistItemPO2DO(item))
+	}
+
+	return counts, checklists, nil
+}
+
+// autoComplete finishes the task once all of its subtasks and checklist items are
+// done, if it asks for it. The write which triggered it already succeeded, so
+// failures are only logged.
+func (t *taskImpl) autoComplete(ctx context.Context, userID, taskID int64) {
+	if taskID == 0 {
+		return
+	}
+
+	taskModel, exist, err := t.TaskRepo.GetTaskByID(ctx, taskID)
+	if err != nil {
+		logs.CtxWarnf(ctx, "load task for auto completion failed, taskID=%d, err=%v", taskID, err)
+		return
+	}
+	if !exist || taskModel.UserID != userID || taskModel.DeletedAt.Valid ||
+		!taskModel.AutoComplete || !entity.Status(taskModel.Status).IsOpen() {
+		return
+	}
+
+	counts, _, err := t.countProgress(ctx, []int64{taskID})
+	if err != nil {
+		logs.CtxWarnf(ctx, "count task progress failed, taskID=%d, err=%v", taskID, err)
+		return
+	}
+	c := counts[taskID]
+	if c == nil || c.done < c.total {
+		return
+	}
+	blockers, err := t.openBlockers(ctx, taskID)
+	if err != nil {
+		logs.CtxWarnf(ctx, "load task blockers failed, taskID=%d, err=%v", taskID, err)
+		return
+	}
+	if len(blockers) > 0 {
+		return
+	}
+
+	// finishing the task may in turn complete its parent
+	if err := t.UpdateTaskStatus(ctx, userID, taskID, entity.DoneStatus); err != nil {
+		logs.CtxWarnf(ctx, "auto complete task failed, taskID=%d, err=%v", taskID, err)
+	}
+}
+
+func progressPercent(status entity.Status, c *progressCount) int32 {
+	if c == nil || c.total == 0 {
+		if status == entity.DoneStatus {
+			return 100
+		}
+		return 0
+	}
+
+	return int32(c.done * 100 / c.total)
+}
+
+// treeUpdates adds the parent and project changes of the update to updates, it
+// returns the parent the task had before. A subtask always lives in the project
+// of its parent.
+func (t *taskImpl) treeUpdates(ctx context.Context, req *UpdateTaskRequest, updates map[string]any) (int64, error) {
+	if req.ParentID == nil && req.ProjectID == nil {
+		return 0, nil
+	}
+
+	taskModel, err := t.getOwnedTask(ctx, req.UserID, req.TaskID)
+	if err != nil {
+		return 0, err
+	}
+
+	parentID := taskModel.ParentID
+	if req.ParentID != nil {
+		parentID = *req.ParentID
+	}
+	if parentID == 0 {
+		if req.ParentID != nil {
+			updates["parent_id"] = int64(0)
+		}
+		if req.ProjectID != nil {
+			projectID, err := t.taskProject(ctx, req.UserID, *req.ProjectID)
+			if err != nil {
+				return 0, err
+			}
+			updates["project_id"] = projectID
+		}
+		return taskModel.ParentID, nil
+	}
+
+	if req.ProjectID != nil && req.ParentID == nil {
+		return 0, errorx.New(errno.ErrTaskInvalidParamCode,
+			errorx.KV("msg", "subtasks move with their parent, move the parent instead"))
+	}
+	height, err := t.subtreeHeight(ctx, req.TaskID)
+	if err != nil {
+		return 0, err
+	}
+	parent, err := t.checkParent(ctx, req.UserID, parentID, req.TaskID, height)
+	if err != nil {
+		return 0, err
+	}
+	if req.ProjectID != nil && *req.ProjectID != parent.ProjectID {
+		return 0, errorx.New(errno.ErrTaskInvalidParamCode,
+			errorx.KV("msg", "subtasks live in the project of their parent"))
+	}
+
+	updates["parent_id"] = parentID
+	updates["project_id"] = parent.ProjectID
+
+	return taskModel.ParentID, nil
+}
+
+func checklistItemPO2DO(item *model.ChecklistItem) *entity.ChecklistItem {
+	return &entity.ChecklistItem{
+		ID:        item.ID,
+		TaskID:    item.TaskID,
+		Content:   item.Content,
+		Checked:   item.Checked,
+		Position:  item.Position,
+		CreatedAt: item.CreatedAt,
+		UpdatedAt: item.UpdatedAt,
+	}
+}
@@ -0,0 +1,258 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/entity"
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/internal/dal"
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/internal/dal/model"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/errorx"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/lang/conv"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/lang/slice"
+	"github.com/crazyfrankie/zrpc-todolist/types/errno"
+)
+
+const (
+	defaultSyncPageSize = 100
+	maxSyncPageSize     = 500
+)
+
+// syncToken is the opaque position handed out to syncing clients. Seq is the last
+// change sequence the client has seen. While Snapshot is set the client is still
+// paging through its live tasks by ID, the changes after Seq follow the snapshot.
+type syncToken struct {
+	Seq      int64 `json:"q"`
+	Snapshot bool  `json:"s,omitempty"`
+	AfterID  int64 `json:"i,omitempty"`
+}
+
+func (t *taskImpl) SyncTasks(ctx context.Context, req *SyncTasksRequest) (*SyncTasksResponse, error) {
+	pageSize := req.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultSyncPageSize
+	}
+	if pageSize > maxSyncPageSize {
+		pageSize = maxSyncPageSize
+	}
+
+	token := &syncToken{Snapshot: true}
+	if req.SinceToken == "" {
+		// changes committed after this point are sent once the snapshot is done
+		seq, err := t.ChangeRepo.LastSeq(ctx, req.UserID)
+		if err != nil {
+			return nil, err
+		}
+		token.Seq = seq
+	} else {
+		var err error
+		if token, err = decodeSyncToken(req.SinceToken); err != nil {
+			return nil, err
+		}
+	}
+
+	if token.Snapshot {
+		return t.syncSnapshot(ctx, req.UserID, token, pageSize)
+	}
+	return t.syncChanges(ctx, req.UserID, token, pageSize)
+}
+
+// syncSnapshot returns a page of the live tasks of the user. It always reports
+// more to come, as the changes made while paging follow the snapshot.
+func (t *taskImpl) syncSnapshot(ctx context.Context, userID int64, token *syncToken, pageSize int) (*SyncTasksResponse, error) {
+	taskModels, err := t.TaskRepo.ListAllTasks(ctx, userID, token.AfterID, pageSize+1)
+	if err != nil {
+		return nil, err
+	}
+	next := &syncToken{Seq: token.Seq}
+	if len(taskModels) > pageSize {
+		taskModels = taskModels[:pageSize]
+		next.Snapshot, next.AfterID = true, taskModels[len(taskModels)-1].ID
+	}
+
+	live := make([]*model.Task, 0, len(taskModels))
+	for _, taskModel := range taskModels {
+		if !taskModel.DeletedAt.Valid {
+			live = append(live, taskModel)
+		}
+	}
+	tasks := slice.Transform(live, taskPO2DO)
+	if err := t.fillTaskDetails(ctx, tasks); err != nil {
+		return nil, err
+	}
+
+	return &SyncTasksResponse{
+		Changes: slice.Transform(tasks, func(task *entity.Task) *entity.TaskChange {
+			return &entity.TaskChange{TaskID: task.ID, Task: task}
+		}),
+		NextToken: encodeSyncToken(next),
+		HasMore:   true,
+	}, nil
+}
+
+// syncChanges returns the tasks changed after the token in the order of their
+// last change within the page. A task is sent in its current state, which may
+// be newer than the page, so a later page may send it again.
+func (t *taskImpl) syncChanges(ctx context.Context, userID int64, token *syncToken, pageSize int) (*SyncTasksResponse, error) {
+	changes, err := t.ChangeRepo.ListChanges(ctx, userID, token.Seq, pageSize+1)
+	if err != nil {
+		return nil, err
+	}
+	hasMore := len(changes) > pageSize
+	if hasMore {
+		changes = changes[:pageSize]
+	}
+	if len(changes) == 0 {
+		return &SyncTasksResponse{NextToken: encodeSyncToken(token)}, nil
+	}
+
+	last := make(map[int64]int, len(changes))
+	for i, change := range changes {
+		last[change.TaskID] = i
+	}
+	var taskIDs []int64
+	for i, change := range changes {
+		if last[change.TaskID] == i {
+			taskIDs = append(taskIDs, change.TaskID)
+		}
+	}
+
+	// tasks missing from the live ones are in the recycle bin or purged
+	taskModels, err := t.TaskRepo.GetTasksByIDs(ctx, userID, taskIDs)
+	if err != nil {
+		return nil, err
+	}
+	tasks := slice.Transform(taskModels, taskPO2DO)
+	if err := t.fillTaskDetails(ctx, tasks); err != nil {
+		return nil, err
+	}
+	byID := slice.ToMap(tasks, func(task *entity.Task) (int64, *entity.Task) {
+		return task.ID, task
+	})
+
+	return &SyncTasksResponse{
+		Changes: slice.Transform(taskIDs, func(id int64) *entity.TaskChange {
+			task := byID[id]
+			return &entity.TaskChange{TaskID: id, Deleted: task == nil, Task: task}
+		}),
+		NextToken: encodeSyncToken(&syncToken{Seq: changes[len(changes)-1].Seq}),
+		HasMore:   hasMore,
+	}, nil
+}
+
+func (t *taskImpl) PushTaskChanges(ctx context.Context, userID int64, changes []*ClientChange) ([]*BatchResult, error) {
+	ctx = dal.WithEventType(ctx, entity.TaskUpdated.Int32())
+
+	if err := checkBatchSize(len(changes)); err != nil {
+		return nil, err
+	}
+
+	results := make([]*BatchResult, len(changes))
+	for i, change := range changes {
+		task, err := t.pushChange(ctx, userID, change)
+		if err != nil && !isItemError(err) {
+			return nil, err
+		}
+		results[i] = &BatchResult{TaskID: change.TaskID, Task: task, Err: err}
+		if task != nil {
+			results[i].TaskID = task.ID
+		}
+	}
+
+	return results, nil
+}
+
+// pushChange applies a change of a client and returns the task after it, or the
+// current task along with a version conflict.
+func (t *taskImpl) pushChange(ctx context.Context, userID int64, change *ClientChange) (*entity.Task, error) {
+	switch change.Op {
+	case entity.CreateChange:
+		if change.Create == nil {
+			return nil, errorx.New(errno.ErrTaskInvalidParamCode, errorx.KV("msg", "create change holds no task"))
+		}
+		change.Create.UserID = userID
+		return t.Create(ctx, change.Create)
+	case entity.UpdateChange:
+		if change.Update == nil && change.Status == nil {
+			return nil, errorx.New(errno.ErrTaskInvalidParamCode, errorx.KV("msg", "update change holds no fields"))
+		}
+	case entity.DeleteChange:
+	default:
+		return nil, errorx.New(errno.ErrTaskInvalidParamCode, errorx.KV("msg", "invalid change op"))
+	}
+
+	taskModel, err := t.getOwnedTask(ctx, userID, change.TaskID)
+	if err != nil {
+		return nil, err
+	}
+	// deleting a deleted task is a no-op whatever the client saw
+	if change.Op == entity.DeleteChange && taskModel.DeletedAt.Valid {
+		return nil, nil
+	}
+	if taskModel.Version != change.BaseVersion {
+		return t.pushConflict(ctx, userID, change.TaskID)
+	}
+
+	if change.Op == entity.DeleteChange {
+		return nil, t.trashTask(ctx, taskModel)
+	}
+
+	if change.Update != nil {
+		update := *change.Update
+		update.UserID, update.TaskID, update.Version = userID, change.TaskID, &change.BaseVersion
+		if err := t.UpdateTask(ctx, &update); err != nil {
+			if isVersionConflict(err) {
+				return t.pushConflict(ctx, userID, change.TaskID)
+			}
+			return nil, err
+		}
+	}
+	if change.Status != nil {
+		if err := t.UpdateTaskStatus(ctx, userID, change.TaskID, *change.Status); err != nil {
+			return nil, err
+		}
+	}
+
+	return t.GetTask(ctx, userID, change.TaskID)
+}
+
+// pushConflict returns the current task along with the conflict, trashed
+// tasks included so the client learns they were deleted.
+func (t *taskImpl) pushConflict(ctx context.Context, userID, taskID int64) (*entity.Task, error) {
+	taskModel, err := t.getOwnedTask(ctx, userID, taskID)
+	if err != nil {
+		return nil, err
+	}
+	current, err := t.taskDetail(ctx, taskModel)
+	if err != nil {
+		return nil, err
+	}
+
+	return current, errorx.New(errno.ErrTaskVersionConflictCode, errorx.KV("task_id", conv.Int64ToStr(taskID)))
+}
+
+func isVersionConflict(err error) bool {
+	var statusErr errorx.StatusError
+	return errors.As(err, &statusErr) && statusErr.Code() == errno.ErrTaskVersionConflictCode
+}
+
+func encodeSyncToken(token *syncToken) string {
+	b, _ := json.Marshal(token)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodeSyncToken(s string) (*syncToken, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, errorx.WrapByCode(err, errno.ErrTaskInvalidParamCode, errorx.KV("msg", "invalid sync token"))
+	}
+
+	token := &syncToken{}
+	if err := json.Unmarshal(b, token); err != nil {
+		return nil, errorx.WrapByCode(err, errno.ErrTaskInvalidParamCode, errorx.KV("msg", "invalid sync token"))
+	}
+
+	return token, nil
+}
@@ -0,0 +1,202 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/entity"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/lang/ptr"
+	"github.com/crazyfrankie/zrpc-todolist/types/errno"
+)
+
+// syncAll pages through the changes after the token and returns them along
+// with the token to sync from next.
+func syncAll(t *testing.T, d Task, token string, pageSize int) ([]*entity.TaskChange, string) {
+	t.Helper()
+
+	var changes []*entity.TaskChange
+	for range 100 {
+		resp, err := d.SyncTasks(context.Background(), &SyncTasksRequest{UserID: ownerID, SinceToken: token, PageSize: pageSize})
+		if err != nil {
+			t.Fatal(err)
+		}
+		changes = append(changes, resp.Changes...)
+		token = resp.NextToken
+		if !resp.HasMore {
+			return changes, token
+		}
+	}
+	t.Fatal("sync never caught up")
+	return nil, ""
+}
+
+func TestSyncTasks(t *testing.T) {
+	ctx := context.Background()
+	d, ids := newBatchTest(t, "a", "b", "c", "d", "e")
+
+	if _, err := d.Create(ctx, &CreateTaskRequest{UserID: otherID, Title: "not mine"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.DeleteTask(ctx, ownerID, ids[4]); err != nil {
+		t.Fatal(err)
+	}
+
+	// the snapshot holds the live tasks of the user
+	changes, token := syncAll(t, d, "", 2)
+	if len(changes) != 4 {
+		t.Fatalf("snapshot holds %d tasks, want 4", len(changes))
+	}
+	for i, change := range changes {
+		if change.TaskID != ids[i] || change.Deleted || change.Task == nil || change.Task.ID != ids[i] {
+			t.Fatalf("snapshot change %d = %+v, want task %d", i, change, ids[i])
+		}
+	}
+
+	// the token round trips to nothing new
+	again, next := syncAll(t, d, token, 2)
+	if len(again) != 0 || next != token {
+		t.Fatalf("sync after the snapshot = %d changes, token %q, want none and %q", len(again), next, token)
+	}
+
+	if err := d.UpdateTask(ctx, &UpdateTaskRequest{UserID: ownerID, TaskID: ids[0], Title: ptr.Of("a2")}); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.DeleteTask(ctx, ownerID, ids[1]); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.PurgeTask(ctx, ownerID, ids[4]); err != nil {
+		t.Fatal(err)
+	}
+	moved, err := d.MoveTask(ctx, &MoveTaskRequest{UserID: ownerID, TaskID: ids[3], AfterID: ids[2]})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the second write of the task moves it to the end of the log
+	if err := d.UpdateTask(ctx, &UpdateTaskRequest{UserID: ownerID, TaskID: ids[0], Title: ptr.Of("a3")}); err != nil {
+		t.Fatal(err)
+	}
+
+	since := token
+	changes, token = syncAll(t, d, since, 10)
+	want := []struct {
+		id      int64
+		deleted bool
+	}{
+		{ids[1], true},
+		{ids[4], true},
+		{ids[3], false},
+		{ids[0], false},
+	}
+	if len(changes) != len(want) {
+		t.Fatalf("got %d changes, want %d", len(changes), len(want))
+	}
+	for i, w := range want {
+		change := changes[i]
+		if change.TaskID != w.id || change.Deleted != w.deleted || (change.Task == nil) != w.deleted {
+			t.Errorf("change %d = %+v, want task %d deleted %v", i, change, w.id, w.deleted)
+		}
+	}
+	if got := changes[2].Task; got.Rank != moved.Rank {
+		t.Errorf("moved task rank = %q, want %q", got.Rank, moved.Rank)
+	}
+	if got := changes[3].Task; got.Title != "a3" {
+		t.Errorf("updated task title = %q, want the last one", got.Title)
+	}
+
+	if again, _ := syncAll(t, d, token, 10); len(again) != 0 {
+		t.Errorf("sync after the changes = %d changes, want none", len(again))
+	}
+
+	// smaller pages may send a task again, applied in order they end the same
+	paged, _ := syncAll(t, d, since, 2)
+	final := make(map[int64]*entity.TaskChange)
+	for _, change := range paged {
+		final[change.TaskID] = change
+	}
+	if len(final) != len(want) {
+		t.Fatalf("paged sync touched %d tasks, want %d", len(final), len(want))
+	}
+	for _, w := range want {
+		change := final[w.id]
+		if change == nil || change.Deleted != w.deleted {
+			t.Errorf("paged sync of task %d = %+v, want deleted %v", w.id, change, w.deleted)
+		}
+	}
+	if got := final[ids[0]].Task; got == nil || got.Title != "a3" {
+		t.Errorf("paged sync of the updated task = %+v, want the last title", got)
+	}
+}
+
+func TestSyncTasksInvalidToken(t *testing.T) {
+	d, _ := newBatchTest(t)
+
+	for _, token := range []string{"not base64!", "bm90IGpzb24"} {
+		_, err := d.SyncTasks(context.Background(), &SyncTasksRequest{UserID: ownerID, SinceToken: token})
+		assertCode(t, err, errno.ErrTaskInvalidParamCode)
+	}
+}
+
+func TestPushTaskChanges(t *testing.T) {
+	ctx := context.Background()
+	d, ids := newBatchTest(t, "fresh", "stale", "stale delete", "trashed")
+
+	tasks := make([]*entity.Task, len(ids))
+	for i, id := range ids {
+		task, err := d.GetTask(ctx, ownerID, id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		tasks[i] = task
+	}
+	// another client got there first
+	for _, id := range ids[1:3] {
+		if err := d.UpdateTask(ctx, &UpdateTaskRequest{UserID: ownerID, TaskID: id, Title: ptr.Of("theirs")}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := d.DeleteTask(ctx, ownerID, ids[3]); err != nil {
+		t.Fatal(err)
+	}
+
+	results, err := d.PushTaskChanges(ctx, ownerID, []*ClientChange{
+		{Op: entity.UpdateChange, TaskID: ids[0], BaseVersion: tasks[0].Version, Update: &UpdateTaskRequest{Title: ptr.Of("mine")}},
+		{Op: entity.UpdateChange, TaskID: ids[1], BaseVersion: tasks[1].Version, Update: &UpdateTaskRequest{Title: ptr.Of("mine")}},
+		{Op: entity.DeleteChange, TaskID: ids[2], BaseVersion: tasks[2].Version},
+		{Op: entity.UpdateChange, TaskID: ids[3], BaseVersion: tasks[3].Version, Status: ptr.Of(entity.DoneStatus)},
+		{Op: entity.CreateChange, Create: &CreateTaskRequest{Title: "offline"}},
+		{Op: entity.UpdateChange, TaskID: ids[0]},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertResults(t, results, nil, []int32{
+		0,
+		errno.ErrTaskVersionConflictCode,
+		errno.ErrTaskVersionConflictCode,
+		errno.ErrTaskVersionConflictCode,
+		0,
+		errno.ErrTaskInvalidParamCode,
+	})
+
+	if got := results[0].Task; got == nil || got.Title != "mine" || got.Version <= tasks[0].Version {
+		t.Errorf("applied change returned %+v", got)
+	}
+	// conflicts return the current task, trashed ones included
+	for i, title := range []string{"theirs", "theirs", "trashed"} {
+		res := results[i+1]
+		if res.Task == nil || res.Task.Title != title {
+			t.Errorf("conflict of task %d returned %+v, want the current task", res.TaskID, res.Task)
+		}
+	}
+	if got := results[2].Task; got.Version <= tasks[2].Version {
+		t.Errorf("conflict returned version %d, want newer than %d", got.Version, tasks[2].Version)
+	}
+	if got := results[4].Task; got == nil || got.UserID != ownerID || results[4].TaskID != got.ID {
+		t.Errorf("created task = %+v", got)
+	}
+
+	// the stale delete left the task alone
+	if _, err := d.GetTask(ctx, ownerID, ids[2]); err != nil {
+		t.Errorf("GetTask() = %v", err)
+	}
+}
@@ -0,0 +1,29 @@
+package service
+
+import (
+	"context"
+
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/entity"
+)
+
+type CreateTagRequest struct {
+	UserID int64
+	Name   string
+	Color  string
+}
+
+type UpdateTagRequest struct {
+	UserID int64
+	TagID  int64
+	Name   *string
+	Color  *string
+}
+
+type Tag interface {
+	CreateTag(ctx context.Context, req *CreateTagRequest) (*entity.Tag, error)
+	UpdateTag(ctx context.Context, req *UpdateTagRequest) (*entity.Tag, error)
+	// DeleteTag deletes the tag and detaches it from its tasks, the tasks are kept.
+	DeleteTag(ctx context.Context, userID, tagID int64) error
+	// ListTags returns the tags of the user with their task counts.
+	ListTags(ctx context.Context, userID int64) ([]*entity.Tag, error)
+}
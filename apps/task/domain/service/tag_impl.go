@@ -0,0 +1,287 @@
+package service
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/entity"
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/internal/dal"
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/internal/dal/model"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/errorx"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/lang/conv"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/lang/ptr"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/lang/slice"
+	"github.com/crazyfrankie/zrpc-todolist/types/errno"
+)
+
+const (
+	maxTagNameLength = 64
+	maxTaskTags      = 20
+)
+
+var tagColorRegexp = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+
+type tagImpl struct {
+	*Components
+}
+
+func NewTagDomain(c *Components) Tag {
+	return &tagImpl{c}
+}
+
+func (t *tagImpl) CreateTag(ctx context.Context, req *CreateTagRequest) (*entity.Tag, error) {
+	name, err := normalizeTagName(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	color, err := normalizeTagColor(req.Color)
+	if err != nil {
+		return nil, err
+	}
+
+	exist, err := t.TagRepo.CheckTagNameExist(ctx, req.UserID, name)
+	if err != nil {
+		return nil, err
+	}
+	if exist {
+		return nil, errorx.New(errno.ErrTagNameExistCode, errorx.KV("name", name))
+	}
+
+	newTag := &model.Tag{
+		UserID: req.UserID,
+		Name:   name,
+		Color:  color,
+	}
+	if err := t.TagRepo.Create(ctx, newTag); err != nil {
+		return nil, err
+	}
+
+	return tagPO2DO(newTag), nil
+}
+
+func (t *tagImpl) UpdateTag(ctx context.Context, req *UpdateTagRequest) (*entity.Tag, error) {
+	tagModel, err := t.getOwnedTag(ctx, req.UserID, req.TagID)
+	if err != nil {
+		return nil, err
+	}
+
+	updates := map[string]any{
+		"updated_at": time.Now().UnixMilli(),
+	}
+	if req.Name != nil {
+		name, err := normalizeTagName(ptr.From(req.Name))
+		if err != nil {
+			return nil, err
+		}
+		// names are compared case insensitively, so a tag may change the case of its own name
+		if !strings.EqualFold(name, tagModel.Name) {
+			exist, err := t.TagRepo.CheckTagNameExist(ctx, req.UserID, name)
+			if err != nil {
+				return nil, err
+			}
+			if exist {
+				return nil, errorx.New(errno.ErrTagNameExistCode, errorx.KV("name", name))
+			}
+		}
+		updates["name"] = name
+		tagModel.Name = name
+	}
+	if req.Color != nil {
+		color, err := normalizeTagColor(ptr.From(req.Color))
+		if err != nil {
+			return nil, err
+		}
+		updates["color"] = color
+		tagModel.Color = color
+	}
+
+	ok, err := t.TagRepo.UpdateTag(ctx, req.UserID, req.TagID, updates)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, errorx.New(errno.ErrTagNotFoundCode, errorx.KV("tag_id", conv.Int64ToStr(req.TagID)))
+	}
+	tagModel.UpdatedAt = updates["updated_at"].(int64)
+
+	return tagPO2DO(tagModel), nil
+}
+
+func (t *tagImpl) DeleteTag(ctx context.Context, userID, tagID int64) error {
+	ok, err := t.TagRepo.DeleteTag(ctx, userID, tagID)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errorx.New(errno.ErrTagNotFoundCode, errorx.KV("tag_id", conv.Int64ToStr(tagID)))
+	}
+
+	return nil
+}
+
+func (t *tagImpl) ListTags(ctx context.Context, userID int64) ([]*entity.Tag, error) {
+	tagModels, err := t.TagRepo.ListTags(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if len(tagModels) == 0 {
+		return []*entity.Tag{}, nil
+	}
+
+	counts, err := t.TagRepo.CountTasks(ctx, slice.Transform(tagModels, func(m *model.Tag) int64 {
+		return m.ID
+	}))
+	if err != nil {
+		return nil, err
+	}
+	countMap := slice.ToMap(counts, func(c *dal.TagCount) (int64, int64) {
+		return c.TagID, c.Count
+	})
+
+	tags := make([]*entity.Tag, 0, len(tagModels))
+	for _, tagModel := range tagModels {
+		tag := tagPO2DO(tagModel)
+		tag.TaskCount = countMap[tagModel.ID]
+		tags = append(tags, tag)
+	}
+
+	return tags, nil
+}
+
+func (t *tagImpl) getOwnedTag(ctx context.Context, userID, tagID int64) (*model.Tag, error) {
+	tagModel, exist, err := t.TagRepo.GetTagByID(ctx, tagID)
+	if err != nil {
+		return nil, err
+	}
+	if !exist || tagModel.UserID != userID {
+		return nil, errorx.New(errno.ErrTagNotFoundCode, errorx.KV("tag_id", conv.Int64ToStr(tagID)))
+	}
+
+	return tagModel, nil
+}
+
+// checkTaskTags dedups the tag IDs and makes sure every tag belongs to the user.
+func (t *taskImpl) checkTaskTags(ctx context.Context, userID int64, tagIDs []int64) ([]int64, error) {
+	if len(tagIDs) == 0 {
+		return nil, nil
+	}
+
+	ids := slice.Unique(tagIDs)
+	if len(ids) > maxTaskTags {
+		return nil, errorx.New(errno.ErrTaskInvalidParamCode, errorx.KV("msg", "too many tags"))
+	}
+
+	tagModels, err := t.TagRepo.GetTagsByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	owned := make(map[int64]bool, len(tagModels))
+	for _, tagModel := range tagModels {
+		owned[tagModel.ID] = tagModel.UserID == userID
+	}
+	for _, id := range ids {
+		if !owned[id] {
+			return nil, errorx.New(errno.ErrTagNotFoundCode, errorx.KV("tag_id", conv.Int64ToStr(id)))
+		}
+	}
+
+	return ids, nil
+}
+
+// tagChanges validates the tags to attach to and detach from a task of the user.
+func (t *taskImpl) tagChanges(ctx context.Context, userID int64, attach, detach []int64) (*dal.TagChanges, error) {
+	if len(attach) == 0 && len(detach) == 0 {
+		return nil, nil
+	}
+
+	detachSet := slice.ToMap(detach, func(id int64) (int64, bool) {
+		return id, true
+	})
+	for _, id := range attach {
+		if detachSet[id] {
+			return nil, errorx.New(errno.ErrTaskInvalidParamCode,
+				errorx.KV("msg", "tag "+conv.Int64ToStr(id)+" is both attached and detached"))
+		}
+	}
+
+	attach, err := t.checkTaskTags(ctx, userID, attach)
+	if err != nil {
+		return nil, err
+	}
+
+	// detaching a tag the task doesn't carry is a no-op, so it needs no check
+	return &dal.TagChanges{Attach: attach, Detach: slice.Unique(detach)}, nil
+}
+
+// fillTags loads the tags of the tasks.
+func (t *taskImpl) fillTags(ctx context.Context, tasks []*entity.Task) error {
+	if len(tasks) == 0 {
+		return nil
+	}
+
+	relations, err := t.TagRepo.ListTaskTags(ctx, slice.Transform(tasks, func(task *entity.Task) int64 {
+		return task.ID
+	}))
+	if err != nil || len(relations) == 0 {
+		return err
+	}
+
+	tagModels, err := t.TagRepo.GetTagsByIDs(ctx, slice.Unique(slice.Transform(relations, func(r *model.TaskTag) int64 {
+		return r.TagID
+	})))
+	if err != nil {
+		return err
+	}
+	tags := slice.ToMap(tagModels, func(m *model.Tag) (int64, *entity.Tag) {
+		return m.ID, tagPO2DO(m)
+	})
+
+	taskTags := make(map[int64][]*entity.Tag, len(tasks))
+	for _, r := range relations {
+		if tag, ok := tags[r.TagID]; ok {
+			taskTags[r.TaskID] = append(taskTags[r.TaskID], tag)
+		}
+	}
+	for _, task := range tasks {
+		task.Tags = taskTags[task.ID]
+	}
+
+	return nil
+}
+
+func normalizeTagName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", errorx.New(errno.ErrTaskInvalidParamCode, errorx.KV("msg", "tag name is empty"))
+	}
+	if utf8.RuneCountInString(name) > maxTagNameLength {
+		return "", errorx.New(errno.ErrTaskInvalidParamCode, errorx.KV("msg", "tag name is too long"))
+	}
+
+	return name, nil
+}
+
+func normalizeTagColor(color string) (string, error) {
+	if color == "" {
+		return "", nil
+	}
+	if !tagColorRegexp.MatchString(color) {
+		return "", errorx.New(errno.ErrTaskInvalidParamCode, errorx.KV("msg", "tag color must be #RRGGBB"))
+	}
+
+	return strings.ToLower(color), nil
+}
+
+func tagPO2DO(tagModel *model.Tag) *entity.Tag {
+	return &entity.Tag{
+		ID:        tagModel.ID,
+		UserID:    tagModel.UserID,
+		Name:      tagModel.Name,
+		Color:     tagModel.Color,
+		CreatedAt: tagModel.CreatedAt,
+		UpdatedAt: tagModel.UpdatedAt,
+	}
+}
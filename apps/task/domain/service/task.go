@@ -2,26 +2,328 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/entity"
+	"github.com/crazyfrankie/zrpc-todolist/infra/contract/eventbus"
 )
 
 type CreateTaskRequest struct {
-	UserID  int64
-	Title   string
-	Content string
+	UserID   int64
+	Title    string
+	Content  string
+	DueAt    *int64
+	RemindAt *int64
+	TagIDs   []int64
+	// ProjectID zero puts the task in the inbox.
+	ProjectID int64
+	// ParentID makes the task a subtask, it lives in the project of its parent.
+	ParentID int64
+	// AutoComplete finishes the task once all of its subtasks and checklist items are done.
+	AutoComplete bool
+	Priority     entity.Priority
+
+	// Recurrence requires DueAt, which becomes the start of the series.
+	Recurrence *entity.Recurrence
 }
 
 type UpdateTaskRequest struct {
+	UserID     int64
+	TaskID     int64
+	Title      *string
+	Content    *string
+	DueAt      *int64
+	RemindAt   *int64
+	Recurrence *entity.Recurrence
+	Scope      entity.UpdateScope
+	// ProjectID moves the task to another project, zero means the inbox.
+	ProjectID *int64
+	// ParentID moves the task under another task, zero makes it a top level task.
+	ParentID     *int64
+	AutoComplete *bool
+	Priority     *entity.Priority
+	// AttachTagIDs and DetachTagIDs change the tags of the task, or of every
+	// open occurrence in the WholeSeries scope.
+	AttachTagIDs []int64
+	DetachTagIDs []int64
+	// Version makes the update fail with a conflict unless the task is still
+	// at this version, nil skips the check.
+	Version *int64
+
+	ClearDueAt      bool
+	ClearRemindAt   bool
+	ClearRecurrence bool
+}
+
+type ListTasksRequest struct {
+	UserID   int64
+	PageSize int
+	Cursor   string
+	SortBy   entity.SortField
+	Asc      bool
+
+	// Time range filters in milliseconds, zero means unbounded.
+	CreatedAfter  int64
+	CreatedBefore int64
+	UpdatedAfter  int64
+	UpdatedBefore int64
+
+	// Statuses filters the listed tasks, empty means the open ones.
+	Statuses []entity.Status
+	// TagIDs keeps the tasks carrying any of the tags.
+	TagIDs []int64
+	// ProjectID scopes the list to a project, zero means all projects.
+	ProjectID int64
+	// Tree pages through the top level tasks and nests their subtasks.
+	Tree bool
+	// Actionable drops the tasks with open blockers.
+	Actionable bool
+}
+
+type ListTasksResponse struct {
+	Tasks      []*entity.Task
+	NextCursor string
+	HasMore    bool
+}
+
+type SearchTasksRequest struct {
+	UserID   int64
+	Keyword  string
+	Statuses []entity.Status
+	Page     int
+	PageSize int
+}
+
+type SearchHit struct {
+	Task             *entity.Task
+	Score            float64
+	TitleHighlight   string
+	ContentHighlight string
+}
+
+type SearchTasksResponse struct {
+	Hits  []*SearchHit
+	Total int64
+}
+
+type ListDueTasksRequest struct {
+	UserID   int64
+	View     entity.DueView
+	Now      time.Time
+	Location *time.Location
+}
+
+// PreviewOccurrencesRequest previews the occurrences following the current one
+// of TaskID, or if TaskID is zero the first occurrences of Recurrence from Start.
+type PreviewOccurrencesRequest struct {
+	UserID     int64
+	TaskID     int64
+	Recurrence *entity.Recurrence
+	Start      int64
+	Count      int
+}
+
+// MoveTaskRequest places the task between BeforeID and AfterID in the manual
+// order, zero for either means the start or the end of the list.
+type MoveTaskRequest struct {
+	UserID   int64
+	TaskID   int64
+	BeforeID int64
+	AfterID  int64
+}
+
+// TaskHistoryRequest pages through the activity log of a task, newest first.
+type TaskHistoryRequest struct {
+	UserID   int64
+	TaskID   int64
+	Cursor   string
+	PageSize int
+}
+
+type TaskHistoryResponse struct {
+	Activities []*entity.TaskActivity
+	NextCursor string
+	HasMore    bool
+}
+
+// ListCommentsRequest pages through the comments on a task, oldest first.
+type ListCommentsRequest struct {
+	UserID   int64
+	TaskID   int64
+	Cursor   string
+	PageSize int
+}
+
+type ListCommentsResponse struct {
+	Comments   []*entity.Comment
+	NextCursor string
+	HasMore    bool
+}
+
+type UploadAttachmentRequest struct {
+	UserID  int64
 	TaskID  int64
-	Title   *string
+	Name    string
+	Content []byte
+}
+
+// BatchResult is the outcome of one item of a batch, in request order. Err tells
+// why the item was skipped, Task is the task created by BatchCreateTasks or the
+// task after a change pushed by PushTaskChanges.
+type BatchResult struct {
+	TaskID int64
+	Task   *entity.Task
+	Err    error
+}
+
+type ImportTasksRequest struct {
+	UserID  int64
+	Format  entity.TransferFormat
+	Content []byte
+	// DryRun checks the rows without creating anything.
+	DryRun bool
+}
+
+type QuickAddRequest struct {
+	UserID int64
+	Text   string
+	// TimeZone is the IANA zone the dates of the text are read in, empty means UTC.
+	TimeZone string
+	// ProjectID zero puts the task in the inbox.
+	ProjectID int64
+}
+
+// SyncTasksRequest pages through the changes of the tasks of the user after
+// SinceToken, an empty token starts with a snapshot of the live tasks.
+type SyncTasksRequest struct {
+	UserID     int64
+	SinceToken string
+	PageSize   int
+}
+
+type SyncTasksResponse struct {
+	Changes   []*entity.TaskChange
+	NextToken string
+	HasMore   bool
+}
+
+// ListTaskEventsRequest pages through the task events of the user after AfterID,
+// the ID of the last event a client saw.
+type ListTaskEventsRequest struct {
+	UserID  int64
+	AfterID int64
+	Limit   int
+}
+
+type ListTaskEventsResponse struct {
+	Events  []*entity.TaskEvent
+	HasMore bool
+}
+
+// ClientChange is a change made by a client while offline. Updates and deletes
+// only apply while the task is still at BaseVersion, the version the client
+// changed, the UserID, TaskID and Version of Update are ignored.
+type ClientChange struct {
+	Op          entity.ClientChangeOp
+	TaskID      int64
+	BaseVersion int64
+	Create      *CreateTaskRequest
+	Update      *UpdateTaskRequest
+	// Status moves the task along with an update.
+	Status *entity.Status
+}
+
+type UpdateChecklistItemRequest struct {
+	UserID  int64
+	ItemID  int64
 	Content *string
+	Checked *bool
 }
 
 type Task interface {
 	Create(ctx context.Context, req *CreateTaskRequest) (*entity.Task, error)
-	GetTaskList(ctx context.Context, userID int64) ([]*entity.Task, error)
+	GetTask(ctx context.Context, userID, taskID int64) (*entity.Task, error)
+	GetTaskList(ctx context.Context, req *ListTasksRequest) (*ListTasksResponse, error)
 	UpdateTask(ctx context.Context, req *UpdateTaskRequest) error
-	UpdateTaskStatus(ctx context.Context, taskID int64, status int32) error
-	GetTaskRecycleList(ctx context.Context, userID int64) ([]*entity.Task, error)
+	UpdateTaskStatus(ctx context.Context, userID, taskID int64, status entity.Status) error
+	GetTaskRecycleList(ctx context.Context, req *ListTasksRequest) (*ListTasksResponse, error)
+	SearchTasks(ctx context.Context, req *SearchTasksRequest) (*SearchTasksResponse, error)
+	ListDueTasks(ctx context.Context, req *ListDueTasksRequest) ([]*entity.Task, error)
+	// DispatchDueReminders sends the reminders due at now, it returns the number of sent reminders.
+	DispatchDueReminders(ctx context.Context, now time.Time) (int, error)
+	DeleteTask(ctx context.Context, userID, taskID int64) error
+	RestoreTask(ctx context.Context, userID, taskID int64) error
+	PurgeTask(ctx context.Context, userID, taskID int64) error
+	// EmptyRecycleBin purges every deleted task of the user, it returns the number of purged tasks.
+	EmptyRecycleBin(ctx context.Context, userID int64) (int64, error)
+	// PurgeExpiredTasks purges the tasks deleted before the given time.
+	PurgeExpiredTasks(ctx context.Context, before time.Time) (int64, error)
+	// PreviewOccurrences returns the occurrence times in milliseconds.
+	PreviewOccurrences(ctx context.Context, req *PreviewOccurrencesRequest) ([]int64, error)
+	AddChecklistItem(ctx context.Context, userID, taskID int64, content string) (*entity.ChecklistItem, error)
+	UpdateChecklistItem(ctx context.Context, req *UpdateChecklistItemRequest) (*entity.ChecklistItem, error)
+	DeleteChecklistItem(ctx context.Context, userID, itemID int64) error
+	// AddDependency makes blockerID block taskID, a task can't be finished while a blocker is open.
+	AddDependency(ctx context.Context, userID, taskID, blockerID int64) error
+	RemoveDependency(ctx context.Context, userID, taskID, blockerID int64) error
+	MoveTask(ctx context.Context, req *MoveTaskRequest) (*entity.Task, error)
+	// RebalanceRanks spreads out the ranks of users whose ranks grew too long, it
+	// returns the number of rebalanced users.
+	RebalanceRanks(ctx context.Context) (int, error)
+	GetTaskHistory(ctx context.Context, req *TaskHistoryRequest) (*TaskHistoryResponse, error)
+	// RevertTask brings the fields of the task back to the given revision, which
+	// is a version an activity left it at. The change is recorded as a new activity.
+	RevertTask(ctx context.Context, userID, taskID, version int64) (*entity.Task, error)
+	AddComment(ctx context.Context, userID, taskID int64, content string) (*entity.Comment, error)
+	UpdateComment(ctx context.Context, userID, commentID int64, content string) (*entity.Comment, error)
+	DeleteComment(ctx context.Context, userID, commentID int64) error
+	ListComments(ctx context.Context, req *ListCommentsRequest) (*ListCommentsResponse, error)
+	// UploadAttachment stores the file and attaches it to the task, the content
+	// type is detected from the content.
+	UploadAttachment(ctx context.Context, req *UploadAttachmentRequest) (*entity.Attachment, error)
+	ListAttachments(ctx context.Context, userID, taskID int64) ([]*entity.Attachment, error)
+	// GetAttachmentURL returns a presigned download URL for the attachment.
+	GetAttachmentURL(ctx context.Context, userID, attachmentID int64) (string, error)
+	DeleteAttachment(ctx context.Context, userID, attachmentID int64) error
+	// Batch writes check every item and apply the valid ones in one transaction,
+	// the items which fail their checks are reported in the results.
+	BatchCreateTasks(ctx context.Context, userID int64, reqs []*CreateTaskRequest) ([]*BatchResult, error)
+	BatchUpdateStatus(ctx context.Context, userID int64, taskIDs []int64, status entity.Status) ([]*BatchResult, error)
+	BatchDeleteTasks(ctx context.Context, userID int64, taskIDs []int64) ([]*BatchResult, error)
+	// BatchMoveTasks moves top level tasks with their subtasks to the project, zero meaning the inbox.
+	BatchMoveTasks(ctx context.Context, userID int64, taskIDs []int64, projectID int64) ([]*BatchResult, error)
+	// ExportTasks writes every task of the user, including the recycle bin, to
+	// storage and returns a presigned download URL for the file.
+	ExportTasks(ctx context.Context, userID int64, format entity.TransferFormat) (string, error)
+	// ImportTasks creates the tasks of a file in one transaction, missing projects
+	// and tags are created by name with the rows which need them. Rows which fail
+	// their checks are reported and skipped.
+	ImportTasks(ctx context.Context, req *ImportTasksRequest) (*entity.ImportReport, error)
+	// QuickAddTask creates a task from one line of text such as
+	// "Pay rent tomorrow 9am #home !high every month", missing tags are created.
+	QuickAddTask(ctx context.Context, req *QuickAddRequest) (*entity.QuickAdd, error)
+	// SyncTasks returns the latest state of the tasks changed after a sync token,
+	// deletions included, along with the token to continue from.
+	SyncTasks(ctx context.Context, req *SyncTasksRequest) (*SyncTasksResponse, error)
+	// PushTaskChanges applies the changes of a client one by one in order. A change
+	// to a task which moved past its base version fails with a version conflict and
+	// its result carries the current task.
+	PushTaskChanges(ctx context.Context, userID int64, changes []*ClientChange) ([]*BatchResult, error)
+	// ListTaskEvents replays the task events missed by a client from the change
+	// log. Only the last change of a task is kept, as an update or a deletion.
+	ListTaskEvents(ctx context.Context, req *ListTaskEventsRequest) (*ListTaskEventsResponse, error)
+	// CreateCalendarFeed gives the user a secret token to subscribe to their
+	// dated tasks from calendar apps. The token is only returned here and by
+	// RotateCalendarFeed, a user has at most one feed.
+	CreateCalendarFeed(ctx context.Context, userID int64) (string, error)
+	// RotateCalendarFeed replaces the token of the feed, the old one stops working.
+	RotateCalendarFeed(ctx context.Context, userID int64) (string, error)
+	RevokeCalendarFeed(ctx context.Context, userID int64) error
+	// GetCalendarFeed renders the tasks due around now of the owner of the token
+	// as an iCalendar file, each task as both a VTODO and a VEVENT.
+	GetCalendarFeed(ctx context.Context, token string) (*entity.CalendarFeed, error)
+	// BroadcastTaskEvent forwards a task event of the event bus to the connected
+	// clients of the owner of the task. Each call publishes again, redeliveries
+	// are dropped by the caller.
+	BroadcastTaskEvent(ctx context.Context, event *eventbus.Event) error
 }
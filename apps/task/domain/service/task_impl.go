@@ -6,15 +6,56 @@ import (
 	"time"
 
 	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/entity"
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/internal/dal"
 	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/internal/dal/model"
 	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/repository"
+	"github.com/crazyfrankie/zrpc-todolist/infra/contract/cache"
 	"github.com/crazyfrankie/zrpc-todolist/infra/contract/idgen"
+	"github.com/crazyfrankie/zrpc-todolist/infra/contract/notify"
+	"github.com/crazyfrankie/zrpc-todolist/infra/contract/pubsub"
+	"github.com/crazyfrankie/zrpc-todolist/infra/contract/search"
+	"github.com/crazyfrankie/zrpc-todolist/infra/contract/storage"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/errorx"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/lang/conv"
 	"github.com/crazyfrankie/zrpc-todolist/pkg/lang/ptr"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/lang/slice"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/logs"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/webhook"
+	"github.com/crazyfrankie/zrpc-todolist/types/errno"
 )
 
 type Components struct {
-	TaskRepo repository.TaskRepository
-	IDGen    idgen.IDGenerator
+	TaskRepo    repository.TaskRepository
+	TagRepo     repository.TagRepository
+	ProjectRepo repository.ProjectRepository
+	// ChecklistRepo holds the checklist items of tasks.
+	ChecklistRepo repository.ChecklistRepository
+	// DependencyRepo holds the blockers of tasks.
+	DependencyRepo repository.DependencyRepository
+	// BoardRepo holds the board columns of projects.
+	BoardRepo repository.BoardRepository
+	// ActivityRepo reads the activity logs of tasks.
+	ActivityRepo repository.ActivityRepository
+	// CommentRepo holds the comments on tasks.
+	CommentRepo repository.CommentRepository
+	// ChangeRepo reads the change logs clients sync from.
+	ChangeRepo repository.ChangeRepository
+	// AttachmentRepo holds the attachments of tasks, their files live in FileOSS
+	// alongside task exports.
+	AttachmentRepo repository.AttachmentRepository
+	FileOSS        storage.Storage
+	IDGen          idgen.IDGenerator
+	Searcher       search.Searcher
+	Notifier       notify.Notifier
+	// WebhookRepo holds the webhooks of users and their delivery logs, the
+	// deliveries are posted by WebhookSender.
+	WebhookRepo   repository.WebhookRepository
+	WebhookSender *webhook.Sender
+	// CalendarRepo holds the tokens of the calendar feeds of users.
+	CalendarRepo repository.CalendarRepository
+	// PubSub carries the task events to the gateways streaming them to clients.
+	PubSub pubsub.PubSub
+	Cache  cache.Cmdable
 }
 
 type taskImpl struct {
@@ -30,38 +71,134 @@ func (t *taskImpl) Create(ctx context.Context, req *CreateTaskRequest) (*entity.
 	if err != nil {
 		return nil, fmt.Errorf("generate id error: %w", err)
 	}
+	rank, err := t.lastRank(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
 
-	newTask := &model.Task{
-		ID:      id,
-		UserID:  req.UserID,
-		Title:   req.Title,
-		Content: req.Content,
-		Status:  entity.ToDoStatus.Int32(),
+	newTask, tagIDs, err := t.newTask(ctx, req, id, rank)
+	if err != nil {
+		return nil, err
 	}
 
-	err = t.TaskRepo.Create(ctx, newTask)
+	err = t.TaskRepo.Create(dal.WithEventType(ctx, entity.TaskCreated.Int32()), newTask, tagIDs)
 	if err != nil {
 		return nil, err
 	}
 
-	return taskPO2DO(newTask), nil
+	if err := t.Searcher.Index(ctx, taskPO2Document(newTask)); err != nil {
+		logs.CtxWarnf(ctx, "index task failed, taskID=%d, err=%v", newTask.ID, err)
+	}
+
+	res := taskPO2DO(newTask)
+	if err := t.fillTaskDetails(ctx, []*entity.Task{res}); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// newTask validates the request and builds the task to create with the given ID
+// and rank, along with the IDs of its tags.
+func (t *taskImpl) newTask(ctx context.Context, req *CreateTaskRequest, id int64, rank string) (*model.Task, []int64, error) {
+	tagIDs, err := t.checkTaskTags(ctx, req.UserID, req.TagIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+	projectID, err := t.createProject(ctx, req)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !req.Priority.IsValid() {
+		return nil, nil, errorx.New(errno.ErrTaskInvalidParamCode, errorx.KV("msg", "invalid priority"))
+	}
+
+	newTask := &model.Task{
+		ID:           id,
+		UserID:       req.UserID,
+		ProjectID:    projectID,
+		ParentID:     req.ParentID,
+		AutoComplete: req.AutoComplete,
+		Priority:     req.Priority.Int32(),
+		SortKey:      rank,
+		Title:        req.Title,
+		Content:      req.Content,
+		Status:       entity.ToDoStatus.Int32(),
+		DueAt:        req.DueAt,
+		RemindAt:     req.RemindAt,
+	}
+	if req.Recurrence != nil {
+		if err := startSeries(newTask, req.Recurrence); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return newTask, tagIDs, nil
 }
 
-func (t *taskImpl) GetTaskList(ctx context.Context, userID int64) ([]*entity.Task, error) {
-	taskModels, err := t.TaskRepo.GetTasksByID(ctx, userID, entity.ToDoStatus.Int32())
+func (t *taskImpl) GetTask(ctx context.Context, userID, taskID int64) (*entity.Task, error) {
+	taskModel, err := t.getLiveTask(ctx, userID, taskID)
 	if err != nil {
 		return nil, err
 	}
 
-	tasks := make([]*entity.Task, 0, len(taskModels))
-	for _, taskModel := range taskModels {
-		tasks = append(tasks, taskPO2DO(taskModel))
+	return t.taskDetail(ctx, taskModel)
+}
+
+func (t *taskImpl) taskDetail(ctx context.Context, taskModel *model.Task) (*entity.Task, error) {
+	res := taskPO2DO(taskModel)
+	if err := t.fillTaskDetails(ctx, []*entity.Task{res}); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (t *taskImpl) GetTaskList(ctx context.Context, req *ListTasksRequest) (*ListTasksResponse, error) {
+	statuses := req.Statuses
+	if len(statuses) == 0 {
+		statuses = entity.OpenStatuses
 	}
 
-	return tasks, nil
+	return t.listTasks(ctx, req, statuses, false)
 }
 
 func (t *taskImpl) UpdateTask(ctx context.Context, req *UpdateTaskRequest) error {
+	ctx = dal.WithEventType(ctx, entity.TaskUpdated.Int32())
+
+	updates, oldParentID, err := t.taskUpdates(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	tags, err := t.tagChanges(ctx, req.UserID, req.AttachTagIDs, req.DetachTagIDs)
+	if err != nil {
+		return err
+	}
+
+	if req.Recurrence != nil || req.ClearRecurrence || req.Scope == entity.WholeSeries {
+		err = t.updateRecurringTask(ctx, req, updates, tags)
+	} else {
+		err = t.updateTask(ctx, req, updates, tags)
+	}
+	if err != nil {
+		return err
+	}
+
+	// the subtasks left behind, or a parent which just asked for it, may all be done now
+	if oldParentID != 0 && oldParentID != ptr.From(req.ParentID) {
+		t.autoComplete(ctx, req.UserID, oldParentID)
+	}
+	if ptr.From(req.AutoComplete) {
+		t.autoComplete(ctx, req.UserID, req.TaskID)
+	}
+
+	return nil
+}
+
+// taskUpdates returns the column updates of the request and the parent the task
+// leaves, if it moves.
+func (t *taskImpl) taskUpdates(ctx context.Context, req *UpdateTaskRequest) (map[string]any, int64, error) {
 	updates := map[string]any{
 		"updated_at": time.Now().UnixMilli(),
 	}
@@ -72,36 +209,246 @@ func (t *taskImpl) UpdateTask(ctx context.Context, req *UpdateTaskRequest) error
 	if req.Content != nil {
 		updates["content"] = ptr.From(req.Content)
 	}
+	if req.ClearDueAt {
+		updates["due_at"] = nil
+	} else if req.DueAt != nil {
+		updates["due_at"] = ptr.From(req.DueAt)
+	}
+	// a new reminder time re-arms the reminder
+	if req.ClearRemindAt {
+		updates["remind_at"] = nil
+		updates["reminded_at"] = nil
+	} else if req.RemindAt != nil {
+		updates["remind_at"] = ptr.From(req.RemindAt)
+		updates["reminded_at"] = nil
+	}
+
+	if req.AutoComplete != nil {
+		updates["auto_complete"] = ptr.From(req.AutoComplete)
+	}
+	if req.Priority != nil {
+		if !req.Priority.IsValid() {
+			return nil, 0, errorx.New(errno.ErrTaskInvalidParamCode, errorx.KV("msg", "invalid priority"))
+		}
+		updates["priority"] = req.Priority.Int32()
+	}
+	oldParentID, err := t.treeUpdates(ctx, req, updates)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return updates, oldParentID, nil
+}
+
+func (t *taskImpl) updateTask(ctx context.Context, req *UpdateTaskRequest, updates map[string]any, tags *dal.TagChanges) error {
+	ok, err := t.TaskRepo.UpdateTask(ctx, req.UserID, req.TaskID, req.Version, updates, tags)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return t.updateMissed(ctx, req.UserID, req.TaskID, req.Version)
+	}
+
+	t.syncSearchIndex(ctx, req.TaskID)
+
+	return nil
+}
+
+func (t *taskImpl) UpdateTaskStatus(ctx context.Context, userID, taskID int64, status entity.Status) error {
+	if !status.IsValid() {
+		return errorx.New(errno.ErrTaskInvalidParamCode, errorx.KV("msg", "invalid status"))
+	}
+	ctx = dal.WithEventType(ctx, entity.TaskStatusChanged.Int32(), taskID)
+
+	// trashed tasks leave the recycle bin through RestoreTask
+	taskModel, err := t.getLiveTask(ctx, userID, taskID)
+	if err != nil {
+		return err
+	}
+
+	from := entity.Status(taskModel.Status)
+	if from == status {
+		return nil
+	}
+	if !from.CanTransitTo(status) {
+		return errorx.New(errno.ErrTaskInvalidStatusTransitionCode,
+			errorx.KV("from", from.String()), errorx.KV("to", status.String()))
+	}
+
+	// trashed tasks live in the recycle bin
+	if status == entity.TrashedStatus {
+		return t.trashTask(ctx, taskModel)
+	}
 
-	return t.TaskRepo.UpdateTask(ctx, req.TaskID, updates)
+	if status == entity.DoneStatus {
+		if err := t.checkBlockers(ctx, taskID); err != nil {
+			return err
+		}
+	}
+	if status == entity.DoneStatus && taskModel.Recurrence != "" {
+		finished, err := t.finishOccurrence(ctx, taskModel)
+		if err != nil {
+			return err
+		}
+		if finished {
+			t.autoComplete(ctx, userID, taskModel.ParentID)
+			return nil
+		}
+	}
+
+	ok, err := t.TaskRepo.UpdateTaskStatus(ctx, userID, taskID, from.Int32(), status.Int32())
+	if err != nil {
+		return err
+	}
+	// the task changed since it was loaded
+	if !ok {
+		return errorx.New(errno.ErrTaskInvalidStatusTransitionCode,
+			errorx.KV("from", from.String()), errorx.KV("to", status.String()))
+	}
+
+	t.syncSearchIndex(ctx, taskID)
+	// finishing a subtask may complete its parent
+	if status == entity.DoneStatus {
+		t.autoComplete(ctx, userID, taskModel.ParentID)
+	}
+
+	return nil
 }
 
-func (t *taskImpl) UpdateTaskStatus(ctx context.Context, taskID int64, status int32) error {
-	return t.TaskRepo.UpdateTaskStatus(ctx, taskID, status)
+func (t *taskImpl) GetTaskRecycleList(ctx context.Context, req *ListTasksRequest) (*ListTasksResponse, error) {
+	return t.listTasks(ctx, req, nil, true)
 }
 
-func (t *taskImpl) GetTaskRecycleList(ctx context.Context, userID int64) ([]*entity.Task, error) {
-	taskModels, err := t.TaskRepo.GetTasksByID(ctx, userID, entity.FinishedStatus.Int32())
+func (t *taskImpl) listTasks(ctx context.Context, req *ListTasksRequest, statuses []entity.Status, deleted bool) (*ListTasksResponse, error) {
+	params, err := buildListParams(req, statuses)
+	if err != nil {
+		return nil, err
+	}
+	params.Deleted = deleted
+	params.TagIDs = req.TagIDs
+	params.RootOnly = req.Tree
+	if req.Actionable {
+		params.BlockingStatuses = statusesToInt32(entity.OpenStatuses)
+	}
+	if req.ProjectID != 0 {
+		params.ProjectIDs, err = t.projectFilter(ctx, req.UserID, req.ProjectID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	taskModels, err := t.TaskRepo.ListTasks(ctx, params)
 	if err != nil {
 		return nil, err
 	}
 
+	pageSize := params.Limit - 1
+	hasMore := len(taskModels) > pageSize
+	if hasMore {
+		taskModels = taskModels[:pageSize]
+	}
+
 	tasks := make([]*entity.Task, 0, len(taskModels))
 	for _, taskModel := range taskModels {
 		tasks = append(tasks, taskPO2DO(taskModel))
 	}
+	details := tasks
+	if req.Tree {
+		details, err = t.loadSubtrees(ctx, tasks)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err := t.fillTaskDetails(ctx, details); err != nil {
+		return nil, err
+	}
+
+	resp := &ListTasksResponse{
+		Tasks:   tasks,
+		HasMore: hasMore,
+	}
+	if hasMore {
+		resp.NextCursor = nextCursor(req, taskModels[len(taskModels)-1])
+	}
+
+	return resp, nil
+}
+
+// createProject returns the project of a new task, a subtask lives in the project
+// of its parent.
+func (t *taskImpl) createProject(ctx context.Context, req *CreateTaskRequest) (int64, error) {
+	if req.ParentID == 0 {
+		return t.taskProject(ctx, req.UserID, req.ProjectID)
+	}
+
+	parent, err := t.checkParent(ctx, req.UserID, req.ParentID, 0, 1)
+	if err != nil {
+		return 0, err
+	}
+	if req.ProjectID != 0 && req.ProjectID != parent.ProjectID {
+		return 0, errorx.New(errno.ErrTaskInvalidParamCode,
+			errorx.KV("msg", "subtasks live in the project of their parent"))
+	}
 
-	return tasks, nil
+	return parent.ProjectID, nil
+}
+
+// projectFilter returns the project IDs the tasks of a project may carry, the
+// inbox also holds the tasks created before projects existed.
+func (t *taskImpl) projectFilter(ctx context.Context, userID, projectID int64) ([]int64, error) {
+	projectModel, exist, err := t.ProjectRepo.GetProjectByID(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	if !exist || projectModel.UserID != userID {
+		return nil, errorx.New(errno.ErrProjectNotFoundCode, errorx.KV("project_id", conv.Int64ToStr(projectID)))
+	}
+	if ptr.From(projectModel.IsInbox) {
+		return []int64{projectID, 0}, nil
+	}
+
+	return []int64{projectID}, nil
+}
+
+// updateMissed tells why an update of the task matched no row.
+func (t *taskImpl) updateMissed(ctx context.Context, userID, taskID int64, version *int64) error {
+	if version != nil {
+		taskModel, exist, err := t.TaskRepo.GetTaskByID(ctx, taskID)
+		if err != nil {
+			return err
+		}
+		if exist && taskModel.UserID == userID && !taskModel.DeletedAt.Valid {
+			return errorx.New(errno.ErrTaskVersionConflictCode, errorx.KV("task_id", conv.Int64ToStr(taskID)))
+		}
+	}
+
+	return errorx.New(errno.ErrTaskNotFoundCode, errorx.KV("task_id", conv.Int64ToStr(taskID)))
 }
 
 func taskPO2DO(taskModel *model.Task) *entity.Task {
 	return &entity.Task{
-		ID:        taskModel.ID,
-		UserID:    taskModel.UserID,
-		Title:     taskModel.Title,
-		Content:   taskModel.Content,
-		Status:    entity.Status(taskModel.Status),
-		CreatedAt: taskModel.CreatedAt,
-		UpdatedAt: taskModel.UpdatedAt,
+		ID:           taskModel.ID,
+		UserID:       taskModel.UserID,
+		ProjectID:    taskModel.ProjectID,
+		ParentID:     taskModel.ParentID,
+		AutoComplete: taskModel.AutoComplete,
+		Title:        taskModel.Title,
+		Content:      taskModel.Content,
+		Status:       entity.Status(taskModel.Status),
+		Priority:     entity.Priority(taskModel.Priority),
+		Rank:         taskModel.SortKey,
+		DueAt:        taskModel.DueAt,
+		RemindAt:     taskModel.RemindAt,
+		Recurrence:   taskRecurrence(taskModel),
+		SeriesID:     taskModel.SeriesID,
+		Version:      taskModel.Version,
+		CreatedAt:    taskModel.CreatedAt,
+		UpdatedAt:    taskModel.UpdatedAt,
 	}
 }
+
+func statusesToInt32(statuses []entity.Status) []int32 {
+	return slice.Transform(statuses, func(s entity.Status) int32 {
+		return s.Int32()
+	})
+}
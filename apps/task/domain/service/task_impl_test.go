@@ -0,0 +1,123 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/entity"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/lang/ptr"
+	"github.com/crazyfrankie/zrpc-todolist/types/errno"
+)
+
+const (
+	ownerID = int64(1)
+	otherID = int64(2)
+)
+
+func TestTaskOwnership(t *testing.T) {
+	ctx := context.Background()
+	d := NewTaskDomain(newTestComponents(t))
+
+	task, err := d.Create(ctx, &CreateTaskRequest{UserID: ownerID, Title: "pay rent"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	trashed, err := d.Create(ctx, &CreateTaskRequest{UserID: ownerID, Title: "old chores"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := d.DeleteTask(ctx, ownerID, trashed.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		call func() error
+	}{
+		{"GetTask", func() error {
+			_, err := d.GetTask(ctx, otherID, task.ID)
+			return err
+		}},
+		{"UpdateTask", func() error {
+			return d.UpdateTask(ctx, &UpdateTaskRequest{UserID: otherID, TaskID: task.ID, Title: ptr.Of("mine now")})
+		}},
+		{"UpdateTaskStatus", func() error {
+			return d.UpdateTaskStatus(ctx, otherID, task.ID, entity.DoneStatus)
+		}},
+		{"DeleteTask", func() error {
+			return d.DeleteTask(ctx, otherID, task.ID)
+		}},
+		{"RestoreTask", func() error {
+			return d.RestoreTask(ctx, otherID, trashed.ID)
+		}},
+		{"PurgeTask", func() error {
+			return d.PurgeTask(ctx, otherID, trashed.ID)
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertCode(t, tt.call(), errno.ErrTaskNotFoundCode)
+		})
+	}
+
+	got, err := d.GetTask(ctx, ownerID, task.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Title != "pay rent" || got.Status != entity.ToDoStatus {
+		t.Errorf("task = %q %v, want it untouched", got.Title, got.Status)
+	}
+	bin, err := d.GetTaskRecycleList(ctx, &ListTasksRequest{UserID: ownerID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(bin.Tasks) != 1 || bin.Tasks[0].ID != trashed.ID {
+		t.Errorf("recycle bin = %v, want the trashed task only", bin.Tasks)
+	}
+}
+
+func TestTrashedTaskAccess(t *testing.T) {
+	ctx := context.Background()
+	d := NewTaskDomain(newTestComponents(t))
+
+	task, err := d.Create(ctx, &CreateTaskRequest{UserID: ownerID, Title: "old chores"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := d.DeleteTask(ctx, ownerID, task.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		call func() error
+	}{
+		{"GetTask", func() error {
+			_, err := d.GetTask(ctx, ownerID, task.ID)
+			return err
+		}},
+		{"UpdateTask", func() error {
+			return d.UpdateTask(ctx, &UpdateTaskRequest{UserID: ownerID, TaskID: task.ID, Title: ptr.Of("new chores")})
+		}},
+		{"UpdateTaskStatus", func() error {
+			return d.UpdateTaskStatus(ctx, ownerID, task.ID, entity.ToDoStatus)
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertCode(t, tt.call(), errno.ErrTaskNotFoundCode)
+		})
+	}
+
+	// only the recycle bin brings it back
+	if err := d.RestoreTask(ctx, ownerID, task.ID); err != nil {
+		t.Fatal(err)
+	}
+	got, err := d.GetTask(ctx, ownerID, task.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Title != "old chores" || got.Status != entity.ToDoStatus {
+		t.Errorf("task = %q %v, want it restored untouched", got.Title, got.Status)
+	}
+}
@@ -0,0 +1,824 @@
+package service
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/entity"
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/internal/dal"
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/internal/dal/model"
+	"github.com/crazyfrankie/zrpc-todolist/infra/contract/storage"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/errorx"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/ical"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/lang/ptr"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/lang/slice"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/logs"
+	"github.com/crazyfrankie/zrpc-todolist/types/errno"
+)
+
+const (
+	// exportPageSize is the number of tasks read per query while exporting.
+	exportPageSize = 500
+	// exportURLExpires is the lifetime of export download URLs in seconds.
+	exportURLExpires = 3600
+	maxImportSize    = 4 << 20
+	maxImportRows    = 1000
+	icalProdID       = "-//zrpc-todolist//Tasks//EN"
+	// csvTagSeparator separates the tag names in the tags column of CSV files.
+	csvTagSeparator = ";"
+	// icalStatusProperty keeps the exact status of a task, as VTODO statuses
+	// can't tell archived and trashed tasks apart.
+	icalStatusProperty  = "X-TASK-STATUS"
+	icalProjectProperty = "X-PROJECT"
+)
+
+var transferFiles = map[entity.TransferFormat]struct {
+	ext         string
+	contentType string
+}{
+	entity.JSONFormat: {ext: "json", contentType: "application/json"},
+	entity.CSVFormat:  {ext: "csv", contentType: "text/csv; charset=utf-8"},
+	entity.ICalFormat: {ext: "ics", contentType: "text/calendar; charset=utf-8"},
+}
+
+var csvHeader = []string{
+	"id", "parent_id", "title", "content", "status", "priority", "project", "tags",
+	"due_at", "remind_at", "recurrence", "time_zone", "created_at", "updated_at", "deleted_at",
+}
+
+// taskRecord is a task as it is exported and imported, times are RFC 3339 and
+// an empty project means the inbox. IDs are informational only, imported tasks
+// get new IDs and are created as top level tasks.
+type taskRecord struct {
+	ID         int64    `json:"id,omitempty"`
+	ParentID   int64    `json:"parent_id,omitempty"`
+	Title      string   `json:"title"`
+	Content    string   `json:"content,omitempty"`
+	Status     string   `json:"status,omitempty"`
+	Priority   string   `json:"priority,omitempty"`
+	Project    string   `json:"project,omitempty"`
+	Tags       []string `json:"tags,omitempty"`
+	DueAt      string   `json:"due_at,omitempty"`
+	RemindAt   string   `json:"remind_at,omitempty"`
+	Recurrence string   `json:"recurrence,omitempty"`
+	TimeZone   string   `json:"time_zone,omitempty"`
+	CreatedAt  string   `json:"created_at,omitempty"`
+	UpdatedAt  string   `json:"updated_at,omitempty"`
+	DeletedAt  string   `json:"deleted_at,omitempty"`
+}
+
+func (t *taskImpl) ExportTasks(ctx context.Context, userID int64, format entity.TransferFormat) (string, error) {
+	file, ok := transferFiles[format]
+	if !ok {
+		return "", errorx.New(errno.ErrTaskInvalidParamCode, errorx.KV("msg", "invalid format"))
+	}
+	// every export gets a key of its own, so a later one can't replace the file
+	// behind an earlier download URL
+	id, err := t.IDGen.GenID(ctx)
+	if err != nil {
+		return "", err
+	}
+	key := fmt.Sprintf("task_export/%d/%d.%s", userID, id, file.ext)
+
+	// the file is uploaded while it is written, so it is never held in memory
+	pr, pw := io.Pipe()
+	go func() {
+		pw.CloseWithError(t.writeExport(ctx, userID, format, pw))
+	}()
+	err = t.FileOSS.PutObjectWithReader(ctx, key, pr,
+		storage.WithContentType(file.contentType),
+		storage.WithContentDisposition(fmt.Sprintf(`attachment; filename="tasks.%s"`, file.ext)))
+	// stops the writer if the upload ended early
+	pr.CloseWithError(err)
+	if err != nil {
+		return "", err
+	}
+
+	return t.FileOSS.GetObjectUrl(ctx, key, storage.WithExpire(exportURLExpires))
+}
+
+func (t *taskImpl) writeExport(ctx context.Context, userID int64, format entity.TransferFormat, w io.Writer) error {
+	projectModels, err := t.ProjectRepo.ListProjects(ctx, userID, true)
+	if err != nil {
+		return err
+	}
+	projectNames := make(map[int64]string, len(projectModels))
+	for _, projectModel := range projectModels {
+		if !ptr.From(projectModel.IsInbox) {
+			projectNames[projectModel.ID] = projectModel.Name
+		}
+	}
+	tagModels, err := t.TagRepo.ListTags(ctx, userID)
+	if err != nil {
+		return err
+	}
+	tagNames := slice.ToMap(tagModels, func(tagModel *model.Tag) (int64, string) {
+		return tagModel.ID, tagModel.Name
+	})
+
+	enc := newRecordEncoder(format, w)
+	var afterID int64
+	for {
+		taskModels, err := t.TaskRepo.ListAllTasks(ctx, userID, afterID, exportPageSize)
+		if err != nil {
+			return err
+		}
+		if len(taskModels) == 0 {
+			break
+		}
+
+		taskIDs := slice.Transform(taskModels, func(taskModel *model.Task) int64 {
+			return taskModel.ID
+		})
+		taskTags, err := t.TagRepo.ListTaskTags(ctx, taskIDs)
+		if err != nil {
+			return err
+		}
+		tags := make(map[int64][]string)
+		for _, taskTag := range taskTags {
+			if name, ok := tagNames[taskTag.TagID]; ok {
+				tags[taskTag.TaskID] = append(tags[taskTag.TaskID], name)
+			}
+		}
+
+		for _, taskModel := range taskModels {
+			if err := enc.encode(taskModel, projectNames[taskModel.ProjectID], tags[taskModel.ID]); err != nil {
+				return err
+			}
+		}
+		if len(taskModels) < exportPageSize {
+			break
+		}
+		afterID = taskModels[len(taskModels)-1].ID
+	}
+
+	return enc.close()
+}
+
+func (t *taskImpl) ImportTasks(ctx context.Context, req *ImportTasksRequest) (*entity.ImportReport, error) {
+	ctx = dal.WithEventType(ctx, entity.TaskCreated.Int32())
+
+	if len(req.Content) > maxImportSize {
+		return nil, errorx.New(errno.ErrTaskInvalidParamCode, errorx.KV("msg", "import file is too large"))
+	}
+	records, err := decodeRecords(req.Format, req.Content)
+	if err != nil {
+		return nil, err
+	}
+	if len(records) > maxImportRows {
+		return nil, errorx.New(errno.ErrTaskInvalidParamCode,
+			errorx.KV("msg", fmt.Sprintf("an import holds at most %d tasks", maxImportRows)))
+	}
+
+	imp, err := t.newTaskImport(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	report := &entity.ImportReport{Total: int32(len(records))}
+	for i, rec := range records {
+		row := int32(i + 1)
+		duplicate, err := imp.add(ctx, rec)
+		if err != nil {
+			if !isItemError(err) {
+				return nil, err
+			}
+			report.Errors = append(report.Errors, &entity.ImportRowError{Row: row, Message: itemErrorMessage(err)})
+			continue
+		}
+		if duplicate {
+			report.Duplicates = append(report.Duplicates, row)
+			continue
+		}
+		report.Created++
+	}
+	if req.DryRun {
+		return report, nil
+	}
+
+	if err := imp.flush(ctx); err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+// taskImport collects the valid rows of an import and creates them at once.
+type taskImport struct {
+	t      *taskImpl
+	userID int64
+
+	// projects and tags map names to IDs, tag names are compared case insensitively
+	projects map[string]int64
+	tags     map[string]int64
+	// newProjects and newTags are the missing ones the rows name, they are only
+	// created with the rows which need them
+	newProjects map[string]*model.Project
+	newTags     map[string]*model.Tag
+	// seen holds the duplicate keys of the existing tasks and the added rows
+	seen map[string]bool
+
+	rank    string
+	pending []*dal.ImportedTask
+}
+
+func (t *taskImpl) newTaskImport(ctx context.Context, req *ImportTasksRequest) (*taskImport, error) {
+	projectModels, err := t.ProjectRepo.ListProjects(ctx, req.UserID, false)
+	if err != nil {
+		return nil, err
+	}
+	projects := make(map[string]int64, len(projectModels))
+	for _, projectModel := range projectModels {
+		if _, ok := projects[projectModel.Name]; !ok && !ptr.From(projectModel.IsInbox) {
+			projects[projectModel.Name] = projectModel.ID
+		}
+	}
+	tagModels, err := t.TagRepo.ListTags(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	tags := slice.ToMap(tagModels, func(tagModel *model.Tag) (string, int64) {
+		return strings.ToLower(tagModel.Name), tagModel.ID
+	})
+
+	seen := make(map[string]bool)
+	var afterID int64
+	for {
+		taskModels, err := t.TaskRepo.ListAllTasks(ctx, req.UserID, afterID, exportPageSize)
+		if err != nil {
+			return nil, err
+		}
+		for _, taskModel := range taskModels {
+			if !taskModel.DeletedAt.Valid {
+				seen[duplicateKey(taskModel.Title, taskModel.DueAt)] = true
+			}
+		}
+		if len(taskModels) < exportPageSize {
+			break
+		}
+		afterID = taskModels[len(taskModels)-1].ID
+	}
+
+	rank, err := t.lastRank(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &taskImport{
+		t:           t,
+		userID:      req.UserID,
+		projects:    projects,
+		tags:        tags,
+		newProjects: make(map[string]*model.Project),
+		newTags:     make(map[string]*model.Tag),
+		seen:        seen,
+		rank:        rank,
+	}, nil
+}
+
+// add checks a row and queues its task, it reports whether the row duplicates
+// a task. Missing projects and tags are queued with the task.
+func (imp *taskImport) add(ctx context.Context, rec *taskRecord) (bool, error) {
+	req, status, err := recordToRequest(rec)
+	if err != nil {
+		return false, err
+	}
+	req.UserID = imp.userID
+
+	key := duplicateKey(req.Title, req.DueAt)
+	if imp.seen[key] {
+		return true, nil
+	}
+
+	var newProject *model.Project
+	projectName := strings.TrimSpace(rec.Project)
+	if projectName != "" {
+		if req.ProjectID, newProject, err = imp.project(projectName); err != nil {
+			return false, err
+		}
+	}
+	var newTags []*model.Tag
+	for _, name := range rec.Tags {
+		tagID, newTag, err := imp.tag(name)
+		if err != nil {
+			return false, err
+		}
+		if newTag != nil && !slices.Contains(newTags, newTag) {
+			newTags = append(newTags, newTag)
+		}
+		if tagID != 0 {
+			req.TagIDs = append(req.TagIDs, tagID)
+		}
+	}
+	if len(slice.Unique(req.TagIDs))+len(newTags) > maxTaskTags {
+		return false, errorx.New(errno.ErrTaskInvalidParamCode, errorx.KV("msg", "too many tags"))
+	}
+
+	// the ID and rank are assigned when the task is created, and the project
+	// once it is created
+	newTask, tagIDs, err := imp.t.newTask(ctx, req, 0, "")
+	if err != nil {
+		return false, err
+	}
+	newTask.Status = status.Int32()
+	imp.seen[key] = true
+	imp.pending = append(imp.pending, &dal.ImportedTask{Task: newTask, TagIDs: tagIDs, Project: newProject, Tags: newTags})
+
+	return false, nil
+}
+
+// project returns the ID of the named project, or the project to create if the
+// user has none of that name.
+func (imp *taskImport) project(name string) (int64, *model.Project, error) {
+	if id, ok := imp.projects[name]; ok {
+		return id, nil, nil
+	}
+	name, err := normalizeProjectName(name)
+	if err != nil {
+		return 0, nil, err
+	}
+	if id, ok := imp.projects[name]; ok {
+		return id, nil, nil
+	}
+
+	project, ok := imp.newProjects[name]
+	if !ok {
+		project = &model.Project{UserID: imp.userID, Name: name}
+		imp.newProjects[name] = project
+	}
+
+	return 0, project, nil
+}
+
+// tag returns the ID of the named tag, or the tag to create if the user has
+// none of that name.
+func (imp *taskImport) tag(name string) (int64, *model.Tag, error) {
+	name, err := normalizeTagName(name)
+	if err != nil {
+		return 0, nil, err
+	}
+	if id, ok := imp.tags[strings.ToLower(name)]; ok {
+		return id, nil, nil
+	}
+
+	tag, ok := imp.newTags[strings.ToLower(name)]
+	if !ok {
+		tag = &model.Tag{UserID: imp.userID, Name: name}
+		imp.newTags[strings.ToLower(name)] = tag
+	}
+
+	return 0, tag, nil
+}
+
+// flush creates the queued tasks in one transaction, with the projects and tags
+// they need.
+func (imp *taskImport) flush(ctx context.Context) error {
+	if len(imp.pending) == 0 {
+		return nil
+	}
+
+	tasks := make([]*model.Task, 0, len(imp.pending))
+	for start := 0; start < len(imp.pending); start += maxBatchSize {
+		chunk := imp.pending[start:min(start+maxBatchSize, len(imp.pending))]
+		ids, err := imp.t.IDGen.GenMultiIDs(ctx, len(chunk))
+		if err != nil {
+			return fmt.Errorf("generate ids error: %w", err)
+		}
+
+		for i, pending := range chunk {
+			imp.rank = midRank(imp.rank, "")
+			newTask := pending.Task
+			newTask.ID = ids[i]
+			newTask.SortKey = imp.rank
+			// a new series is identified by its first occurrence
+			if newTask.Recurrence != "" {
+				newTask.SeriesID = newTask.ID
+			}
+			tasks = append(tasks, newTask)
+		}
+	}
+	if err := imp.t.TaskRepo.ImportTasks(ctx, imp.userID, imp.pending); err != nil {
+		return err
+	}
+
+	for _, newTask := range tasks {
+		if err := imp.t.Searcher.Index(ctx, taskPO2Document(newTask)); err != nil {
+			logs.CtxWarnf(ctx, "index task failed, taskID=%d, err=%v", newTask.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// recordToRequest checks the fields of a row which don't need a lookup.
+func recordToRequest(rec *taskRecord) (*CreateTaskRequest, entity.Status, error) {
+	title := strings.TrimSpace(rec.Title)
+	if title == "" {
+		return nil, 0, errorx.New(errno.ErrTaskInvalidParamCode, errorx.KV("msg", "title is empty"))
+	}
+
+	status := entity.ToDoStatus
+	if rec.Status != "" {
+		var ok bool
+		status, ok = entity.ParseStatus(strings.ToLower(rec.Status))
+		if !ok {
+			return nil, 0, errorx.New(errno.ErrTaskInvalidParamCode, errorx.KV("msg", "invalid status "+rec.Status))
+		}
+	}
+	if status == entity.TrashedStatus || rec.DeletedAt != "" {
+		return nil, 0, errorx.New(errno.ErrTaskInvalidParamCode, errorx.KV("msg", "trashed tasks are not imported"))
+	}
+
+	priority := entity.NoPriority
+	if rec.Priority != "" {
+		var ok bool
+		priority, ok = entity.ParsePriority(strings.ToLower(rec.Priority))
+		if !ok {
+			return nil, 0, errorx.New(errno.ErrTaskInvalidParamCode, errorx.KV("msg", "invalid priority "+rec.Priority))
+		}
+	}
+	if len(rec.Tags) > maxTaskTags {
+		return nil, 0, errorx.New(errno.ErrTaskInvalidParamCode, errorx.KV("msg", "too many tags"))
+	}
+
+	req := &CreateTaskRequest{
+		Title:    title,
+		Content:  rec.Content,
+		Priority: priority,
+	}
+	var err error
+	if req.DueAt, err = parseRecordTime("due_at", rec.DueAt); err != nil {
+		return nil, 0, err
+	}
+	if req.RemindAt, err = parseRecordTime("remind_at", rec.RemindAt); err != nil {
+		return nil, 0, err
+	}
+	if rec.Recurrence != "" {
+		timeZone := rec.TimeZone
+		if timeZone == "" {
+			timeZone = "UTC"
+		}
+		req.Recurrence = &entity.Recurrence{Rule: rec.Recurrence, TimeZone: timeZone}
+	}
+
+	return req, status, nil
+}
+
+// parseRecordTime reads an RFC 3339 time or a date, into milliseconds.
+func parseRecordTime(field, value string) (*int64, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	tm, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		tm, err = time.Parse(time.DateOnly, value)
+	}
+	if err != nil {
+		return nil, errorx.New(errno.ErrTaskInvalidParamCode,
+			errorx.KV("msg", field+" must be an RFC 3339 time or a date"))
+	}
+
+	return ptr.Of(tm.UnixMilli()), nil
+}
+
+func formatRecordTime(ms int64) string {
+	return time.UnixMilli(ms).UTC().Format(time.RFC3339)
+}
+
+// duplicateKey identifies the tasks an import treats as the same task.
+func duplicateKey(title string, dueAt *int64) string {
+	key := strings.ToLower(strings.TrimSpace(title)) + "\x00"
+	if dueAt != nil {
+		key += strconv.FormatInt(*dueAt, 10)
+	}
+	return key
+}
+
+func itemErrorMessage(err error) string {
+	var statusErr errorx.StatusError
+	if errors.As(err, &statusErr) {
+		return statusErr.Msg()
+	}
+	return err.Error()
+}
+
+func taskPO2Record(taskModel *model.Task, project string, tags []string) *taskRecord {
+	rec := &taskRecord{
+		ID:         taskModel.ID,
+		ParentID:   taskModel.ParentID,
+		Title:      taskModel.Title,
+		Content:    taskModel.Content,
+		Status:     entity.Status(taskModel.Status).String(),
+		Priority:   entity.Priority(taskModel.Priority).String(),
+		Project:    project,
+		Tags:       tags,
+		Recurrence: taskModel.Recurrence,
+		TimeZone:   taskModel.TimeZone,
+		CreatedAt:  formatRecordTime(taskModel.CreatedAt),
+		UpdatedAt:  formatRecordTime(taskModel.UpdatedAt),
+	}
+	if taskModel.DueAt != nil {
+		rec.DueAt = formatRecordTime(*taskModel.DueAt)
+	}
+	if taskModel.RemindAt != nil {
+		rec.RemindAt = formatRecordTime(*taskModel.RemindAt)
+	}
+	if taskModel.DeletedAt.Valid {
+		rec.DeletedAt = taskModel.DeletedAt.Time.UTC().Format(time.RFC3339)
+	}
+
+	return rec
+}
+
+// recordEncoder writes the tasks of an export in one format.
+type recordEncoder interface {
+	encode(taskModel *model.Task, project string, tags []string) error
+	close() error
+}
+
+func newRecordEncoder(format entity.TransferFormat, w io.Writer) recordEncoder {
+	switch format {
+	case entity.CSVFormat:
+		return &csvEncoder{w: csv.NewWriter(w)}
+	case entity.ICalFormat:
+		return &icalEncoder{w: ical.NewWriter(w, icalProdID)}
+	default:
+		return &jsonEncoder{w: bufio.NewWriter(w)}
+	}
+}
+
+// jsonEncoder writes an array of task records.
+type jsonEncoder struct {
+	w     *bufio.Writer
+	count int
+}
+
+func (e *jsonEncoder) encode(taskModel *model.Task, project string, tags []string) error {
+	data, err := json.Marshal(taskPO2Record(taskModel, project, tags))
+	if err != nil {
+		return err
+	}
+	sep := ",\n"
+	if e.count == 0 {
+		sep = "[\n"
+	}
+	e.count++
+	e.w.WriteString(sep)
+	_, err = e.w.Write(data)
+	return err
+}
+
+func (e *jsonEncoder) close() error {
+	if e.count == 0 {
+		e.w.WriteString("[")
+	}
+	e.w.WriteString("\n]\n")
+	return e.w.Flush()
+}
+
+type csvEncoder struct {
+	w      *csv.Writer
+	header bool
+}
+
+func (e *csvEncoder) encode(taskModel *model.Task, project string, tags []string) error {
+	if !e.header {
+		e.header = true
+		if err := e.w.Write(csvHeader); err != nil {
+			return err
+		}
+	}
+
+	rec := taskPO2Record(taskModel, project, tags)
+	parentID := ""
+	if rec.ParentID != 0 {
+		parentID = strconv.FormatInt(rec.ParentID, 10)
+	}
+	return e.w.Write([]string{
+		strconv.FormatInt(rec.ID, 10), parentID, rec.Title, rec.Content, rec.Status, rec.Priority,
+		rec.Project, strings.Join(rec.Tags, csvTagSeparator), rec.DueAt, rec.RemindAt,
+		rec.Recurrence, rec.TimeZone, rec.CreatedAt, rec.UpdatedAt, rec.DeletedAt,
+	})
+}
+
+func (e *csvEncoder) close() error {
+	if !e.header {
+		e.w.Write(csvHeader)
+	}
+	e.w.Flush()
+	return e.w.Error()
+}
+
+type icalEncoder struct {
+	w *ical.Writer
+}
+
+func (e *icalEncoder) encode(taskModel *model.Task, project string, tags []string) error {
+	status := entity.Status(taskModel.Status)
+	todo := &ical.Todo{
+		UID:          fmt.Sprintf("task-%d", taskModel.ID),
+		Summary:      taskModel.Title,
+		Description:  taskModel.Content,
+		Status:       icalStatus(status),
+		Priority:     icalPriority(entity.Priority(taskModel.Priority)),
+		RRule:        taskModel.Recurrence,
+		TimeZone:     taskModel.TimeZone,
+		Categories:   tags,
+		Created:      time.UnixMilli(taskModel.CreatedAt),
+		LastModified: time.UnixMilli(taskModel.UpdatedAt),
+		X:            map[string]string{icalStatusProperty: status.String()},
+	}
+	if project != "" {
+		todo.X[icalProjectProperty] = project
+	}
+	if taskModel.DueAt != nil {
+		todo.Due = time.UnixMilli(*taskModel.DueAt)
+	}
+	if taskModel.RemindAt != nil {
+		todo.Alarm = time.UnixMilli(*taskModel.RemindAt)
+	}
+
+	return e.w.Write(todo)
+}
+
+func (e *icalEncoder) close() error {
+	return e.w.Close()
+}
+
+func decodeRecords(format entity.TransferFormat, content []byte) ([]*taskRecord, error) {
+	var records []*taskRecord
+	var err error
+	switch format {
+	case entity.JSONFormat:
+		err = json.Unmarshal(content, &records)
+	case entity.CSVFormat:
+		records, err = decodeCSV(content)
+	case entity.ICalFormat:
+		records, err = decodeICal(content)
+	default:
+		return nil, errorx.New(errno.ErrTaskInvalidParamCode, errorx.KV("msg", "invalid format"))
+	}
+	if err != nil {
+		return nil, errorx.WrapByCode(err, errno.ErrTaskInvalidParamCode,
+			errorx.KV("msg", "invalid import file: "+err.Error()))
+	}
+	for i, rec := range records {
+		if rec == nil {
+			records[i] = &taskRecord{}
+		}
+	}
+
+	return records, nil
+}
+
+// decodeCSV reads a CSV file with a header row, the columns are matched by
+// name and unknown columns are ignored.
+func decodeCSV(content []byte) ([]*taskRecord, error) {
+	r := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(content, []byte("\ufeff"))))
+	r.FieldsPerRecord = -1
+	rows, err := r.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	columns := make(map[string]int, len(rows[0]))
+	for i, name := range rows[0] {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	if _, ok := columns["title"]; !ok {
+		return nil, errors.New("missing title column")
+	}
+
+	records := make([]*taskRecord, 0, len(rows)-1)
+	for _, row := range rows[1:] {
+		get := func(name string) string {
+			if i, ok := columns[name]; ok && i < len(row) {
+				return strings.TrimSpace(row[i])
+			}
+			return ""
+		}
+		rec := &taskRecord{
+			Title:      get("title"),
+			Content:    get("content"),
+			Status:     get("status"),
+			Priority:   get("priority"),
+			Project:    get("project"),
+			DueAt:      get("due_at"),
+			RemindAt:   get("remind_at"),
+			Recurrence: get("recurrence"),
+			TimeZone:   get("time_zone"),
+			DeletedAt:  get("deleted_at"),
+		}
+		if tags := get("tags"); tags != "" {
+			rec.Tags = strings.Split(tags, csvTagSeparator)
+		}
+		records = append(records, rec)
+	}
+
+	return records, nil
+}
+
+func decodeICal(content []byte) ([]*taskRecord, error) {
+	todos, err := ical.Parse(bytes.NewReader(content))
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([]*taskRecord, 0, len(todos))
+	for _, todo := range todos {
+		rec := &taskRecord{
+			Title:      todo.Summary,
+			Content:    todo.Description,
+			Status:     todo.X[icalStatusProperty],
+			Project:    todo.X[icalProjectProperty],
+			Tags:       todo.Categories,
+			Recurrence: todo.RRule,
+			TimeZone:   todo.TimeZone,
+		}
+		if _, ok := entity.ParseStatus(rec.Status); !ok {
+			rec.Status = statusFromICal(todo.Status)
+		}
+		if p := priorityFromICal(todo.Priority); p != entity.NoPriority {
+			rec.Priority = p.String()
+		}
+		if !todo.Due.IsZero() {
+			rec.DueAt = todo.Due.UTC().Format(time.RFC3339)
+		}
+		if !todo.Alarm.IsZero() {
+			rec.RemindAt = todo.Alarm.UTC().Format(time.RFC3339)
+		}
+		records = append(records, rec)
+	}
+
+	return records, nil
+}
+
+func icalStatus(status entity.Status) string {
+	switch status {
+	case entity.InProgressStatus:
+		return ical.StatusInProcess
+	case entity.DoneStatus:
+		return ical.StatusCompleted
+	case entity.ArchivedStatus, entity.TrashedStatus:
+		return ical.StatusCancelled
+	default:
+		return ical.StatusNeedsAction
+	}
+}
+
+func statusFromICal(status string) string {
+	switch status {
+	case ical.StatusInProcess:
+		return entity.InProgressStatus.String()
+	case ical.StatusCompleted:
+		return entity.DoneStatus.String()
+	case ical.StatusCancelled:
+		return entity.ArchivedStatus.String()
+	default:
+		return entity.ToDoStatus.String()
+	}
+}
+
+// icalPriority maps priorities onto the 1 (highest) to 9 (lowest) scale.
+func icalPriority(priority entity.Priority) int {
+	switch priority {
+	case entity.UrgentPriority:
+		return 1
+	case entity.HighPriority:
+		return 2
+	case entity.MediumPriority:
+		return 5
+	case entity.LowPriority:
+		return 9
+	default:
+		return 0
+	}
+}
+
+func priorityFromICal(priority int) entity.Priority {
+	switch {
+	case priority == 1:
+		return entity.UrgentPriority
+	case priority >= 2 && priority <= 4:
+		return entity.HighPriority
+	case priority == 5:
+		return entity.MediumPriority
+	case priority >= 6:
+		return entity.LowPriority
+	default:
+		return entity.NoPriority
+	}
+}
@@ -0,0 +1,106 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/entity"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/lang/ptr"
+)
+
+// importContent names the new project Work and the new tags home and errands,
+// the second row fails after naming the new project Broken and the tag broken.
+const importContent = `[
+	{"title": "file taxes", "project": "Work", "tags": ["home"]},
+	{"title": "water plants", "project": "Broken", "tags": ["broken"], "recurrence": "FREQ=SOMETIMES"},
+	{"title": "buy stamps", "project": "Work", "tags": ["Home", "errands"]}
+]`
+
+// userProjects returns the names of the projects of the user, the inbox left out.
+func userProjects(t *testing.T, c *Components) []string {
+	t.Helper()
+
+	projects, err := c.ProjectRepo.ListProjects(context.Background(), ownerID, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, project := range projects {
+		if !ptr.From(project.IsInbox) {
+			names = append(names, project.Name)
+		}
+	}
+	return names
+}
+
+func userTags(t *testing.T, c *Components) []string {
+	t.Helper()
+
+	tags, err := c.TagRepo.ListTags(context.Background(), ownerID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, tag := range tags {
+		names = append(names, tag.Name)
+	}
+	return names
+}
+
+func TestImportCreatesProjectsAndTagsOfCommittedRows(t *testing.T) {
+	ctx := context.Background()
+	c := newTestComponents(t)
+	d := NewTaskDomain(c)
+
+	report, err := d.ImportTasks(ctx, &ImportTasksRequest{UserID: ownerID, Format: entity.JSONFormat, Content: []byte(importContent)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Created != 2 || len(report.Errors) != 1 || report.Errors[0].Row != 2 {
+		t.Fatalf("report = %+v, want rows 1 and 3 created and row 2 refused", report)
+	}
+
+	if got := userProjects(t, c); len(got) != 1 || got[0] != "Work" {
+		t.Errorf("projects = %v, want [Work]", got)
+	}
+	if got := userTags(t, c); len(got) != 2 {
+		t.Errorf("tags = %v, want home and errands", got)
+	}
+
+	resp, err := d.GetTaskList(ctx, &ListTasksRequest{UserID: ownerID, PageSize: maxPageSize})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Tasks) != 2 {
+		t.Fatalf("got %d tasks, want 2", len(resp.Tasks))
+	}
+	projectID := resp.Tasks[0].ProjectID
+	for _, task := range resp.Tasks {
+		if task.ProjectID != projectID || projectID == 0 {
+			t.Errorf("task %q is in project %d, want both in Work", task.Title, task.ProjectID)
+		}
+		if want := map[string]int{"file taxes": 1, "buy stamps": 2}[task.Title]; len(task.Tags) != want {
+			t.Errorf("task %q has %d tags, want %d", task.Title, len(task.Tags), want)
+		}
+	}
+}
+
+func TestImportDryRunCreatesNothing(t *testing.T) {
+	ctx := context.Background()
+	c := newTestComponents(t)
+	d := NewTaskDomain(c)
+
+	report, err := d.ImportTasks(ctx, &ImportTasksRequest{UserID: ownerID, Format: entity.JSONFormat, Content: []byte(importContent), DryRun: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Created != 2 || len(report.Errors) != 1 {
+		t.Fatalf("report = %+v, want 2 rows to create and 1 refused", report)
+	}
+	if got := userProjects(t, c); len(got) != 0 {
+		t.Errorf("projects = %v, want none", got)
+	}
+	if got := userTags(t, c); len(got) != 0 {
+		t.Errorf("tags = %v, want none", got)
+	}
+}
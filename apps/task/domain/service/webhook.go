@@ -0,0 +1,70 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/entity"
+	"github.com/crazyfrankie/zrpc-todolist/infra/contract/eventbus"
+)
+
+// CreateWebhookRequest subscribes URL to the task events of EventTypes, empty
+// means every event. A secret is generated unless one is given.
+type CreateWebhookRequest struct {
+	UserID     int64
+	URL        string
+	EventTypes []entity.TaskEventType
+	Secret     string
+}
+
+// UpdateWebhookRequest changes the given fields, EventTypes replaces the
+// subscribed events unless it is empty, AllEventTypes subscribes every event.
+type UpdateWebhookRequest struct {
+	UserID        int64
+	WebhookID     int64
+	URL           *string
+	EventTypes    []entity.TaskEventType
+	AllEventTypes bool
+	Secret        *string
+	Enabled       *bool
+}
+
+// ListDeliveriesRequest pages through the deliveries of a webhook newest
+// first, Status filters them unless it is nil.
+type ListDeliveriesRequest struct {
+	UserID    int64
+	WebhookID int64
+	Status    *entity.DeliveryStatus
+	Cursor    string
+	PageSize  int
+}
+
+type ListDeliveriesResponse struct {
+	Deliveries []*entity.WebhookDelivery
+	NextCursor string
+	HasMore    bool
+}
+
+type Webhook interface {
+	CreateWebhook(ctx context.Context, req *CreateWebhookRequest) (*entity.Webhook, error)
+	UpdateWebhook(ctx context.Context, req *UpdateWebhookRequest) (*entity.Webhook, error)
+	// DeleteWebhook deletes the webhook along with its delivery log.
+	DeleteWebhook(ctx context.Context, userID, webhookID int64) error
+	ListWebhooks(ctx context.Context, userID int64) ([]*entity.Webhook, error)
+	ListDeliveries(ctx context.Context, req *ListDeliveriesRequest) (*ListDeliveriesResponse, error)
+	// ReplayDelivery sends the delivery again with a fresh set of attempts,
+	// whatever its status.
+	ReplayDelivery(ctx context.Context, userID, deliveryID int64) (*entity.WebhookDelivery, error)
+
+	// EnqueueDeliveries adds a delivery of a task event of the event bus to each
+	// webhook of the owner subscribed to it. Redeliveries of the event are ignored,
+	// the delivery of an event to a webhook is unique.
+	EnqueueDeliveries(ctx context.Context, event *eventbus.Event) error
+	// DispatchDueDeliveries attempts the deliveries which are due and returns how
+	// many succeeded. A failed attempt is retried with exponential backoff, the
+	// delivery is dead once it runs out of attempts.
+	DispatchDueDeliveries(ctx context.Context, now time.Time) (int, error)
+	// PurgeDeliveries deletes the succeeded and dead deliveries last attempted
+	// before the given time and returns how many it deleted.
+	PurgeDeliveries(ctx context.Context, before time.Time) (int64, error)
+}
@@ -0,0 +1,610 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+	"unicode/utf8"
+
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/entity"
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/internal/dal"
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/internal/dal/model"
+	"github.com/crazyfrankie/zrpc-todolist/infra/contract/eventbus"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/errorx"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/lang/conv"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/lang/slice"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/logs"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/webhook"
+	"github.com/crazyfrankie/zrpc-todolist/types/errno"
+)
+
+const (
+	maxWebhooks         = 10
+	maxWebhookURLLength = 2048
+	minSecretLength     = 16
+	maxSecretLength     = 128
+	secretPrefix        = "whsec_"
+
+	deliveryBatchSize = 100
+	// deliveryConcurrency bounds the deliveries a dispatcher sends at once.
+	deliveryConcurrency = 8
+	// deliveryLease must outlive a dispatch round, a delivery claimed by a
+	// replica which died mid round is attempted again once it expires.
+	deliveryLease = 5 * time.Minute
+	// maxDeliveryAttempts attempts back off from retryBackoff, doubling up to
+	// maxRetryBackoff, which spreads them over about an hour.
+	maxDeliveryAttempts = 8
+	retryBackoff        = 30 * time.Second
+	maxRetryBackoff     = time.Hour
+	maxLastErrorLength  = 512
+	purgeDeliveryBatch  = 1000
+)
+
+// webhookImpl reads the tasks it tells about, so it builds on the task domain.
+type webhookImpl struct {
+	*taskImpl
+}
+
+func NewWebhookDomain(c *Components) Webhook {
+	return &webhookImpl{&taskImpl{c}}
+}
+
+func (w *webhookImpl) CreateWebhook(ctx context.Context, req *CreateWebhookRequest) (*entity.Webhook, error) {
+	if err := validateWebhookURL(ctx, req.URL); err != nil {
+		return nil, err
+	}
+	eventTypes, err := formatEventTypes(req.EventTypes)
+	if err != nil {
+		return nil, err
+	}
+	secret := req.Secret
+	if secret == "" {
+		secret, err = generateSecret()
+		if err != nil {
+			return nil, err
+		}
+	} else if err := validateSecret(secret); err != nil {
+		return nil, err
+	}
+
+	count, err := w.WebhookRepo.CountWebhooks(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if count >= maxWebhooks {
+		return nil, errorx.New(errno.ErrWebhookLimitCode, errorx.KV("limit", conv.Int64ToStr(maxWebhooks)))
+	}
+
+	id, err := w.IDGen.GenID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now().UnixMilli()
+	hook := &model.Webhook{
+		ID:         id,
+		UserID:     req.UserID,
+		URL:        req.URL,
+		EventTypes: eventTypes,
+		Secret:     secret,
+		Enabled:    true,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+	if err := w.WebhookRepo.Create(ctx, hook); err != nil {
+		return nil, err
+	}
+
+	// the secret is only handed out once
+	webhookDO := webhookPO2DO(hook)
+	webhookDO.Secret = secret
+
+	return webhookDO, nil
+}
+
+func (w *webhookImpl) UpdateWebhook(ctx context.Context, req *UpdateWebhookRequest) (*entity.Webhook, error) {
+	hook, err := w.getOwnedWebhook(ctx, req.UserID, req.WebhookID)
+	if err != nil {
+		return nil, err
+	}
+
+	updates := map[string]any{}
+	if req.URL != nil {
+		if err := validateWebhookURL(ctx, *req.URL); err != nil {
+			return nil, err
+		}
+		updates["url"] = *req.URL
+		hook.URL = *req.URL
+	}
+	switch {
+	case req.AllEventTypes:
+		updates["event_types"] = ""
+		hook.EventTypes = ""
+	case len(req.EventTypes) > 0:
+		eventTypes, err := formatEventTypes(req.EventTypes)
+		if err != nil {
+			return nil, err
+		}
+		updates["event_types"] = eventTypes
+		hook.EventTypes = eventTypes
+	}
+	if req.Secret != nil {
+		if err := validateSecret(*req.Secret); err != nil {
+			return nil, err
+		}
+		updates["secret"] = *req.Secret
+	}
+	if req.Enabled != nil {
+		updates["enabled"] = *req.Enabled
+		hook.Enabled = *req.Enabled
+	}
+	if len(updates) == 0 {
+		return webhookPO2DO(hook), nil
+	}
+
+	now := time.Now().UnixMilli()
+	updates["updated_at"] = now
+	updated, err := w.WebhookRepo.UpdateWebhook(ctx, req.UserID, req.WebhookID, updates)
+	if err != nil {
+		return nil, err
+	}
+	if !updated {
+		return nil, errorx.New(errno.ErrWebhookNotFoundCode, errorx.KV("webhook_id", conv.Int64ToStr(req.WebhookID)))
+	}
+	hook.UpdatedAt = now
+
+	return webhookPO2DO(hook), nil
+}
+
+func (w *webhookImpl) DeleteWebhook(ctx context.Context, userID, webhookID int64) error {
+	deleted, err := w.WebhookRepo.DeleteWebhook(ctx, userID, webhookID)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return errorx.New(errno.ErrWebhookNotFoundCode, errorx.KV("webhook_id", conv.Int64ToStr(webhookID)))
+	}
+
+	return nil
+}
+
+func (w *webhookImpl) ListWebhooks(ctx context.Context, userID int64) ([]*entity.Webhook, error) {
+	hooks, err := w.WebhookRepo.ListWebhooks(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return slice.Transform(hooks, webhookPO2DO), nil
+}
+
+func (w *webhookImpl) ListDeliveries(ctx context.Context, req *ListDeliveriesRequest) (*ListDeliveriesResponse, error) {
+	if _, err := w.getOwnedWebhook(ctx, req.UserID, req.WebhookID); err != nil {
+		return nil, err
+	}
+
+	var beforeID int64
+	if req.Cursor != "" {
+		id, err := conv.StrToInt64(req.Cursor)
+		if err != nil || id <= 0 {
+			return nil, errorx.New(errno.ErrTaskInvalidParamCode, errorx.KV("msg", "invalid cursor"))
+		}
+		beforeID = id
+	}
+	pageSize := req.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+	status := int32(-1)
+	if req.Status != nil {
+		status = req.Status.Int32()
+	}
+
+	deliveries, err := w.WebhookRepo.ListDeliveries(ctx, req.WebhookID, beforeID, status, pageSize+1)
+	if err != nil {
+		return nil, err
+	}
+	hasMore := len(deliveries) > pageSize
+	if hasMore {
+		deliveries = deliveries[:pageSize]
+	}
+
+	resp := &ListDeliveriesResponse{
+		Deliveries: slice.Transform(deliveries, deliveryPO2DO),
+		HasMore:    hasMore,
+	}
+	if hasMore {
+		resp.NextCursor = conv.Int64ToStr(deliveries[len(deliveries)-1].ID)
+	}
+
+	return resp, nil
+}
+
+func (w *webhookImpl) ReplayDelivery(ctx context.Context, userID, deliveryID int64) (*entity.WebhookDelivery, error) {
+	delivery, exist, err := w.WebhookRepo.GetDeliveryByID(ctx, deliveryID)
+	if err != nil {
+		return nil, err
+	}
+	if !exist || delivery.UserID != userID {
+		return nil, errorx.New(errno.ErrWebhookDeliveryNotFoundCode, errorx.KV("delivery_id", conv.Int64ToStr(deliveryID)))
+	}
+
+	now := time.Now().UnixMilli()
+	err = w.WebhookRepo.UpdateDelivery(ctx, deliveryID, map[string]any{
+		"status":          dal.DeliveryPending,
+		"attempts":        0,
+		"next_attempt_at": now,
+		"response_status": 0,
+		"last_error":      "",
+		"updated_at":      now,
+	})
+	if err != nil {
+		return nil, err
+	}
+	delivery.Status = dal.DeliveryPending
+	delivery.Attempts = 0
+	delivery.NextAttemptAt = now
+	delivery.ResponseStatus = 0
+	delivery.LastError = ""
+	delivery.UpdatedAt = now
+
+	return deliveryPO2DO(delivery), nil
+}
+
+// webhookPayload is the body posted to webhooks. Task is the task when the
+// delivery was enqueued, it is left out for deleted tasks.
+type webhookPayload struct {
+	ID        string       `json:"id"`
+	Type      string       `json:"type"`
+	CreatedAt int64        `json:"created_at"`
+	TaskID    int64        `json:"task_id"`
+	Task      *webhookTask `json:"task,omitempty"`
+}
+
+type webhookTask struct {
+	ID        int64  `json:"id"`
+	Title     string `json:"title"`
+	Content   string `json:"content"`
+	Status    string `json:"status"`
+	Priority  string `json:"priority"`
+	DueAt     *int64 `json:"due_at,omitempty"`
+	ProjectID int64  `json:"project_id"`
+	ParentID  int64  `json:"parent_id"`
+	Version   int64  `json:"version"`
+	UpdatedAt int64  `json:"updated_at"`
+}
+
+func (w *webhookImpl) EnqueueDeliveries(ctx context.Context, event *eventbus.Event) error {
+	var taskEvent dal.TaskEvent
+	if err := json.Unmarshal(event.Payload, &taskEvent); err != nil {
+		// a malformed event won't get better on retry
+		logs.CtxWarnf(ctx, "decode task event failed, id=%s, err=%v", event.ID, err)
+		return nil
+	}
+	eventType := entity.TaskEventType(taskEvent.Type)
+
+	hooks, err := w.WebhookRepo.ListWebhooks(ctx, taskEvent.UserID)
+	if err != nil {
+		return err
+	}
+	hooks = slices.DeleteFunc(hooks, func(hook *model.Webhook) bool {
+		return !hook.Enabled || !webhookPO2DO(hook).Subscribes(eventType)
+	})
+	if len(hooks) == 0 {
+		return nil
+	}
+
+	payload := &webhookPayload{
+		ID:        event.ID,
+		Type:      eventType.String(),
+		CreatedAt: event.CreatedAt,
+		TaskID:    taskEvent.TaskID,
+	}
+	if eventType != entity.TaskDeleted {
+		taskModel, exist, err := w.TaskRepo.GetTaskByID(ctx, taskEvent.TaskID)
+		if err != nil {
+			return err
+		}
+		if exist && !taskModel.DeletedAt.Valid {
+			payload.Task = newWebhookTask(taskModel)
+		}
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UnixMilli()
+	deliveries := make([]*model.WebhookDelivery, 0, len(hooks))
+	for _, hook := range hooks {
+		id, err := w.IDGen.GenID(ctx)
+		if err != nil {
+			return err
+		}
+		deliveries = append(deliveries, &model.WebhookDelivery{
+			ID:            id,
+			WebhookID:     hook.ID,
+			UserID:        hook.UserID,
+			EventID:       event.ID,
+			EventType:     eventType.Int32(),
+			Payload:       string(body),
+			Status:        dal.DeliveryPending,
+			NextAttemptAt: now,
+			CreatedAt:     now,
+			UpdatedAt:     now,
+		})
+	}
+
+	return w.WebhookRepo.CreateDeliveries(ctx, deliveries)
+}
+
+func (w *webhookImpl) DispatchDueDeliveries(ctx context.Context, now time.Time) (int, error) {
+	deliveries, err := w.WebhookRepo.ListDueDeliveries(ctx, now.UnixMilli(), deliveryBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	var (
+		wg        sync.WaitGroup
+		succeeded atomic.Int64
+		sem       = make(chan struct{}, deliveryConcurrency)
+	)
+
+	leaseUntil := now.Add(deliveryLease).UnixMilli()
+	for _, delivery := range deliveries {
+		// only one replica may attempt a given delivery
+		claimed, err := w.WebhookRepo.ClaimDelivery(ctx, delivery.ID, delivery.NextAttemptAt, leaseUntil)
+		if err != nil {
+			wg.Wait()
+			return int(succeeded.Load()), err
+		}
+		if !claimed {
+			continue
+		}
+
+		sem <- struct{}{}
+		wg.Add(1)
+		go func(delivery *model.WebhookDelivery) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			if w.attemptDelivery(ctx, delivery) {
+				succeeded.Add(1)
+			}
+		}(delivery)
+	}
+	wg.Wait()
+
+	return int(succeeded.Load()), nil
+}
+
+// attemptDelivery sends the claimed delivery and records how it went, it
+// reports whether the delivery succeeded.
+func (w *webhookImpl) attemptDelivery(ctx context.Context, delivery *model.WebhookDelivery) bool {
+	hook, exist, err := w.WebhookRepo.GetWebhookByID(ctx, delivery.WebhookID)
+	if err != nil {
+		// the lease runs out and the delivery is attempted again
+		logs.CtxWarnf(ctx, "get webhook failed, webhookID=%d, err=%v", delivery.WebhookID, err)
+		return false
+	}
+	if !exist {
+		// deleted along with its deliveries meanwhile
+		return false
+	}
+
+	attempts := delivery.Attempts + 1
+	updates := map[string]any{"attempts": attempts}
+	var statusCode int
+	if hook.Enabled {
+		statusCode, err = w.WebhookSender.Send(ctx, &webhook.Request{
+			URL:        hook.URL,
+			Secret:     hook.Secret,
+			Event:      entity.TaskEventType(delivery.EventType).String(),
+			DeliveryID: conv.Int64ToStr(delivery.ID),
+			Body:       []byte(delivery.Payload),
+		})
+	} else {
+		err = errors.New("webhook disabled")
+		// no point in retrying, the delivery can be replayed once it is enabled
+		attempts = maxDeliveryAttempts
+	}
+
+	now := time.Now()
+	updates["response_status"] = statusCode
+	updates["updated_at"] = now.UnixMilli()
+	switch {
+	case err == nil:
+		updates["status"] = dal.DeliverySucceeded
+		updates["last_error"] = ""
+		updates["delivered_at"] = now.UnixMilli()
+	case attempts >= maxDeliveryAttempts:
+		updates["status"] = dal.DeliveryDead
+		updates["last_error"] = truncateError(err)
+	default:
+		updates["next_attempt_at"] = now.Add(deliveryBackoff(attempts)).UnixMilli()
+		updates["last_error"] = truncateError(err)
+	}
+
+	if err := w.WebhookRepo.UpdateDelivery(ctx, delivery.ID, updates); err != nil {
+		logs.CtxWarnf(ctx, "update webhook delivery failed, deliveryID=%d, err=%v", delivery.ID, err)
+	}
+
+	return err == nil
+}
+
+func (w *webhookImpl) PurgeDeliveries(ctx context.Context, before time.Time) (int64, error) {
+	var purged int64
+	for {
+		n, err := w.WebhookRepo.PurgeDeliveries(ctx, before.UnixMilli(), purgeDeliveryBatch)
+		if err != nil {
+			return purged, err
+		}
+		purged += n
+		if n < purgeDeliveryBatch {
+			return purged, nil
+		}
+	}
+}
+
+func (w *webhookImpl) getOwnedWebhook(ctx context.Context, userID, webhookID int64) (*model.Webhook, error) {
+	hook, exist, err := w.WebhookRepo.GetWebhookByID(ctx, webhookID)
+	if err != nil {
+		return nil, err
+	}
+	if !exist || hook.UserID != userID {
+		return nil, errorx.New(errno.ErrWebhookNotFoundCode, errorx.KV("webhook_id", conv.Int64ToStr(webhookID)))
+	}
+
+	return hook, nil
+}
+
+// deliveryBackoff is the wait after the given number of failed attempts.
+func deliveryBackoff(attempts int32) time.Duration {
+	backoff := retryBackoff
+	for i := int32(1); i < attempts && backoff < maxRetryBackoff; i++ {
+		backoff *= 2
+	}
+
+	return min(backoff, maxRetryBackoff)
+}
+
+func truncateError(err error) string {
+	msg := err.Error()
+	if utf8.RuneCountInString(msg) <= maxLastErrorLength {
+		return msg
+	}
+
+	return string([]rune(msg)[:maxLastErrorLength])
+}
+
+// validateWebhookURL also keeps webhooks off the host and its private
+// network, the sender checks the address again when it connects.
+func validateWebhookURL(ctx context.Context, rawURL string) error {
+	if len(rawURL) > maxWebhookURLLength {
+		return errorx.New(errno.ErrTaskInvalidParamCode, errorx.KV("msg", "webhook url is too long"))
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return errorx.New(errno.ErrTaskInvalidParamCode, errorx.KV("msg", "webhook url must be an absolute http or https url"))
+	}
+	if err := webhook.CheckHost(ctx, u.Hostname()); err != nil {
+		if errors.Is(err, webhook.ErrForbiddenAddress) {
+			return errorx.New(errno.ErrTaskInvalidParamCode, errorx.KV("msg", "webhook url must not point to a local or private address"))
+		}
+		return errorx.New(errno.ErrTaskInvalidParamCode, errorx.KV("msg", "webhook host can't be resolved"))
+	}
+
+	return nil
+}
+
+func validateSecret(secret string) error {
+	if len(secret) < minSecretLength || len(secret) > maxSecretLength {
+		return errorx.New(errno.ErrTaskInvalidParamCode, errorx.KVf("msg", "webhook secret must be %d to %d characters", minSecretLength, maxSecretLength))
+	}
+
+	return nil
+}
+
+func generateSecret() (string, error) {
+	b := make([]byte, 24)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return secretPrefix + hex.EncodeToString(b), nil
+}
+
+// formatEventTypes joins the names of the event types for storage.
+func formatEventTypes(types []entity.TaskEventType) (string, error) {
+	names := make([]string, 0, len(types))
+	seen := make(map[entity.TaskEventType]bool, len(types))
+	for _, typ := range types {
+		if _, ok := parseEventType(typ.String()); !ok {
+			return "", errorx.New(errno.ErrTaskInvalidParamCode, errorx.KV("msg", "invalid event type"))
+		}
+		if !seen[typ] {
+			seen[typ] = true
+			names = append(names, typ.String())
+		}
+	}
+
+	return strings.Join(names, ","), nil
+}
+
+func parseEventTypes(s string) []entity.TaskEventType {
+	if s == "" {
+		return nil
+	}
+
+	var types []entity.TaskEventType
+	for _, name := range strings.Split(s, ",") {
+		if typ, ok := parseEventType(name); ok {
+			types = append(types, typ)
+		}
+	}
+	return types
+}
+
+func parseEventType(name string) (entity.TaskEventType, bool) {
+	for _, typ := range []entity.TaskEventType{entity.TaskCreated, entity.TaskUpdated, entity.TaskStatusChanged, entity.TaskDeleted} {
+		if typ.String() == name {
+			return typ, true
+		}
+	}
+	return 0, false
+}
+
+func webhookPO2DO(hook *model.Webhook) *entity.Webhook {
+	return &entity.Webhook{
+		ID:         hook.ID,
+		UserID:     hook.UserID,
+		URL:        hook.URL,
+		EventTypes: parseEventTypes(hook.EventTypes),
+		Enabled:    hook.Enabled,
+		CreatedAt:  hook.CreatedAt,
+		UpdatedAt:  hook.UpdatedAt,
+	}
+}
+
+func deliveryPO2DO(delivery *model.WebhookDelivery) *entity.WebhookDelivery {
+	return &entity.WebhookDelivery{
+		ID:             delivery.ID,
+		WebhookID:      delivery.WebhookID,
+		EventID:        delivery.EventID,
+		EventType:      entity.TaskEventType(delivery.EventType),
+		Payload:        delivery.Payload,
+		Status:         entity.DeliveryStatus(delivery.Status),
+		Attempts:       delivery.Attempts,
+		NextAttemptAt:  delivery.NextAttemptAt,
+		ResponseStatus: delivery.ResponseStatus,
+		LastError:      delivery.LastError,
+		CreatedAt:      delivery.CreatedAt,
+		UpdatedAt:      delivery.UpdatedAt,
+		DeliveredAt:    delivery.DeliveredAt,
+	}
+}
+
+func newWebhookTask(taskModel *model.Task) *webhookTask {
+	return &webhookTask{
+		ID:        taskModel.ID,
+		Title:     taskModel.Title,
+		Content:   taskModel.Content,
+		Status:    entity.Status(taskModel.Status).String(),
+		Priority:  entity.Priority(taskModel.Priority).String(),
+		DueAt:     taskModel.DueAt,
+		ProjectID: taskModel.ProjectID,
+		ParentID:  taskModel.ParentID,
+		Version:   taskModel.Version,
+		UpdatedAt: taskModel.UpdatedAt,
+	}
+}
@@ -0,0 +1,428 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/entity"
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/internal/dal"
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/internal/dal/model"
+	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/repository"
+	"github.com/crazyfrankie/zrpc-todolist/infra/contract/eventbus"
+	"github.com/crazyfrankie/zrpc-todolist/infra/contract/idgen"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/lang/ptr"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/webhook"
+	"github.com/crazyfrankie/zrpc-todolist/types/errno"
+)
+
+const testWebhookSecret = "whsec_0123456789abcdef"
+
+func TestCreateWebhookRejectsLocalURL(t *testing.T) {
+	ctx := context.Background()
+	d := NewWebhookDomain(newTestComponents(t))
+
+	urls := []string{
+		"http://127.0.0.1:8080/hook",
+		"http://localhost/hook",
+		"http://[::1]/hook",
+		"http://0.0.0.0/hook",
+		"http://10.0.0.7/hook",
+		"http://192.168.1.1/hook",
+		"http://169.254.169.254/latest/meta-data",
+		"http://[fe80::1]/hook",
+		"ftp://93.184.216.34/hook",
+	}
+	for _, rawURL := range urls {
+		_, err := d.CreateWebhook(ctx, &CreateWebhookRequest{UserID: ownerID, URL: rawURL})
+		assertCode(t, err, errno.ErrTaskInvalidParamCode)
+	}
+	hooks, err := d.ListWebhooks(ctx, ownerID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(hooks) != 0 {
+		t.Fatalf("got %d webhooks, want 0", len(hooks))
+	}
+
+	hook, err := d.CreateWebhook(ctx, &CreateWebhookRequest{UserID: ownerID, URL: "https://93.184.216.34/hook"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = d.UpdateWebhook(ctx, &UpdateWebhookRequest{UserID: ownerID, WebhookID: hook.ID, URL: ptr.Of("http://127.0.0.1/hook")})
+	assertCode(t, err, errno.ErrTaskInvalidParamCode)
+}
+
+// receiver counts the deliveries it gets and answers them with the statuses
+// in turn, the last one over and over.
+type receiver struct {
+	t        *testing.T
+	statuses []int
+	hits     atomic.Int32
+}
+
+func (r *receiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	body, _ := io.ReadAll(req.Body)
+	if err := webhook.Verify(testWebhookSecret, req.Header, body, time.Now(), time.Minute); err != nil {
+		r.t.Errorf("Verify() = %v", err)
+	}
+	if req.Header.Get(webhook.EventHeader) != entity.TaskCreated.String() {
+		r.t.Errorf("event = %q, want %q", req.Header.Get(webhook.EventHeader), entity.TaskCreated.String())
+	}
+	n := int(r.hits.Add(1))
+	w.WriteHeader(r.statuses[min(n, len(r.statuses))-1])
+}
+
+// newDeliveryTest returns a webhook domain with a pending delivery to a
+// webhook served by recv.
+func newDeliveryTest(t *testing.T, recv *receiver, enabled bool) (Webhook, *Components, int64) {
+	t.Helper()
+
+	srv := httptest.NewServer(recv)
+	t.Cleanup(srv.Close)
+
+	c := newTestComponents(t)
+	// the receiver listens on loopback
+	c.WebhookSender = webhook.NewSender(nil, func(net.IP) bool { return true })
+
+	ctx := context.Background()
+	now := time.Now().UnixMilli()
+	hook := &model.Webhook{ID: 1, UserID: ownerID, URL: srv.URL, Secret: testWebhookSecret, Enabled: true, CreatedAt: now, UpdatedAt: now}
+	if err := c.WebhookRepo.Create(ctx, hook); err != nil {
+		t.Fatal(err)
+	}
+	// a false Enabled is left to the column default on insert
+	if !enabled {
+		if _, err := c.WebhookRepo.UpdateWebhook(ctx, ownerID, hook.ID, map[string]any{"enabled": false}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	delivery := &model.WebhookDelivery{
+		ID:            2,
+		WebhookID:     hook.ID,
+		UserID:        ownerID,
+		EventID:       "event-1",
+		EventType:     entity.TaskCreated.Int32(),
+		Payload:       `{"type":"task.created"}`,
+		Status:        dal.DeliveryPending,
+		NextAttemptAt: now,
+	}
+	if err := c.WebhookRepo.CreateDeliveries(ctx, []*model.WebhookDelivery{delivery}); err != nil {
+		t.Fatal(err)
+	}
+
+	return NewWebhookDomain(c), c, delivery.ID
+}
+
+func getDelivery(t *testing.T, c *Components, deliveryID int64) *model.WebhookDelivery {
+	t.Helper()
+
+	delivery, exist, err := c.WebhookRepo.GetDeliveryByID(context.Background(), deliveryID)
+	if err != nil || !exist {
+		t.Fatalf("GetDeliveryByID(%d) = %v, %v", deliveryID, exist, err)
+	}
+	return delivery
+}
+
+func dispatch(t *testing.T, d Webhook, now time.Time) int {
+	t.Helper()
+
+	n, err := d.DispatchDueDeliveries(context.Background(), now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return n
+}
+
+func TestDispatchRetriesWithBackoff(t *testing.T) {
+	recv := &receiver{t: t, statuses: []int{http.StatusInternalServerError, http.StatusBadGateway, http.StatusOK}}
+	d, c, deliveryID := newDeliveryTest(t, recv, true)
+
+	for attempt := int32(1); attempt <= 2; attempt++ {
+		before := time.Now()
+		if n := dispatch(t, d, before); n != 0 {
+			t.Fatalf("attempt %d: %d succeeded, want 0", attempt, n)
+		}
+		after := time.Now()
+
+		delivery := getDelivery(t, c, deliveryID)
+		if delivery.Status != dal.DeliveryPending || delivery.Attempts != attempt {
+			t.Fatalf("attempt %d: status %d, attempts %d", attempt, delivery.Status, delivery.Attempts)
+		}
+		if !strings.Contains(delivery.LastError, "unexpected status") || delivery.ResponseStatus != int32(recv.statuses[attempt-1]) {
+			t.Fatalf("attempt %d: last error %q, response status %d", attempt, delivery.LastError, delivery.ResponseStatus)
+		}
+		backoff := deliveryBackoff(attempt)
+		if next := delivery.NextAttemptAt; next < before.Add(backoff).UnixMilli() || next > after.Add(backoff).UnixMilli() {
+			t.Fatalf("attempt %d: next attempt in %v, want %v", attempt, time.UnixMilli(next).Sub(before), backoff)
+		}
+
+		// nothing is sent before the backoff runs out
+		dispatch(t, d, after)
+		if n := recv.hits.Load(); n != attempt {
+			t.Fatalf("attempt %d: receiver got %d requests", attempt, n)
+		}
+		// wait out the backoff
+		err := c.WebhookRepo.UpdateDelivery(context.Background(), deliveryID, map[string]any{"next_attempt_at": time.Now().UnixMilli()})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if n := dispatch(t, d, time.Now()); n != 1 {
+		t.Fatalf("%d succeeded, want 1", n)
+	}
+	delivery := getDelivery(t, c, deliveryID)
+	if delivery.Status != dal.DeliverySucceeded || delivery.Attempts != 3 || delivery.LastError != "" || delivery.DeliveredAt == 0 {
+		t.Fatalf("delivery = %+v, want succeeded on the third attempt", delivery)
+	}
+}
+
+func TestDispatchDeadLetter(t *testing.T) {
+	recv := &receiver{t: t, statuses: []int{http.StatusServiceUnavailable}}
+	d, c, deliveryID := newDeliveryTest(t, recv, true)
+
+	for range maxDeliveryAttempts {
+		next := getDelivery(t, c, deliveryID).NextAttemptAt
+		dispatch(t, d, time.UnixMilli(next))
+	}
+
+	delivery := getDelivery(t, c, deliveryID)
+	if delivery.Status != dal.DeliveryDead || delivery.Attempts != maxDeliveryAttempts {
+		t.Fatalf("status %d, attempts %d, want dead after %d", delivery.Status, delivery.Attempts, maxDeliveryAttempts)
+	}
+	if delivery.ResponseStatus != http.StatusServiceUnavailable || delivery.LastError == "" {
+		t.Fatalf("response status %d, last error %q", delivery.ResponseStatus, delivery.LastError)
+	}
+
+	// dead deliveries are left alone
+	dispatch(t, d, time.Now().Add(24*time.Hour))
+	if n := recv.hits.Load(); n != maxDeliveryAttempts {
+		t.Fatalf("receiver got %d requests, want %d", n, maxDeliveryAttempts)
+	}
+}
+
+func TestDispatchDisabledWebhook(t *testing.T) {
+	recv := &receiver{t: t, statuses: []int{http.StatusOK}}
+	d, c, deliveryID := newDeliveryTest(t, recv, false)
+
+	dispatch(t, d, time.Now())
+
+	delivery := getDelivery(t, c, deliveryID)
+	if delivery.Status != dal.DeliveryDead || delivery.LastError != "webhook disabled" {
+		t.Fatalf("status %d, last error %q, want dead", delivery.Status, delivery.LastError)
+	}
+	if n := recv.hits.Load(); n != 0 {
+		t.Fatalf("receiver got %d requests, want 0", n)
+	}
+}
+
+func TestDeliveryBackoff(t *testing.T) {
+	tests := []struct {
+		attempts int32
+		want     time.Duration
+	}{
+		{1, 30 * time.Second},
+		{2, time.Minute},
+		{3, 2 * time.Minute},
+		{7, 32 * time.Minute},
+		{8, time.Hour},
+		{20, time.Hour},
+	}
+	for _, tt := range tests {
+		if got := deliveryBackoff(tt.attempts); got != tt.want {
+			t.Errorf("deliveryBackoff(%d) = %v, want %v", tt.attempts, got, tt.want)
+		}
+	}
+}
+
+// failingWebhookRepo fails listing the webhooks or saving deliveries with the
+// errors set.
+type failingWebhookRepo struct {
+	repository.WebhookRepository
+	listErr   error
+	createErr error
+}
+
+func (r *failingWebhookRepo) ListWebhooks(ctx context.Context, userID int64) ([]*model.Webhook, error) {
+	if r.listErr != nil {
+		return nil, r.listErr
+	}
+	return r.WebhookRepository.ListWebhooks(ctx, userID)
+}
+
+func (r *failingWebhookRepo) CreateDeliveries(ctx context.Context, deliveries []*model.WebhookDelivery) error {
+	if r.createErr != nil {
+		return r.createErr
+	}
+	return r.WebhookRepository.CreateDeliveries(ctx, deliveries)
+}
+
+// failingIDGen hands out left more IDs while fail is set, then fails.
+type failingIDGen struct {
+	idgen.IDGenerator
+	fail bool
+	left int
+}
+
+func (g *failingIDGen) GenID(ctx context.Context) (int64, error) {
+	if g.fail {
+		if g.left == 0 {
+			return 0, errors.New("id generator down")
+		}
+		g.left--
+	}
+	return g.IDGenerator.GenID(ctx)
+}
+
+// newFanOutTest returns the webhook domain with a task of the owner and the
+// event of its creation, along with a webhook per URL.
+func newFanOutTest(t *testing.T, urls ...string) (*Components, *eventbus.Event, []int64) {
+	t.Helper()
+
+	ctx := context.Background()
+	c := newTestComponents(t)
+	// the receivers listen on loopback
+	c.WebhookSender = webhook.NewSender(nil, func(net.IP) bool { return true })
+
+	task, err := NewTaskDomain(c).Create(ctx, &CreateTaskRequest{UserID: ownerID, Title: "ship it"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var hookIDs []int64
+	for _, url := range urls {
+		id, err := c.IDGen.GenID(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		hook := &model.Webhook{ID: id, UserID: ownerID, URL: url, Secret: testWebhookSecret, Enabled: true}
+		if err := c.WebhookRepo.Create(ctx, hook); err != nil {
+			t.Fatal(err)
+		}
+		hookIDs = append(hookIDs, id)
+	}
+
+	payload, err := json.Marshal(&dal.TaskEvent{Type: entity.TaskCreated.Int32(), UserID: ownerID, TaskID: task.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	event := &eventbus.Event{ID: "event-1", Payload: payload, CreatedAt: time.Now().UnixMilli()}
+
+	return c, event, hookIDs
+}
+
+func hookDeliveries(t *testing.T, c *Components, hookID int64) []*model.WebhookDelivery {
+	t.Helper()
+
+	deliveries, err := c.WebhookRepo.ListDeliveries(context.Background(), hookID, 0, -1, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return deliveries
+}
+
+func TestEnqueueDeliveriesFailures(t *testing.T) {
+	errDown := errors.New("database down")
+
+	tests := []struct {
+		name string
+		fail func(c *Components)
+	}{
+		{"list webhooks", func(c *Components) {
+			c.WebhookRepo = &failingWebhookRepo{WebhookRepository: c.WebhookRepo, listErr: errDown}
+		}},
+		{"generate second id", func(c *Components) {
+			c.IDGen = &failingIDGen{IDGenerator: c.IDGen, fail: true, left: 1}
+		}},
+		{"save deliveries", func(c *Components) {
+			c.WebhookRepo = &failingWebhookRepo{WebhookRepository: c.WebhookRepo, createErr: errDown}
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			c, event, hookIDs := newFanOutTest(t, "https://93.184.216.34/a", "https://93.184.216.34/b")
+			repo, gen := c.WebhookRepo, c.IDGen
+
+			tt.fail(c)
+			if err := NewWebhookDomain(c).EnqueueDeliveries(ctx, event); err == nil {
+				t.Fatal("EnqueueDeliveries() = nil, want the error so the event is redelivered")
+			}
+			// no webhook got a delivery of the failed event
+			c.WebhookRepo, c.IDGen = repo, gen
+			for _, hookID := range hookIDs {
+				if got := hookDeliveries(t, c, hookID); len(got) != 0 {
+					t.Fatalf("webhook %d got %d deliveries, want none", hookID, len(got))
+				}
+			}
+
+			// the redelivered event reaches every webhook
+			if err := NewWebhookDomain(c).EnqueueDeliveries(ctx, event); err != nil {
+				t.Fatal(err)
+			}
+			for _, hookID := range hookIDs {
+				got := hookDeliveries(t, c, hookID)
+				if len(got) != 1 || got[0].EventID != event.ID || got[0].Status != dal.DeliveryPending {
+					t.Fatalf("webhook %d deliveries = %+v, want one pending", hookID, got)
+				}
+			}
+		})
+	}
+}
+
+func TestEnqueueDeliveriesMalformedEvent(t *testing.T) {
+	c, _, hookIDs := newFanOutTest(t, "https://93.184.216.34/a")
+
+	// retrying would not help, so the event is dropped
+	event := &eventbus.Event{ID: "event-1", Payload: []byte("{not json")}
+	if err := NewWebhookDomain(c).EnqueueDeliveries(context.Background(), event); err != nil {
+		t.Fatalf("EnqueueDeliveries() = %v, want nil", err)
+	}
+	if got := hookDeliveries(t, c, hookIDs[0]); len(got) != 0 {
+		t.Fatalf("got %d deliveries, want none", len(got))
+	}
+}
+
+func TestFanOutFailingWebhook(t *testing.T) {
+	failing := &receiver{t: t, statuses: []int{http.StatusInternalServerError}}
+	healthy := &receiver{t: t, statuses: []int{http.StatusOK}}
+	var urls []string
+	for _, recv := range []*receiver{failing, healthy} {
+		srv := httptest.NewServer(recv)
+		t.Cleanup(srv.Close)
+		urls = append(urls, srv.URL)
+	}
+	c, event, hookIDs := newFanOutTest(t, urls...)
+	d := NewWebhookDomain(c)
+
+	if err := d.EnqueueDeliveries(context.Background(), event); err != nil {
+		t.Fatal(err)
+	}
+	if n := dispatch(t, d, time.Now()); n != 1 {
+		t.Fatalf("%d succeeded, want 1", n)
+	}
+
+	// the failing webhook is retried on its own
+	failed := hookDeliveries(t, c, hookIDs[0])
+	if len(failed) != 1 || failed[0].Status != dal.DeliveryPending || failed[0].Attempts != 1 || failed[0].LastError == "" {
+		t.Fatalf("failing webhook deliveries = %+v, want one pending retry", failed)
+	}
+	delivered := hookDeliveries(t, c, hookIDs[1])
+	if len(delivered) != 1 || delivered[0].Status != dal.DeliverySucceeded {
+		t.Fatalf("healthy webhook deliveries = %+v, want one succeeded", delivered)
+	}
+
+	next := failed[0].NextAttemptAt
+	dispatch(t, d, time.UnixMilli(next))
+	if failing.hits.Load() != 2 || healthy.hits.Load() != 1 {
+		t.Fatalf("receivers got %d and %d requests, want 2 and 1", failing.hits.Load(), healthy.hits.Load())
+	}
+}
@@ -8,7 +8,10 @@ import (
 	"github.com/crazyfrankie/zrpc-todolist/apps/task/application"
 	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/repository"
 	"github.com/crazyfrankie/zrpc-todolist/apps/task/domain/service"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/outbox"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/webhook"
 	"github.com/crazyfrankie/zrpc-todolist/protocol/task"
+	"github.com/crazyfrankie/zrpc-todolist/types/consts"
 )
 
 func Start(ctx context.Context, srv zrpc.ServiceRegistrar, getConn func(service string) (zrpc.ClientInterface, error)) error {
@@ -16,12 +19,44 @@ func Start(ctx context.Context, srv zrpc.ServiceRegistrar, getConn func(service
 	if err != nil {
 		return err
 	}
-	taskRepo := repository.NewTaskRepository(basic.DB)
-	taskDomain := service.NewTaskDomain(&service.Components{
-		TaskRepo: taskRepo,
-		IDGen:    basic.IDGen,
-	})
-	appService := application.NewTaskApplicationService(taskDomain)
+	components := &service.Components{
+		TaskRepo:       repository.NewTaskRepository(basic.DB),
+		TagRepo:        repository.NewTagRepository(basic.DB),
+		ProjectRepo:    repository.NewProjectRepository(basic.DB),
+		ChecklistRepo:  repository.NewChecklistRepository(basic.DB),
+		DependencyRepo: repository.NewDependencyRepository(basic.DB),
+		BoardRepo:      repository.NewBoardRepository(basic.DB),
+		ActivityRepo:   repository.NewActivityRepository(basic.DB),
+		CommentRepo:    repository.NewCommentRepository(basic.DB),
+		AttachmentRepo: repository.NewAttachmentRepository(basic.DB),
+		ChangeRepo:     repository.NewChangeRepository(basic.DB),
+		WebhookRepo:    repository.NewWebhookRepository(basic.DB),
+		WebhookSender:  webhook.NewSender(nil, nil),
+		CalendarRepo:   repository.NewCalendarRepository(basic.DB),
+		FileOSS:        basic.FileOSS,
+		IDGen:          basic.IDGen,
+		Searcher:       basic.Searcher,
+		Notifier:       basic.Notifier,
+		PubSub:         basic.PubSub,
+		Cache:          basic.Cache,
+	}
+	taskDomain := service.NewTaskDomain(components)
+	tagDomain := service.NewTagDomain(components)
+	projectDomain := service.NewProjectDomain(components)
+	boardDomain := service.NewBoardDomain(components)
+	webhookDomain := service.NewWebhookDomain(components)
+	appService := application.NewTaskApplicationService(taskDomain, tagDomain, projectDomain, boardDomain, webhookDomain)
+
+	go application.NewReminderScheduler(taskDomain).Run(ctx)
+	go application.NewRecycleBinPurger(taskDomain).Run(ctx)
+	go application.NewRankRebalancer(taskDomain).Run(ctx)
+	go application.NewWebhookDispatcher(webhookDomain).Run(ctx)
+	go outbox.NewRelay(basic.DB, basic.EventBus).Run(ctx)
+	// a redelivered event must not reach the streams twice
+	go outbox.Consume(ctx, basic.EventBus, consts.TaskEventTopic, consts.TaskStreamGroup,
+		outbox.Idempotent(basic.DB, consts.TaskStreamGroup, taskDomain.BroadcastTaskEvent))
+	// idempotent as is, a webhook holds at most one delivery of an event
+	go outbox.Consume(ctx, basic.EventBus, consts.TaskEventTopic, consts.TaskWebhookGroup, webhookDomain.EnqueueDeliveries)
 
 	task.RegisterTaskServiceServer(srv, appService)
 
@@ -6,9 +6,11 @@ import (
 	"github.com/crazyfrankie/zrpc"
 	"gorm.io/gorm"
 
+	"github.com/crazyfrankie/zrpc-todolist/infra/contract/eventbus"
 	"github.com/crazyfrankie/zrpc-todolist/infra/contract/idgen"
 	"github.com/crazyfrankie/zrpc-todolist/infra/contract/storage"
 	"github.com/crazyfrankie/zrpc-todolist/infra/impl/cache/redis"
+	eventbusimpl "github.com/crazyfrankie/zrpc-todolist/infra/impl/eventbus"
 	idgenimpl "github.com/crazyfrankie/zrpc-todolist/infra/impl/idgen"
 	"github.com/crazyfrankie/zrpc-todolist/infra/impl/mysql"
 	storageimpl "github.com/crazyfrankie/zrpc-todolist/infra/impl/storage"
@@ -21,6 +23,8 @@ type BasicServices struct {
 	IDGen   idgen.IDGenerator
 	IconOSS storage.Storage
 	AuthCli auth.AuthServiceClient
+	// EventBus carries the events relayed from the outbox.
+	EventBus eventbus.EventBus
 }
 
 func Init(ctx context.Context, getConn func(service string) (zrpc.ClientInterface, error)) (*BasicServices, error) {
@@ -51,5 +55,7 @@ func Init(ctx context.Context, getConn func(service string) (zrpc.ClientInterfac
 		return nil, err
 	}
 
+	basic.EventBus = eventbusimpl.New()
+
 	return basic, nil
 }
@@ -0,0 +1,15 @@
+package application
+
+import (
+	"context"
+
+	"github.com/crazyfrankie/zrpc-todolist/infra/contract/eventbus"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/metrics"
+)
+
+// CountRegistration counts the users registered, one user.registered event at a
+// time. It runs wrapped in outbox.Idempotent so redeliveries aren't counted.
+func CountRegistration(ctx context.Context, event *eventbus.Event) error {
+	metrics.UserRegisterCounter.Add(1)
+	return nil
+}
@@ -49,8 +49,6 @@ func (u *UserApplicationService) Register(ctx context.Context, req *user.Registe
 	data.AccessToken = tkRes.AccessToken
 	data.RefreshToken = tkRes.RefreshToken
 
-	metrics.UserRegisterCounter.Add(1)
-
 	return &user.RegisterResponse{
 		Data: data,
 	}, nil
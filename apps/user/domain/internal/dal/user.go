@@ -2,6 +2,7 @@ package dal
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"time"
 
@@ -9,6 +10,10 @@ import (
 
 	"github.com/crazyfrankie/zrpc-todolist/apps/user/domain/internal/dal/model"
 	"github.com/crazyfrankie/zrpc-todolist/apps/user/domain/internal/dal/query"
+	"github.com/crazyfrankie/zrpc-todolist/infra/contract/eventbus"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/lang/conv"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/outbox"
+	"github.com/crazyfrankie/zrpc-todolist/types/consts"
 )
 
 type UserDao struct {
@@ -71,7 +76,27 @@ func (u *UserDao) CheckUniqueNameExist(ctx context.Context, name string) (bool,
 	return true, nil
 }
 
-// CreateUser Create a new user
+// CreateUser Create a new user, along with its user.registered event
 func (u *UserDao) CreateUser(ctx context.Context, user *model.User) error {
-	return u.query.User.WithContext(ctx).Create(user)
+	return u.query.Transaction(func(tx *query.Query) error {
+		if err := tx.User.WithContext(ctx).Create(user); err != nil {
+			return err
+		}
+
+		payload, err := json.Marshal(&userRegisteredEvent{UserID: user.ID})
+		if err != nil {
+			return err
+		}
+
+		return outbox.Add(tx.User.WithContext(ctx).UnderlyingDB(), &eventbus.Event{
+			Topic:   consts.UserRegisteredTopic,
+			Key:     conv.Int64ToStr(user.ID),
+			Payload: payload,
+		})
+	})
+}
+
+// userRegisteredEvent is the payload of the user.registered events.
+type userRegisteredEvent struct {
+	UserID int64 `json:"user_id"`
 }
@@ -8,7 +8,9 @@ import (
 	"github.com/crazyfrankie/zrpc-todolist/apps/user/application"
 	"github.com/crazyfrankie/zrpc-todolist/apps/user/domain/repository"
 	"github.com/crazyfrankie/zrpc-todolist/apps/user/domain/service"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/outbox"
 	"github.com/crazyfrankie/zrpc-todolist/protocol/user"
+	"github.com/crazyfrankie/zrpc-todolist/types/consts"
 )
 
 func Start(ctx context.Context, srv zrpc.ServiceRegistrar, getConn func(service string) (zrpc.ClientInterface, error)) error {
@@ -24,6 +26,10 @@ func Start(ctx context.Context, srv zrpc.ServiceRegistrar, getConn func(service
 	})
 	appService := application.NewUserApplicationService(userDomain, basic.AuthCli)
 
+	go outbox.NewRelay(basic.DB, basic.EventBus).Run(ctx)
+	go outbox.Consume(ctx, basic.EventBus, consts.UserRegisteredTopic, consts.UserMetricsGroup,
+		outbox.Idempotent(basic.DB, consts.UserMetricsGroup, application.CountRegistration))
+
 	user.RegisterUserServiceServer(srv, appService)
 
 	return nil
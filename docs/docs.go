@@ -15,33 +15,28 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/tasks/create": {
-            "post": {
-                "description": "Create a new task with title and content",
-                "consumes": [
-                    "application/json"
-                ],
+        "/tasks/attachment/delete/{id}": {
+            "delete": {
+                "description": "Delete an attachment of a task along with its stored file",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Task"
+                    "Attachment"
                 ],
-                "summary": "Create a new task",
+                "summary": "Delete attachment",
                 "parameters": [
                     {
-                        "description": "Create task request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_interfaces_task_model.CreateTaskReq"
-                        }
+                        "type": "string",
+                        "description": "Attachment ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Task created successfully",
+                        "description": "Attachment deleted successfully",
                         "schema": {
                             "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
                         }
@@ -61,94 +56,42 @@ const docTemplate = `{
                 }
             }
         },
-        "/tasks/list": {
-            "get": {
-                "description": "Get all tasks for current user",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Task"
-                ],
-                "summary": "Get task list",
-                "responses": {
-                    "200": {
-                        "description": "Task list retrieved successfully",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal server error",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
-                        }
-                    }
-                }
-            }
-        },
-        "/tasks/recycle-list": {
+        "/tasks/attachment/download/{id}": {
             "get": {
-                "description": "Get all deleted tasks in recycle bin",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Task"
-                ],
-                "summary": "Get recycle bin task list",
-                "responses": {
-                    "200": {
-                        "description": "Recycle bin task list retrieved successfully",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal server error",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
-                        }
-                    }
-                }
-            }
-        },
-        "/tasks/update/{id}": {
-            "put": {
-                "description": "Update task title and content by task ID",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Get a presigned download URL for an attachment, the URL is valid for 15 minutes",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Task"
+                    "Attachment"
                 ],
-                "summary": "Update task",
+                "summary": "Download attachment",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Task ID",
+                        "description": "Attachment ID",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Update task request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_interfaces_task_model.UpdateTaskReq"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Task updated successfully",
+                        "description": "Download URL retrieved successfully",
                         "schema": {
-                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_interfaces_task_model.AttachmentURLResp"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
@@ -166,16 +109,16 @@ const docTemplate = `{
                 }
             }
         },
-        "/tasks/update/{id}/status": {
-            "put": {
-                "description": "Update task status by task ID",
+        "/tasks/attachment/list/{id}": {
+            "get": {
+                "description": "List the attachments of a task oldest first",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Task"
+                    "Attachment"
                 ],
-                "summary": "Update task status",
+                "summary": "List attachments",
                 "parameters": [
                     {
                         "type": "string",
@@ -183,18 +126,32 @@ const docTemplate = `{
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Task status",
-                        "name": "status",
-                        "in": "query",
-                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Task status updated successfully",
+                        "description": "Attachments retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_protocol_task.Attachment"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid parameters",
                         "schema": {
                             "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
                         }
@@ -208,9 +165,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/user/avatar": {
+        "/tasks/attachment/upload": {
             "post": {
-                "description": "Upload and update user avatar image",
+                "description": "Attach a file of at most 4MB to a task, images, PDFs and plain text are accepted",
                 "consumes": [
                     "multipart/form-data"
                 ],
@@ -218,23 +175,42 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Attachment"
                 ],
-                "summary": "Update user avatar",
+                "summary": "Upload an attachment",
                 "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Task ID",
+                        "name": "task_id",
+                        "in": "formData",
+                        "required": true
+                    },
                     {
                         "type": "file",
-                        "description": "Avatar image file",
-                        "name": "avatar",
+                        "description": "Attached file",
+                        "name": "file",
                         "in": "formData",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Avatar updated successfully",
+                        "description": "Attachment uploaded successfully",
                         "schema": {
-                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_protocol_task.Attachment"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
@@ -252,9 +228,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/user/login": {
+        "/tasks/batch/create": {
             "post": {
-                "description": "User login authentication",
+                "description": "Create up to 100 tasks at once, the valid ones are created together and each result tells whether its task was created",
                 "consumes": [
                     "application/json"
                 ],
@@ -262,23 +238,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Batch"
                 ],
-                "summary": "User login",
+                "summary": "Create tasks in batch",
                 "parameters": [
                     {
-                        "description": "Login request",
+                        "description": "Batch create tasks request",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_interfaces_user_model.UserLoginReq"
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_interfaces_task_model.BatchCreateTasksReq"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Login successful",
+                        "description": "Batch applied, see the results",
                         "schema": {
                             "allOf": [
                                 {
@@ -288,7 +264,10 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_interfaces_user_model.UserInfoResp"
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_protocol_task.BatchResult"
+                                            }
                                         }
                                     }
                                 }
@@ -310,45 +289,33 @@ const docTemplate = `{
                 }
             }
         },
-        "/user/logout": {
-            "get": {
-                "description": "User logout",
+        "/tasks/batch/delete": {
+            "delete": {
+                "description": "Move up to 100 tasks with their subtasks to the recycle bin at once",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Batch"
                 ],
-                "summary": "User logout",
-                "responses": {
-                    "200": {
-                        "description": "Logout successful",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal server error",
+                "summary": "Delete tasks in batch",
+                "parameters": [
+                    {
+                        "description": "Batch delete request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
                         "schema": {
-                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_interfaces_task_model.BatchDeleteReq"
                         }
                     }
-                }
-            }
-        },
-        "/user/profile": {
-            "get": {
-                "description": "Get current user profile information",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "User"
                 ],
-                "summary": "Get user information",
                 "responses": {
                     "200": {
-                        "description": "User information retrieved successfully",
+                        "description": "Batch applied, see the results",
                         "schema": {
                             "allOf": [
                                 {
@@ -358,13 +325,22 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_interfaces_user_model.UserInfoResp"
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_protocol_task.BatchResult"
+                                            }
                                         }
                                     }
                                 }
                             ]
                         }
                     },
+                    "400": {
+                        "description": "Invalid parameters",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -374,9 +350,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/user/refresh-token": {
-            "post": {
-                "description": "Refresh User AccessToken",
+        "/tasks/batch/move": {
+            "put": {
+                "description": "Move up to 100 top level tasks with their subtasks to a project at once",
                 "consumes": [
                     "application/json"
                 ],
@@ -384,14 +360,40 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Batch"
+                ],
+                "summary": "Move tasks in batch",
+                "parameters": [
+                    {
+                        "description": "Batch move request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_interfaces_task_model.BatchMoveReq"
+                        }
+                    }
                 ],
-                "summary": "Refresh User Token",
                 "responses": {
                     "200": {
-                        "description": "Refresh token successfully",
+                        "description": "Batch applied, see the results",
                         "schema": {
-                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_protocol_task.BatchResult"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
@@ -409,9 +411,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/user/register": {
-            "post": {
-                "description": "Register a new user",
+        "/tasks/batch/status": {
+            "put": {
+                "description": "Move up to 100 tasks to a status at once, tasks blocked by open tasks outside the batch can't be done",
                 "consumes": [
                     "application/json"
                 ],
@@ -419,23 +421,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Batch"
                 ],
-                "summary": "User registration",
+                "summary": "Update task status in batch",
                 "parameters": [
                     {
-                        "description": "Registration request",
+                        "description": "Batch update status request",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_interfaces_user_model.UserRegisterReq"
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_interfaces_task_model.BatchUpdateStatusReq"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Registration successful",
+                        "description": "Batch applied, see the results",
                         "schema": {
                             "allOf": [
                                 {
@@ -445,7 +447,10 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_interfaces_user_model.UserInfoResp"
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_protocol_task.BatchResult"
+                                            }
                                         }
                                     }
                                 }
@@ -467,9 +472,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/user/reset-password": {
+        "/tasks/board/column/create": {
             "post": {
-                "description": "Reset user password",
+                "description": "Append a column to a board, it shows the tasks in its status. The first column of a status can't have a WIP limit",
                 "consumes": [
                     "application/json"
                 ],
@@ -477,27 +482,308 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Board"
                 ],
-                "summary": "Reset user password",
+                "summary": "Create board column",
                 "parameters": [
                     {
-                        "description": "Reset password request",
+                        "description": "Create column request",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_interfaces_user_model.UserResetPasswordReq"
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_interfaces_task_model.CreateColumnReq"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Password reset successfully",
+                        "description": "Column created successfully",
                         "schema": {
-                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
-                        }
-                    },
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_protocol_task.BoardColumn"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid parameters",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/board/column/delete/{id}": {
+            "delete": {
+                "description": "Delete a column, its tasks move to the first column of their status",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Board"
+                ],
+                "summary": "Delete board column",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Column ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Column deleted successfully",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid parameters",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/board/column/reorder": {
+            "put": {
+                "description": "Put the given columns of a board in the given order, the others keep their places",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Board"
+                ],
+                "summary": "Reorder board columns",
+                "parameters": [
+                    {
+                        "description": "Reorder columns request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_interfaces_task_model.ReorderColumnsReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Columns reordered successfully",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid parameters",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/board/column/update/{id}": {
+            "put": {
+                "description": "Rename a column or change its WIP limit, a lower limit keeps the tasks already in the column. The first column of a status can't have a WIP limit",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Board"
+                ],
+                "summary": "Update board column",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Column ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update column request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_interfaces_task_model.UpdateColumnReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Column updated successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_protocol_task.BoardColumn"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid parameters",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/board/get": {
+            "get": {
+                "description": "Get every column of a project's kanban board with its tasks, a board without columns gets To Do, In Progress and Done",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Board"
+                ],
+                "summary": "Get board",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Project ID, absent means the inbox",
+                        "name": "project_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Board retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_protocol_task.Board"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid parameters",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/board/move/{id}": {
+            "put": {
+                "description": "Move a task into a column, taking the status of the column, fails once the column reached its WIP limit",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Board"
+                ],
+                "summary": "Move board card",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Move card request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_interfaces_task_model.MoveCardReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Card moved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_protocol_task.Task"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
                     "400": {
                         "description": "Invalid parameters",
                         "schema": {
@@ -512,92 +798,4621 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "github_com_crazyfrankie_zrpc-todolist_interfaces_task_model.CreateTaskReq": {
+        },
+        "/tasks/calendar/feed/create": {
+            "post": {
+                "description": "Create a secret iCalendar feed URL of the dated tasks to subscribe to from calendar apps. The token is only returned here and when rotated, a user has at most one feed.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Calendar"
+                ],
+                "summary": "Create calendar feed",
+                "responses": {
+                    "200": {
+                        "description": "Calendar feed created successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_interfaces_task_model.CalendarFeedResp"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/calendar/feed/revoke": {
+            "delete": {
+                "description": "Delete the calendar feed, its URL stops working",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Calendar"
+                ],
+                "summary": "Revoke calendar feed",
+                "responses": {
+                    "200": {
+                        "description": "Calendar feed revoked successfully",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/calendar/feed/rotate": {
+            "post": {
+                "description": "Replace the token of the calendar feed, the old feed URL stops working",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Calendar"
+                ],
+                "summary": "Rotate calendar feed",
+                "responses": {
+                    "200": {
+                        "description": "Calendar feed rotated successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_interfaces_task_model.CalendarFeedResp"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/calendar/{file}": {
+            "get": {
+                "description": "Get the tasks due from 90 days ago to 2 years ahead as an iCalendar file, each task as both a VTODO and a VEVENT at its due time. The token in the path authorizes the request, no Bearer token is needed. The ETag header changes along with the content.",
+                "produces": [
+                    "text/calendar"
+                ],
+                "tags": [
+                    "Calendar"
+                ],
+                "summary": "Get calendar feed",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Feed token followed by .ics",
+                        "name": "file",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of a cached copy",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "iCalendar file",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "304": {
+                        "description": "Feed not modified"
+                    },
+                    "404": {
+                        "description": "Unknown or revoked token",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/checklist/add": {
+            "post": {
+                "description": "Append a checklist item to a task, a task holds at most 100 items",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Checklist"
+                ],
+                "summary": "Add a checklist item",
+                "parameters": [
+                    {
+                        "description": "Add checklist item request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_interfaces_task_model.AddChecklistItemReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Checklist item added successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_protocol_task.ChecklistItem"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid parameters",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/checklist/delete/{id}": {
+            "delete": {
+                "description": "Delete a checklist item of a task",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Checklist"
+                ],
+                "summary": "Delete checklist item",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Checklist item ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Checklist item deleted successfully",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid parameters",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/checklist/update/{id}": {
+            "put": {
+                "description": "Edit and/or check a checklist item, checking the last open item may complete the task",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Checklist"
+                ],
+                "summary": "Update checklist item",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Checklist item ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update checklist item request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_interfaces_task_model.UpdateChecklistItemReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Checklist item updated successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_protocol_task.ChecklistItem"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid parameters",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/comment/add": {
+            "post": {
+                "description": "Append a comment to the discussion thread of a task",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Comment"
+                ],
+                "summary": "Add a comment",
+                "parameters": [
+                    {
+                        "description": "Add comment request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_interfaces_task_model.AddCommentReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Comment added successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_protocol_task.Comment"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid parameters",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/comment/delete/{id}": {
+            "delete": {
+                "description": "Delete a comment of a task",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Comment"
+                ],
+                "summary": "Delete comment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Comment ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Comment deleted successfully",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid parameters",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/comment/list/{id}": {
+            "get": {
+                "description": "List the comments on a task oldest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Comment"
+                ],
+                "summary": "List comments",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor returned by the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size",
+                        "name": "page_size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Comments retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_interfaces_task_model.ListCommentsResp"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid parameters",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/comment/update/{id}": {
+            "put": {
+                "description": "Edit the content of a comment",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Comment"
+                ],
+                "summary": "Update comment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Comment ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update comment request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_interfaces_task_model.UpdateCommentReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Comment updated successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_protocol_task.Comment"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid parameters",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/create": {
+            "post": {
+                "description": "Create a new task with title and content",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Task"
+                ],
+                "summary": "Create a new task",
+                "parameters": [
+                    {
+                        "description": "Create task request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_interfaces_task_model.CreateTaskReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Task created successfully",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid parameters",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/delete/{id}": {
+            "delete": {
+                "description": "Move a task to the recycle bin",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Task"
+                ],
+                "summary": "Delete task",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Task deleted successfully",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid parameters",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/dependency/add": {
+            "post": {
+                "description": "Make a task block another one of the same user, the blocked task can't be done until its blockers are. Dependencies creating a cycle are rejected",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Dependency"
+                ],
+                "summary": "Add a task dependency",
+                "parameters": [
+                    {
+                        "description": "Add dependency request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_interfaces_task_model.DependencyReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Dependency added successfully",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid parameters",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/dependency/remove": {
+            "delete": {
+                "description": "Stop a task from blocking another one",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Dependency"
+                ],
+                "summary": "Remove a task dependency",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Blocked task ID",
+                        "name": "task_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Blocking task ID",
+                        "name": "blocker_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Dependency removed successfully",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid parameters",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/due": {
+            "get": {
+                "description": "Get the overdue tasks or the tasks due today of current user, ordered by due time",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Task"
+                ],
+                "summary": "Get due tasks",
+                "parameters": [
+                    {
+                        "enum": [
+                            "overdue",
+                            "today"
+                        ],
+                        "type": "string",
+                        "description": "Due view, default overdue",
+                        "name": "view",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "IANA time zone used to determine today, default UTC",
+                        "name": "tz",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Due task list retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid parameters",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/export": {
+            "get": {
+                "description": "Export every task including the recycle bin as JSON, CSV or iCalendar, the download URL is valid for an hour",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Task"
+                ],
+                "summary": "Export tasks",
+                "parameters": [
+                    {
+                        "enum": [
+                            "json",
+                            "csv",
+                            "ical"
+                        ],
+                        "type": "string",
+                        "description": "File format, json by default",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Export created successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_interfaces_task_model.ExportTasksResp"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid parameters",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/get/{id}": {
+            "get": {
+                "description": "Get a task by ID, the ETag header changes with the task shown and leads with its version for conditional updates",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Task"
+                ],
+                "summary": "Get task",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of a cached copy",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Task retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_protocol_task.Task"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "304": {
+                        "description": "Task not modified"
+                    },
+                    "400": {
+                        "description": "Invalid parameters",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/history/{id}": {
+            "get": {
+                "description": "Get the activity log of a task newest first, each entry lists the changed fields with their old and new values",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Task"
+                ],
+                "summary": "Get task history",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor returned by the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size",
+                        "name": "page_size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Task history retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_interfaces_task_model.TaskHistoryResp"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid parameters",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/import": {
+            "post": {
+                "description": "Import at most 1000 tasks from a JSON, CSV or iCalendar file of at most 4MB. Missing projects and tags are created by name, rows duplicating a task by title and due time are skipped, and failing rows are reported. A dry run only checks the rows.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Task"
+                ],
+                "summary": "Import tasks",
+                "parameters": [
+                    {
+                        "enum": [
+                            "json",
+                            "csv",
+                            "ical"
+                        ],
+                        "type": "string",
+                        "description": "File format",
+                        "name": "format",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only check the rows",
+                        "name": "dry_run",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "file",
+                        "description": "File to import",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Import report",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_protocol_task.ImportTasksResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid parameters",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/list": {
+            "get": {
+                "description": "Get a page of tasks for current user",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Task"
+                ],
+                "summary": "Get task list",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page size, default 20, max 100",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor returned by the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "created_at",
+                            "updated_at",
+                            "priority",
+                            "rank"
+                        ],
+                        "type": "string",
+                        "description": "Sort field",
+                        "name": "sort_by",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "Sort order, default desc or asc for rank",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Created at or after, unix seconds",
+                        "name": "created_after",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Created at or before, unix seconds",
+                        "name": "created_before",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Updated at or after, unix seconds",
+                        "name": "updated_after",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Updated at or before, unix seconds",
+                        "name": "updated_before",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "enum": [
+                                "todo",
+                                "in_progress",
+                                "done",
+                                "archived"
+                            ],
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Task status filter, default todo and in_progress",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "integer"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Keep the tasks carrying any of the tags",
+                        "name": "tag_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Scope the list to a project",
+                        "name": "project_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Page through the top level tasks and nest their subtasks in children",
+                        "name": "tree",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Drop the tasks with open blockers",
+                        "name": "actionable",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Task list retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_interfaces_task_model.TaskListResp"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid parameters",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/move/{id}": {
+            "put": {
+                "description": "Place a task between two others in the manual order, see sort_by=rank",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Task"
+                ],
+                "summary": "Move task",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Move task request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_interfaces_task_model.MoveTaskReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Task moved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_protocol_task.Task"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid parameters",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/project/archive/{id}": {
+            "put": {
+                "description": "Archive or unarchive a project, archived projects take no new tasks",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Project"
+                ],
+                "summary": "Archive project",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Archive or unarchive",
+                        "name": "archived",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Project archived successfully",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid parameters",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/project/create": {
+            "post": {
+                "description": "Create a project for current user, it is placed after the existing ones",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Project"
+                ],
+                "summary": "Create a project",
+                "parameters": [
+                    {
+                        "description": "Create project request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_interfaces_task_model.CreateProjectReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Project created successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_protocol_task.Project"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid parameters",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/project/delete/{id}": {
+            "delete": {
+                "description": "Delete a project, its tasks move to another project or to the recycle bin",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Project"
+                ],
+                "summary": "Delete project",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Project taking the tasks, absent moves them to the recycle bin",
+                        "name": "move_to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Project deleted successfully",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid parameters",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/project/list": {
+            "get": {
+                "description": "Get the projects of current user in display order, starting with the inbox",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Project"
+                ],
+                "summary": "Get project list",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Include archived projects",
+                        "name": "include_archived",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Project list retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_protocol_task.Project"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid parameters",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/project/rename/{id}": {
+            "put": {
+                "description": "Rename a project",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Project"
+                ],
+                "summary": "Rename project",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Rename project request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_interfaces_task_model.RenameProjectReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Project renamed successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_protocol_task.Project"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid parameters",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/project/reorder": {
+            "put": {
+                "description": "Put the given projects in the given order, the others keep their places",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Project"
+                ],
+                "summary": "Reorder projects",
+                "parameters": [
+                    {
+                        "description": "Reorder projects request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_interfaces_task_model.ReorderProjectsReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Projects reordered successfully",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid parameters",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/purge/{id}": {
+            "delete": {
+                "description": "Permanently delete a task in the recycle bin",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Task"
+                ],
+                "summary": "Purge task",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Task purged successfully",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid parameters",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/quick-add": {
+            "post": {
+                "description": "Create a task from one line of text such as \"Pay rent tomorrow 9am #home !high every month\". Due dates and times, tags, priority and recurrence are taken out of the title, missing tags are created, and the recognized phrases are returned with their rune offsets for highlighting.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Task"
+                ],
+                "summary": "Quick add a task",
+                "parameters": [
+                    {
+                        "description": "Quick add request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_interfaces_task_model.QuickAddTaskReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Task created successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_protocol_task.QuickAddTaskResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid parameters",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/recurrence/preview": {
+            "get": {
+                "description": "Preview the occurrences after the current one of a recurring task, or the first occurrences of a recurrence rule",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Task"
+                ],
+                "summary": "Preview recurring task occurrences",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Recurring task ID",
+                        "name": "task_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "RFC 5545 RRULE, required without task_id",
+                        "name": "rule",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "IANA time zone of the rule, default UTC",
+                        "name": "tz",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Series start in unix seconds, required without task_id",
+                        "name": "start",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of occurrences, default 10, max 50",
+                        "name": "count",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Occurrences in unix seconds",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid parameters",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/recycle-bin": {
+            "delete": {
+                "description": "Permanently delete every task in the recycle bin of current user",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Task"
+                ],
+                "summary": "Empty recycle bin",
+                "responses": {
+                    "200": {
+                        "description": "Number of purged tasks",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/recycle-list": {
+            "get": {
+                "description": "Get a page of deleted tasks in recycle bin, they are purged after the retention period",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Task"
+                ],
+                "summary": "Get recycle bin task list",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page size, default 20, max 100",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor returned by the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "created_at",
+                            "updated_at",
+                            "priority",
+                            "rank"
+                        ],
+                        "type": "string",
+                        "description": "Sort field",
+                        "name": "sort_by",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "Sort order, default desc or asc for rank",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Created at or after, unix seconds",
+                        "name": "created_after",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Created at or before, unix seconds",
+                        "name": "created_before",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Updated at or after, unix seconds",
+                        "name": "updated_after",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Updated at or before, unix seconds",
+                        "name": "updated_before",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Recycle bin task list retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_interfaces_task_model.TaskListResp"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid parameters",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/restore/{id}": {
+            "put": {
+                "description": "Restore a task from the recycle bin as todo",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Task"
+                ],
+                "summary": "Restore task",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Task restored successfully",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid parameters",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/revert/{id}": {
+            "put": {
+                "description": "Bring the fields of a task back to a revision from its history, the recurrence is not reverted",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Task"
+                ],
+                "summary": "Revert task",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Revert task request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_interfaces_task_model.RevertTaskReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Task reverted successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_protocol_task.Task"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid parameters",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/search": {
+            "get": {
+                "description": "Full-text search over task titles and content, ordered by relevance",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Task"
+                ],
+                "summary": "Search tasks",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Search keyword",
+                        "name": "q",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "enum": [
+                                "todo",
+                                "in_progress",
+                                "done",
+                                "archived",
+                                "trashed"
+                            ],
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Task status filter",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page number, starting from 1",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size, default 20, max 100",
+                        "name": "page_size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Search result retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_interfaces_task_model.TaskSearchResp"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid parameters",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/stream": {
+            "get": {
+                "description": "Server-Sent Events of the changes to the tasks of the user: created, updated, status_changed and deleted, the data holds the event id, type and task_id. Comments are sent as heartbeats. Reconnecting with Last-Event-ID replays the missed events as updated or deleted, a reset event asks for a full sync when too many were missed. A user holds a limited number of streams at once.",
+                "produces": [
+                    "text/event-stream"
+                ],
+                "tags": [
+                    "Stream"
+                ],
+                "summary": "Stream task events",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Id of the last event received",
+                        "name": "Last-Event-ID",
+                        "in": "header"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Same as Last-Event-ID, for clients which can't set it",
+                        "name": "last_event_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Access token for clients which can't set the Authorization header",
+                        "name": "access_token",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Event stream",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_interfaces_task_model.TaskEvent"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid parameters",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many open streams",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/stream/ws": {
+            "get": {
+                "description": "The events of GET /tasks/stream as JSON text messages over a WebSocket, heartbeats are ping frames. Browsers pass the access token in the access_token query parameter and resume with last_event_id.",
+                "tags": [
+                    "Stream"
+                ],
+                "summary": "Stream task events over WebSocket",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Id of the last event received",
+                        "name": "last_event_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Access token for clients which can't set the Authorization header",
+                        "name": "access_token",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "101": {
+                        "description": "Switching protocols",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_interfaces_task_model.TaskEvent"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid parameters",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many open streams",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/sync": {
+            "get": {
+                "description": "Get the current state of the tasks changed after a sync token, tasks moved to the recycle bin or purged come as deleted tombstones. An empty token starts with a snapshot of the live tasks. Keep calling with next_token while has_more is set, then keep the token for the next sync.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Sync"
+                ],
+                "summary": "Sync task changes",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Next token of the previous sync",
+                        "name": "since_token",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Changes per page, 100 by default and at most 500",
+                        "name": "page_size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Changes retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_protocol_task.SyncTasksResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid parameters",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/sync/push": {
+            "post": {
+                "description": "Apply up to 100 changes made by a client while offline, one by one in order. Updates and deletes only apply while the task is still at their base version, a conflicting change is reported with the version conflict code and the current task.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Sync"
+                ],
+                "summary": "Push offline task changes",
+                "parameters": [
+                    {
+                        "description": "Push task changes request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_interfaces_task_model.PushTaskChangesReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Changes applied, see the results",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_protocol_task.BatchResult"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid parameters",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/tag/create": {
+            "post": {
+                "description": "Create a tag for current user, tag names are unique per user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tag"
+                ],
+                "summary": "Create a tag",
+                "parameters": [
+                    {
+                        "description": "Create tag request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_interfaces_task_model.CreateTagReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Tag created successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_protocol_task.Tag"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid parameters",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/tag/delete/{id}": {
+            "delete": {
+                "description": "Delete a tag and detach it from its tasks, the tasks are kept",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tag"
+                ],
+                "summary": "Delete tag",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Tag ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Tag deleted successfully",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid parameters",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/tag/list": {
+            "get": {
+                "description": "Get the tags of current user with the number of live tasks carrying them",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tag"
+                ],
+                "summary": "Get tag list",
+                "responses": {
+                    "200": {
+                        "description": "Tag list retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_protocol_task.Tag"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/tag/update/{id}": {
+            "put": {
+                "description": "Rename and/or recolor a tag",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tag"
+                ],
+                "summary": "Update tag",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Tag ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update tag request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_interfaces_task_model.UpdateTagReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Tag updated successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_protocol_task.Tag"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid parameters",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/update/{id}": {
+            "put": {
+                "description": "Update task title, content, schedule and tags by task ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Task"
+                ],
+                "summary": "Update task",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the task version the update is based on",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Update task request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_interfaces_task_model.UpdateTaskReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Task updated successfully",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid parameters",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    },
+                    "412": {
+                        "description": "Task has been modified since the given version",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/update/{id}/status": {
+            "put": {
+                "description": "Move a task to another status, only the allowed status transitions are accepted",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Task"
+                ],
+                "summary": "Update task status",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "todo",
+                            "in_progress",
+                            "done",
+                            "archived",
+                            "trashed"
+                        ],
+                        "type": "string",
+                        "description": "Task status",
+                        "name": "status",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Task status updated successfully",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid parameters",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/webhook/create": {
+            "post": {
+                "description": "Subscribe a URL to the task events of the user, at most 10 webhooks per user. Each event is POSTed as JSON with the headers X-Webhook-Event, X-Webhook-Delivery, X-Webhook-Timestamp (Unix seconds) and X-Webhook-Signature, \"sha256=\" followed by the hex HMAC-SHA256 of the timestamp, a dot and the body keyed by the secret. Failed deliveries are retried with exponential backoff for about an hour, then marked dead. The secret is only returned here.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhook"
+                ],
+                "summary": "Create a webhook",
+                "parameters": [
+                    {
+                        "description": "Create webhook request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_interfaces_task_model.CreateWebhookReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Webhook created successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_protocol_task.Webhook"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid parameters",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/webhook/delete/{id}": {
+            "delete": {
+                "description": "Delete a webhook along with its delivery log",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhook"
+                ],
+                "summary": "Delete webhook",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Webhook ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Webhook deleted successfully",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid parameters",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/webhook/deliveries/{id}": {
+            "get": {
+                "description": "List the deliveries of a webhook newest first, with the outcome of their last attempt. Finished deliveries are kept for 30 days.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhook"
+                ],
+                "summary": "List webhook deliveries",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Webhook ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "pending",
+                            "succeeded",
+                            "dead"
+                        ],
+                        "type": "string",
+                        "description": "Delivery status filter",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor returned by the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size",
+                        "name": "page_size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Deliveries retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_interfaces_task_model.ListWebhookDeliveriesResp"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid parameters",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/webhook/delivery/replay/{id}": {
+            "post": {
+                "description": "Send a delivery again with a fresh set of attempts, dead and succeeded ones included",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhook"
+                ],
+                "summary": "Replay webhook delivery",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Delivery ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Delivery replayed successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_protocol_task.WebhookDelivery"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid parameters",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/webhook/list": {
+            "get": {
+                "description": "List the webhooks of the user, without their secrets",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhook"
+                ],
+                "summary": "List webhooks",
+                "responses": {
+                    "200": {
+                        "description": "Webhooks retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_protocol_task.Webhook"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/webhook/update/{id}": {
+            "put": {
+                "description": "Change the URL, subscribed events or secret of a webhook, or disable it. Pending deliveries of a disabled webhook are marked dead.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhook"
+                ],
+                "summary": "Update webhook",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Webhook ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update webhook request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_interfaces_task_model.UpdateWebhookReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Webhook updated successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_protocol_task.Webhook"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid parameters",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/avatar": {
+            "post": {
+                "description": "Upload and update user avatar image",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Update user avatar",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "Avatar image file",
+                        "name": "avatar",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Avatar updated successfully",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid parameters",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/login": {
+            "post": {
+                "description": "User login authentication",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "User login",
+                "parameters": [
+                    {
+                        "description": "Login request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_interfaces_user_model.UserLoginReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Login successful",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_interfaces_user_model.UserInfoResp"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid parameters",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/logout": {
+            "get": {
+                "description": "User logout",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "User logout",
+                "responses": {
+                    "200": {
+                        "description": "Logout successful",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/profile": {
+            "get": {
+                "description": "Get current user profile information",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Get user information",
+                "responses": {
+                    "200": {
+                        "description": "User information retrieved successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_interfaces_user_model.UserInfoResp"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/refresh-token": {
+            "post": {
+                "description": "Refresh User AccessToken",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Refresh User Token",
+                "responses": {
+                    "200": {
+                        "description": "Refresh token successfully",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid parameters",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/register": {
+            "post": {
+                "description": "Register a new user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "User registration",
+                "parameters": [
+                    {
+                        "description": "Registration request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_interfaces_user_model.UserRegisterReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Registration successful",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_interfaces_user_model.UserInfoResp"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid parameters",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/reset-password": {
+            "post": {
+                "description": "Reset user password",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Reset user password",
+                "parameters": [
+                    {
+                        "description": "Reset password request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_interfaces_user_model.UserResetPasswordReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Password reset successfully",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid parameters",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "github_com_crazyfrankie_zrpc-todolist_interfaces_task_model.AddChecklistItemReq": {
+            "type": "object",
+            "required": [
+                "content",
+                "task_id"
+            ],
+            "properties": {
+                "content": {
+                    "type": "string"
+                },
+                "task_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_crazyfrankie_zrpc-todolist_interfaces_task_model.AddCommentReq": {
+            "type": "object",
+            "required": [
+                "content",
+                "task_id"
+            ],
+            "properties": {
+                "content": {
+                    "type": "string"
+                },
+                "task_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_crazyfrankie_zrpc-todolist_interfaces_task_model.AttachmentURLResp": {
+            "type": "object",
+            "properties": {
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_crazyfrankie_zrpc-todolist_interfaces_task_model.BatchCreateTasksReq": {
+            "type": "object",
+            "required": [
+                "tasks"
+            ],
+            "properties": {
+                "tasks": {
+                    "type": "array",
+                    "maxItems": 100,
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_interfaces_task_model.CreateTaskReq"
+                    }
+                }
+            }
+        },
+        "github_com_crazyfrankie_zrpc-todolist_interfaces_task_model.BatchDeleteReq": {
+            "type": "object",
+            "required": [
+                "task_ids"
+            ],
+            "properties": {
+                "task_ids": {
+                    "type": "array",
+                    "maxItems": 100,
+                    "minItems": 1,
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "github_com_crazyfrankie_zrpc-todolist_interfaces_task_model.BatchMoveReq": {
+            "type": "object",
+            "required": [
+                "task_ids"
+            ],
+            "properties": {
+                "project_id": {
+                    "type": "integer"
+                },
+                "task_ids": {
+                    "type": "array",
+                    "maxItems": 100,
+                    "minItems": 1,
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "github_com_crazyfrankie_zrpc-todolist_interfaces_task_model.BatchUpdateStatusReq": {
+            "type": "object",
+            "required": [
+                "status",
+                "task_ids"
+            ],
+            "properties": {
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "todo",
+                        "in_progress",
+                        "done",
+                        "archived"
+                    ]
+                },
+                "task_ids": {
+                    "type": "array",
+                    "maxItems": 100,
+                    "minItems": 1,
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "github_com_crazyfrankie_zrpc-todolist_interfaces_task_model.CalendarFeedResp": {
+            "type": "object",
+            "properties": {
+                "token": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_crazyfrankie_zrpc-todolist_interfaces_task_model.ClientTaskChangeReq": {
+            "type": "object",
+            "required": [
+                "op"
+            ],
+            "properties": {
+                "base_version": {
+                    "type": "integer"
+                },
+                "create": {
+                    "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_interfaces_task_model.CreateTaskReq"
+                },
+                "op": {
+                    "type": "string",
+                    "enum": [
+                        "create",
+                        "update",
+                        "delete"
+                    ]
+                },
+                "status": {
+                    "description": "Status moves the task along with an update.",
+                    "type": "string",
+                    "enum": [
+                        "todo",
+                        "in_progress",
+                        "done",
+                        "archived",
+                        "trashed"
+                    ]
+                },
+                "task_id": {
+                    "type": "integer"
+                },
+                "update": {
+                    "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_interfaces_task_model.UpdateTaskReq"
+                }
+            }
+        },
+        "github_com_crazyfrankie_zrpc-todolist_interfaces_task_model.CreateColumnReq": {
+            "type": "object",
+            "required": [
+                "name",
+                "status"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "project_id": {
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "todo",
+                        "in_progress",
+                        "done"
+                    ]
+                },
+                "wip_limit": {
+                    "type": "integer",
+                    "minimum": 0
+                }
+            }
+        },
+        "github_com_crazyfrankie_zrpc-todolist_interfaces_task_model.CreateProjectReq": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_crazyfrankie_zrpc-todolist_interfaces_task_model.CreateTagReq": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "color": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_crazyfrankie_zrpc-todolist_interfaces_task_model.CreateTaskReq": {
+            "type": "object",
+            "properties": {
+                "auto_complete": {
+                    "description": "AutoComplete finishes the task once all of its subtasks and checklist items are done.",
+                    "type": "boolean"
+                },
+                "content": {
+                    "type": "string"
+                },
+                "due_at": {
+                    "type": "integer"
+                },
+                "parent_id": {
+                    "description": "ParentID makes the task a subtask, it lives in the project of its parent.",
+                    "type": "integer"
+                },
+                "priority": {
+                    "type": "string",
+                    "enum": [
+                        "none",
+                        "low",
+                        "medium",
+                        "high",
+                        "urgent"
+                    ]
+                },
+                "project_id": {
+                    "description": "ProjectID defaults to the inbox.",
+                    "type": "integer"
+                },
+                "recurrence": {
+                    "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_interfaces_task_model.RecurrenceReq"
+                },
+                "remind_at": {
+                    "type": "integer"
+                },
+                "tag_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_crazyfrankie_zrpc-todolist_interfaces_task_model.CreateWebhookReq": {
+            "type": "object",
+            "required": [
+                "url"
+            ],
+            "properties": {
+                "event_types": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "secret": {
+                    "type": "string",
+                    "maxLength": 128,
+                    "minLength": 16
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_crazyfrankie_zrpc-todolist_interfaces_task_model.DependencyReq": {
+            "type": "object",
+            "required": [
+                "blocker_id",
+                "task_id"
+            ],
+            "properties": {
+                "blocker_id": {
+                    "type": "integer"
+                },
+                "task_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_crazyfrankie_zrpc-todolist_interfaces_task_model.ExportTasksResp": {
+            "type": "object",
+            "properties": {
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_crazyfrankie_zrpc-todolist_interfaces_task_model.ListCommentsResp": {
+            "type": "object",
+            "properties": {
+                "comments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_protocol_task.Comment"
+                    }
+                },
+                "has_more": {
+                    "type": "boolean"
+                },
+                "next_cursor": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_crazyfrankie_zrpc-todolist_interfaces_task_model.ListWebhookDeliveriesResp": {
+            "type": "object",
+            "properties": {
+                "deliveries": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_protocol_task.WebhookDelivery"
+                    }
+                },
+                "has_more": {
+                    "type": "boolean"
+                },
+                "next_cursor": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_crazyfrankie_zrpc-todolist_interfaces_task_model.MoveCardReq": {
+            "type": "object",
+            "required": [
+                "column_id"
+            ],
+            "properties": {
+                "after_id": {
+                    "type": "integer"
+                },
+                "before_id": {
+                    "type": "integer"
+                },
+                "column_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_crazyfrankie_zrpc-todolist_interfaces_task_model.MoveTaskReq": {
+            "type": "object",
+            "properties": {
+                "after_id": {
+                    "type": "integer"
+                },
+                "before_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_crazyfrankie_zrpc-todolist_interfaces_task_model.PushTaskChangesReq": {
+            "type": "object",
+            "required": [
+                "changes"
+            ],
+            "properties": {
+                "changes": {
+                    "type": "array",
+                    "maxItems": 100,
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_interfaces_task_model.ClientTaskChangeReq"
+                    }
+                }
+            }
+        },
+        "github_com_crazyfrankie_zrpc-todolist_interfaces_task_model.QuickAddTaskReq": {
+            "type": "object",
+            "required": [
+                "text"
+            ],
+            "properties": {
+                "project_id": {
+                    "description": "ProjectID defaults to the inbox.",
+                    "type": "integer"
+                },
+                "text": {
+                    "type": "string"
+                },
+                "time_zone": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_crazyfrankie_zrpc-todolist_interfaces_task_model.RecurrenceReq": {
+            "type": "object",
+            "required": [
+                "rule"
+            ],
+            "properties": {
+                "rule": {
+                    "type": "string"
+                },
+                "time_zone": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_crazyfrankie_zrpc-todolist_interfaces_task_model.RenameProjectReq": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_crazyfrankie_zrpc-todolist_interfaces_task_model.ReorderColumnsReq": {
+            "type": "object",
+            "required": [
+                "column_ids"
+            ],
+            "properties": {
+                "column_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "project_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_crazyfrankie_zrpc-todolist_interfaces_task_model.ReorderProjectsReq": {
+            "type": "object",
+            "required": [
+                "project_ids"
+            ],
+            "properties": {
+                "project_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "github_com_crazyfrankie_zrpc-todolist_interfaces_task_model.RevertTaskReq": {
+            "type": "object",
+            "required": [
+                "version"
+            ],
+            "properties": {
+                "version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_crazyfrankie_zrpc-todolist_interfaces_task_model.TaskEvent": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer"
+                },
+                "task_id": {
+                    "type": "integer"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_crazyfrankie_zrpc-todolist_interfaces_task_model.TaskHistoryResp": {
+            "type": "object",
+            "properties": {
+                "activities": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_protocol_task.TaskActivity"
+                    }
+                },
+                "has_more": {
+                    "type": "boolean"
+                },
+                "next_cursor": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_crazyfrankie_zrpc-todolist_interfaces_task_model.TaskListResp": {
+            "type": "object",
+            "properties": {
+                "has_more": {
+                    "type": "boolean"
+                },
+                "next_cursor": {
+                    "type": "string"
+                },
+                "tasks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_protocol_task.Task"
+                    }
+                }
+            }
+        },
+        "github_com_crazyfrankie_zrpc-todolist_interfaces_task_model.TaskSearchResp": {
+            "type": "object",
+            "properties": {
+                "hits": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_protocol_task.SearchHit"
+                    }
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_crazyfrankie_zrpc-todolist_interfaces_task_model.UpdateChecklistItemReq": {
+            "type": "object",
+            "properties": {
+                "checked": {
+                    "type": "boolean"
+                },
+                "content": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_crazyfrankie_zrpc-todolist_interfaces_task_model.UpdateColumnReq": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "wip_limit": {
+                    "type": "integer",
+                    "minimum": 0
+                }
+            }
+        },
+        "github_com_crazyfrankie_zrpc-todolist_interfaces_task_model.UpdateCommentReq": {
+            "type": "object",
+            "required": [
+                "content"
+            ],
+            "properties": {
+                "content": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_crazyfrankie_zrpc-todolist_interfaces_task_model.UpdateTagReq": {
+            "type": "object",
+            "properties": {
+                "color": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_crazyfrankie_zrpc-todolist_interfaces_task_model.UpdateTaskReq": {
+            "type": "object",
+            "properties": {
+                "attach_tag_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "auto_complete": {
+                    "type": "boolean"
+                },
+                "clear_due_at": {
+                    "type": "boolean"
+                },
+                "clear_recurrence": {
+                    "type": "boolean"
+                },
+                "clear_remind_at": {
+                    "type": "boolean"
+                },
+                "content": {
+                    "type": "string"
+                },
+                "detach_tag_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "due_at": {
+                    "type": "integer"
+                },
+                "parent_id": {
+                    "description": "ParentID moves the task with its subtasks under another task, 0 makes it a top level task.",
+                    "type": "integer"
+                },
+                "priority": {
+                    "type": "string",
+                    "enum": [
+                        "none",
+                        "low",
+                        "medium",
+                        "high",
+                        "urgent"
+                    ]
+                },
+                "project_id": {
+                    "description": "ProjectID moves the task to another project, 0 means the inbox.",
+                    "type": "integer"
+                },
+                "recurrence": {
+                    "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_interfaces_task_model.RecurrenceReq"
+                },
+                "remind_at": {
+                    "type": "integer"
+                },
+                "scope": {
+                    "description": "Scope of an update of a recurring task, the whole series requires \"series\".",
+                    "type": "string",
+                    "enum": [
+                        "this",
+                        "series"
+                    ]
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_crazyfrankie_zrpc-todolist_interfaces_task_model.UpdateWebhookReq": {
+            "type": "object",
+            "properties": {
+                "all_event_types": {
+                    "type": "boolean"
+                },
+                "enabled": {
+                    "type": "boolean"
+                },
+                "event_types": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "secret": {
+                    "type": "string",
+                    "maxLength": 128,
+                    "minLength": 16
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_crazyfrankie_zrpc-todolist_interfaces_user_model.UserInfoResp": {
+            "type": "object",
+            "properties": {
+                "avatar": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "user_create_time": {
+                    "type": "integer"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_crazyfrankie_zrpc-todolist_interfaces_user_model.UserLoginReq": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_crazyfrankie_zrpc-todolist_interfaces_user_model.UserRegisterReq": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_crazyfrankie_zrpc-todolist_interfaces_user_model.UserResetPasswordReq": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer"
+                },
+                "data": {},
+                "msg": {
+                    "type": "string"
+                }
+            }
+        },
+        "task.ActivityAction": {
+            "type": "integer",
+            "format": "int32",
+            "enum": [
+                0,
+                1
+            ],
+            "x-enum-varnames": [
+                "ActivityAction_ACTIVITY_ACTION_CREATED",
+                "ActivityAction_ACTIVITY_ACTION_UPDATED"
+            ]
+        },
+        "task.ActivitySource": {
+            "type": "integer",
+            "format": "int32",
+            "enum": [
+                0,
+                1
+            ],
+            "x-enum-varnames": [
+                "ActivitySource_ACTIVITY_SOURCE_API",
+                "ActivitySource_ACTIVITY_SOURCE_SCHEDULER"
+            ]
+        },
+        "github_com_crazyfrankie_zrpc-todolist_protocol_task.Attachment": {
+            "type": "object",
+            "properties": {
+                "attachmentID": {
+                    "type": "integer"
+                },
+                "content_type": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "size": {
+                    "type": "integer"
+                },
+                "taskID": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_crazyfrankie_zrpc-todolist_protocol_task.BatchResult": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer"
+                },
+                "data": {
+                    "description": "data is the created task, only filled by BatchCreateTasks.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_protocol_task.Task"
+                        }
+                    ]
+                },
+                "message": {
+                    "type": "string"
+                },
+                "taskID": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_crazyfrankie_zrpc-todolist_protocol_task.Board": {
+            "type": "object",
+            "properties": {
+                "columns": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_protocol_task.BoardColumn"
+                    }
+                },
+                "project_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_crazyfrankie_zrpc-todolist_protocol_task.BoardColumn": {
             "type": "object",
             "properties": {
-                "content": {
+                "columnID": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "integer"
+                },
+                "name": {
                     "type": "string"
                 },
-                "title": {
+                "position": {
+                    "type": "integer"
+                },
+                "project_id": {
+                    "type": "integer"
+                },
+                "status": {
+                    "$ref": "#/definitions/task.TaskStatus"
+                },
+                "tasks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_protocol_task.Task"
+                    }
+                },
+                "updated_at": {
+                    "type": "integer"
+                },
+                "wip_limit": {
+                    "description": "wip_limit bounds the tasks moved into the column, 0 means no limit.",
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_crazyfrankie_zrpc-todolist_protocol_task.ChecklistItem": {
+            "type": "object",
+            "properties": {
+                "checked": {
+                    "type": "boolean"
+                },
+                "content": {
                     "type": "string"
+                },
+                "created_at": {
+                    "type": "integer"
+                },
+                "itemID": {
+                    "type": "integer"
+                },
+                "taskID": {
+                    "type": "integer"
+                },
+                "updated_at": {
+                    "type": "integer"
                 }
             }
         },
-        "github_com_crazyfrankie_zrpc-todolist_interfaces_task_model.UpdateTaskReq": {
+        "github_com_crazyfrankie_zrpc-todolist_protocol_task.Comment": {
             "type": "object",
             "properties": {
+                "commentID": {
+                    "type": "integer"
+                },
                 "content": {
                     "type": "string"
                 },
-                "title": {
+                "created_at": {
+                    "type": "integer"
+                },
+                "taskID": {
+                    "type": "integer"
+                },
+                "updated_at": {
+                    "type": "integer"
+                },
+                "userID": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_crazyfrankie_zrpc-todolist_protocol_task.FieldChange": {
+            "type": "object",
+            "properties": {
+                "field": {
+                    "type": "string"
+                },
+                "new_value": {
+                    "type": "string"
+                },
+                "old_value": {
                     "type": "string"
                 }
             }
         },
-        "github_com_crazyfrankie_zrpc-todolist_interfaces_user_model.UserInfoResp": {
+        "github_com_crazyfrankie_zrpc-todolist_protocol_task.ImportRowError": {
             "type": "object",
             "properties": {
-                "avatar": {
+                "message": {
                     "type": "string"
                 },
+                "row": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_crazyfrankie_zrpc-todolist_protocol_task.ImportTasksResponse": {
+            "type": "object",
+            "properties": {
+                "created": {
+                    "type": "integer"
+                },
+                "duplicates": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_protocol_task.ImportRowError"
+                    }
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_crazyfrankie_zrpc-todolist_protocol_task.Project": {
+            "type": "object",
+            "properties": {
+                "archived": {
+                    "type": "boolean"
+                },
+                "created_at": {
+                    "type": "integer"
+                },
+                "inbox": {
+                    "type": "boolean"
+                },
                 "name": {
                     "type": "string"
                 },
-                "user_create_time": {
+                "position": {
                     "type": "integer"
                 },
-                "user_id": {
+                "projectID": {
+                    "type": "integer"
+                },
+                "updated_at": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_crazyfrankie_zrpc-todolist_protocol_task.QuickAddTaskResponse": {
+            "type": "object",
+            "properties": {
+                "all_day": {
+                    "type": "boolean"
+                },
+                "data": {
+                    "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_protocol_task.Task"
+                },
+                "tokens": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_protocol_task.QuickAddToken"
+                    }
+                }
+            }
+        },
+        "github_com_crazyfrankie_zrpc-todolist_protocol_task.QuickAddToken": {
+            "type": "object",
+            "properties": {
+                "end": {
+                    "type": "integer"
+                },
+                "kind": {
+                    "$ref": "#/definitions/task.QuickAddTokenKind"
+                },
+                "start": {
+                    "type": "integer"
+                },
+                "text": {
                     "type": "string"
                 }
             }
         },
-        "github_com_crazyfrankie_zrpc-todolist_interfaces_user_model.UserLoginReq": {
+        "task.QuickAddTokenKind": {
+            "type": "integer",
+            "format": "int32",
+            "enum": [
+                0,
+                1,
+                2,
+                3
+            ],
+            "x-enum-varnames": [
+                "QuickAddTokenKind_QUICK_ADD_TOKEN_KIND_DUE",
+                "QuickAddTokenKind_QUICK_ADD_TOKEN_KIND_TAG",
+                "QuickAddTokenKind_QUICK_ADD_TOKEN_KIND_PRIORITY",
+                "QuickAddTokenKind_QUICK_ADD_TOKEN_KIND_RECURRENCE"
+            ]
+        },
+        "github_com_crazyfrankie_zrpc-todolist_protocol_task.Recurrence": {
             "type": "object",
             "properties": {
-                "name": {
+                "rule": {
                     "type": "string"
                 },
-                "password": {
+                "time_zone": {
+                    "description": "IANA time zone name the rule is evaluated in, defaults to UTC.",
                     "type": "string"
                 }
             }
         },
-        "github_com_crazyfrankie_zrpc-todolist_interfaces_user_model.UserRegisterReq": {
+        "github_com_crazyfrankie_zrpc-todolist_protocol_task.SearchHit": {
             "type": "object",
             "properties": {
-                "name": {
+                "content_highlight": {
                     "type": "string"
                 },
-                "password": {
+                "score": {
+                    "type": "number"
+                },
+                "task": {
+                    "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_protocol_task.Task"
+                },
+                "title_highlight": {
                     "type": "string"
                 }
             }
         },
-        "github_com_crazyfrankie_zrpc-todolist_interfaces_user_model.UserResetPasswordReq": {
+        "github_com_crazyfrankie_zrpc-todolist_protocol_task.SyncTasksResponse": {
+            "type": "object",
+            "properties": {
+                "changes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_protocol_task.TaskChange"
+                    }
+                },
+                "has_more": {
+                    "type": "boolean"
+                },
+                "next_token": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_crazyfrankie_zrpc-todolist_protocol_task.Tag": {
             "type": "object",
             "properties": {
+                "color": {
+                    "description": "color is a #RRGGBB hex color, empty means the default one.",
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "integer"
+                },
                 "name": {
                     "type": "string"
                 },
-                "password": {
+                "tagID": {
+                    "type": "integer"
+                },
+                "task_count": {
+                    "description": "task_count is the number of live tasks carrying the tag, only filled by ListTags.",
+                    "type": "integer"
+                },
+                "updated_at": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_crazyfrankie_zrpc-todolist_protocol_task.Task": {
+            "type": "object",
+            "properties": {
+                "auto_complete": {
+                    "description": "auto_complete finishes the task once all of its subtasks and checklist items are done.",
+                    "type": "boolean"
+                },
+                "blocked": {
+                    "type": "boolean"
+                },
+                "blocker_ids": {
+                    "description": "blocker_ids are the tasks which have to be done first, blocked tells\nwhether any of them is still open.",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "checklist": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_protocol_task.ChecklistItem"
+                    }
+                },
+                "children": {
+                    "description": "children holds the subtasks, only filled by ListTasks with tree.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_protocol_task.Task"
+                    }
+                },
+                "comment_count": {
+                    "type": "integer"
+                },
+                "completion_percent": {
+                    "description": "completion_percent is the share of done subtasks and checked checklist items.",
+                    "type": "integer"
+                },
+                "content": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "integer"
+                },
+                "due_at": {
+                    "type": "integer"
+                },
+                "parent_id": {
+                    "description": "parent_id zero means a top level task.",
+                    "type": "integer"
+                },
+                "priority": {
+                    "$ref": "#/definitions/task.TaskPriority"
+                },
+                "project_id": {
+                    "description": "project_id zero means the inbox.",
+                    "type": "integer"
+                },
+                "rank": {
+                    "description": "rank orders the tasks of a user manually, ranks compare as byte strings.",
+                    "type": "string"
+                },
+                "recurrence": {
+                    "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_protocol_task.Recurrence"
+                },
+                "remind_at": {
+                    "type": "integer"
+                },
+                "series_id": {
+                    "type": "integer"
+                },
+                "status": {
+                    "$ref": "#/definitions/task.TaskStatus"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_protocol_task.Tag"
+                    }
+                },
+                "taskID": {
+                    "type": "integer"
+                },
+                "title": {
                     "type": "string"
+                },
+                "updated_at": {
+                    "type": "integer"
+                },
+                "version": {
+                    "description": "version increases on every write, see UpdateTaskRequest.version.",
+                    "type": "integer"
                 }
             }
         },
-        "github_com_crazyfrankie_zrpc-todolist_pkg_gin_response.Response": {
+        "github_com_crazyfrankie_zrpc-todolist_protocol_task.TaskActivity": {
             "type": "object",
             "properties": {
-                "code": {
+                "action": {
+                    "$ref": "#/definitions/task.ActivityAction"
+                },
+                "activityID": {
                     "type": "integer"
                 },
-                "data": {},
-                "msg": {
+                "changes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_protocol_task.FieldChange"
+                    }
+                },
+                "created_at": {
+                    "type": "integer"
+                },
+                "source": {
+                    "$ref": "#/definitions/task.ActivitySource"
+                },
+                "taskID": {
+                    "type": "integer"
+                },
+                "user_id": {
+                    "type": "integer"
+                },
+                "version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_crazyfrankie_zrpc-todolist_protocol_task.TaskChange": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/github_com_crazyfrankie_zrpc-todolist_protocol_task.Task"
+                },
+                "deleted": {
+                    "type": "boolean"
+                },
+                "taskID": {
+                    "type": "integer"
+                }
+            }
+        },
+        "task.TaskEventType": {
+            "type": "integer",
+            "format": "int32",
+            "enum": [
+                0,
+                1,
+                2,
+                3
+            ],
+            "x-enum-varnames": [
+                "TaskEventType_TASK_EVENT_CREATED",
+                "TaskEventType_TASK_EVENT_UPDATED",
+                "TaskEventType_TASK_EVENT_STATUS_CHANGED",
+                "TaskEventType_TASK_EVENT_DELETED"
+            ]
+        },
+        "task.TaskPriority": {
+            "type": "integer",
+            "format": "int32",
+            "enum": [
+                0,
+                1,
+                2,
+                3,
+                4
+            ],
+            "x-enum-varnames": [
+                "TaskPriority_TASK_PRIORITY_NONE",
+                "TaskPriority_TASK_PRIORITY_LOW",
+                "TaskPriority_TASK_PRIORITY_MEDIUM",
+                "TaskPriority_TASK_PRIORITY_HIGH",
+                "TaskPriority_TASK_PRIORITY_URGENT"
+            ]
+        },
+        "task.TaskStatus": {
+            "type": "integer",
+            "format": "int32",
+            "enum": [
+                0,
+                1,
+                2,
+                3,
+                4
+            ],
+            "x-enum-varnames": [
+                "TaskStatus_TASK_STATUS_TODO",
+                "TaskStatus_TASK_STATUS_DONE",
+                "TaskStatus_TASK_STATUS_IN_PROGRESS",
+                "TaskStatus_TASK_STATUS_ARCHIVED",
+                "TaskStatus_TASK_STATUS_TRASHED"
+            ]
+        },
+        "github_com_crazyfrankie_zrpc-todolist_protocol_task.Webhook": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "integer"
+                },
+                "enabled": {
+                    "type": "boolean"
+                },
+                "event_types": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/task.TaskEventType"
+                    }
+                },
+                "secret": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "integer"
+                },
+                "url": {
+                    "type": "string"
+                },
+                "webhookID": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_crazyfrankie_zrpc-todolist_protocol_task.WebhookDelivery": {
+            "type": "object",
+            "properties": {
+                "attempts": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "integer"
+                },
+                "delivered_at": {
+                    "type": "integer"
+                },
+                "deliveryID": {
+                    "type": "integer"
+                },
+                "event_id": {
+                    "type": "string"
+                },
+                "event_type": {
+                    "$ref": "#/definitions/task.TaskEventType"
+                },
+                "last_error": {
+                    "type": "string"
+                },
+                "next_attempt_at": {
+                    "type": "integer"
+                },
+                "payload": {
                     "type": "string"
+                },
+                "response_status": {
+                    "type": "integer"
+                },
+                "status": {
+                    "$ref": "#/definitions/task.WebhookDeliveryStatus"
+                },
+                "updated_at": {
+                    "type": "integer"
+                },
+                "webhookID": {
+                    "type": "integer"
                 }
             }
+        },
+        "task.WebhookDeliveryStatus": {
+            "type": "integer",
+            "format": "int32",
+            "enum": [
+                0,
+                1,
+                2
+            ],
+            "x-enum-varnames": [
+                "WebhookDeliveryStatus_WEBHOOK_DELIVERY_PENDING",
+                "WebhookDeliveryStatus_WEBHOOK_DELIVERY_SUCCEEDED",
+                "WebhookDeliveryStatus_WEBHOOK_DELIVERY_DEAD"
+            ]
         }
     }
 }`
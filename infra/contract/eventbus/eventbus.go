@@ -0,0 +1,33 @@
+package eventbus
+
+import "context"
+
+// Event is a domain event on a topic. ID is unique across the events of the
+// topic, consumers tell redeliveries apart by it.
+type Event struct {
+	ID    string
+	Topic string
+	// Key groups related events, such as the events of one user.
+	Key       string
+	Payload   []byte
+	CreatedAt int64
+}
+
+// Handler processes an event, an error has the event delivered again later.
+type Handler func(ctx context.Context, event *Event) error
+
+type Publisher interface {
+	Publish(ctx context.Context, events ...*Event) error
+}
+
+type Subscriber interface {
+	// Subscribe runs handler on the events of the topic until ctx is done. Each
+	// event goes to one subscriber of the group at least once, a new group
+	// starts with the events the bus still holds.
+	Subscribe(ctx context.Context, topic, group string, handler Handler) error
+}
+
+type EventBus interface {
+	Publisher
+	Subscriber
+}
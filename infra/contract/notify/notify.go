@@ -0,0 +1,16 @@
+package notify
+
+import "context"
+
+type Notification struct {
+	UserID int64
+	Title  string
+	Body   string
+	// Data carries machine readable attributes, e.g. the task id.
+	Data map[string]string
+}
+
+type Notifier interface {
+	// Notify delivers the notification to the user.
+	Notify(ctx context.Context, n *Notification) error
+}
@@ -0,0 +1,25 @@
+package pubsub
+
+import "context"
+
+type Message struct {
+	Channel string
+	Payload []byte
+}
+
+// PubSub delivers messages to the subscribers of a channel that are online when
+// they are published, nothing is kept for the others.
+type PubSub interface {
+	Publish(ctx context.Context, channel string, payload []byte) error
+	// Subscribe returns a subscription to the channels, it may start without any.
+	Subscribe(ctx context.Context, channels ...string) (Subscription, error)
+}
+
+type Subscription interface {
+	Subscribe(ctx context.Context, channels ...string) error
+	Unsubscribe(ctx context.Context, channels ...string) error
+	// Channel delivers the messages of the subscribed channels, it is closed
+	// once the subscription is.
+	Channel() <-chan *Message
+	Close() error
+}
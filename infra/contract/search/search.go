@@ -0,0 +1,43 @@
+package search
+
+import "context"
+
+// Document is a searchable record, it is owned by a single user.
+type Document struct {
+	ID        int64
+	OwnerID   int64
+	Status    int32
+	Title     string
+	Content   string
+	UpdatedAt int64
+}
+
+type Request struct {
+	OwnerID  int64
+	Keyword  string
+	Statuses []int32 // empty means any status
+	Offset   int
+	Limit    int
+}
+
+type Hit struct {
+	ID    int64
+	Score float64
+	// Highlighted snippets, matched terms are wrapped with <em></em>.
+	TitleHighlight   string
+	ContentHighlight string
+}
+
+type Result struct {
+	Hits  []*Hit
+	Total int64
+}
+
+type Searcher interface {
+	// Index adds or replaces the document.
+	Index(ctx context.Context, doc *Document) error
+	// Delete removes the document with the specified id.
+	Delete(ctx context.Context, id int64) error
+	// Search returns the documents matching the keyword ordered by relevance.
+	Search(ctx context.Context, req *Request) (*Result, error)
+}
@@ -2,12 +2,16 @@ package storage
 
 import (
 	"context"
+	"io"
 	"time"
 )
 
 type Storage interface {
 	// PutObject puts the object with the specified key.
 	PutObject(ctx context.Context, objectKey string, content []byte, opts ...PutOptFn) error
+	// PutObjectWithReader puts the object read from content with the specified key.
+	// The object size is taken from WithObjectSize and is unknown if not set.
+	PutObjectWithReader(ctx context.Context, objectKey string, content io.Reader, opts ...PutOptFn) error
 	// GetObject returns the object with the specified key.
 	GetObject(ctx context.Context, objectKey string) ([]byte, error)
 	// DeleteObject deletes the object with the specified key.
@@ -0,0 +1,23 @@
+package eventbus
+
+import (
+	"os"
+
+	"github.com/crazyfrankie/zrpc-todolist/infra/contract/eventbus"
+	"github.com/crazyfrankie/zrpc-todolist/infra/impl/eventbus/memory"
+	"github.com/crazyfrankie/zrpc-todolist/infra/impl/eventbus/redis"
+	"github.com/crazyfrankie/zrpc-todolist/types/consts"
+)
+
+type EventBus = eventbus.EventBus
+
+// New returns the event bus selected by EVENTBUS_TYPE, it defaults to Redis
+// Streams. The memory bus only reaches subscribers within the process.
+func New() EventBus {
+	switch os.Getenv(consts.EventBusType) {
+	case "memory":
+		return memory.New()
+	default:
+		return redis.New()
+	}
+}
@@ -0,0 +1,129 @@
+package memory
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/crazyfrankie/zrpc-todolist/infra/contract/eventbus"
+)
+
+const retryDelay = 100 * time.Millisecond
+
+// New returns an event bus within the process, meant for tests and local
+// runs. Topics keep all of their events, a new group starts from the first.
+func New() eventbus.EventBus {
+	return &memoryBus{topics: make(map[string]*topic), wake: make(chan struct{})}
+}
+
+type memoryBus struct {
+	mu     sync.Mutex
+	topics map[string]*topic
+	// wake is closed and replaced whenever there is something to deliver
+	wake chan struct{}
+}
+
+type topic struct {
+	events []*eventbus.Event
+	groups map[string]*group
+}
+
+type group struct {
+	// next is the index of the next event to deliver
+	next int
+	// retries holds the failed events, they go before the new ones
+	retries []*eventbus.Event
+}
+
+// Publish implements eventbus.Publisher.
+func (m *memoryBus) Publish(ctx context.Context, events ...*eventbus.Event) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, event := range events {
+		t := m.topic(event.Topic)
+		event := *event
+		if event.ID == "" {
+			event.ID = strconv.Itoa(len(t.events) + 1)
+		}
+		t.events = append(t.events, &event)
+	}
+	m.notify()
+
+	return nil
+}
+
+// Subscribe implements eventbus.Subscriber.
+func (m *memoryBus) Subscribe(ctx context.Context, topicName, groupName string, handler eventbus.Handler) error {
+	for {
+		event, wake := m.next(topicName, groupName)
+		if event == nil {
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-wake:
+			}
+			continue
+		}
+
+		if err := handler(ctx, event); err != nil {
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-time.After(retryDelay):
+			}
+			m.retry(topicName, groupName, event)
+		}
+	}
+}
+
+// next takes the next event for the group, or returns the channel to wait on
+// when there is none.
+func (m *memoryBus) next(topicName, groupName string) (*eventbus.Event, <-chan struct{}) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	t := m.topic(topicName)
+	g, ok := t.groups[groupName]
+	if !ok {
+		g = &group{}
+		t.groups[groupName] = g
+	}
+
+	if len(g.retries) > 0 {
+		event := g.retries[0]
+		g.retries = g.retries[1:]
+		return event, nil
+	}
+	if g.next < len(t.events) {
+		event := t.events[g.next]
+		g.next++
+		return event, nil
+	}
+
+	return nil, m.wake
+}
+
+func (m *memoryBus) retry(topicName, groupName string, event *eventbus.Event) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	g := m.topic(topicName).groups[groupName]
+	g.retries = append(g.retries, event)
+	m.notify()
+}
+
+func (m *memoryBus) topic(name string) *topic {
+	t, ok := m.topics[name]
+	if !ok {
+		t = &topic{groups: make(map[string]*group)}
+		m.topics[name] = t
+	}
+	return t
+}
+
+func (m *memoryBus) notify() {
+	close(m.wake)
+	m.wake = make(chan struct{})
+}
@@ -0,0 +1,192 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/crazyfrankie/zrpc-todolist/infra/contract/eventbus"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/logs"
+)
+
+const (
+	streamPrefix = "eventbus:"
+	// maxStreamLen bounds the events a stream holds, the oldest are trimmed.
+	maxStreamLen = 100000
+	readCount    = 16
+	readBlock    = 5 * time.Second
+	// claimIdle is how long an event may stay unacknowledged before another
+	// consumer of the group retries it.
+	claimIdle  = time.Minute
+	retryDelay = time.Second
+)
+
+func New() eventbus.EventBus {
+	addr := os.Getenv("REDIS_ADDR")
+	password := os.Getenv("REDIS_PASSWORD")
+
+	return NewWithAddrAndPassword(addr, password)
+}
+
+// NewWithAddrAndPassword returns an event bus over Redis Streams, a topic is a
+// stream and a group of subscribers a consumer group of it.
+func NewWithAddrAndPassword(addr, password string) eventbus.EventBus {
+	rdb := redis.NewClient(&redis.Options{
+		Addr:     addr,
+		DB:       0,
+		Password: password,
+	})
+
+	hostname, _ := os.Hostname()
+	return &redisBus{client: rdb, consumer: fmt.Sprintf("%s-%d", hostname, os.Getpid())}
+}
+
+type redisBus struct {
+	client *redis.Client
+	// consumer names this process within the consumer groups
+	consumer string
+}
+
+// Publish implements eventbus.Publisher.
+func (r *redisBus) Publish(ctx context.Context, events ...*eventbus.Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	pipe := r.client.Pipeline()
+	for _, event := range events {
+		pipe.XAdd(ctx, &redis.XAddArgs{
+			Stream: streamPrefix + event.Topic,
+			MaxLen: maxStreamLen,
+			Approx: true,
+			Values: map[string]any{
+				"id":         event.ID,
+				"key":        event.Key,
+				"payload":    event.Payload,
+				"created_at": event.CreatedAt,
+			},
+		})
+	}
+	_, err := pipe.Exec(ctx)
+
+	return err
+}
+
+// Subscribe implements eventbus.Subscriber.
+func (r *redisBus) Subscribe(ctx context.Context, topic, group string, handler eventbus.Handler) error {
+	stream := streamPrefix + topic
+	err := r.client.XGroupCreateMkStream(ctx, stream, group, "0").Err()
+	if err != nil && !strings.HasPrefix(err.Error(), "BUSYGROUP") {
+		return err
+	}
+
+	claimTicker := time.NewTicker(claimIdle / 2)
+	defer claimTicker.Stop()
+
+	for ctx.Err() == nil {
+		select {
+		case <-claimTicker.C:
+			r.claim(ctx, topic, stream, group, handler)
+		default:
+		}
+
+		streams, err := r.client.XReadGroup(ctx, &redis.XReadGroupArgs{
+			Group:    group,
+			Consumer: r.consumer,
+			Streams:  []string{stream, ">"},
+			Count:    readCount,
+			Block:    readBlock,
+		}).Result()
+		if errors.Is(err, redis.Nil) {
+			continue
+		}
+		if err != nil {
+			if ctx.Err() != nil {
+				break
+			}
+			logs.CtxWarnf(ctx, "read events failed, topic=%s, group=%s, err=%v", topic, group, err)
+			sleep(ctx, retryDelay)
+			continue
+		}
+
+		for _, s := range streams {
+			for _, msg := range s.Messages {
+				r.handle(ctx, topic, stream, group, msg, handler)
+			}
+		}
+	}
+
+	return nil
+}
+
+// claim takes over the events other consumers of the group left unacknowledged,
+// failed ones included, and retries them.
+func (r *redisBus) claim(ctx context.Context, topic, stream, group string, handler eventbus.Handler) {
+	msgs, _, err := r.client.XAutoClaim(ctx, &redis.XAutoClaimArgs{
+		Stream:   stream,
+		Group:    group,
+		MinIdle:  claimIdle,
+		Start:    "0-0",
+		Count:    readCount,
+		Consumer: r.consumer,
+	}).Result()
+	if err != nil {
+		logs.CtxWarnf(ctx, "claim events failed, topic=%s, group=%s, err=%v", topic, group, err)
+		return
+	}
+
+	for _, msg := range msgs {
+		r.handle(ctx, topic, stream, group, msg, handler)
+	}
+}
+
+// handle acknowledges the event once handler succeeds, a failed event stays
+// pending until it is claimed again.
+func (r *redisBus) handle(ctx context.Context, topic, stream, group string, msg redis.XMessage, handler eventbus.Handler) {
+	event := messageToEvent(topic, msg)
+	if err := handler(ctx, event); err != nil {
+		logs.CtxWarnf(ctx, "handle event failed, topic=%s, group=%s, id=%s, err=%v", topic, group, event.ID, err)
+		return
+	}
+
+	if err := r.client.XAck(ctx, stream, group, msg.ID).Err(); err != nil {
+		logs.CtxWarnf(ctx, "ack event failed, topic=%s, group=%s, id=%s, err=%v", topic, group, event.ID, err)
+	}
+}
+
+func messageToEvent(topic string, msg redis.XMessage) *eventbus.Event {
+	value := func(field string) string {
+		s, _ := msg.Values[field].(string)
+		return s
+	}
+
+	event := &eventbus.Event{
+		ID:      value("id"),
+		Topic:   topic,
+		Key:     value("key"),
+		Payload: []byte(value("payload")),
+	}
+	// events published without an ID are told apart by their stream entry
+	if event.ID == "" {
+		event.ID = msg.ID
+	}
+	event.CreatedAt, _ = strconv.ParseInt(value("created_at"), 10, 64)
+
+	return event
+}
+
+func sleep(ctx context.Context, d time.Duration) {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+	case <-timer.C:
+	}
+}
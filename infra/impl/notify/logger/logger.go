@@ -0,0 +1,21 @@
+package logger
+
+import (
+	"context"
+
+	"github.com/crazyfrankie/zrpc-todolist/infra/contract/notify"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/logs"
+)
+
+// New returns a notifier that only writes notifications to the log,
+// it is the default when no delivery channel is configured.
+func New() notify.Notifier {
+	return &logNotifier{}
+}
+
+type logNotifier struct{}
+
+func (l *logNotifier) Notify(ctx context.Context, n *notify.Notification) error {
+	logs.CtxInfof(ctx, "[Notify] userID=%d, title=%s, body=%s, data=%v", n.UserID, n.Title, n.Body, n.Data)
+	return nil
+}
@@ -0,0 +1,12 @@
+package notify
+
+import (
+	"github.com/crazyfrankie/zrpc-todolist/infra/contract/notify"
+	"github.com/crazyfrankie/zrpc-todolist/infra/impl/notify/logger"
+)
+
+type Notifier = notify.Notifier
+
+func New() Notifier {
+	return logger.New()
+}
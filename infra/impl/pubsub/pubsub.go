@@ -0,0 +1,12 @@
+package pubsub
+
+import (
+	"github.com/crazyfrankie/zrpc-todolist/infra/contract/pubsub"
+	"github.com/crazyfrankie/zrpc-todolist/infra/impl/pubsub/redis"
+)
+
+type PubSub = pubsub.PubSub
+
+func New() PubSub {
+	return redis.New()
+}
@@ -0,0 +1,85 @@
+package redis
+
+import (
+	"context"
+	"os"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/crazyfrankie/zrpc-todolist/infra/contract/pubsub"
+)
+
+func New() pubsub.PubSub {
+	addr := os.Getenv("REDIS_ADDR")
+	password := os.Getenv("REDIS_PASSWORD")
+
+	return NewWithAddrAndPassword(addr, password)
+}
+
+func NewWithAddrAndPassword(addr, password string) pubsub.PubSub {
+	rdb := redis.NewClient(&redis.Options{
+		Addr:     addr,
+		DB:       0,
+		Password: password,
+	})
+
+	return &redisPubSub{client: rdb}
+}
+
+type redisPubSub struct {
+	client *redis.Client
+}
+
+// Publish implements pubsub.PubSub.
+func (r *redisPubSub) Publish(ctx context.Context, channel string, payload []byte) error {
+	return r.client.Publish(ctx, channel, payload).Err()
+}
+
+// Subscribe implements pubsub.PubSub.
+func (r *redisPubSub) Subscribe(ctx context.Context, channels ...string) (pubsub.Subscription, error) {
+	ps := r.client.Subscribe(ctx)
+	if len(channels) > 0 {
+		if err := ps.Subscribe(ctx, channels...); err != nil {
+			_ = ps.Close()
+			return nil, err
+		}
+	}
+
+	sub := &subscription{ps: ps, ch: make(chan *pubsub.Message, 100)}
+	go sub.forward()
+
+	return sub, nil
+}
+
+type subscription struct {
+	ps *redis.PubSub
+	ch chan *pubsub.Message
+}
+
+// forward converts the messages of redis until the subscription is closed.
+func (s *subscription) forward() {
+	defer close(s.ch)
+	for msg := range s.ps.Channel() {
+		s.ch <- &pubsub.Message{Channel: msg.Channel, Payload: []byte(msg.Payload)}
+	}
+}
+
+// Subscribe implements pubsub.Subscription.
+func (s *subscription) Subscribe(ctx context.Context, channels ...string) error {
+	return s.ps.Subscribe(ctx, channels...)
+}
+
+// Unsubscribe implements pubsub.Subscription.
+func (s *subscription) Unsubscribe(ctx context.Context, channels ...string) error {
+	return s.ps.Unsubscribe(ctx, channels...)
+}
+
+// Channel implements pubsub.Subscription.
+func (s *subscription) Channel() <-chan *pubsub.Message {
+	return s.ch
+}
+
+// Close implements pubsub.Subscription.
+func (s *subscription) Close() error {
+	return s.ps.Close()
+}
@@ -0,0 +1,123 @@
+package text
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	highlightPre  = "<em>"
+	highlightPost = "</em>"
+)
+
+// Tokenize splits s into lower-cased terms. Letters and digits form words,
+// while every Han character is a term of its own.
+func Tokenize(s string) []string {
+	var terms []string
+	var word strings.Builder
+
+	flush := func() {
+		if word.Len() > 0 {
+			terms = append(terms, word.String())
+			word.Reset()
+		}
+	}
+
+	for _, r := range s {
+		switch {
+		case unicode.Is(unicode.Han, r):
+			flush()
+			terms = append(terms, string(r))
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			word.WriteRune(unicode.ToLower(r))
+		default:
+			flush()
+		}
+	}
+	flush()
+
+	return terms
+}
+
+// Highlight returns a snippet of at most maxRunes runes around the first
+// matched term, with every matched term wrapped by <em></em>. It returns an
+// empty string if none of the terms occurs in s.
+func Highlight(s string, terms []string, maxRunes int) string {
+	lower := strings.ToLower(s)
+	if len(lower) != len(s) {
+		// lower-casing changed the byte layout, offsets can't be shared
+		lower = s
+	}
+
+	type span struct{ start, end int }
+	var spans []span
+	for i := 0; i < len(lower); {
+		matched := 0
+		for _, term := range terms {
+			if term != "" && strings.HasPrefix(lower[i:], term) && len(term) > matched {
+				matched = len(term)
+			}
+		}
+		if matched > 0 {
+			if n := len(spans); n > 0 && spans[n-1].end == i {
+				// merge adjacent matches, e.g. consecutive Han terms
+				spans[n-1].end = i + matched
+			} else {
+				spans = append(spans, span{i, i + matched})
+			}
+			i += matched
+			continue
+		}
+		_, size := utf8.DecodeRuneInString(lower[i:])
+		i += size
+	}
+	if len(spans) == 0 {
+		return ""
+	}
+
+	// center the window on the first match
+	from, to := 0, len(s)
+	if maxRunes > 0 && utf8.RuneCountInString(s) > maxRunes {
+		from = backRunes(s, spans[0].start, maxRunes/4)
+		to = forwardRunes(s, from, maxRunes)
+	}
+
+	var b strings.Builder
+	if from > 0 {
+		b.WriteString("...")
+	}
+	last := from
+	for _, sp := range spans {
+		if sp.start < from || sp.end > to {
+			continue
+		}
+		b.WriteString(s[last:sp.start])
+		b.WriteString(highlightPre)
+		b.WriteString(s[sp.start:sp.end])
+		b.WriteString(highlightPost)
+		last = sp.end
+	}
+	b.WriteString(s[last:to])
+	if to < len(s) {
+		b.WriteString("...")
+	}
+
+	return b.String()
+}
+
+func backRunes(s string, pos, n int) int {
+	for ; n > 0 && pos > 0; n-- {
+		_, size := utf8.DecodeLastRuneInString(s[:pos])
+		pos -= size
+	}
+	return pos
+}
+
+func forwardRunes(s string, pos, n int) int {
+	for ; n > 0 && pos < len(s); n-- {
+		_, size := utf8.DecodeRuneInString(s[pos:])
+		pos += size
+	}
+	return pos
+}
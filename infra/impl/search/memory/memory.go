@@ -0,0 +1,167 @@
+package memory
+
+import (
+	"context"
+	"math"
+	"slices"
+	"sort"
+	"sync"
+
+	"github.com/crazyfrankie/zrpc-todolist/infra/contract/search"
+	"github.com/crazyfrankie/zrpc-todolist/infra/impl/search/internal/text"
+)
+
+const (
+	titleBoost   = 2.0
+	snippetRunes = 120
+)
+
+// New returns an in-process inverted index, it is meant for tests and
+// local development since the index is neither persisted nor shared.
+func New() search.Searcher {
+	return &memorySearch{
+		docs:     make(map[int64]*search.Document),
+		postings: make(map[string]map[int64]*posting),
+	}
+}
+
+type posting struct {
+	titleFreq   int
+	contentFreq int
+}
+
+type memorySearch struct {
+	mu       sync.RWMutex
+	docs     map[int64]*search.Document
+	postings map[string]map[int64]*posting
+}
+
+func (m *memorySearch) Index(ctx context.Context, doc *search.Document) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.remove(doc.ID)
+
+	cp := *doc
+	m.docs[doc.ID] = &cp
+	for _, term := range text.Tokenize(doc.Title) {
+		m.posting(term, doc.ID).titleFreq++
+	}
+	for _, term := range text.Tokenize(doc.Content) {
+		m.posting(term, doc.ID).contentFreq++
+	}
+
+	return nil
+}
+
+func (m *memorySearch) Delete(ctx context.Context, id int64) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.remove(id)
+
+	return nil
+}
+
+func (m *memorySearch) Search(ctx context.Context, req *search.Request) (*search.Result, error) {
+	terms := uniqueTerms(text.Tokenize(req.Keyword))
+	if len(terms) == 0 {
+		return &search.Result{}, nil
+	}
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	scores := make(map[int64]float64)
+	for _, term := range terms {
+		docs := m.postings[term]
+		if len(docs) == 0 {
+			continue
+		}
+		idf := math.Log(1 + float64(len(m.docs))/float64(len(docs)))
+		for id, p := range docs {
+			doc := m.docs[id]
+			if doc.OwnerID != req.OwnerID {
+				continue
+			}
+			if len(req.Statuses) > 0 && !slices.Contains(req.Statuses, doc.Status) {
+				continue
+			}
+			scores[id] += idf * (titleBoost*float64(p.titleFreq) + float64(p.contentFreq))
+		}
+	}
+
+	hits := make([]*search.Hit, 0, len(scores))
+	for id, score := range scores {
+		hits = append(hits, &search.Hit{ID: id, Score: score})
+	}
+	sort.Slice(hits, func(i, j int) bool {
+		if hits[i].Score != hits[j].Score {
+			return hits[i].Score > hits[j].Score
+		}
+		return m.docs[hits[i].ID].UpdatedAt > m.docs[hits[j].ID].UpdatedAt
+	})
+
+	res := &search.Result{Total: int64(len(hits))}
+	if req.Offset >= len(hits) {
+		return res, nil
+	}
+	hits = hits[req.Offset:]
+	if req.Limit > 0 && len(hits) > req.Limit {
+		hits = hits[:req.Limit]
+	}
+	for _, hit := range hits {
+		doc := m.docs[hit.ID]
+		hit.TitleHighlight = text.Highlight(doc.Title, terms, snippetRunes)
+		hit.ContentHighlight = text.Highlight(doc.Content, terms, snippetRunes)
+	}
+	res.Hits = hits
+
+	return res, nil
+}
+
+func (m *memorySearch) posting(term string, id int64) *posting {
+	docs, ok := m.postings[term]
+	if !ok {
+		docs = make(map[int64]*posting)
+		m.postings[term] = docs
+	}
+	p, ok := docs[id]
+	if !ok {
+		p = &posting{}
+		docs[id] = p
+	}
+
+	return p
+}
+
+func (m *memorySearch) remove(id int64) {
+	doc, ok := m.docs[id]
+	if !ok {
+		return
+	}
+	delete(m.docs, id)
+
+	for _, term := range append(text.Tokenize(doc.Title), text.Tokenize(doc.Content)...) {
+		if docs, ok := m.postings[term]; ok {
+			delete(docs, id)
+			if len(docs) == 0 {
+				delete(m.postings, term)
+			}
+		}
+	}
+}
+
+func uniqueTerms(terms []string) []string {
+	seen := make(map[string]struct{}, len(terms))
+	res := make([]string, 0, len(terms))
+	for _, term := range terms {
+		if _, ok := seen[term]; ok {
+			continue
+		}
+		seen[term] = struct{}{}
+		res = append(res, term)
+	}
+
+	return res
+}
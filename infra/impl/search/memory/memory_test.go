@@ -0,0 +1,188 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/crazyfrankie/zrpc-todolist/infra/contract/search"
+)
+
+func newIndex(t *testing.T, docs ...*search.Document) search.Searcher {
+	t.Helper()
+
+	s := New()
+	for _, doc := range docs {
+		if err := s.Index(context.Background(), doc); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return s
+}
+
+func hitIDs(res *search.Result) []int64 {
+	ids := make([]int64, 0, len(res.Hits))
+	for _, hit := range res.Hits {
+		ids = append(ids, hit.ID)
+	}
+	return ids
+}
+
+func TestSearch(t *testing.T) {
+	s := newIndex(t,
+		&search.Document{ID: 1, OwnerID: 1, Title: "Write report", Content: "for the board", UpdatedAt: 1},
+		&search.Document{ID: 2, OwnerID: 1, Title: "Groceries", Content: "print the report first", UpdatedAt: 2},
+		&search.Document{ID: 3, OwnerID: 1, Title: "Weekly report", Content: "report the numbers", UpdatedAt: 3},
+		&search.Document{ID: 4, OwnerID: 1, Status: 1, Title: "Old report", UpdatedAt: 4},
+		&search.Document{ID: 5, OwnerID: 2, Title: "Report of another user", UpdatedAt: 5},
+		&search.Document{ID: 6, OwnerID: 1, Title: "Read a book", UpdatedAt: 6},
+	)
+
+	tests := []struct {
+		name  string
+		req   *search.Request
+		want  []int64
+		total int64
+	}{
+		{
+			name:  "title matches weigh more than content matches",
+			req:   &search.Request{OwnerID: 1, Keyword: "report", Statuses: []int32{0}},
+			want:  []int64{3, 1, 2},
+			total: 3,
+		},
+		{
+			name:  "any status",
+			req:   &search.Request{OwnerID: 1, Keyword: "report"},
+			want:  []int64{3, 4, 1, 2},
+			total: 4,
+		},
+		{
+			name:  "status filter",
+			req:   &search.Request{OwnerID: 1, Keyword: "report", Statuses: []int32{1}},
+			want:  []int64{4},
+			total: 1,
+		},
+		{
+			name:  "case insensitive",
+			req:   &search.Request{OwnerID: 2, Keyword: "REPORT"},
+			want:  []int64{5},
+			total: 1,
+		},
+		{
+			name:  "any of the terms",
+			req:   &search.Request{OwnerID: 1, Keyword: "book groceries", Statuses: []int32{0}},
+			want:  []int64{6, 2},
+			total: 2,
+		},
+		{
+			name:  "page",
+			req:   &search.Request{OwnerID: 1, Keyword: "report", Offset: 1, Limit: 2},
+			want:  []int64{4, 1},
+			total: 4,
+		},
+		{
+			name:  "page past the end",
+			req:   &search.Request{OwnerID: 1, Keyword: "report", Offset: 10, Limit: 2},
+			want:  []int64{},
+			total: 4,
+		},
+		{
+			name: "no terms",
+			req:  &search.Request{OwnerID: 1, Keyword: " ,.!"},
+			want: []int64{},
+		},
+		{
+			name: "no match",
+			req:  &search.Request{OwnerID: 1, Keyword: "holiday"},
+			want: []int64{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := s.Search(context.Background(), tt.req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := hitIDs(res); !slices.Equal(got, tt.want) {
+				t.Errorf("hits = %v, want %v", got, tt.want)
+			}
+			if res.Total != tt.total {
+				t.Errorf("total = %d, want %d", res.Total, tt.total)
+			}
+		})
+	}
+}
+
+func TestSearchTiesNewestFirst(t *testing.T) {
+	s := newIndex(t,
+		&search.Document{ID: 1, OwnerID: 1, Title: "call mom", UpdatedAt: 10},
+		&search.Document{ID: 2, OwnerID: 1, Title: "call dad", UpdatedAt: 30},
+		&search.Document{ID: 3, OwnerID: 1, Title: "call bank", UpdatedAt: 20},
+	)
+
+	res, err := s.Search(context.Background(), &search.Request{OwnerID: 1, Keyword: "call"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := hitIDs(res), []int64{2, 3, 1}; !slices.Equal(got, want) {
+		t.Errorf("hits = %v, want %v", got, want)
+	}
+}
+
+func TestSearchHighlight(t *testing.T) {
+	s := newIndex(t,
+		&search.Document{ID: 1, OwnerID: 1, Title: "Buy Milk", Content: "milk and bread, then more milk"},
+		&search.Document{ID: 2, OwnerID: 1, Title: "买牛奶", Content: "记得买牛奶和面包"},
+	)
+
+	tests := []struct {
+		keyword, title, content string
+	}{
+		{"milk", "Buy <em>Milk</em>", "<em>milk</em> and bread, then more <em>milk</em>"},
+		{"bread", "", "milk and <em>bread</em>, then more milk"},
+		{"牛奶", "买<em>牛奶</em>", "记得买<em>牛奶</em>和面包"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.keyword, func(t *testing.T) {
+			res, err := s.Search(context.Background(), &search.Request{OwnerID: 1, Keyword: tt.keyword})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(res.Hits) != 1 {
+				t.Fatalf("hits = %v, want 1", hitIDs(res))
+			}
+			if hit := res.Hits[0]; hit.TitleHighlight != tt.title || hit.ContentHighlight != tt.content {
+				t.Errorf("highlights = %q, %q, want %q, %q", hit.TitleHighlight, hit.ContentHighlight, tt.title, tt.content)
+			}
+		})
+	}
+}
+
+func TestIndexReplacesAndDeleteRemoves(t *testing.T) {
+	ctx := context.Background()
+	s := newIndex(t, &search.Document{ID: 1, OwnerID: 1, Title: "draft report"})
+
+	if err := s.Index(ctx, &search.Document{ID: 1, OwnerID: 1, Title: "final summary"}); err != nil {
+		t.Fatal(err)
+	}
+	for keyword, want := range map[string][]int64{"draft": {}, "final": {1}} {
+		res, err := s.Search(ctx, &search.Request{OwnerID: 1, Keyword: keyword})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := hitIDs(res); !slices.Equal(got, want) {
+			t.Errorf("%s: hits = %v, want %v", keyword, got, want)
+		}
+	}
+
+	if err := s.Delete(ctx, 1); err != nil {
+		t.Fatal(err)
+	}
+	res, err := s.Search(ctx, &search.Request{OwnerID: 1, Keyword: "final"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Hits) != 0 || res.Total != 0 {
+		t.Errorf("hits = %v, total %d, want none", hitIDs(res), res.Total)
+	}
+}
@@ -0,0 +1,87 @@
+package mysql
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/crazyfrankie/zrpc-todolist/infra/contract/search"
+	"github.com/crazyfrankie/zrpc-todolist/infra/impl/search/internal/text"
+)
+
+const (
+	matchExpr    = "MATCH(`title`, `content`) AGAINST (? IN NATURAL LANGUAGE MODE)"
+	snippetRunes = 120
+)
+
+// New returns a searcher backed by a MySQL FULLTEXT index. The table must
+// have `id`, `user_id`, `status`, `title`, `content` and `deleted_at` columns
+// and a FULLTEXT index over (`title`, `content`), which MySQL keeps up to date
+// by itself, so Index and Delete are no-ops. Soft deleted rows never match,
+// as if Delete had removed them.
+func New(db *gorm.DB, table string) search.Searcher {
+	return &mysqlSearch{db: db, table: table}
+}
+
+type mysqlSearch struct {
+	db    *gorm.DB
+	table string
+}
+
+type row struct {
+	ID      int64
+	Title   string
+	Content string
+	Score   float64
+}
+
+func (m *mysqlSearch) Index(ctx context.Context, doc *search.Document) error {
+	return nil
+}
+
+func (m *mysqlSearch) Delete(ctx context.Context, id int64) error {
+	return nil
+}
+
+func (m *mysqlSearch) Search(ctx context.Context, req *search.Request) (*search.Result, error) {
+	terms := text.Tokenize(req.Keyword)
+	if len(terms) == 0 {
+		return &search.Result{}, nil
+	}
+
+	q := m.db.WithContext(ctx).Table(m.table).
+		Where("`user_id` = ?", req.OwnerID).
+		Where("`deleted_at` IS NULL").
+		Where(matchExpr, req.Keyword)
+	if len(req.Statuses) > 0 {
+		q = q.Where("`status` IN ?", req.Statuses)
+	}
+
+	var total int64
+	if err := q.Session(&gorm.Session{}).Count(&total).Error; err != nil {
+		return nil, err
+	}
+
+	var rows []*row
+	err := q.Session(&gorm.Session{}).
+		Select("`id`, `title`, `content`, "+matchExpr+" AS `score`", req.Keyword).
+		Order("`score` DESC").
+		Offset(req.Offset).
+		Limit(req.Limit).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	hits := make([]*search.Hit, 0, len(rows))
+	for _, r := range rows {
+		hits = append(hits, &search.Hit{
+			ID:               r.ID,
+			Score:            r.Score,
+			TitleHighlight:   text.Highlight(r.Title, terms, snippetRunes),
+			ContentHighlight: text.Highlight(r.Content, terms, snippetRunes),
+		})
+	}
+
+	return &search.Result{Hits: hits, Total: total}, nil
+}
@@ -0,0 +1,25 @@
+package search
+
+import (
+	"os"
+
+	"gorm.io/gorm"
+
+	"github.com/crazyfrankie/zrpc-todolist/infra/contract/search"
+	"github.com/crazyfrankie/zrpc-todolist/infra/impl/search/memory"
+	"github.com/crazyfrankie/zrpc-todolist/infra/impl/search/mysql"
+	"github.com/crazyfrankie/zrpc-todolist/types/consts"
+)
+
+type Searcher = search.Searcher
+
+// New returns the searcher selected by SEARCH_TYPE, it defaults to the
+// MySQL FULLTEXT implementation over the given table.
+func New(db *gorm.DB, table string) Searcher {
+	switch os.Getenv(consts.SearchType) {
+	case "memory":
+		return memory.New()
+	default:
+		return mysql.New(db, table)
+	}
+}
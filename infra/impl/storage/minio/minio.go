@@ -41,6 +41,24 @@ func New(ctx context.Context, endpoint, accessKeyID, secretAccessKey, bucketName
 }
 
 func (m *minioStore) PutObject(ctx context.Context, objectKey string, content []byte, opts ...storage.PutOptFn) error {
+	return m.putObject(ctx, objectKey, bytes.NewReader(content), int64(len(content)), opts...)
+}
+
+func (m *minioStore) PutObjectWithReader(ctx context.Context, objectKey string, content io.Reader, opts ...storage.PutOptFn) error {
+	option := storage.PutOption{}
+	for _, opt := range opts {
+		opt(&option)
+	}
+
+	size := int64(-1)
+	if option.ObjectSize > 0 {
+		size = option.ObjectSize
+	}
+
+	return m.putObject(ctx, objectKey, content, size, opts...)
+}
+
+func (m *minioStore) putObject(ctx context.Context, objectKey string, content io.Reader, size int64, opts ...storage.PutOptFn) error {
 	option := storage.PutOption{}
 	for _, opt := range opts {
 		opt(&option)
@@ -67,8 +85,7 @@ func (m *minioStore) PutObject(ctx context.Context, objectKey string, content []
 		minioOpts.Expires = *option.Expires
 	}
 
-	_, err := m.client.PutObject(ctx, m.bucketName, objectKey,
-		bytes.NewReader(content), int64(len(content)), minioOpts)
+	_, err := m.client.PutObject(ctx, m.bucketName, objectKey, content, size, minioOpts)
 	if err != nil {
 		return fmt.Errorf("PutObject failed: %v", err)
 	}
@@ -0,0 +1,163 @@
+package handler
+
+import (
+	"io"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/crazyfrankie/zrpc-todolist/interfaces/task/model"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/gin/response"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/lang/conv"
+	"github.com/crazyfrankie/zrpc-todolist/protocol/task"
+)
+
+// maxUploadSize matches the attachment size limit of the task service, larger
+// files are rejected before they are read.
+const maxUploadSize = 4 << 20
+
+// UploadAttachment godoc
+// @Summary Upload an attachment
+// @Description Attach a file of at most 4MB to a task, images, PDFs and plain text are accepted
+// @Tags Attachment
+// @Accept multipart/form-data
+// @Produce json
+// @Param task_id formData int true "Task ID"
+// @Param file formData file true "Attached file"
+// @Success 200 {object} response.Response{data=task.Attachment} "Attachment uploaded successfully"
+// @Failure 400 {object} response.Response "Invalid parameters"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Router /tasks/attachment/upload [post]
+func (t *TaskHandler) UploadAttachment() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req model.UploadAttachmentReq
+		if err := c.ShouldBind(&req); err != nil {
+			response.InvalidParamError(c, err.Error())
+			return
+		}
+
+		file, err := c.FormFile("file")
+		if err != nil {
+			response.InvalidParamError(c, "missing attachment file")
+			return
+		}
+		if file.Size > maxUploadSize {
+			response.InvalidParamError(c, "attachment is too large")
+			return
+		}
+
+		src, err := file.Open()
+		if err != nil {
+			response.InternalServerError(c, err)
+			return
+		}
+		defer src.Close()
+
+		content, err := io.ReadAll(src)
+		if err != nil {
+			response.InternalServerError(c, err)
+			return
+		}
+
+		res, err := t.taskClient.UploadAttachment(c.Request.Context(), &task.UploadAttachmentRequest{
+			TaskID:  req.TaskID,
+			Name:    file.Filename,
+			Content: content,
+		})
+		if err != nil {
+			response.InternalServerError(c, err)
+			return
+		}
+
+		response.Success(c, res.GetData())
+	}
+}
+
+// ListAttachments godoc
+// @Summary List attachments
+// @Description List the attachments of a task oldest first
+// @Tags Attachment
+// @Produce json
+// @Param id path string true "Task ID"
+// @Success 200 {object} response.Response{data=[]task.Attachment} "Attachments retrieved successfully"
+// @Failure 400 {object} response.Response "Invalid parameters"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Router /tasks/attachment/list/{id} [get]
+func (t *TaskHandler) ListAttachments() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		taskID, err := conv.StrToInt64(c.Param("id"))
+		if err != nil {
+			response.InvalidParamError(c, "invalid task id")
+			return
+		}
+
+		res, err := t.taskClient.ListAttachments(c.Request.Context(), &task.ListAttachmentsRequest{
+			TaskID: taskID,
+		})
+		if err != nil {
+			response.InternalServerError(c, err)
+			return
+		}
+
+		response.Success(c, res.GetData())
+	}
+}
+
+// DownloadAttachment godoc
+// @Summary Download attachment
+// @Description Get a presigned download URL for an attachment, the URL is valid for 15 minutes
+// @Tags Attachment
+// @Produce json
+// @Param id path string true "Attachment ID"
+// @Success 200 {object} response.Response{data=model.AttachmentURLResp} "Download URL retrieved successfully"
+// @Failure 400 {object} response.Response "Invalid parameters"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Router /tasks/attachment/download/{id} [get]
+func (t *TaskHandler) DownloadAttachment() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		attachmentID, err := conv.StrToInt64(c.Param("id"))
+		if err != nil {
+			response.InvalidParamError(c, "invalid attachment id")
+			return
+		}
+
+		res, err := t.taskClient.GetAttachmentURL(c.Request.Context(), &task.GetAttachmentURLRequest{
+			AttachmentID: attachmentID,
+		})
+		if err != nil {
+			response.InternalServerError(c, err)
+			return
+		}
+
+		response.Success(c, &model.AttachmentURLResp{URL: res.GetUrl()})
+	}
+}
+
+// DeleteAttachment godoc
+// @Summary Delete attachment
+// @Description Delete an attachment of a task along with its stored file
+// @Tags Attachment
+// @Produce json
+// @Param id path string true "Attachment ID"
+// @Success 200 {object} response.Response "Attachment deleted successfully"
+// @Failure 400 {object} response.Response "Invalid parameters"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Router /tasks/attachment/delete/{id} [delete]
+func (t *TaskHandler) DeleteAttachment() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		attachmentID, err := conv.StrToInt64(c.Param("id"))
+		if err != nil {
+			response.InvalidParamError(c, "invalid attachment id")
+			return
+		}
+
+		_, err = t.taskClient.DeleteAttachment(c.Request.Context(), &task.DeleteAttachmentRequest{
+			AttachmentID: attachmentID,
+		})
+		if err != nil {
+			response.InternalServerError(c, err)
+			return
+		}
+
+		response.Success(c, nil)
+	}
+}
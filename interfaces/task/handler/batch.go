@@ -0,0 +1,136 @@
+package handler
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/crazyfrankie/zrpc-todolist/interfaces/task/model"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/gin/response"
+	langslice "github.com/crazyfrankie/zrpc-todolist/pkg/lang/slice"
+	"github.com/crazyfrankie/zrpc-todolist/protocol/task"
+)
+
+// BatchCreateTasks godoc
+// @Summary Create tasks in batch
+// @Description Create up to 100 tasks at once, the valid ones are created together and each result tells whether its task was created
+// @Tags Batch
+// @Accept json
+// @Produce json
+// @Param request body model.BatchCreateTasksReq true "Batch create tasks request"
+// @Success 200 {object} response.Response{data=[]task.BatchResult} "Batch applied, see the results"
+// @Failure 400 {object} response.Response "Invalid parameters"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Router /tasks/batch/create [post]
+func (t *TaskHandler) BatchCreateTasks() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req model.BatchCreateTasksReq
+		if err := c.ShouldBind(&req); err != nil {
+			response.InvalidParamError(c, err.Error())
+			return
+		}
+
+		res, err := t.taskClient.BatchCreateTasks(c.Request.Context(), &task.BatchCreateTasksRequest{
+			Tasks: langslice.Transform(req.Tasks, createTaskVO2DTO),
+		})
+		if err != nil {
+			response.InternalServerError(c, err)
+			return
+		}
+
+		response.Success(c, res.GetData())
+	}
+}
+
+// BatchUpdateStatus godoc
+// @Summary Update task status in batch
+// @Description Move up to 100 tasks to a status at once, tasks blocked by open tasks outside the batch can't be done
+// @Tags Batch
+// @Accept json
+// @Produce json
+// @Param request body model.BatchUpdateStatusReq true "Batch update status request"
+// @Success 200 {object} response.Response{data=[]task.BatchResult} "Batch applied, see the results"
+// @Failure 400 {object} response.Response "Invalid parameters"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Router /tasks/batch/status [put]
+func (t *TaskHandler) BatchUpdateStatus() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req model.BatchUpdateStatusReq
+		if err := c.ShouldBind(&req); err != nil {
+			response.InvalidParamError(c, err.Error())
+			return
+		}
+
+		res, err := t.taskClient.BatchUpdateStatus(c.Request.Context(), &task.BatchUpdateStatusRequest{
+			TaskIds: req.TaskIDs,
+			Status:  statusVO2DTO(req.Status),
+		})
+		if err != nil {
+			response.InternalServerError(c, err)
+			return
+		}
+
+		response.Success(c, res.GetData())
+	}
+}
+
+// BatchDelete godoc
+// @Summary Delete tasks in batch
+// @Description Move up to 100 tasks with their subtasks to the recycle bin at once
+// @Tags Batch
+// @Accept json
+// @Produce json
+// @Param request body model.BatchDeleteReq true "Batch delete request"
+// @Success 200 {object} response.Response{data=[]task.BatchResult} "Batch applied, see the results"
+// @Failure 400 {object} response.Response "Invalid parameters"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Router /tasks/batch/delete [delete]
+func (t *TaskHandler) BatchDelete() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req model.BatchDeleteReq
+		if err := c.ShouldBind(&req); err != nil {
+			response.InvalidParamError(c, err.Error())
+			return
+		}
+
+		res, err := t.taskClient.BatchDelete(c.Request.Context(), &task.BatchDeleteRequest{
+			TaskIds: req.TaskIDs,
+		})
+		if err != nil {
+			response.InternalServerError(c, err)
+			return
+		}
+
+		response.Success(c, res.GetData())
+	}
+}
+
+// BatchMove godoc
+// @Summary Move tasks in batch
+// @Description Move up to 100 top level tasks with their subtasks to a project at once
+// @Tags Batch
+// @Accept json
+// @Produce json
+// @Param request body model.BatchMoveReq true "Batch move request"
+// @Success 200 {object} response.Response{data=[]task.BatchResult} "Batch applied, see the results"
+// @Failure 400 {object} response.Response "Invalid parameters"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Router /tasks/batch/move [put]
+func (t *TaskHandler) BatchMove() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req model.BatchMoveReq
+		if err := c.ShouldBind(&req); err != nil {
+			response.InvalidParamError(c, err.Error())
+			return
+		}
+
+		res, err := t.taskClient.BatchMove(c.Request.Context(), &task.BatchMoveRequest{
+			TaskIds:   req.TaskIDs,
+			ProjectId: req.ProjectID,
+		})
+		if err != nil {
+			response.InternalServerError(c, err)
+			return
+		}
+
+		response.Success(c, res.GetData())
+	}
+}
@@ -0,0 +1,217 @@
+package handler
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/crazyfrankie/zrpc-todolist/interfaces/task/model"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/gin/response"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/lang/conv"
+	"github.com/crazyfrankie/zrpc-todolist/protocol/task"
+)
+
+// GetBoard godoc
+// @Summary Get board
+// @Description Get every column of a project's kanban board with its tasks, a board without columns gets To Do, In Progress and Done
+// @Tags Board
+// @Produce json
+// @Param project_id query int false "Project ID, absent means the inbox"
+// @Success 200 {object} response.Response{data=task.Board} "Board retrieved successfully"
+// @Failure 400 {object} response.Response "Invalid parameters"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Router /tasks/board/get [get]
+func (t *TaskHandler) GetBoard() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req model.GetBoardReq
+		if err := c.ShouldBindQuery(&req); err != nil {
+			response.InvalidParamError(c, err.Error())
+			return
+		}
+
+		res, err := t.taskClient.GetBoard(c.Request.Context(), &task.GetBoardRequest{
+			ProjectId: req.ProjectID,
+		})
+		if err != nil {
+			response.InternalServerError(c, err)
+			return
+		}
+
+		response.Success(c, res.GetData())
+	}
+}
+
+// CreateColumn godoc
+// @Summary Create board column
+// @Description Append a column to a board, it shows the tasks in its status. The first column of a status can't have a WIP limit
+// @Tags Board
+// @Accept json
+// @Produce json
+// @Param request body model.CreateColumnReq true "Create column request"
+// @Success 200 {object} response.Response{data=task.BoardColumn} "Column created successfully"
+// @Failure 400 {object} response.Response "Invalid parameters"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Router /tasks/board/column/create [post]
+func (t *TaskHandler) CreateColumn() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req model.CreateColumnReq
+		if err := c.ShouldBind(&req); err != nil {
+			response.InvalidParamError(c, err.Error())
+			return
+		}
+
+		res, err := t.taskClient.CreateColumn(c.Request.Context(), &task.CreateColumnRequest{
+			ProjectId: req.ProjectID,
+			Name:      req.Name,
+			Status:    statusVO2DTO(req.Status),
+			WipLimit:  req.WIPLimit,
+		})
+		if err != nil {
+			response.InternalServerError(c, err)
+			return
+		}
+
+		response.Success(c, res.GetData())
+	}
+}
+
+// UpdateColumn godoc
+// @Summary Update board column
+// @Description Rename a column or change its WIP limit, a lower limit keeps the tasks already in the column. The first column of a status can't have a WIP limit
+// @Tags Board
+// @Accept json
+// @Produce json
+// @Param id path string true "Column ID"
+// @Param request body model.UpdateColumnReq true "Update column request"
+// @Success 200 {object} response.Response{data=task.BoardColumn} "Column updated successfully"
+// @Failure 400 {object} response.Response "Invalid parameters"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Router /tasks/board/column/update/{id} [put]
+func (t *TaskHandler) UpdateColumn() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req model.UpdateColumnReq
+		if err := c.ShouldBind(&req); err != nil {
+			response.InvalidParamError(c, err.Error())
+			return
+		}
+
+		columnID, err := conv.StrToInt64(c.Param("id"))
+		if err != nil {
+			response.InvalidParamError(c, "invalid column id")
+			return
+		}
+
+		res, err := t.taskClient.UpdateColumn(c.Request.Context(), &task.UpdateColumnRequest{
+			ColumnID: columnID,
+			Name:     req.Name,
+			WipLimit: req.WIPLimit,
+		})
+		if err != nil {
+			response.InternalServerError(c, err)
+			return
+		}
+
+		response.Success(c, res.GetData())
+	}
+}
+
+// ReorderColumns godoc
+// @Summary Reorder board columns
+// @Description Put the given columns of a board in the given order, the others keep their places
+// @Tags Board
+// @Accept json
+// @Produce json
+// @Param request body model.ReorderColumnsReq true "Reorder columns request"
+// @Success 200 {object} response.Response "Columns reordered successfully"
+// @Failure 400 {object} response.Response "Invalid parameters"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Router /tasks/board/column/reorder [put]
+func (t *TaskHandler) ReorderColumns() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req model.ReorderColumnsReq
+		if err := c.ShouldBind(&req); err != nil {
+			response.InvalidParamError(c, err.Error())
+			return
+		}
+
+		_, err := t.taskClient.ReorderColumns(c.Request.Context(), &task.ReorderColumnsRequest{
+			ProjectId: req.ProjectID,
+			ColumnIds: req.ColumnIDs,
+		})
+		if err != nil {
+			response.InternalServerError(c, err)
+			return
+		}
+
+		response.Success(c, nil)
+	}
+}
+
+// DeleteColumn godoc
+// @Summary Delete board column
+// @Description Delete a column, its tasks move to the first column of their status
+// @Tags Board
+// @Produce json
+// @Param id path string true "Column ID"
+// @Success 200 {object} response.Response "Column deleted successfully"
+// @Failure 400 {object} response.Response "Invalid parameters"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Router /tasks/board/column/delete/{id} [delete]
+func (t *TaskHandler) DeleteColumn() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		columnID, err := conv.StrToInt64(c.Param("id"))
+		if err != nil {
+			response.InvalidParamError(c, "invalid column id")
+			return
+		}
+
+		_, err = t.taskClient.DeleteColumn(c.Request.Context(), &task.DeleteColumnRequest{
+			ColumnID: columnID,
+		})
+		if err != nil {
+			response.InternalServerError(c, err)
+			return
+		}
+
+		response.Success(c, nil)
+	}
+}
+
+// MoveCard godoc
+// @Summary Move board card
+// @Description Move a task into a column, taking the status of the column, fails once the column reached its WIP limit
+// @Tags Board
+// @Accept json
+// @Produce json
+// @Param id path string true "Task ID"
+// @Param request body model.MoveCardReq true "Move card request"
+// @Success 200 {object} response.Response{data=task.Task} "Card moved successfully"
+// @Failure 400 {object} response.Response "Invalid parameters"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Router /tasks/board/move/{id} [put]
+func (t *TaskHandler) MoveCard() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req model.MoveCardReq
+		if err := c.ShouldBind(&req); err != nil {
+			response.InvalidParamError(c, err.Error())
+			return
+		}
+
+		taskID, err := conv.StrToInt64(c.Param("id"))
+		if err != nil {
+			response.InvalidParamError(c, "invalid task id")
+			return
+		}
+
+		res, err := t.taskClient.MoveCard(c.Request.Context(), &task.MoveCardRequest{
+			TaskID:   taskID,
+			ColumnId: req.ColumnID,
+			BeforeId: req.BeforeID,
+			AfterId:  req.AfterID,
+		})
+		if err != nil {
+			response.InternalServerError(c, err)
+			return
+		}
+
+		response.Success(c, res.GetData())
+	}
+}
@@ -0,0 +1,151 @@
+package handler
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/status"
+
+	"github.com/crazyfrankie/zrpc-todolist/interfaces/task/model"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/gin/response"
+	"github.com/crazyfrankie/zrpc-todolist/protocol/task"
+	"github.com/crazyfrankie/zrpc-todolist/types/errno"
+)
+
+const calendarFeedPath = "/api/task/calendar/"
+
+// CreateCalendarFeed godoc
+// @Summary Create calendar feed
+// @Description Create a secret iCalendar feed URL of the dated tasks to subscribe to from calendar apps. The token is only returned here and when rotated, a user has at most one feed.
+// @Tags Calendar
+// @Produce json
+// @Success 200 {object} response.Response{data=model.CalendarFeedResp} "Calendar feed created successfully"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Router /tasks/calendar/feed/create [post]
+func (t *TaskHandler) CreateCalendarFeed() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		res, err := t.taskClient.CreateCalendarFeed(c.Request.Context(), &task.CreateCalendarFeedRequest{})
+		if err != nil {
+			response.InternalServerError(c, err)
+			return
+		}
+
+		response.Success(c, calendarFeedResp(c, res.GetToken()))
+	}
+}
+
+// RotateCalendarFeed godoc
+// @Summary Rotate calendar feed
+// @Description Replace the token of the calendar feed, the old feed URL stops working
+// @Tags Calendar
+// @Produce json
+// @Success 200 {object} response.Response{data=model.CalendarFeedResp} "Calendar feed rotated successfully"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Router /tasks/calendar/feed/rotate [post]
+func (t *TaskHandler) RotateCalendarFeed() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		res, err := t.taskClient.RotateCalendarFeed(c.Request.Context(), &task.RotateCalendarFeedRequest{})
+		if err != nil {
+			response.InternalServerError(c, err)
+			return
+		}
+
+		response.Success(c, calendarFeedResp(c, res.GetToken()))
+	}
+}
+
+// RevokeCalendarFeed godoc
+// @Summary Revoke calendar feed
+// @Description Delete the calendar feed, its URL stops working
+// @Tags Calendar
+// @Produce json
+// @Success 200 {object} response.Response "Calendar feed revoked successfully"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Router /tasks/calendar/feed/revoke [delete]
+func (t *TaskHandler) RevokeCalendarFeed() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		_, err := t.taskClient.RevokeCalendarFeed(c.Request.Context(), &task.RevokeCalendarFeedRequest{})
+		if err != nil {
+			response.InternalServerError(c, err)
+			return
+		}
+
+		response.Success(c, nil)
+	}
+}
+
+// GetCalendarFeed godoc
+// @Summary Get calendar feed
+// @Description Get the tasks due from 90 days ago to 2 years ahead as an iCalendar file, each task as both a VTODO and a VEVENT at its due time. The token in the path authorizes the request, no Bearer token is needed. The ETag header changes along with the content.
+// @Tags Calendar
+// @Produce text/calendar
+// @Param file path string true "Feed token followed by .ics"
+// @Param If-None-Match header string false "ETag of a cached copy"
+// @Success 200 {string} string "iCalendar file"
+// @Success 304 "Feed not modified"
+// @Failure 404 {object} response.Response "Unknown or revoked token"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Router /tasks/calendar/{file} [get]
+func (t *TaskHandler) GetCalendarFeed() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		token, ok := strings.CutSuffix(c.Param("file"), ".ics")
+		if !ok {
+			c.Status(http.StatusNotFound)
+			return
+		}
+
+		res, err := t.taskClient.GetCalendarFeed(c.Request.Context(), &task.GetCalendarFeedRequest{
+			Token: token,
+		})
+		if isCalendarFeedNotFound(err) {
+			response.NotFound(c, err)
+			return
+		}
+		if err != nil {
+			response.InternalServerError(c, err)
+			return
+		}
+
+		c.Header("ETag", res.GetEtag())
+		// calendar apps poll the feed, make them revalidate every time
+		c.Header("Cache-Control", "private, no-cache")
+		if etagMatches(c.GetHeader("If-None-Match"), res.GetEtag()) {
+			c.Status(http.StatusNotModified)
+			return
+		}
+
+		c.Data(http.StatusOK, "text/calendar; charset=utf-8", res.GetContent())
+	}
+}
+
+func calendarFeedResp(c *gin.Context, token string) *model.CalendarFeedResp {
+	scheme := "http"
+	if c.Request.TLS != nil {
+		scheme = "https"
+	} else if proto := c.GetHeader("X-Forwarded-Proto"); proto != "" {
+		scheme = proto
+	}
+
+	return &model.CalendarFeedResp{
+		Token: token,
+		URL:   scheme + "://" + c.Request.Host + calendarFeedPath + token + ".ics",
+	}
+}
+
+func isCalendarFeedNotFound(err error) bool {
+	s, ok := status.FromError(err)
+	return ok && err != nil && int32(s.Code()) == errno.ErrCalendarFeedNotFoundCode
+}
+
+// etagMatches reports whether an If-None-Match header lists the entity tag,
+// weakly compared as proxies compressing the feed turn it into a weak one.
+func etagMatches(header, etag string) bool {
+	for _, tag := range strings.Split(header, ",") {
+		tag = strings.TrimPrefix(strings.TrimSpace(tag), "W/")
+		if tag == "*" || tag == strings.TrimPrefix(etag, "W/") {
+			return true
+		}
+	}
+	return false
+}
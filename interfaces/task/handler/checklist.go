@@ -0,0 +1,112 @@
+package handler
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/crazyfrankie/zrpc-todolist/interfaces/task/model"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/gin/response"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/lang/conv"
+	"github.com/crazyfrankie/zrpc-todolist/protocol/task"
+)
+
+// AddChecklistItem godoc
+// @Summary Add a checklist item
+// @Description Append a checklist item to a task, a task holds at most 100 items
+// @Tags Checklist
+// @Accept json
+// @Produce json
+// @Param request body model.AddChecklistItemReq true "Add checklist item request"
+// @Success 200 {object} response.Response{data=task.ChecklistItem} "Checklist item added successfully"
+// @Failure 400 {object} response.Response "Invalid parameters"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Router /tasks/checklist/add [post]
+func (t *TaskHandler) AddChecklistItem() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req model.AddChecklistItemReq
+		if err := c.ShouldBind(&req); err != nil {
+			response.InvalidParamError(c, err.Error())
+			return
+		}
+
+		res, err := t.taskClient.AddChecklistItem(c.Request.Context(), &task.AddChecklistItemRequest{
+			TaskID:  req.TaskID,
+			Content: req.Content,
+		})
+		if err != nil {
+			response.InternalServerError(c, err)
+			return
+		}
+
+		response.Success(c, res.GetData())
+	}
+}
+
+// UpdateChecklistItem godoc
+// @Summary Update checklist item
+// @Description Edit and/or check a checklist item, checking the last open item may complete the task
+// @Tags Checklist
+// @Accept json
+// @Produce json
+// @Param id path string true "Checklist item ID"
+// @Param request body model.UpdateChecklistItemReq true "Update checklist item request"
+// @Success 200 {object} response.Response{data=task.ChecklistItem} "Checklist item updated successfully"
+// @Failure 400 {object} response.Response "Invalid parameters"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Router /tasks/checklist/update/{id} [put]
+func (t *TaskHandler) UpdateChecklistItem() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req model.UpdateChecklistItemReq
+		if err := c.ShouldBind(&req); err != nil {
+			response.InvalidParamError(c, err.Error())
+			return
+		}
+
+		itemID, err := conv.StrToInt64(c.Param("id"))
+		if err != nil {
+			response.InvalidParamError(c, "invalid checklist item id")
+			return
+		}
+
+		res, err := t.taskClient.UpdateChecklistItem(c.Request.Context(), &task.UpdateChecklistItemRequest{
+			ItemID:  itemID,
+			Content: req.Content,
+			Checked: req.Checked,
+		})
+		if err != nil {
+			response.InternalServerError(c, err)
+			return
+		}
+
+		response.Success(c, res.GetData())
+	}
+}
+
+// DeleteChecklistItem godoc
+// @Summary Delete checklist item
+// @Description Delete a checklist item of a task
+// @Tags Checklist
+// @Produce json
+// @Param id path string true "Checklist item ID"
+// @Success 200 {object} response.Response "Checklist item deleted successfully"
+// @Failure 400 {object} response.Response "Invalid parameters"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Router /tasks/checklist/delete/{id} [delete]
+func (t *TaskHandler) DeleteChecklistItem() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		itemID, err := conv.StrToInt64(c.Param("id"))
+		if err != nil {
+			response.InvalidParamError(c, "invalid checklist item id")
+			return
+		}
+
+		_, err = t.taskClient.DeleteChecklistItem(c.Request.Context(), &task.DeleteChecklistItemRequest{
+			ItemID: itemID,
+		})
+		if err != nil {
+			response.InternalServerError(c, err)
+			return
+		}
+
+		response.Success(c, nil)
+	}
+}
@@ -0,0 +1,155 @@
+package handler
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/crazyfrankie/zrpc-todolist/interfaces/task/model"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/gin/response"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/lang/conv"
+	"github.com/crazyfrankie/zrpc-todolist/protocol/task"
+)
+
+// AddComment godoc
+// @Summary Add a comment
+// @Description Append a comment to the discussion thread of a task
+// @Tags Comment
+// @Accept json
+// @Produce json
+// @Param request body model.AddCommentReq true "Add comment request"
+// @Success 200 {object} response.Response{data=task.Comment} "Comment added successfully"
+// @Failure 400 {object} response.Response "Invalid parameters"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Router /tasks/comment/add [post]
+func (t *TaskHandler) AddComment() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req model.AddCommentReq
+		if err := c.ShouldBind(&req); err != nil {
+			response.InvalidParamError(c, err.Error())
+			return
+		}
+
+		res, err := t.taskClient.AddComment(c.Request.Context(), &task.AddCommentRequest{
+			TaskID:  req.TaskID,
+			Content: req.Content,
+		})
+		if err != nil {
+			response.InternalServerError(c, err)
+			return
+		}
+
+		response.Success(c, res.GetData())
+	}
+}
+
+// UpdateComment godoc
+// @Summary Update comment
+// @Description Edit the content of a comment
+// @Tags Comment
+// @Accept json
+// @Produce json
+// @Param id path string true "Comment ID"
+// @Param request body model.UpdateCommentReq true "Update comment request"
+// @Success 200 {object} response.Response{data=task.Comment} "Comment updated successfully"
+// @Failure 400 {object} response.Response "Invalid parameters"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Router /tasks/comment/update/{id} [put]
+func (t *TaskHandler) UpdateComment() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req model.UpdateCommentReq
+		if err := c.ShouldBind(&req); err != nil {
+			response.InvalidParamError(c, err.Error())
+			return
+		}
+
+		commentID, err := conv.StrToInt64(c.Param("id"))
+		if err != nil {
+			response.InvalidParamError(c, "invalid comment id")
+			return
+		}
+
+		res, err := t.taskClient.UpdateComment(c.Request.Context(), &task.UpdateCommentRequest{
+			CommentID: commentID,
+			Content:   req.Content,
+		})
+		if err != nil {
+			response.InternalServerError(c, err)
+			return
+		}
+
+		response.Success(c, res.GetData())
+	}
+}
+
+// DeleteComment godoc
+// @Summary Delete comment
+// @Description Delete a comment of a task
+// @Tags Comment
+// @Produce json
+// @Param id path string true "Comment ID"
+// @Success 200 {object} response.Response "Comment deleted successfully"
+// @Failure 400 {object} response.Response "Invalid parameters"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Router /tasks/comment/delete/{id} [delete]
+func (t *TaskHandler) DeleteComment() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		commentID, err := conv.StrToInt64(c.Param("id"))
+		if err != nil {
+			response.InvalidParamError(c, "invalid comment id")
+			return
+		}
+
+		_, err = t.taskClient.DeleteComment(c.Request.Context(), &task.DeleteCommentRequest{
+			CommentID: commentID,
+		})
+		if err != nil {
+			response.InternalServerError(c, err)
+			return
+		}
+
+		response.Success(c, nil)
+	}
+}
+
+// ListComments godoc
+// @Summary List comments
+// @Description List the comments on a task oldest first
+// @Tags Comment
+// @Produce json
+// @Param id path string true "Task ID"
+// @Param cursor query string false "Cursor returned by the previous page"
+// @Param page_size query int false "Page size"
+// @Success 200 {object} response.Response{data=model.ListCommentsResp} "Comments retrieved successfully"
+// @Failure 400 {object} response.Response "Invalid parameters"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Router /tasks/comment/list/{id} [get]
+func (t *TaskHandler) ListComments() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req model.ListCommentsReq
+		if err := c.ShouldBindQuery(&req); err != nil {
+			response.InvalidParamError(c, err.Error())
+			return
+		}
+
+		taskID, err := conv.StrToInt64(c.Param("id"))
+		if err != nil {
+			response.InvalidParamError(c, "invalid task id")
+			return
+		}
+
+		res, err := t.taskClient.ListComments(c.Request.Context(), &task.ListCommentsRequest{
+			TaskID:   taskID,
+			Cursor:   req.Cursor,
+			PageSize: req.PageSize,
+		})
+		if err != nil {
+			response.InternalServerError(c, err)
+			return
+		}
+
+		response.Success(c, &model.ListCommentsResp{
+			Comments:   res.GetData(),
+			NextCursor: res.GetNextCursor(),
+			HasMore:    res.GetHasMore(),
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package handler
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/crazyfrankie/zrpc-todolist/interfaces/task/model"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/gin/response"
+	"github.com/crazyfrankie/zrpc-todolist/protocol/task"
+)
+
+// AddDependency godoc
+// @Summary Add a task dependency
+// @Description Make a task block another one of the same user, the blocked task can't be done until its blockers are. Dependencies creating a cycle are rejected
+// @Tags Dependency
+// @Accept json
+// @Produce json
+// @Param request body model.DependencyReq true "Add dependency request"
+// @Success 200 {object} response.Response "Dependency added successfully"
+// @Failure 400 {object} response.Response "Invalid parameters"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Router /tasks/dependency/add [post]
+func (t *TaskHandler) AddDependency() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req model.DependencyReq
+		if err := c.ShouldBind(&req); err != nil {
+			response.InvalidParamError(c, err.Error())
+			return
+		}
+
+		_, err := t.taskClient.AddDependency(c.Request.Context(), &task.AddDependencyRequest{
+			TaskID:    req.TaskID,
+			BlockerID: req.BlockerID,
+		})
+		if err != nil {
+			response.InternalServerError(c, err)
+			return
+		}
+
+		response.Success(c, nil)
+	}
+}
+
+// RemoveDependency godoc
+// @Summary Remove a task dependency
+// @Description Stop a task from blocking another one
+// @Tags Dependency
+// @Produce json
+// @Param task_id query int true "Blocked task ID"
+// @Param blocker_id query int true "Blocking task ID"
+// @Success 200 {object} response.Response "Dependency removed successfully"
+// @Failure 400 {object} response.Response "Invalid parameters"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Router /tasks/dependency/remove [delete]
+func (t *TaskHandler) RemoveDependency() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req model.DependencyReq
+		if err := c.ShouldBindQuery(&req); err != nil {
+			response.InvalidParamError(c, err.Error())
+			return
+		}
+
+		_, err := t.taskClient.RemoveDependency(c.Request.Context(), &task.RemoveDependencyRequest{
+			TaskID:    req.TaskID,
+			BlockerID: req.BlockerID,
+		})
+		if err != nil {
+			response.InternalServerError(c, err)
+			return
+		}
+
+		response.Success(c, nil)
+	}
+}
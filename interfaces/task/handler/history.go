@@ -0,0 +1,93 @@
+package handler
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/crazyfrankie/zrpc-todolist/interfaces/task/model"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/gin/response"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/lang/conv"
+	"github.com/crazyfrankie/zrpc-todolist/protocol/task"
+)
+
+// GetTaskHistory godoc
+// @Summary Get task history
+// @Description Get the activity log of a task newest first, each entry lists the changed fields with their old and new values
+// @Tags Task
+// @Produce json
+// @Param id path string true "Task ID"
+// @Param cursor query string false "Cursor returned by the previous page"
+// @Param page_size query int false "Page size"
+// @Success 200 {object} response.Response{data=model.TaskHistoryResp} "Task history retrieved successfully"
+// @Failure 400 {object} response.Response "Invalid parameters"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Router /tasks/history/{id} [get]
+func (t *TaskHandler) GetTaskHistory() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req model.TaskHistoryReq
+		if err := c.ShouldBindQuery(&req); err != nil {
+			response.InvalidParamError(c, err.Error())
+			return
+		}
+
+		taskID, err := conv.StrToInt64(c.Param("id"))
+		if err != nil {
+			response.InvalidParamError(c, "invalid task id")
+			return
+		}
+
+		res, err := t.taskClient.GetTaskHistory(c.Request.Context(), &task.GetTaskHistoryRequest{
+			TaskID:   taskID,
+			Cursor:   req.Cursor,
+			PageSize: req.PageSize,
+		})
+		if err != nil {
+			response.InternalServerError(c, err)
+			return
+		}
+
+		response.Success(c, &model.TaskHistoryResp{
+			Activities: res.GetData(),
+			NextCursor: res.GetNextCursor(),
+			HasMore:    res.GetHasMore(),
+		})
+	}
+}
+
+// RevertTask godoc
+// @Summary Revert task
+// @Description Bring the fields of a task back to a revision from its history, the recurrence is not reverted
+// @Tags Task
+// @Accept json
+// @Produce json
+// @Param id path string true "Task ID"
+// @Param request body model.RevertTaskReq true "Revert task request"
+// @Success 200 {object} response.Response{data=task.Task} "Task reverted successfully"
+// @Failure 400 {object} response.Response "Invalid parameters"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Router /tasks/revert/{id} [put]
+func (t *TaskHandler) RevertTask() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req model.RevertTaskReq
+		if err := c.ShouldBind(&req); err != nil {
+			response.InvalidParamError(c, err.Error())
+			return
+		}
+
+		taskID, err := conv.StrToInt64(c.Param("id"))
+		if err != nil {
+			response.InvalidParamError(c, "invalid task id")
+			return
+		}
+
+		res, err := t.taskClient.RevertTask(c.Request.Context(), &task.RevertTaskRequest{
+			TaskID:  taskID,
+			Version: *req.Version,
+		})
+		if err != nil {
+			response.InternalServerError(c, err)
+			return
+		}
+
+		response.Success(c, res.GetData())
+	}
+}
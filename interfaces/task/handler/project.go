@@ -0,0 +1,217 @@
+package handler
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/crazyfrankie/zrpc-todolist/interfaces/task/model"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/gin/response"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/lang/conv"
+	"github.com/crazyfrankie/zrpc-todolist/protocol/task"
+)
+
+// CreateProject godoc
+// @Summary Create a project
+// @Description Create a project for current user, it is placed after the existing ones
+// @Tags Project
+// @Accept json
+// @Produce json
+// @Param request body model.CreateProjectReq true "Create project request"
+// @Success 200 {object} response.Response{data=task.Project} "Project created successfully"
+// @Failure 400 {object} response.Response "Invalid parameters"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Router /tasks/project/create [post]
+func (t *TaskHandler) CreateProject() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req model.CreateProjectReq
+		if err := c.ShouldBind(&req); err != nil {
+			response.InvalidParamError(c, err.Error())
+			return
+		}
+
+		res, err := t.taskClient.CreateProject(c.Request.Context(), &task.CreateProjectRequest{
+			Name: req.Name,
+		})
+		if err != nil {
+			response.InternalServerError(c, err)
+			return
+		}
+
+		response.Success(c, res.GetData())
+	}
+}
+
+// ListProjects godoc
+// @Summary Get project list
+// @Description Get the projects of current user in display order, starting with the inbox
+// @Tags Project
+// @Produce json
+// @Param include_archived query bool false "Include archived projects"
+// @Success 200 {object} response.Response{data=[]task.Project} "Project list retrieved successfully"
+// @Failure 400 {object} response.Response "Invalid parameters"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Router /tasks/project/list [get]
+func (t *TaskHandler) ListProjects() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req model.ListProjectReq
+		if err := c.ShouldBindQuery(&req); err != nil {
+			response.InvalidParamError(c, err.Error())
+			return
+		}
+
+		res, err := t.taskClient.ListProjects(c.Request.Context(), &task.ListProjectsRequest{
+			IncludeArchived: req.IncludeArchived,
+		})
+		if err != nil {
+			response.InternalServerError(c, err)
+			return
+		}
+
+		response.Success(c, res.GetData())
+	}
+}
+
+// RenameProject godoc
+// @Summary Rename project
+// @Description Rename a project
+// @Tags Project
+// @Accept json
+// @Produce json
+// @Param id path string true "Project ID"
+// @Param request body model.RenameProjectReq true "Rename project request"
+// @Success 200 {object} response.Response{data=task.Project} "Project renamed successfully"
+// @Failure 400 {object} response.Response "Invalid parameters"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Router /tasks/project/rename/{id} [put]
+func (t *TaskHandler) RenameProject() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req model.RenameProjectReq
+		if err := c.ShouldBind(&req); err != nil {
+			response.InvalidParamError(c, err.Error())
+			return
+		}
+
+		projectID, err := conv.StrToInt64(c.Param("id"))
+		if err != nil {
+			response.InvalidParamError(c, "invalid project id")
+			return
+		}
+
+		res, err := t.taskClient.RenameProject(c.Request.Context(), &task.RenameProjectRequest{
+			ProjectID: projectID,
+			Name:      req.Name,
+		})
+		if err != nil {
+			response.InternalServerError(c, err)
+			return
+		}
+
+		response.Success(c, res.GetData())
+	}
+}
+
+// ArchiveProject godoc
+// @Summary Archive project
+// @Description Archive or unarchive a project, archived projects take no new tasks
+// @Tags Project
+// @Produce json
+// @Param id path string true "Project ID"
+// @Param archived query bool true "Archive or unarchive"
+// @Success 200 {object} response.Response "Project archived successfully"
+// @Failure 400 {object} response.Response "Invalid parameters"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Router /tasks/project/archive/{id} [put]
+func (t *TaskHandler) ArchiveProject() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req model.ArchiveProjectReq
+		if err := c.ShouldBindQuery(&req); err != nil {
+			response.InvalidParamError(c, err.Error())
+			return
+		}
+
+		projectID, err := conv.StrToInt64(c.Param("id"))
+		if err != nil {
+			response.InvalidParamError(c, "invalid project id")
+			return
+		}
+
+		_, err = t.taskClient.ArchiveProject(c.Request.Context(), &task.ArchiveProjectRequest{
+			ProjectID: projectID,
+			Archived:  *req.Archived,
+		})
+		if err != nil {
+			response.InternalServerError(c, err)
+			return
+		}
+
+		response.Success(c, nil)
+	}
+}
+
+// ReorderProjects godoc
+// @Summary Reorder projects
+// @Description Put the given projects in the given order, the others keep their places
+// @Tags Project
+// @Accept json
+// @Produce json
+// @Param request body model.ReorderProjectsReq true "Reorder projects request"
+// @Success 200 {object} response.Response "Projects reordered successfully"
+// @Failure 400 {object} response.Response "Invalid parameters"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Router /tasks/project/reorder [put]
+func (t *TaskHandler) ReorderProjects() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req model.ReorderProjectsReq
+		if err := c.ShouldBind(&req); err != nil {
+			response.InvalidParamError(c, err.Error())
+			return
+		}
+
+		_, err := t.taskClient.ReorderProjects(c.Request.Context(), &task.ReorderProjectsRequest{
+			ProjectIds: req.ProjectIDs,
+		})
+		if err != nil {
+			response.InternalServerError(c, err)
+			return
+		}
+
+		response.Success(c, nil)
+	}
+}
+
+// DeleteProject godoc
+// @Summary Delete project
+// @Description Delete a project, its tasks move to another project or to the recycle bin
+// @Tags Project
+// @Produce json
+// @Param id path string true "Project ID"
+// @Param move_to query int false "Project taking the tasks, absent moves them to the recycle bin"
+// @Success 200 {object} response.Response "Project deleted successfully"
+// @Failure 400 {object} response.Response "Invalid parameters"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Router /tasks/project/delete/{id} [delete]
+func (t *TaskHandler) DeleteProject() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req model.DeleteProjectReq
+		if err := c.ShouldBindQuery(&req); err != nil {
+			response.InvalidParamError(c, err.Error())
+			return
+		}
+
+		projectID, err := conv.StrToInt64(c.Param("id"))
+		if err != nil {
+			response.InvalidParamError(c, "invalid project id")
+			return
+		}
+
+		_, err = t.taskClient.DeleteProject(c.Request.Context(), &task.DeleteProjectRequest{
+			ProjectID:       projectID,
+			MoveToProjectId: req.MoveTo,
+		})
+		if err != nil {
+			response.InternalServerError(c, err)
+			return
+		}
+
+		response.Success(c, nil)
+	}
+}
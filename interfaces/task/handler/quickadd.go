@@ -0,0 +1,42 @@
+package handler
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/crazyfrankie/zrpc-todolist/interfaces/task/model"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/gin/response"
+	"github.com/crazyfrankie/zrpc-todolist/protocol/task"
+)
+
+// QuickAddTask godoc
+// @Summary Quick add a task
+// @Description Create a task from one line of text such as "Pay rent tomorrow 9am #home !high every month". Due dates and times, tags, priority and recurrence are taken out of the title, missing tags are created, and the recognized phrases are returned with their rune offsets for highlighting.
+// @Tags Task
+// @Accept json
+// @Produce json
+// @Param request body model.QuickAddTaskReq true "Quick add request"
+// @Success 200 {object} response.Response{data=task.QuickAddTaskResponse} "Task created successfully"
+// @Failure 400 {object} response.Response "Invalid parameters"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Router /tasks/quick-add [post]
+func (t *TaskHandler) QuickAddTask() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req model.QuickAddTaskReq
+		if err := c.ShouldBindJSON(&req); err != nil {
+			response.InvalidParamError(c, err.Error())
+			return
+		}
+
+		res, err := t.taskClient.QuickAddTask(c.Request.Context(), &task.QuickAddTaskRequest{
+			Text:      req.Text,
+			TimeZone:  req.TimeZone,
+			ProjectId: req.ProjectID,
+		})
+		if err != nil {
+			response.InternalServerError(c, err)
+			return
+		}
+
+		response.Success(c, res)
+	}
+}
@@ -0,0 +1,275 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/crazyfrankie/zrpc/metadata"
+	"github.com/gin-gonic/gin"
+	"golang.org/x/net/websocket"
+
+	"github.com/crazyfrankie/zrpc-todolist/interfaces/task/model"
+	"github.com/crazyfrankie/zrpc-todolist/interfaces/task/stream"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/errorx"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/gin/response"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/lang/conv"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/logs"
+	"github.com/crazyfrankie/zrpc-todolist/protocol/task"
+)
+
+const (
+	replayPageSize = 500
+	// maxReplayPages bounds the events replayed on resume, a client further
+	// behind is sent a reset instead.
+	maxReplayPages = 4
+	resetEvent     = "reset"
+)
+
+// StreamTasks godoc
+// @Summary Stream task events
+// @Description Server-Sent Events of the changes to the tasks of the user: created, updated, status_changed and deleted, the data holds the event id, type and task_id. Comments are sent as heartbeats. Reconnecting with Last-Event-ID replays the missed events as updated or deleted, a reset event asks for a full sync when too many were missed. A user holds a limited number of streams at once.
+// @Tags Stream
+// @Produce text/event-stream
+// @Param Last-Event-ID header int false "Id of the last event received"
+// @Param last_event_id query int false "Same as Last-Event-ID, for clients which can't set it"
+// @Param access_token query string false "Access token for clients which can't set the Authorization header"
+// @Success 200 {object} model.TaskEvent "Event stream"
+// @Failure 400 {object} response.Response "Invalid parameters"
+// @Failure 429 {object} response.Response "Too many open streams"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Router /tasks/stream [get]
+func (t *TaskHandler) StreamTasks() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		lastEventID, err := parseLastEventID(c)
+		if err != nil {
+			response.InvalidParamError(c, err.Error())
+			return
+		}
+		conn, ok := t.joinStream(c)
+		if !ok {
+			return
+		}
+		defer t.streamHub.Leave(conn)
+
+		c.Header("Content-Type", "text/event-stream")
+		c.Header("Cache-Control", "no-cache")
+		c.Header("Connection", "keep-alive")
+		c.Header("X-Accel-Buffering", "no")
+		c.Writer.WriteHeaderNow()
+		c.Writer.Flush()
+
+		t.serveStream(c.Request.Context(), conn, lastEventID, &sseSink{c: c})
+	}
+}
+
+// StreamTasksWS godoc
+// @Summary Stream task events over WebSocket
+// @Description The events of GET /tasks/stream as JSON text messages over a WebSocket, heartbeats are ping frames. Browsers pass the access token in the access_token query parameter and resume with last_event_id.
+// @Tags Stream
+// @Param last_event_id query int false "Id of the last event received"
+// @Param access_token query string false "Access token for clients which can't set the Authorization header"
+// @Success 101 {object} model.TaskEvent "Switching protocols"
+// @Failure 400 {object} response.Response "Invalid parameters"
+// @Failure 429 {object} response.Response "Too many open streams"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Router /tasks/stream/ws [get]
+func (t *TaskHandler) StreamTasksWS() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		lastEventID, err := parseLastEventID(c)
+		if err != nil {
+			response.InvalidParamError(c, err.Error())
+			return
+		}
+		conn, ok := t.joinStream(c)
+		if !ok {
+			return
+		}
+		defer t.streamHub.Leave(conn)
+
+		// the token authenticates the client, so any origin may connect
+		srv := websocket.Server{Handler: func(ws *websocket.Conn) {
+			ctx, cancel := context.WithCancel(c.Request.Context())
+			defer cancel()
+			// clients don't talk, reading only notices when they leave
+			go func() {
+				var msg []byte
+				for websocket.Message.Receive(ws, &msg) == nil {
+				}
+				cancel()
+			}()
+
+			t.serveStream(ctx, conn, lastEventID, &wsSink{ws: ws})
+		}}
+		srv.ServeHTTP(c.Writer, c.Request)
+	}
+}
+
+// eventSink writes the events of a stream to its transport.
+type eventSink interface {
+	send(event *model.TaskEvent) error
+	heartbeat() error
+}
+
+// serveStream replays the events after lastEventID, then forwards the live
+// events of the connection until the client leaves or falls behind.
+func (t *TaskHandler) serveStream(ctx context.Context, conn *stream.Conn, lastEventID int64, sink eventSink) {
+	replayed, err := t.replayEvents(ctx, lastEventID, sink)
+	if err != nil {
+		logs.CtxWarnf(ctx, "replay task events failed, lastEventID=%d, err=%v", lastEventID, err)
+		return
+	}
+
+	ticker := time.NewTicker(t.streamHub.Heartbeat())
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-conn.Dropped():
+			return
+		case event := <-conn.Events():
+			// sent by the replay already
+			if event.ID <= replayed {
+				continue
+			}
+			if err := sink.send(event); err != nil {
+				return
+			}
+		case <-ticker.C:
+			if err := sink.heartbeat(); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// replayEvents sends the events after afterID and returns the id of the last
+// one, the live events up to it are covered.
+func (t *TaskHandler) replayEvents(ctx context.Context, afterID int64, sink eventSink) (int64, error) {
+	if afterID <= 0 {
+		return 0, nil
+	}
+
+	for range maxReplayPages {
+		res, err := t.taskClient.ListTaskEvents(ctx, &task.ListTaskEventsRequest{
+			AfterId: afterID,
+			Limit:   replayPageSize,
+		})
+		if err != nil {
+			return 0, err
+		}
+		for _, event := range res.GetData() {
+			if err := sink.send(taskEventDTO2VO(event)); err != nil {
+				return 0, err
+			}
+			afterID = event.GetId()
+		}
+		if !res.GetHasMore() {
+			return afterID, nil
+		}
+	}
+
+	return afterID, sink.send(&model.TaskEvent{Type: resetEvent})
+}
+
+// joinStream registers a stream of the user, it writes the error response if
+// the user can't open another one.
+func (t *TaskHandler) joinStream(c *gin.Context) (*stream.Conn, bool) {
+	md, _ := metadata.FromOutgoingContext(c.Request.Context())
+	var userID int64
+	if vals := md.Get("user_id"); len(vals) > 0 {
+		userID, _ = conv.StrToInt64(vals[0])
+	}
+
+	conn, err := t.streamHub.Join(c.Request.Context(), userID)
+	if err != nil {
+		var statusErr errorx.StatusError
+		if errors.As(err, &statusErr) {
+			response.TooManyRequests(c, statusErr.Code(), statusErr.Msg())
+		} else {
+			response.InternalServerError(c, err)
+		}
+		return nil, false
+	}
+
+	return conn, true
+}
+
+func parseLastEventID(c *gin.Context) (int64, error) {
+	if header := c.GetHeader("Last-Event-ID"); header != "" {
+		id, err := conv.StrToInt64(header)
+		if err != nil {
+			return 0, errors.New("invalid Last-Event-ID")
+		}
+		return id, nil
+	}
+
+	var req model.StreamTasksReq
+	if err := c.ShouldBindQuery(&req); err != nil {
+		return 0, err
+	}
+
+	return req.LastEventID, nil
+}
+
+type sseSink struct {
+	c *gin.Context
+}
+
+func (s *sseSink) send(event *model.TaskEvent) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	var b strings.Builder
+	if event.ID > 0 {
+		fmt.Fprintf(&b, "id: %d\n", event.ID)
+	}
+	fmt.Fprintf(&b, "event: %s\ndata: %s\n\n", event.Type, data)
+
+	return s.write(b.String())
+}
+
+func (s *sseSink) heartbeat() error {
+	return s.write(": heartbeat\n\n")
+}
+
+func (s *sseSink) write(msg string) error {
+	if _, err := s.c.Writer.WriteString(msg); err != nil {
+		return err
+	}
+	s.c.Writer.Flush()
+
+	return nil
+}
+
+type wsSink struct {
+	ws *websocket.Conn
+}
+
+func (s *wsSink) send(event *model.TaskEvent) error {
+	return websocket.JSON.Send(s.ws, event)
+}
+
+func (s *wsSink) heartbeat() error {
+	// the client answers the ping, events go out as text frames
+	s.ws.PayloadType = websocket.PingFrame
+	defer func() { s.ws.PayloadType = websocket.TextFrame }()
+
+	_, err := s.ws.Write(nil)
+	return err
+}
+
+func taskEventDTO2VO(event *task.TaskEvent) *model.TaskEvent {
+	return &model.TaskEvent{
+		ID:     event.GetId(),
+		Type:   strings.ToLower(strings.TrimPrefix(event.GetType().String(), "TASK_EVENT_")),
+		TaskID: event.GetTaskID(),
+	}
+}
@@ -0,0 +1,235 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http/httptest"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/crazyfrankie/zrpc-todolist/infra/contract/cache"
+	"github.com/crazyfrankie/zrpc-todolist/infra/contract/pubsub"
+	"github.com/crazyfrankie/zrpc-todolist/interfaces/task/model"
+	"github.com/crazyfrankie/zrpc-todolist/interfaces/task/stream"
+	"github.com/crazyfrankie/zrpc-todolist/protocol/task"
+	"github.com/crazyfrankie/zrpc-todolist/types/consts"
+)
+
+// eventLog serves the stored events of the user like the task service.
+type eventLog struct {
+	task.TaskServiceClient
+
+	events []*task.TaskEvent
+	calls  int
+}
+
+func (l *eventLog) ListTaskEvents(_ context.Context, in *task.ListTaskEventsRequest) (*task.ListTaskEventsResponse, error) {
+	l.calls++
+	res := &task.ListTaskEventsResponse{}
+	for _, event := range l.events {
+		if event.GetId() <= in.GetAfterId() {
+			continue
+		}
+		if len(res.Data) == int(in.GetLimit()) {
+			res.HasMore = true
+			break
+		}
+		res.Data = append(res.Data, event)
+	}
+	return res, nil
+}
+
+func storedEvents(n int) []*task.TaskEvent {
+	events := make([]*task.TaskEvent, n)
+	for i := range events {
+		events[i] = &task.TaskEvent{Id: int64(i + 1), Type: task.TaskEventType_TASK_EVENT_UPDATED, TaskID: 100}
+	}
+	return events
+}
+
+// recordSink collects what a stream sends.
+type recordSink struct {
+	mu     sync.Mutex
+	events []*model.TaskEvent
+	sent   chan struct{}
+}
+
+func (s *recordSink) send(event *model.TaskEvent) error {
+	s.mu.Lock()
+	s.events = append(s.events, event)
+	s.mu.Unlock()
+	s.sent <- struct{}{}
+	return nil
+}
+
+func (s *recordSink) heartbeat() error {
+	return nil
+}
+
+func (s *recordSink) ids() []int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ids := make([]int64, len(s.events))
+	for i, event := range s.events {
+		ids[i] = event.ID
+	}
+	return ids
+}
+
+// livePubSub has a single subscription the test publishes to directly.
+type livePubSub struct {
+	sub *liveSubscription
+}
+
+func (p *livePubSub) Publish(context.Context, string, []byte) error { return nil }
+
+func (p *livePubSub) Subscribe(context.Context, ...string) (pubsub.Subscription, error) {
+	return p.sub, nil
+}
+
+type liveSubscription struct {
+	ch chan *pubsub.Message
+}
+
+func (s *liveSubscription) Subscribe(context.Context, ...string) error   { return nil }
+func (s *liveSubscription) Unsubscribe(context.Context, ...string) error { return nil }
+func (s *liveSubscription) Channel() <-chan *pubsub.Message              { return s.ch }
+func (s *liveSubscription) Close() error                                 { return nil }
+
+type noopCounter struct {
+	cache.Cmdable
+}
+
+func (noopCounter) Incr(context.Context, string) cache.IntCmd { return intCmd(1) }
+func (noopCounter) IncrBy(_ context.Context, _ string, v int64) cache.IntCmd {
+	return intCmd(v)
+}
+func (noopCounter) Expire(context.Context, string, time.Duration) cache.BoolCmd { return boolCmd(true) }
+
+type intCmd int64
+
+func (c intCmd) Err() error             { return nil }
+func (c intCmd) Result() (int64, error) { return int64(c), nil }
+
+type boolCmd bool
+
+func (c boolCmd) Err() error            { return nil }
+func (c boolCmd) Result() (bool, error) { return bool(c), nil }
+
+// runStream serves a stream resuming after lastEventID, the live events are
+// queued on the connection first. It stops once want events were sent.
+func runStream(t *testing.T, log *eventLog, lastEventID int64, live []int64, want int) *recordSink {
+	t.Helper()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	sub := &liveSubscription{ch: make(chan *pubsub.Message, len(live))}
+	hub, err := stream.NewHub(ctx, &livePubSub{sub: sub}, noopCounter{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	conn, err := hub.Join(ctx, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, id := range live {
+		payload, _ := json.Marshal(&model.TaskEvent{ID: id, Type: "updated", TaskID: 100})
+		sub.ch <- &pubsub.Message{Channel: consts.TaskEventChannelPrefix + "1", Payload: payload}
+	}
+	for deadline := time.Now().Add(time.Second); len(conn.Events()) < len(live); {
+		if time.Now().After(deadline) {
+			t.Fatal("live events not dispatched")
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	sink := &recordSink{sent: make(chan struct{}, want+1)}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		(&TaskHandler{taskClient: log, streamHub: hub}).serveStream(ctx, conn, lastEventID, sink)
+	}()
+	for range want {
+		select {
+		case <-sink.sent:
+		case <-time.After(time.Second):
+			t.Fatalf("stream sent %v, want %d events", sink.ids(), want)
+		}
+	}
+	cancel()
+	<-done
+
+	return sink
+}
+
+func TestServeStreamReplay(t *testing.T) {
+	t.Run("resume skips the live events already replayed", func(t *testing.T) {
+		log := &eventLog{events: storedEvents(5)}
+		sink := runStream(t, log, 3, []int64{5, 6}, 3)
+		if got := sink.ids(); !slices.Equal(got, []int64{4, 5, 6}) {
+			t.Errorf("sent %v, want [4 5 6]", got)
+		}
+		if sink.events[0].Type != "updated" || sink.events[0].TaskID != 100 {
+			t.Errorf("replayed event = %+v", sink.events[0])
+		}
+	})
+
+	t.Run("new streams only get live events", func(t *testing.T) {
+		log := &eventLog{events: storedEvents(5)}
+		sink := runStream(t, log, 0, []int64{6}, 1)
+		if got := sink.ids(); !slices.Equal(got, []int64{6}) {
+			t.Errorf("sent %v, want [6]", got)
+		}
+		if log.calls != 0 {
+			t.Errorf("listed events %d times, want none", log.calls)
+		}
+	})
+
+	t.Run("too far behind asks for a reset", func(t *testing.T) {
+		log := &eventLog{events: storedEvents(replayPageSize*maxReplayPages + 10)}
+		sink := runStream(t, log, 1, nil, replayPageSize*maxReplayPages+1)
+		last := sink.events[len(sink.events)-1]
+		if last.Type != resetEvent {
+			t.Errorf("last event = %+v, want a reset", last)
+		}
+		if log.calls != maxReplayPages {
+			t.Errorf("listed %d pages, want %d", log.calls, maxReplayPages)
+		}
+	})
+}
+
+func TestParseLastEventID(t *testing.T) {
+	tests := []struct {
+		name    string
+		header  string
+		query   string
+		want    int64
+		wantErr bool
+	}{
+		{name: "none"},
+		{name: "header", header: "42", want: 42},
+		{name: "query", query: "?last_event_id=7", want: 7},
+		{name: "header wins", header: "42", query: "?last_event_id=7", want: 42},
+		{name: "invalid header", header: "abc", wantErr: true},
+		{name: "invalid query", query: "?last_event_id=abc", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, _ := gin.CreateTestContext(httptest.NewRecorder())
+			c.Request = httptest.NewRequest("GET", "/api/task/stream"+tt.query, nil)
+			if tt.header != "" {
+				c.Request.Header.Set("Last-Event-ID", tt.header)
+			}
+
+			got, err := parseLastEventID(c)
+			if (err != nil) != tt.wantErr || got != tt.want {
+				t.Errorf("parseLastEventID = %d, %v, want %d, error %v", got, err, tt.want, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package handler
+
+import (
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/crazyfrankie/zrpc-todolist/interfaces/task/model"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/gin/response"
+	langslice "github.com/crazyfrankie/zrpc-todolist/pkg/lang/slice"
+	"github.com/crazyfrankie/zrpc-todolist/protocol/task"
+)
+
+// SyncTasks godoc
+// @Summary Sync task changes
+// @Description Get the current state of the tasks changed after a sync token, tasks moved to the recycle bin or purged come as deleted tombstones. An empty token starts with a snapshot of the live tasks. Keep calling with next_token while has_more is set, then keep the token for the next sync.
+// @Tags Sync
+// @Produce json
+// @Param since_token query string false "Next token of the previous sync"
+// @Param page_size query int false "Changes per page, 100 by default and at most 500"
+// @Success 200 {object} response.Response{data=task.SyncTasksResponse} "Changes retrieved successfully"
+// @Failure 400 {object} response.Response "Invalid parameters"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Router /tasks/sync [get]
+func (t *TaskHandler) SyncTasks() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req model.SyncTasksReq
+		if err := c.ShouldBindQuery(&req); err != nil {
+			response.InvalidParamError(c, err.Error())
+			return
+		}
+
+		res, err := t.taskClient.SyncTasks(c.Request.Context(), &task.SyncTasksRequest{
+			SinceToken: req.SinceToken,
+			PageSize:   req.PageSize,
+		})
+		if err != nil {
+			response.InternalServerError(c, err)
+			return
+		}
+
+		response.Success(c, res)
+	}
+}
+
+// PushTaskChanges godoc
+// @Summary Push offline task changes
+// @Description Apply up to 100 changes made by a client while offline, one by one in order. Updates and deletes only apply while the task is still at their base version, a conflicting change is reported with the version conflict code and the current task.
+// @Tags Sync
+// @Accept json
+// @Produce json
+// @Param request body model.PushTaskChangesReq true "Push task changes request"
+// @Success 200 {object} response.Response{data=[]task.BatchResult} "Changes applied, see the results"
+// @Failure 400 {object} response.Response "Invalid parameters"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Router /tasks/sync/push [post]
+func (t *TaskHandler) PushTaskChanges() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req model.PushTaskChangesReq
+		if err := c.ShouldBindJSON(&req); err != nil {
+			response.InvalidParamError(c, err.Error())
+			return
+		}
+
+		res, err := t.taskClient.PushTaskChanges(c.Request.Context(), &task.PushTaskChangesRequest{
+			Changes: langslice.Transform(req.Changes, clientChangeVO2DTO),
+		})
+		if err != nil {
+			response.InternalServerError(c, err)
+			return
+		}
+
+		response.Success(c, res.GetData())
+	}
+}
+
+func clientChangeVO2DTO(change *model.ClientTaskChangeReq) *task.ClientTaskChange {
+	res := &task.ClientTaskChange{
+		Op:          task.ClientChangeOp(task.ClientChangeOp_value["CLIENT_CHANGE_OP_"+strings.ToUpper(change.Op)]),
+		TaskID:      change.TaskID,
+		BaseVersion: change.BaseVersion,
+	}
+	if change.Create != nil {
+		res.Create = createTaskVO2DTO(change.Create)
+	}
+	if change.Update != nil {
+		res.Update = updateTaskVO2DTO(change.Update)
+	}
+	if change.Status != nil {
+		res.Status = statusVO2DTO(*change.Status).Enum()
+	}
+
+	return res
+}
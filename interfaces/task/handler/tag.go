@@ -0,0 +1,132 @@
+package handler
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/crazyfrankie/zrpc-todolist/interfaces/task/model"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/gin/response"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/lang/conv"
+	"github.com/crazyfrankie/zrpc-todolist/protocol/task"
+)
+
+// CreateTag godoc
+// @Summary Create a tag
+// @Description Create a tag for current user, tag names are unique per user
+// @Tags Tag
+// @Accept json
+// @Produce json
+// @Param request body model.CreateTagReq true "Create tag request"
+// @Success 200 {object} response.Response{data=task.Tag} "Tag created successfully"
+// @Failure 400 {object} response.Response "Invalid parameters"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Router /tasks/tag/create [post]
+func (t *TaskHandler) CreateTag() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req model.CreateTagReq
+		if err := c.ShouldBind(&req); err != nil {
+			response.InvalidParamError(c, err.Error())
+			return
+		}
+
+		res, err := t.taskClient.CreateTag(c.Request.Context(), &task.CreateTagRequest{
+			Name:  req.Name,
+			Color: req.Color,
+		})
+		if err != nil {
+			response.InternalServerError(c, err)
+			return
+		}
+
+		response.Success(c, res.GetData())
+	}
+}
+
+// ListTags godoc
+// @Summary Get tag list
+// @Description Get the tags of current user with the number of live tasks carrying them
+// @Tags Tag
+// @Produce json
+// @Success 200 {object} response.Response{data=[]task.Tag} "Tag list retrieved successfully"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Router /tasks/tag/list [get]
+func (t *TaskHandler) ListTags() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		res, err := t.taskClient.ListTags(c.Request.Context(), &task.ListTagsRequest{})
+		if err != nil {
+			response.InternalServerError(c, err)
+			return
+		}
+
+		response.Success(c, res.GetData())
+	}
+}
+
+// UpdateTag godoc
+// @Summary Update tag
+// @Description Rename and/or recolor a tag
+// @Tags Tag
+// @Accept json
+// @Produce json
+// @Param id path string true "Tag ID"
+// @Param request body model.UpdateTagReq true "Update tag request"
+// @Success 200 {object} response.Response{data=task.Tag} "Tag updated successfully"
+// @Failure 400 {object} response.Response "Invalid parameters"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Router /tasks/tag/update/{id} [put]
+func (t *TaskHandler) UpdateTag() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req model.UpdateTagReq
+		if err := c.ShouldBind(&req); err != nil {
+			response.InvalidParamError(c, err.Error())
+			return
+		}
+
+		tagID, err := conv.StrToInt64(c.Param("id"))
+		if err != nil {
+			response.InvalidParamError(c, "invalid tag id")
+			return
+		}
+
+		res, err := t.taskClient.UpdateTag(c.Request.Context(), &task.UpdateTagRequest{
+			TagID: tagID,
+			Name:  req.Name,
+			Color: req.Color,
+		})
+		if err != nil {
+			response.InternalServerError(c, err)
+			return
+		}
+
+		response.Success(c, res.GetData())
+	}
+}
+
+// DeleteTag godoc
+// @Summary Delete tag
+// @Description Delete a tag and detach it from its tasks, the tasks are kept
+// @Tags Tag
+// @Produce json
+// @Param id path string true "Tag ID"
+// @Success 200 {object} response.Response "Tag deleted successfully"
+// @Failure 400 {object} response.Response "Invalid parameters"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Router /tasks/tag/delete/{id} [delete]
+func (t *TaskHandler) DeleteTag() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		tagID, err := conv.StrToInt64(c.Param("id"))
+		if err != nil {
+			response.InvalidParamError(c, "invalid tag id")
+			return
+		}
+
+		_, err = t.taskClient.DeleteTag(c.Request.Context(), &task.DeleteTagRequest{
+			TagID: tagID,
+		})
+		if err != nil {
+			response.InternalServerError(c, err)
+			return
+		}
+
+		response.Success(c, nil)
+	}
+}
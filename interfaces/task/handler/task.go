@@ -1,30 +1,103 @@
 package handler
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
 	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/status"
 
 	"github.com/crazyfrankie/zrpc-todolist/interfaces/task/model"
+	"github.com/crazyfrankie/zrpc-todolist/interfaces/task/stream"
 	"github.com/crazyfrankie/zrpc-todolist/pkg/gin/response"
 	"github.com/crazyfrankie/zrpc-todolist/pkg/lang/conv"
+	langslice "github.com/crazyfrankie/zrpc-todolist/pkg/lang/slice"
 	"github.com/crazyfrankie/zrpc-todolist/protocol/task"
+	"github.com/crazyfrankie/zrpc-todolist/types/errno"
 )
 
 type TaskHandler struct {
 	taskClient task.TaskServiceClient
+	streamHub  *stream.Hub
 }
 
-func NewTaskHandler(taskClient task.TaskServiceClient) *TaskHandler {
-	return &TaskHandler{taskClient: taskClient}
+func NewTaskHandler(taskClient task.TaskServiceClient, streamHub *stream.Hub) *TaskHandler {
+	return &TaskHandler{taskClient: taskClient, streamHub: streamHub}
 }
 
 func (t *TaskHandler) RegisterRoute(r *gin.RouterGroup) {
 	taskGroup := r.Group("task")
 	{
 		taskGroup.POST("create", t.CreateTask())
+		taskGroup.POST("quick-add", t.QuickAddTask())
+		taskGroup.GET("get/:id", t.GetTask())
 		taskGroup.GET("list", t.ListTask())
 		taskGroup.GET("recycle-list", t.RecycleListTask())
+		taskGroup.GET("search", t.SearchTask())
+		taskGroup.GET("due", t.DueTask())
+		taskGroup.GET("recurrence/preview", t.PreviewOccurrences())
 		taskGroup.PUT("update/:id", t.UpdateTask())
 		taskGroup.PUT("update/:id/status", t.UpdateTaskStatus())
+		taskGroup.PUT("move/:id", t.MoveTask())
+		taskGroup.GET("history/:id", t.GetTaskHistory())
+		taskGroup.PUT("revert/:id", t.RevertTask())
+		taskGroup.DELETE("delete/:id", t.DeleteTask())
+		taskGroup.PUT("restore/:id", t.RestoreTask())
+		taskGroup.DELETE("purge/:id", t.PurgeTask())
+		taskGroup.DELETE("recycle-bin", t.EmptyRecycleBin())
+		taskGroup.POST("tag/create", t.CreateTag())
+		taskGroup.GET("tag/list", t.ListTags())
+		taskGroup.PUT("tag/update/:id", t.UpdateTag())
+		taskGroup.DELETE("tag/delete/:id", t.DeleteTag())
+		taskGroup.POST("project/create", t.CreateProject())
+		taskGroup.GET("project/list", t.ListProjects())
+		taskGroup.PUT("project/rename/:id", t.RenameProject())
+		taskGroup.PUT("project/archive/:id", t.ArchiveProject())
+		taskGroup.PUT("project/reorder", t.ReorderProjects())
+		taskGroup.DELETE("project/delete/:id", t.DeleteProject())
+		taskGroup.POST("checklist/add", t.AddChecklistItem())
+		taskGroup.PUT("checklist/update/:id", t.UpdateChecklistItem())
+		taskGroup.DELETE("checklist/delete/:id", t.DeleteChecklistItem())
+		taskGroup.POST("comment/add", t.AddComment())
+		taskGroup.PUT("comment/update/:id", t.UpdateComment())
+		taskGroup.DELETE("comment/delete/:id", t.DeleteComment())
+		taskGroup.GET("comment/list/:id", t.ListComments())
+		taskGroup.POST("attachment/upload", t.UploadAttachment())
+		taskGroup.GET("attachment/list/:id", t.ListAttachments())
+		taskGroup.GET("attachment/download/:id", t.DownloadAttachment())
+		taskGroup.DELETE("attachment/delete/:id", t.DeleteAttachment())
+		taskGroup.POST("batch/create", t.BatchCreateTasks())
+		taskGroup.PUT("batch/status", t.BatchUpdateStatus())
+		taskGroup.DELETE("batch/delete", t.BatchDelete())
+		taskGroup.PUT("batch/move", t.BatchMove())
+		taskGroup.GET("export", t.ExportTasks())
+		taskGroup.POST("import", t.ImportTasks())
+		taskGroup.GET("sync", t.SyncTasks())
+		taskGroup.POST("sync/push", t.PushTaskChanges())
+		taskGroup.GET("stream", t.StreamTasks())
+		taskGroup.GET("stream/ws", t.StreamTasksWS())
+		taskGroup.POST("dependency/add", t.AddDependency())
+		taskGroup.DELETE("dependency/remove", t.RemoveDependency())
+		taskGroup.GET("board/get", t.GetBoard())
+		taskGroup.POST("board/column/create", t.CreateColumn())
+		taskGroup.PUT("board/column/update/:id", t.UpdateColumn())
+		taskGroup.PUT("board/column/reorder", t.ReorderColumns())
+		taskGroup.DELETE("board/column/delete/:id", t.DeleteColumn())
+		taskGroup.PUT("board/move/:id", t.MoveCard())
+		taskGroup.POST("webhook/create", t.CreateWebhook())
+		taskGroup.GET("webhook/list", t.ListWebhooks())
+		taskGroup.PUT("webhook/update/:id", t.UpdateWebhook())
+		taskGroup.DELETE("webhook/delete/:id", t.DeleteWebhook())
+		taskGroup.GET("webhook/deliveries/:id", t.ListWebhookDeliveries())
+		taskGroup.POST("webhook/delivery/replay/:id", t.ReplayWebhookDelivery())
+		taskGroup.POST("calendar/feed/create", t.CreateCalendarFeed())
+		taskGroup.POST("calendar/feed/rotate", t.RotateCalendarFeed())
+		taskGroup.DELETE("calendar/feed/revoke", t.RevokeCalendarFeed())
+		taskGroup.GET("calendar/:file", t.GetCalendarFeed())
 	}
 }
 
@@ -47,50 +120,214 @@ func (t *TaskHandler) CreateTask() gin.HandlerFunc {
 			return
 		}
 
-		res, err := t.taskClient.AddTask(c.Request.Context(), &task.AddTaskRequest{
-			Title:   req.Title,
-			Content: req.Content,
+		res, err := t.taskClient.AddTask(c.Request.Context(), createTaskVO2DTO(&req))
+		if err != nil {
+			response.InternalServerError(c, err)
+			return
+		}
+
+		response.Success(c, res.GetData())
+	}
+}
+
+// GetTask godoc
+// @Summary Get task
+// @Description Get a task by ID, the ETag header changes with the task shown and leads with its version for conditional updates
+// @Tags Task
+// @Produce json
+// @Param id path string true "Task ID"
+// @Param If-None-Match header string false "ETag of a cached copy"
+// @Success 200 {object} response.Response{data=task.Task} "Task retrieved successfully"
+// @Success 304 "Task not modified"
+// @Failure 400 {object} response.Response "Invalid parameters"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Router /tasks/get/{id} [get]
+func (t *TaskHandler) GetTask() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		taskID, err := conv.StrToInt64(c.Param("id"))
+		if err != nil {
+			response.InvalidParamError(c, "invalid task id")
+			return
+		}
+
+		res, err := t.taskClient.GetTask(c.Request.Context(), &task.GetTaskRequest{
+			TaskID: taskID,
 		})
 		if err != nil {
 			response.InternalServerError(c, err)
 			return
 		}
 
+		etag := taskETag(res.GetData())
+		c.Header("ETag", etag)
+		if c.GetHeader("If-None-Match") == etag {
+			c.Status(http.StatusNotModified)
+			return
+		}
+
 		response.Success(c, res.GetData())
 	}
 }
 
 // ListTask godoc
 // @Summary Get task list
-// @Description Get all tasks for current user
+// @Description Get a page of tasks for current user
 // @Tags Task
 // @Produce json
-// @Success 200 {object} response.Response "Task list retrieved successfully"
+// @Param page_size query int false "Page size, default 20, max 100"
+// @Param cursor query string false "Cursor returned by the previous page"
+// @Param sort_by query string false "Sort field" Enums(created_at, updated_at, priority, rank)
+// @Param order query string false "Sort order, default desc or asc for rank" Enums(asc, desc)
+// @Param created_after query int false "Created at or after, unix seconds"
+// @Param created_before query int false "Created at or before, unix seconds"
+// @Param updated_after query int false "Updated at or after, unix seconds"
+// @Param updated_before query int false "Updated at or before, unix seconds"
+// @Param status query []string false "Task status filter, default todo and in_progress" Enums(todo, in_progress, done, archived) collectionFormat(multi)
+// @Param tag_id query []int false "Keep the tasks carrying any of the tags" collectionFormat(multi)
+// @Param project_id query int false "Scope the list to a project"
+// @Param tree query bool false "Page through the top level tasks and nest their subtasks in children"
+// @Param actionable query bool false "Drop the tasks with open blockers"
+// @Success 200 {object} response.Response{data=model.TaskListResp} "Task list retrieved successfully"
+// @Failure 400 {object} response.Response "Invalid parameters"
 // @Failure 500 {object} response.Response "Internal server error"
 // @Router /tasks/list [get]
 func (t *TaskHandler) ListTask() gin.HandlerFunc {
 	return func(c *gin.Context) {
-		res, err := t.taskClient.ListTasks(c.Request.Context(), &task.ListTasksRequest{})
+		var req model.ListTaskReq
+		if err := c.ShouldBindQuery(&req); err != nil {
+			response.InvalidParamError(c, err.Error())
+			return
+		}
+
+		res, err := t.taskClient.ListTasks(c.Request.Context(), &task.ListTasksRequest{
+			Option:     listOptionVO2DTO(&req),
+			Statuses:   langslice.Transform(req.Statuses, statusVO2DTO),
+			TagIds:     req.TagIDs,
+			ProjectId:  req.ProjectID,
+			Tree:       req.Tree,
+			Actionable: req.Actionable,
+		})
 		if err != nil {
 			response.InternalServerError(c, err)
 			return
 		}
 
-		response.Success(c, res.GetData())
+		response.Success(c, &model.TaskListResp{
+			Tasks:      res.GetData(),
+			NextCursor: res.GetNextCursor(),
+			HasMore:    res.GetHasMore(),
+		})
 	}
 }
 
 // RecycleListTask godoc
 // @Summary Get recycle bin task list
-// @Description Get all deleted tasks in recycle bin
+// @Description Get a page of deleted tasks in recycle bin, they are purged after the retention period
 // @Tags Task
 // @Produce json
-// @Success 200 {object} response.Response "Recycle bin task list retrieved successfully"
+// @Param page_size query int false "Page size, default 20, max 100"
+// @Param cursor query string false "Cursor returned by the previous page"
+// @Param sort_by query string false "Sort field" Enums(created_at, updated_at, priority, rank)
+// @Param order query string false "Sort order, default desc or asc for rank" Enums(asc, desc)
+// @Param created_after query int false "Created at or after, unix seconds"
+// @Param created_before query int false "Created at or before, unix seconds"
+// @Param updated_after query int false "Updated at or after, unix seconds"
+// @Param updated_before query int false "Updated at or before, unix seconds"
+// @Success 200 {object} response.Response{data=model.TaskListResp} "Recycle bin task list retrieved successfully"
+// @Failure 400 {object} response.Response "Invalid parameters"
 // @Failure 500 {object} response.Response "Internal server error"
 // @Router /tasks/recycle-list [get]
 func (t *TaskHandler) RecycleListTask() gin.HandlerFunc {
 	return func(c *gin.Context) {
-		res, err := t.taskClient.RecycleBin(c.Request.Context(), &task.RecycleBinRequest{})
+		var req model.ListTaskReq
+		if err := c.ShouldBindQuery(&req); err != nil {
+			response.InvalidParamError(c, err.Error())
+			return
+		}
+
+		res, err := t.taskClient.RecycleBin(c.Request.Context(), &task.RecycleBinRequest{
+			Option: listOptionVO2DTO(&req),
+		})
+		if err != nil {
+			response.InternalServerError(c, err)
+			return
+		}
+
+		response.Success(c, &model.TaskListResp{
+			Tasks:      res.GetData(),
+			NextCursor: res.GetNextCursor(),
+			HasMore:    res.GetHasMore(),
+		})
+	}
+}
+
+// SearchTask godoc
+// @Summary Search tasks
+// @Description Full-text search over task titles and content, ordered by relevance
+// @Tags Task
+// @Produce json
+// @Param q query string true "Search keyword"
+// @Param status query []string false "Task status filter" Enums(todo, in_progress, done, archived, trashed) collectionFormat(multi)
+// @Param page query int false "Page number, starting from 1"
+// @Param page_size query int false "Page size, default 20, max 100"
+// @Success 200 {object} response.Response{data=model.TaskSearchResp} "Search result retrieved successfully"
+// @Failure 400 {object} response.Response "Invalid parameters"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Router /tasks/search [get]
+func (t *TaskHandler) SearchTask() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req model.SearchTaskReq
+		if err := c.ShouldBindQuery(&req); err != nil {
+			response.InvalidParamError(c, err.Error())
+			return
+		}
+
+		res, err := t.taskClient.SearchTasks(c.Request.Context(), &task.SearchTasksRequest{
+			Keyword:  req.Keyword,
+			Statuses: langslice.Transform(req.Statuses, statusVO2DTO),
+			Page:     req.Page,
+			PageSize: req.PageSize,
+		})
+		if err != nil {
+			response.InternalServerError(c, err)
+			return
+		}
+
+		response.Success(c, &model.TaskSearchResp{
+			Hits:  res.GetData(),
+			Total: res.GetTotal(),
+		})
+	}
+}
+
+// DueTask godoc
+// @Summary Get due tasks
+// @Description Get the overdue tasks or the tasks due today of current user, ordered by due time
+// @Tags Task
+// @Produce json
+// @Param view query string false "Due view, default overdue" Enums(overdue, today)
+// @Param tz query string false "IANA time zone used to determine today, default UTC"
+// @Success 200 {object} response.Response "Due task list retrieved successfully"
+// @Failure 400 {object} response.Response "Invalid parameters"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Router /tasks/due [get]
+func (t *TaskHandler) DueTask() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req model.DueTaskReq
+		if err := c.ShouldBindQuery(&req); err != nil {
+			response.InvalidParamError(c, err.Error())
+			return
+		}
+
+		view := task.DueView_DUE_VIEW_OVERDUE
+		if req.View == "today" {
+			view = task.DueView_DUE_VIEW_TODAY
+		}
+
+		res, err := t.taskClient.ListDueTasks(c.Request.Context(), &task.ListDueTasksRequest{
+			View:     view,
+			TimeZone: req.TimeZone,
+		})
 		if err != nil {
 			response.InternalServerError(c, err)
 			return
@@ -100,16 +337,62 @@ func (t *TaskHandler) RecycleListTask() gin.HandlerFunc {
 	}
 }
 
+// PreviewOccurrences godoc
+// @Summary Preview recurring task occurrences
+// @Description Preview the occurrences after the current one of a recurring task, or the first occurrences of a recurrence rule
+// @Tags Task
+// @Produce json
+// @Param task_id query int false "Recurring task ID"
+// @Param rule query string false "RFC 5545 RRULE, required without task_id"
+// @Param tz query string false "IANA time zone of the rule, default UTC"
+// @Param start query int false "Series start in unix seconds, required without task_id"
+// @Param count query int false "Number of occurrences, default 10, max 50"
+// @Success 200 {object} response.Response "Occurrences in unix seconds"
+// @Failure 400 {object} response.Response "Invalid parameters"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Router /tasks/recurrence/preview [get]
+func (t *TaskHandler) PreviewOccurrences() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req model.PreviewOccurrencesReq
+		if err := c.ShouldBindQuery(&req); err != nil {
+			response.InvalidParamError(c, err.Error())
+			return
+		}
+
+		rpcReq := &task.PreviewOccurrencesRequest{
+			TaskID: req.TaskID,
+			Start:  req.Start,
+			Count:  req.Count,
+		}
+		if req.Rule != "" {
+			rpcReq.Recurrence = &task.Recurrence{
+				Rule:     req.Rule,
+				TimeZone: req.TimeZone,
+			}
+		}
+
+		res, err := t.taskClient.PreviewOccurrences(c.Request.Context(), rpcReq)
+		if err != nil {
+			response.InternalServerError(c, err)
+			return
+		}
+
+		response.Success(c, res.GetOccurrences())
+	}
+}
+
 // UpdateTask godoc
 // @Summary Update task
-// @Description Update task title and content by task ID
+// @Description Update task title, content, schedule and tags by task ID
 // @Tags Task
 // @Accept json
 // @Produce json
 // @Param id path string true "Task ID"
+// @Param If-Match header string false "ETag of the task version the update is based on"
 // @Param request body model.UpdateTaskReq true "Update task request"
 // @Success 200 {object} response.Response "Task updated successfully"
 // @Failure 400 {object} response.Response "Invalid parameters"
+// @Failure 412 {object} response.Response "Task has been modified since the given version"
 // @Failure 500 {object} response.Response "Internal server error"
 // @Router /tasks/update/{id} [put]
 func (t *TaskHandler) UpdateTask() gin.HandlerFunc {
@@ -120,13 +403,26 @@ func (t *TaskHandler) UpdateTask() gin.HandlerFunc {
 			return
 		}
 
-		taskID, _ := conv.StrToInt64(c.Param("id"))
+		taskID, err := conv.StrToInt64(c.Param("id"))
+		if err != nil {
+			response.InvalidParamError(c, "invalid task id")
+			return
+		}
 
-		_, err := t.taskClient.UpdateTask(c.Request.Context(), &task.UpdateTaskRequest{
-			TaskID:  taskID,
-			Title:   req.Title,
-			Content: req.Content,
-		})
+		version, err := parseIfMatch(c.GetHeader("If-Match"))
+		if err != nil {
+			response.InvalidParamError(c, "invalid If-Match header")
+			return
+		}
+
+		updateReq := updateTaskVO2DTO(&req)
+		updateReq.TaskID, updateReq.Version = taskID, version
+
+		_, err = t.taskClient.UpdateTask(c.Request.Context(), updateReq)
+		if isVersionConflict(err) {
+			response.PreconditionFailed(c, err)
+			return
+		}
 		if err != nil {
 			response.InternalServerError(c, err)
 			return
@@ -138,22 +434,32 @@ func (t *TaskHandler) UpdateTask() gin.HandlerFunc {
 
 // UpdateTaskStatus godoc
 // @Summary Update task status
-// @Description Update task status by task ID
+// @Description Move a task to another status, only the allowed status transitions are accepted
 // @Tags Task
 // @Produce json
 // @Param id path string true "Task ID"
-// @Param status query int true "Task status"
+// @Param status query string true "Task status" Enums(todo, in_progress, done, archived, trashed)
 // @Success 200 {object} response.Response "Task status updated successfully"
+// @Failure 400 {object} response.Response "Invalid parameters"
 // @Failure 500 {object} response.Response "Internal server error"
 // @Router /tasks/update/{id}/status [put]
 func (t *TaskHandler) UpdateTaskStatus() gin.HandlerFunc {
 	return func(c *gin.Context) {
-		taskID, _ := conv.StrToInt64(c.Param("id"))
-		status, _ := conv.StrToInt64(c.Query("status"))
+		var req model.UpdateTaskStatusReq
+		if err := c.ShouldBindQuery(&req); err != nil {
+			response.InvalidParamError(c, err.Error())
+			return
+		}
+
+		taskID, err := conv.StrToInt64(c.Param("id"))
+		if err != nil {
+			response.InvalidParamError(c, "invalid task id")
+			return
+		}
 
-		_, err := t.taskClient.UpdateTaskStatus(c.Request.Context(), &task.UpdateTaskStatusRequest{
+		_, err = t.taskClient.UpdateTaskStatus(c.Request.Context(), &task.UpdateTaskStatusRequest{
 			TaskID: taskID,
-			Status: int32(status),
+			Status: statusVO2DTO(req.Status),
 		})
 		if err != nil {
 			response.InternalServerError(c, err)
@@ -163,3 +469,279 @@ func (t *TaskHandler) UpdateTaskStatus() gin.HandlerFunc {
 		response.Success(c, nil)
 	}
 }
+
+// MoveTask godoc
+// @Summary Move task
+// @Description Place a task between two others in the manual order, see sort_by=rank
+// @Tags Task
+// @Accept json
+// @Produce json
+// @Param id path string true "Task ID"
+// @Param request body model.MoveTaskReq true "Move task request"
+// @Success 200 {object} response.Response{data=task.Task} "Task moved successfully"
+// @Failure 400 {object} response.Response "Invalid parameters"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Router /tasks/move/{id} [put]
+func (t *TaskHandler) MoveTask() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req model.MoveTaskReq
+		if err := c.ShouldBind(&req); err != nil {
+			response.InvalidParamError(c, err.Error())
+			return
+		}
+
+		taskID, err := conv.StrToInt64(c.Param("id"))
+		if err != nil {
+			response.InvalidParamError(c, "invalid task id")
+			return
+		}
+
+		res, err := t.taskClient.MoveTask(c.Request.Context(), &task.MoveTaskRequest{
+			TaskID:   taskID,
+			BeforeId: req.BeforeID,
+			AfterId:  req.AfterID,
+		})
+		if err != nil {
+			response.InternalServerError(c, err)
+			return
+		}
+
+		response.Success(c, res.GetData())
+	}
+}
+
+// DeleteTask godoc
+// @Summary Delete task
+// @Description Move a task to the recycle bin
+// @Tags Task
+// @Produce json
+// @Param id path string true "Task ID"
+// @Success 200 {object} response.Response "Task deleted successfully"
+// @Failure 400 {object} response.Response "Invalid parameters"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Router /tasks/delete/{id} [delete]
+func (t *TaskHandler) DeleteTask() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		taskID, err := conv.StrToInt64(c.Param("id"))
+		if err != nil {
+			response.InvalidParamError(c, "invalid task id")
+			return
+		}
+
+		_, err = t.taskClient.DeleteTask(c.Request.Context(), &task.DeleteTaskRequest{
+			TaskID: taskID,
+		})
+		if err != nil {
+			response.InternalServerError(c, err)
+			return
+		}
+
+		response.Success(c, nil)
+	}
+}
+
+// RestoreTask godoc
+// @Summary Restore task
+// @Description Restore a task from the recycle bin as todo
+// @Tags Task
+// @Produce json
+// @Param id path string true "Task ID"
+// @Success 200 {object} response.Response "Task restored successfully"
+// @Failure 400 {object} response.Response "Invalid parameters"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Router /tasks/restore/{id} [put]
+func (t *TaskHandler) RestoreTask() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		taskID, err := conv.StrToInt64(c.Param("id"))
+		if err != nil {
+			response.InvalidParamError(c, "invalid task id")
+			return
+		}
+
+		_, err = t.taskClient.RestoreTask(c.Request.Context(), &task.RestoreTaskRequest{
+			TaskID: taskID,
+		})
+		if err != nil {
+			response.InternalServerError(c, err)
+			return
+		}
+
+		response.Success(c, nil)
+	}
+}
+
+// PurgeTask godoc
+// @Summary Purge task
+// @Description Permanently delete a task in the recycle bin
+// @Tags Task
+// @Produce json
+// @Param id path string true "Task ID"
+// @Success 200 {object} response.Response "Task purged successfully"
+// @Failure 400 {object} response.Response "Invalid parameters"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Router /tasks/purge/{id} [delete]
+func (t *TaskHandler) PurgeTask() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		taskID, err := conv.StrToInt64(c.Param("id"))
+		if err != nil {
+			response.InvalidParamError(c, "invalid task id")
+			return
+		}
+
+		_, err = t.taskClient.PurgeTask(c.Request.Context(), &task.PurgeTaskRequest{
+			TaskID: taskID,
+		})
+		if err != nil {
+			response.InternalServerError(c, err)
+			return
+		}
+
+		response.Success(c, nil)
+	}
+}
+
+// EmptyRecycleBin godoc
+// @Summary Empty recycle bin
+// @Description Permanently delete every task in the recycle bin of current user
+// @Tags Task
+// @Produce json
+// @Success 200 {object} response.Response "Number of purged tasks"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Router /tasks/recycle-bin [delete]
+func (t *TaskHandler) EmptyRecycleBin() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		res, err := t.taskClient.EmptyRecycleBin(c.Request.Context(), &task.EmptyRecycleBinRequest{})
+		if err != nil {
+			response.InternalServerError(c, err)
+			return
+		}
+
+		response.Success(c, res.GetPurged())
+	}
+}
+
+// taskETag is the strong entity tag of the task as shown. Checklist, tag,
+// blocker and comment changes leave the version alone, so the version is
+// followed by a digest of the whole task.
+func taskETag(data *task.Task) string {
+	body, _ := json.Marshal(data)
+	sum := sha256.Sum256(body)
+
+	return `"` + conv.Int64ToStr(data.GetVersion()) + "-" + hex.EncodeToString(sum[:8]) + `"`
+}
+
+// parseIfMatch returns the task version required by an If-Match header,
+// nil if the header is absent or matches any version. Only the version of
+// the entity tag is compared, a bare version is accepted as well.
+func parseIfMatch(header string) (*int64, error) {
+	header = strings.TrimSpace(header)
+	if header == "" || header == "*" {
+		return nil, nil
+	}
+	if len(header) < 2 || header[0] != '"' || header[len(header)-1] != '"' {
+		return nil, fmt.Errorf("invalid entity tag %s", header)
+	}
+
+	tag, _, _ := strings.Cut(header[1:len(header)-1], "-")
+	version, err := conv.StrToInt64(tag)
+	if err != nil {
+		return nil, err
+	}
+	return &version, nil
+}
+
+func isVersionConflict(err error) bool {
+	s, ok := status.FromError(err)
+	return ok && err != nil && int32(s.Code()) == errno.ErrTaskVersionConflictCode
+}
+
+// statusVO2DTO converts a validated status name such as "in_progress".
+func statusVO2DTO(status string) task.TaskStatus {
+	return task.TaskStatus(task.TaskStatus_value["TASK_STATUS_"+strings.ToUpper(status)])
+}
+
+func priorityVO2DTO(priority string) task.TaskPriority {
+	return task.TaskPriority(task.TaskPriority_value["TASK_PRIORITY_"+strings.ToUpper(priority)])
+}
+
+func createTaskVO2DTO(req *model.CreateTaskReq) *task.AddTaskRequest {
+	return &task.AddTaskRequest{
+		Title:      req.Title,
+		Content:    req.Content,
+		DueAt:      req.DueAt,
+		RemindAt:   req.RemindAt,
+		Recurrence: recurrenceVO2DTO(req.Recurrence),
+		TagIds:     req.TagIDs,
+		ProjectId:  req.ProjectID,
+		ParentId:   req.ParentID,
+		Priority:   priorityVO2DTO(req.Priority),
+
+		AutoComplete: req.AutoComplete,
+	}
+}
+
+func updateTaskVO2DTO(req *model.UpdateTaskReq) *task.UpdateTaskRequest {
+	scope := task.UpdateScope_UPDATE_SCOPE_THIS
+	if req.Scope == "series" {
+		scope = task.UpdateScope_UPDATE_SCOPE_SERIES
+	}
+	var priority *task.TaskPriority
+	if req.Priority != nil {
+		priority = priorityVO2DTO(*req.Priority).Enum()
+	}
+
+	return &task.UpdateTaskRequest{
+		Title:         req.Title,
+		Content:       req.Content,
+		DueAt:         req.DueAt,
+		RemindAt:      req.RemindAt,
+		ClearDueAt:    req.ClearDueAt,
+		ClearRemindAt: req.ClearRemindAt,
+
+		Recurrence:      recurrenceVO2DTO(req.Recurrence),
+		ClearRecurrence: req.ClearRecurrence,
+		Scope:           scope,
+
+		AttachTagIds: req.AttachTagIDs,
+		DetachTagIds: req.DetachTagIDs,
+		ProjectId:    req.ProjectID,
+		ParentId:     req.ParentID,
+		AutoComplete: req.AutoComplete,
+		Priority:     priority,
+	}
+}
+
+func recurrenceVO2DTO(rec *model.RecurrenceReq) *task.Recurrence {
+	if rec == nil {
+		return nil
+	}
+	return &task.Recurrence{
+		Rule:     rec.Rule,
+		TimeZone: rec.TimeZone,
+	}
+}
+
+func listOptionVO2DTO(req *model.ListTaskReq) *task.ListOption {
+	opt := &task.ListOption{
+		PageSize:      req.PageSize,
+		Cursor:        req.Cursor,
+		CreatedAfter:  req.CreatedAfter,
+		CreatedBefore: req.CreatedBefore,
+		UpdatedAfter:  req.UpdatedAfter,
+		UpdatedBefore: req.UpdatedBefore,
+	}
+	switch req.SortBy {
+	case "updated_at":
+		opt.SortField = task.SortField_SORT_FIELD_UPDATED_AT
+	case "priority":
+		opt.SortField = task.SortField_SORT_FIELD_PRIORITY
+	case "rank":
+		opt.SortField = task.SortField_SORT_FIELD_RANK
+	}
+	// the manual order reads top down
+	if req.Order == "asc" || (req.Order == "" && req.SortBy == "rank") {
+		opt.SortOrder = task.SortOrder_SORT_ORDER_ASC
+	}
+
+	return opt
+}
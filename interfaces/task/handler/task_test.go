@@ -0,0 +1,128 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/crazyfrankie/zrpc-todolist/protocol/task"
+)
+
+// storedTask serves a single task like the task service.
+type storedTask struct {
+	task.TaskServiceClient
+
+	task *task.Task
+}
+
+func (s *storedTask) GetTask(_ context.Context, in *task.GetTaskRequest) (*task.GetTaskResponse, error) {
+	return &task.GetTaskResponse{Data: s.task}, nil
+}
+
+func getTask(t *testing.T, r http.Handler, ifNoneMatch string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	req := httptest.NewRequest(http.MethodGet, "/task/get/7", nil)
+	if ifNoneMatch != "" {
+		req.Header.Set("If-None-Match", ifNoneMatch)
+	}
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestGetTaskETag(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	store := &storedTask{task: &task.Task{
+		TaskID:    7,
+		Title:     "pack",
+		Version:   3,
+		Checklist: []*task.ChecklistItem{{ItemID: 1, TaskID: 7, Content: "passport"}},
+	}}
+	r := gin.New()
+	NewTaskHandler(store, nil).RegisterRoute(r.Group(""))
+
+	first := getTask(t, r, "")
+	etag := first.Header().Get("ETag")
+	if first.Code != http.StatusOK || etag == "" {
+		t.Fatalf("GET = %d, ETag %q", first.Code, etag)
+	}
+	if rec := getTask(t, r, etag); rec.Code != http.StatusNotModified {
+		t.Fatalf("GET with a fresh copy = %d, want %d", rec.Code, http.StatusNotModified)
+	}
+
+	// checking an item leaves the version alone
+	store.task.Checklist[0].Checked = true
+	rec := getTask(t, r, etag)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("GET after a checklist change = %d, want %d", rec.Code, http.StatusOK)
+	}
+	if got := rec.Header().Get("ETag"); got == etag {
+		t.Fatalf("ETag = %s, want a new one", got)
+	}
+	var body struct {
+		Data *task.Task `json:"data"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+		t.Fatal(err)
+	}
+	if !body.Data.GetChecklist()[0].GetChecked() {
+		t.Error("body holds the stale checklist")
+	}
+
+	// the version still guards updates
+	version, err := parseIfMatch(rec.Header().Get("ETag"))
+	if err != nil || version == nil || *version != 3 {
+		t.Errorf("parseIfMatch(%s) = %v, %v, want 3", rec.Header().Get("ETag"), version, err)
+	}
+}
+
+func TestParseIfMatch(t *testing.T) {
+	tests := []struct {
+		header  string
+		version int64
+		any     bool
+		wantErr bool
+	}{
+		{header: "", any: true},
+		{header: "*", any: true},
+		{header: `"3-0a1b2c3d4e5f6a7b"`, version: 3},
+		{header: `"3"`, version: 3},
+		{header: "3", wantErr: true},
+		{header: `"v3-0a1b"`, wantErr: true},
+	}
+	for _, tt := range tests {
+		version, err := parseIfMatch(tt.header)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("parseIfMatch(%s) err = %v", tt.header, err)
+			continue
+		}
+		if tt.wantErr {
+			continue
+		}
+		if tt.any != (version == nil) || (version != nil && *version != tt.version) {
+			t.Errorf("parseIfMatch(%s) = %v, want %d", tt.header, version, tt.version)
+		}
+	}
+}
+
+func TestUpdateTaskInvalidID(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	// the task service is never reached, the stub panics on UpdateTask
+	r := gin.New()
+	NewTaskHandler(&storedTask{}, nil).RegisterRoute(r.Group(""))
+
+	req := httptest.NewRequest(http.MethodPut, "/task/update/abc", strings.NewReader(`{"title":"t"}`))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("PUT /task/update/abc = %d, want %d", rec.Code, http.StatusBadRequest)
+	}
+}
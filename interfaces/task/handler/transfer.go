@@ -0,0 +1,108 @@
+package handler
+
+import (
+	"io"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/crazyfrankie/zrpc-todolist/interfaces/task/model"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/gin/response"
+	"github.com/crazyfrankie/zrpc-todolist/protocol/task"
+)
+
+// maxImportSize matches the import size limit of the task service.
+const maxImportSize = 4 << 20
+
+// ExportTasks godoc
+// @Summary Export tasks
+// @Description Export every task including the recycle bin as JSON, CSV or iCalendar, the download URL is valid for an hour
+// @Tags Task
+// @Produce json
+// @Param format query string false "File format, json by default" Enums(json, csv, ical)
+// @Success 200 {object} response.Response{data=model.ExportTasksResp} "Export created successfully"
+// @Failure 400 {object} response.Response "Invalid parameters"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Router /tasks/export [get]
+func (t *TaskHandler) ExportTasks() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req model.ExportTasksReq
+		if err := c.ShouldBindQuery(&req); err != nil {
+			response.InvalidParamError(c, err.Error())
+			return
+		}
+
+		res, err := t.taskClient.ExportTasks(c.Request.Context(), &task.ExportTasksRequest{
+			Format: transferFormatVO2DTO(req.Format),
+		})
+		if err != nil {
+			response.InternalServerError(c, err)
+			return
+		}
+
+		response.Success(c, &model.ExportTasksResp{URL: res.GetUrl()})
+	}
+}
+
+// ImportTasks godoc
+// @Summary Import tasks
+// @Description Import at most 1000 tasks from a JSON, CSV or iCalendar file of at most 4MB. Missing projects and tags are created by name, rows duplicating a task by title and due time are skipped, and failing rows are reported. A dry run only checks the rows.
+// @Tags Task
+// @Accept multipart/form-data
+// @Produce json
+// @Param format formData string true "File format" Enums(json, csv, ical)
+// @Param dry_run formData bool false "Only check the rows"
+// @Param file formData file true "File to import"
+// @Success 200 {object} response.Response{data=task.ImportTasksResponse} "Import report"
+// @Failure 400 {object} response.Response "Invalid parameters"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Router /tasks/import [post]
+func (t *TaskHandler) ImportTasks() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req model.ImportTasksReq
+		if err := c.ShouldBind(&req); err != nil {
+			response.InvalidParamError(c, err.Error())
+			return
+		}
+
+		file, err := c.FormFile("file")
+		if err != nil {
+			response.InvalidParamError(c, "missing import file")
+			return
+		}
+		if file.Size > maxImportSize {
+			response.InvalidParamError(c, "import file is too large")
+			return
+		}
+
+		src, err := file.Open()
+		if err != nil {
+			response.InternalServerError(c, err)
+			return
+		}
+		defer src.Close()
+
+		content, err := io.ReadAll(src)
+		if err != nil {
+			response.InternalServerError(c, err)
+			return
+		}
+
+		res, err := t.taskClient.ImportTasks(c.Request.Context(), &task.ImportTasksRequest{
+			Format:  transferFormatVO2DTO(req.Format),
+			Content: content,
+			DryRun:  req.DryRun,
+		})
+		if err != nil {
+			response.InternalServerError(c, err)
+			return
+		}
+
+		response.Success(c, res)
+	}
+}
+
+// transferFormatVO2DTO converts a validated format name, empty means JSON.
+func transferFormatVO2DTO(format string) task.TransferFormat {
+	return task.TransferFormat(task.TransferFormat_value["TRANSFER_FORMAT_"+strings.ToUpper(format)])
+}
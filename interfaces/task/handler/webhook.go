@@ -0,0 +1,224 @@
+package handler
+
+import (
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/crazyfrankie/zrpc-todolist/interfaces/task/model"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/gin/response"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/lang/conv"
+	langslice "github.com/crazyfrankie/zrpc-todolist/pkg/lang/slice"
+	"github.com/crazyfrankie/zrpc-todolist/protocol/task"
+)
+
+// CreateWebhook godoc
+// @Summary Create a webhook
+// @Description Subscribe a URL to the task events of the user, at most 10 webhooks per user. Each event is POSTed as JSON with the headers X-Webhook-Event, X-Webhook-Delivery, X-Webhook-Timestamp (Unix seconds) and X-Webhook-Signature, "sha256=" followed by the hex HMAC-SHA256 of the timestamp, a dot and the body keyed by the secret. Failed deliveries are retried with exponential backoff for about an hour, then marked dead. The secret is only returned here.
+// @Tags Webhook
+// @Accept json
+// @Produce json
+// @Param request body model.CreateWebhookReq true "Create webhook request"
+// @Success 200 {object} response.Response{data=task.Webhook} "Webhook created successfully"
+// @Failure 400 {object} response.Response "Invalid parameters"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Router /tasks/webhook/create [post]
+func (t *TaskHandler) CreateWebhook() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req model.CreateWebhookReq
+		if err := c.ShouldBind(&req); err != nil {
+			response.InvalidParamError(c, err.Error())
+			return
+		}
+
+		res, err := t.taskClient.CreateWebhook(c.Request.Context(), &task.CreateWebhookRequest{
+			Url:        req.URL,
+			EventTypes: langslice.Transform(req.EventTypes, eventTypeVO2DTO),
+			Secret:     req.Secret,
+		})
+		if err != nil {
+			response.InternalServerError(c, err)
+			return
+		}
+
+		response.Success(c, res.GetData())
+	}
+}
+
+// ListWebhooks godoc
+// @Summary List webhooks
+// @Description List the webhooks of the user, without their secrets
+// @Tags Webhook
+// @Produce json
+// @Success 200 {object} response.Response{data=[]task.Webhook} "Webhooks retrieved successfully"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Router /tasks/webhook/list [get]
+func (t *TaskHandler) ListWebhooks() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		res, err := t.taskClient.ListWebhooks(c.Request.Context(), &task.ListWebhooksRequest{})
+		if err != nil {
+			response.InternalServerError(c, err)
+			return
+		}
+
+		response.Success(c, res.GetData())
+	}
+}
+
+// UpdateWebhook godoc
+// @Summary Update webhook
+// @Description Change the URL, subscribed events or secret of a webhook, or disable it. Pending deliveries of a disabled webhook are marked dead.
+// @Tags Webhook
+// @Accept json
+// @Produce json
+// @Param id path string true "Webhook ID"
+// @Param request body model.UpdateWebhookReq true "Update webhook request"
+// @Success 200 {object} response.Response{data=task.Webhook} "Webhook updated successfully"
+// @Failure 400 {object} response.Response "Invalid parameters"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Router /tasks/webhook/update/{id} [put]
+func (t *TaskHandler) UpdateWebhook() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req model.UpdateWebhookReq
+		if err := c.ShouldBind(&req); err != nil {
+			response.InvalidParamError(c, err.Error())
+			return
+		}
+
+		webhookID, err := conv.StrToInt64(c.Param("id"))
+		if err != nil {
+			response.InvalidParamError(c, "invalid webhook id")
+			return
+		}
+
+		res, err := t.taskClient.UpdateWebhook(c.Request.Context(), &task.UpdateWebhookRequest{
+			WebhookID:     webhookID,
+			Url:           req.URL,
+			EventTypes:    langslice.Transform(req.EventTypes, eventTypeVO2DTO),
+			AllEventTypes: req.AllEventTypes,
+			Secret:        req.Secret,
+			Enabled:       req.Enabled,
+		})
+		if err != nil {
+			response.InternalServerError(c, err)
+			return
+		}
+
+		response.Success(c, res.GetData())
+	}
+}
+
+// DeleteWebhook godoc
+// @Summary Delete webhook
+// @Description Delete a webhook along with its delivery log
+// @Tags Webhook
+// @Produce json
+// @Param id path string true "Webhook ID"
+// @Success 200 {object} response.Response "Webhook deleted successfully"
+// @Failure 400 {object} response.Response "Invalid parameters"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Router /tasks/webhook/delete/{id} [delete]
+func (t *TaskHandler) DeleteWebhook() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		webhookID, err := conv.StrToInt64(c.Param("id"))
+		if err != nil {
+			response.InvalidParamError(c, "invalid webhook id")
+			return
+		}
+
+		_, err = t.taskClient.DeleteWebhook(c.Request.Context(), &task.DeleteWebhookRequest{
+			WebhookID: webhookID,
+		})
+		if err != nil {
+			response.InternalServerError(c, err)
+			return
+		}
+
+		response.Success(c, nil)
+	}
+}
+
+// ListWebhookDeliveries godoc
+// @Summary List webhook deliveries
+// @Description List the deliveries of a webhook newest first, with the outcome of their last attempt. Finished deliveries are kept for 30 days.
+// @Tags Webhook
+// @Produce json
+// @Param id path string true "Webhook ID"
+// @Param status query string false "Delivery status filter" Enums(pending, succeeded, dead)
+// @Param cursor query string false "Cursor returned by the previous page"
+// @Param page_size query int false "Page size"
+// @Success 200 {object} response.Response{data=model.ListWebhookDeliveriesResp} "Deliveries retrieved successfully"
+// @Failure 400 {object} response.Response "Invalid parameters"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Router /tasks/webhook/deliveries/{id} [get]
+func (t *TaskHandler) ListWebhookDeliveries() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req model.ListWebhookDeliveriesReq
+		if err := c.ShouldBindQuery(&req); err != nil {
+			response.InvalidParamError(c, err.Error())
+			return
+		}
+
+		webhookID, err := conv.StrToInt64(c.Param("id"))
+		if err != nil {
+			response.InvalidParamError(c, "invalid webhook id")
+			return
+		}
+
+		var status *task.WebhookDeliveryStatus
+		if req.Status != "" {
+			status = task.WebhookDeliveryStatus(task.WebhookDeliveryStatus_value["WEBHOOK_DELIVERY_"+strings.ToUpper(req.Status)]).Enum()
+		}
+		res, err := t.taskClient.ListWebhookDeliveries(c.Request.Context(), &task.ListWebhookDeliveriesRequest{
+			WebhookID: webhookID,
+			Status:    status,
+			Cursor:    req.Cursor,
+			PageSize:  req.PageSize,
+		})
+		if err != nil {
+			response.InternalServerError(c, err)
+			return
+		}
+
+		response.Success(c, &model.ListWebhookDeliveriesResp{
+			Deliveries: res.GetData(),
+			NextCursor: res.GetNextCursor(),
+			HasMore:    res.GetHasMore(),
+		})
+	}
+}
+
+// ReplayWebhookDelivery godoc
+// @Summary Replay webhook delivery
+// @Description Send a delivery again with a fresh set of attempts, dead and succeeded ones included
+// @Tags Webhook
+// @Produce json
+// @Param id path string true "Delivery ID"
+// @Success 200 {object} response.Response{data=task.WebhookDelivery} "Delivery replayed successfully"
+// @Failure 400 {object} response.Response "Invalid parameters"
+// @Failure 500 {object} response.Response "Internal server error"
+// @Router /tasks/webhook/delivery/replay/{id} [post]
+func (t *TaskHandler) ReplayWebhookDelivery() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		deliveryID, err := conv.StrToInt64(c.Param("id"))
+		if err != nil {
+			response.InvalidParamError(c, "invalid delivery id")
+			return
+		}
+
+		res, err := t.taskClient.ReplayWebhookDelivery(c.Request.Context(), &task.ReplayWebhookDeliveryRequest{
+			DeliveryID: deliveryID,
+		})
+		if err != nil {
+			response.InternalServerError(c, err)
+			return
+		}
+
+		response.Success(c, res.GetData())
+	}
+}
+
+// eventTypeVO2DTO converts a validated event type name such as "status_changed".
+func eventTypeVO2DTO(name string) task.TaskEventType {
+	return task.TaskEventType(task.TaskEventType_value["TASK_EVENT_"+strings.ToUpper(name)])
+}
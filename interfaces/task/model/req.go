@@ -1,11 +1,317 @@
 package model
 
+// CreateTaskReq due_at and remind_at are unix seconds.
 type CreateTaskReq struct {
-	Title   string `json:"title"`
-	Content string `json:"content"`
+	Title    string  `json:"title"`
+	Content  string  `json:"content"`
+	DueAt    *int64  `json:"due_at,omitempty"`
+	RemindAt *int64  `json:"remind_at,omitempty"`
+	TagIDs   []int64 `json:"tag_ids,omitempty"`
+	// ProjectID defaults to the inbox.
+	ProjectID int64 `json:"project_id,omitempty"`
+	// ParentID makes the task a subtask, it lives in the project of its parent.
+	ParentID int64 `json:"parent_id,omitempty"`
+	// AutoComplete finishes the task once all of its subtasks and checklist items are done.
+	AutoComplete bool   `json:"auto_complete,omitempty"`
+	Priority     string `json:"priority,omitempty" binding:"omitempty,oneof=none low medium high urgent"`
+
+	Recurrence *RecurrenceReq `json:"recurrence,omitempty"`
 }
 
+// UpdateTaskReq due_at and remind_at are unix seconds, use the clear flags to remove them.
 type UpdateTaskReq struct {
-	Title   *string `json:"title,omitempty"`
+	Title         *string `json:"title,omitempty"`
+	Content       *string `json:"content,omitempty"`
+	DueAt         *int64  `json:"due_at,omitempty"`
+	RemindAt      *int64  `json:"remind_at,omitempty"`
+	ClearDueAt    bool    `json:"clear_due_at,omitempty"`
+	ClearRemindAt bool    `json:"clear_remind_at,omitempty"`
+
+	Recurrence      *RecurrenceReq `json:"recurrence,omitempty"`
+	ClearRecurrence bool           `json:"clear_recurrence,omitempty"`
+	// Scope of an update of a recurring task, the whole series requires "series".
+	Scope string `json:"scope,omitempty" binding:"omitempty,oneof=this series"`
+
+	AttachTagIDs []int64 `json:"attach_tag_ids,omitempty"`
+	DetachTagIDs []int64 `json:"detach_tag_ids,omitempty"`
+	// ProjectID moves the task to another project, 0 means the inbox.
+	ProjectID *int64 `json:"project_id,omitempty"`
+	// ParentID moves the task with its subtasks under another task, 0 makes it a top level task.
+	ParentID     *int64  `json:"parent_id,omitempty"`
+	AutoComplete *bool   `json:"auto_complete,omitempty"`
+	Priority     *string `json:"priority,omitempty" binding:"omitempty,oneof=none low medium high urgent"`
+}
+
+// RecurrenceReq rule is an RFC 5545 RRULE, e.g. "FREQ=WEEKLY;BYDAY=MO,WE;COUNT=10".
+type RecurrenceReq struct {
+	Rule     string `json:"rule" binding:"required"`
+	TimeZone string `json:"time_zone,omitempty"`
+}
+
+// ListTaskReq carries the pagination, ordering and filter options of task lists.
+// Time ranges are unix seconds.
+type ListTaskReq struct {
+	PageSize int32  `form:"page_size"`
+	Cursor   string `form:"cursor"`
+	SortBy   string `form:"sort_by" binding:"omitempty,oneof=created_at updated_at priority rank"`
+	// Order defaults to desc, or to asc when sorting by rank.
+	Order         string `form:"order" binding:"omitempty,oneof=asc desc"`
+	CreatedAfter  int64  `form:"created_after"`
+	CreatedBefore int64  `form:"created_before"`
+	UpdatedAfter  int64  `form:"updated_after"`
+	UpdatedBefore int64  `form:"updated_before"`
+
+	// Statuses filters the task list by status names, it is ignored by the recycle bin.
+	Statuses []string `form:"status" binding:"dive,oneof=todo in_progress done archived trashed"`
+	// TagIDs keeps the tasks carrying any of the tags, it is ignored by the recycle bin.
+	TagIDs []int64 `form:"tag_id"`
+	// ProjectID scopes the task list to a project.
+	ProjectID int64 `form:"project_id"`
+	// Tree lists the top level tasks with their subtasks nested, it is ignored by the recycle bin.
+	Tree bool `form:"tree"`
+	// Actionable drops the tasks with open blockers, it is ignored by the recycle bin.
+	Actionable bool `form:"actionable"`
+}
+
+// SearchTaskReq statuses are status names, see UpdateTaskStatusReq.
+type SearchTaskReq struct {
+	Keyword  string   `form:"q" binding:"required"`
+	Statuses []string `form:"status" binding:"dive,oneof=todo in_progress done archived trashed"`
+	Page     int32    `form:"page"`
+	PageSize int32    `form:"page_size"`
+}
+
+type UpdateTaskStatusReq struct {
+	Status string `form:"status" binding:"required,oneof=todo in_progress done archived trashed"`
+}
+
+type DueTaskReq struct {
+	View     string `form:"view" binding:"omitempty,oneof=overdue today"`
+	TimeZone string `form:"tz"`
+}
+
+// PreviewOccurrencesReq previews the next occurrences of task_id,
+// or of rule starting at start (unix seconds) when task_id is absent.
+type PreviewOccurrencesReq struct {
+	TaskID   int64  `form:"task_id"`
+	Rule     string `form:"rule"`
+	TimeZone string `form:"tz"`
+	Start    int64  `form:"start"`
+	Count    int32  `form:"count"`
+}
+
+// CreateTagReq color is a #RRGGBB hex color.
+type CreateTagReq struct {
+	Name  string `json:"name" binding:"required"`
+	Color string `json:"color,omitempty"`
+}
+
+// UpdateTagReq renames and/or recolors a tag.
+type UpdateTagReq struct {
+	Name  *string `json:"name,omitempty"`
+	Color *string `json:"color,omitempty"`
+}
+
+type CreateProjectReq struct {
+	Name string `json:"name" binding:"required"`
+}
+
+type RenameProjectReq struct {
+	Name string `json:"name" binding:"required"`
+}
+
+type ArchiveProjectReq struct {
+	Archived *bool `form:"archived" binding:"required"`
+}
+
+// ReorderProjectsReq lists projects in their new order, the others keep their places.
+type ReorderProjectsReq struct {
+	ProjectIDs []int64 `json:"project_ids" binding:"required"`
+}
+
+// DeleteProjectReq moves the tasks of the deleted project to move_to,
+// or moves them to the recycle bin if it is absent.
+type DeleteProjectReq struct {
+	MoveTo int64 `form:"move_to"`
+}
+
+type ListProjectReq struct {
+	IncludeArchived bool `form:"include_archived"`
+}
+
+type AddChecklistItemReq struct {
+	TaskID  int64  `json:"task_id" binding:"required"`
+	Content string `json:"content" binding:"required"`
+}
+
+type UpdateChecklistItemReq struct {
 	Content *string `json:"content,omitempty"`
+	Checked *bool   `json:"checked,omitempty"`
+}
+
+// DependencyReq makes blocker_id block task_id, task_id can't be done until blocker_id is.
+type DependencyReq struct {
+	TaskID    int64 `json:"task_id" form:"task_id" binding:"required"`
+	BlockerID int64 `json:"blocker_id" form:"blocker_id" binding:"required"`
+}
+
+// MoveTaskReq places the task between before_id and after_id in the manual order,
+// leave one out to move the task to the start or the end of the list.
+type MoveTaskReq struct {
+	BeforeID int64 `json:"before_id,omitempty"`
+	AfterID  int64 `json:"after_id,omitempty"`
+}
+
+// GetBoardReq shows the board of project_id, absent means the inbox.
+type GetBoardReq struct {
+	ProjectID int64 `form:"project_id"`
+}
+
+// CreateColumnReq adds a column showing the tasks in status, wip_limit zero means no limit.
+type CreateColumnReq struct {
+	ProjectID int64  `json:"project_id"`
+	Name      string `json:"name" binding:"required"`
+	Status    string `json:"status" binding:"required,oneof=todo in_progress done"`
+	WIPLimit  int32  `json:"wip_limit" binding:"gte=0"`
+}
+
+type UpdateColumnReq struct {
+	Name     *string `json:"name,omitempty"`
+	WIPLimit *int32  `json:"wip_limit,omitempty" binding:"omitempty,gte=0"`
+}
+
+// ReorderColumnsReq lists the columns of a board in their new order, the others keep their places.
+type ReorderColumnsReq struct {
+	ProjectID int64   `json:"project_id"`
+	ColumnIDs []int64 `json:"column_ids" binding:"required"`
+}
+
+// MoveCardReq moves the task into column_id between before_id and after_id,
+// leave both out to move it to the end of the column.
+type MoveCardReq struct {
+	ColumnID int64 `json:"column_id" binding:"required"`
+	BeforeID int64 `json:"before_id,omitempty"`
+	AfterID  int64 `json:"after_id,omitempty"`
+}
+
+type TaskHistoryReq struct {
+	Cursor   string `form:"cursor"`
+	PageSize int32  `form:"page_size"`
+}
+
+// RevertTaskReq names the revision to revert to by the version it left the task at.
+type RevertTaskReq struct {
+	Version *int64 `json:"version" binding:"required"`
+}
+
+type AddCommentReq struct {
+	TaskID  int64  `json:"task_id" binding:"required"`
+	Content string `json:"content" binding:"required"`
+}
+
+type UpdateCommentReq struct {
+	Content string `json:"content" binding:"required"`
+}
+
+type ListCommentsReq struct {
+	Cursor   string `form:"cursor"`
+	PageSize int32  `form:"page_size"`
+}
+
+type UploadAttachmentReq struct {
+	TaskID int64 `form:"task_id" binding:"required"`
+}
+
+// Batch requests hold at most 100 items, the valid ones are applied together and
+// the others are reported in the results.
+type BatchCreateTasksReq struct {
+	Tasks []*CreateTaskReq `json:"tasks" binding:"required,min=1,max=100,dive"`
+}
+
+// BatchUpdateStatusReq can't trash tasks, use BatchDeleteReq instead.
+type BatchUpdateStatusReq struct {
+	TaskIDs []int64 `json:"task_ids" binding:"required,min=1,max=100"`
+	Status  string  `json:"status" binding:"required,oneof=todo in_progress done archived"`
+}
+
+type BatchDeleteReq struct {
+	TaskIDs []int64 `json:"task_ids" binding:"required,min=1,max=100"`
+}
+
+// BatchMoveReq moves top level tasks with their subtasks to a project, 0 means the inbox.
+type BatchMoveReq struct {
+	TaskIDs   []int64 `json:"task_ids" binding:"required,min=1,max=100"`
+	ProjectID int64   `json:"project_id"`
+}
+
+type ExportTasksReq struct {
+	Format string `form:"format" binding:"omitempty,oneof=json csv ical"`
+}
+
+// ImportTasksReq goes along with the file to import, dry_run only checks its rows.
+type ImportTasksReq struct {
+	Format string `form:"format" binding:"required,oneof=json csv ical"`
+	DryRun bool   `form:"dry_run"`
+}
+
+// QuickAddTaskReq creates a task from one line of text, dates are read in
+// time_zone, an IANA name defaulting to UTC.
+type QuickAddTaskReq struct {
+	Text     string `json:"text" binding:"required"`
+	TimeZone string `json:"time_zone"`
+	// ProjectID defaults to the inbox.
+	ProjectID int64 `json:"project_id,omitempty"`
+}
+
+// SyncTasksReq since_token is the next_token of the previous sync, empty for the
+// first sync of a client.
+type SyncTasksReq struct {
+	SinceToken string `form:"since_token"`
+	PageSize   int32  `form:"page_size"`
+}
+
+// StreamTasksReq last_event_id resumes a stream after the event with that id, it
+// stands in for the Last-Event-ID header where a client can't set it.
+type StreamTasksReq struct {
+	LastEventID int64 `form:"last_event_id"`
+}
+
+// ClientTaskChangeReq is a change made while offline. Updates and deletes name the
+// task by task_id and only apply while it is still at base_version.
+type ClientTaskChangeReq struct {
+	Op          string         `json:"op" binding:"required,oneof=create update delete"`
+	TaskID      int64          `json:"task_id,omitempty"`
+	BaseVersion int64          `json:"base_version,omitempty"`
+	Create      *CreateTaskReq `json:"create,omitempty"`
+	Update      *UpdateTaskReq `json:"update,omitempty"`
+	// Status moves the task along with an update.
+	Status *string `json:"status,omitempty" binding:"omitempty,oneof=todo in_progress done archived trashed"`
+}
+
+type PushTaskChangesReq struct {
+	Changes []*ClientTaskChangeReq `json:"changes" binding:"required,min=1,max=100,dive"`
+}
+
+// CreateWebhookReq subscribes url to the task events of event_types, empty means
+// every event. A secret is generated unless one is given.
+type CreateWebhookReq struct {
+	URL        string   `json:"url" binding:"required,url"`
+	EventTypes []string `json:"event_types,omitempty" binding:"dive,oneof=created updated status_changed deleted"`
+	Secret     string   `json:"secret,omitempty" binding:"omitempty,min=16,max=128"`
+}
+
+// UpdateWebhookReq changes the given fields, event_types replaces the subscribed
+// events unless it is empty, all_event_types subscribes every event.
+type UpdateWebhookReq struct {
+	URL           *string  `json:"url,omitempty" binding:"omitempty,url"`
+	EventTypes    []string `json:"event_types,omitempty" binding:"dive,oneof=created updated status_changed deleted"`
+	AllEventTypes bool     `json:"all_event_types,omitempty"`
+	Secret        *string  `json:"secret,omitempty" binding:"omitempty,min=16,max=128"`
+	Enabled       *bool    `json:"enabled,omitempty"`
+}
+
+type ListWebhookDeliveriesReq struct {
+	Status   string `form:"status" binding:"omitempty,oneof=pending succeeded dead"`
+	Cursor   string `form:"cursor"`
+	PageSize int32  `form:"page_size"`
 }
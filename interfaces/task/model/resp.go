@@ -0,0 +1,56 @@
+package model
+
+import "github.com/crazyfrankie/zrpc-todolist/protocol/task"
+
+type TaskListResp struct {
+	Tasks      []*task.Task `json:"tasks"`
+	NextCursor string       `json:"next_cursor"`
+	HasMore    bool         `json:"has_more"`
+}
+
+type TaskSearchResp struct {
+	Hits  []*task.SearchHit `json:"hits"`
+	Total int64             `json:"total"`
+}
+
+type TaskHistoryResp struct {
+	Activities []*task.TaskActivity `json:"activities"`
+	NextCursor string               `json:"next_cursor"`
+	HasMore    bool                 `json:"has_more"`
+}
+
+type ListCommentsResp struct {
+	Comments   []*task.Comment `json:"comments"`
+	NextCursor string          `json:"next_cursor"`
+	HasMore    bool            `json:"has_more"`
+}
+
+type ListWebhookDeliveriesResp struct {
+	Deliveries []*task.WebhookDelivery `json:"deliveries"`
+	NextCursor string                  `json:"next_cursor"`
+	HasMore    bool                    `json:"has_more"`
+}
+
+type AttachmentURLResp struct {
+	URL string `json:"url"`
+}
+
+type ExportTasksResp struct {
+	URL string `json:"url"`
+}
+
+// CalendarFeedResp holds the secret token of a calendar feed, along with the
+// URL calendar apps subscribe to.
+type CalendarFeedResp struct {
+	Token string `json:"token"`
+	URL   string `json:"url"`
+}
+
+// TaskEvent is the data of an event on a task stream. Resumed streams replay the
+// missed events as updated or deleted, a reset event without id asks the client
+// for a full sync as it fell too far behind.
+type TaskEvent struct {
+	ID     int64  `json:"id,omitempty"`
+	Type   string `json:"type"`
+	TaskID int64  `json:"task_id,omitempty"`
+}
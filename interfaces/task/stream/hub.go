@@ -0,0 +1,351 @@
+package stream
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/crazyfrankie/zrpc-todolist/infra/contract/cache"
+	"github.com/crazyfrankie/zrpc-todolist/infra/contract/pubsub"
+	"github.com/crazyfrankie/zrpc-todolist/interfaces/task/model"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/errorx"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/lang/conv"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/logs"
+	"github.com/crazyfrankie/zrpc-todolist/types/consts"
+	"github.com/crazyfrankie/zrpc-todolist/types/errno"
+)
+
+const (
+	defaultMaxConns  = 5
+	defaultHeartbeat = 25 * time.Second
+	// connBuffer bounds the events queued for a connection, a connection which
+	// falls further behind is dropped and resumes from its last event.
+	connBuffer = 64
+	// connsTTLBeats is the number of heartbeats the stream count of a user lives
+	// without being refreshed.
+	connsTTLBeats = 3
+)
+
+// Hub fans the task events published for a user out to the streams the user
+// has open on this gateway. It subscribes to the channel of a user while the
+// user has any stream open.
+//
+// The streams of a user are counted in the cache, so the limit holds across all
+// gateways. The gateways refresh the counts of their users every heartbeat, the
+// streams of a gateway which died without leaving count until the count expires.
+//
+// mu only guards the maps, the cache and the subscription are called without
+// holding it so a slow round trip doesn't hold up the events of other users.
+type Hub struct {
+	sub       pubsub.Subscription
+	counter   cache.Cmdable
+	maxConns  int
+	heartbeat time.Duration
+
+	mu    sync.Mutex
+	users map[int64]*userConns
+}
+
+// userConns are the streams of a user on this gateway.
+type userConns struct {
+	// subMu orders the subscription changes of the user, subscribed is only
+	// accessed under it.
+	subMu      sync.Mutex
+	subscribed bool
+
+	// conns and joining are guarded by the mu of the hub, joining counts the
+	// joins waiting for the subscription.
+	conns   map[*Conn]struct{}
+	joining int
+}
+
+func (u *userConns) idle() bool {
+	return len(u.conns) == 0 && u.joining == 0
+}
+
+// Conn is a stream of a user registered with the hub.
+type Conn struct {
+	userID int64
+	events chan *model.TaskEvent
+	// dropped is closed when the connection fell behind or the hub stopped
+	dropped chan struct{}
+}
+
+// NewHub subscribes to the task events and dispatches them until ctx is done,
+// counter holds the stream counts. STREAM_MAX_CONNS bounds the streams of a user
+// and STREAM_HEARTBEAT_INTERVAL sets how often idle streams are pinged.
+func NewHub(ctx context.Context, ps pubsub.PubSub, counter cache.Cmdable) (*Hub, error) {
+	maxConns, err := strconv.Atoi(os.Getenv(consts.StreamMaxConns))
+	if err != nil || maxConns <= 0 {
+		maxConns = defaultMaxConns
+	}
+	heartbeat, err := time.ParseDuration(os.Getenv(consts.StreamHeartbeatInterval))
+	if err != nil || heartbeat <= 0 {
+		heartbeat = defaultHeartbeat
+	}
+
+	sub, err := ps.Subscribe(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	h := &Hub{
+		sub:       sub,
+		counter:   counter,
+		maxConns:  maxConns,
+		heartbeat: heartbeat,
+		users:     make(map[int64]*userConns),
+	}
+	go h.run(ctx)
+	go h.refresh(ctx)
+
+	return h, nil
+}
+
+// Join registers a stream of the user, it fails once the user holds maxConns
+// on all gateways together.
+func (h *Hub) Join(ctx context.Context, userID int64) (*Conn, error) {
+	if err := h.acquire(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	h.mu.Lock()
+	u := h.users[userID]
+	if u == nil {
+		u = &userConns{conns: make(map[*Conn]struct{})}
+		h.users[userID] = u
+	}
+	u.joining++
+	h.mu.Unlock()
+
+	u.subMu.Lock()
+	var err error
+	if !u.subscribed {
+		err = h.sub.Subscribe(ctx, channel(userID))
+		u.subscribed = err == nil
+	}
+	u.subMu.Unlock()
+
+	c := &Conn{
+		userID:  userID,
+		events:  make(chan *model.TaskEvent, connBuffer),
+		dropped: make(chan struct{}),
+	}
+	h.mu.Lock()
+	u.joining--
+	if err == nil {
+		u.conns[c] = struct{}{}
+	}
+	h.mu.Unlock()
+
+	if err != nil {
+		h.release(userID)
+		h.unsubscribeIdle(userID, u)
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// Leave unregisters the stream, the last one of a user ends the subscription to
+// the channel of the user.
+func (h *Hub) Leave(c *Conn) {
+	h.mu.Lock()
+	u, ok := h.users[c.userID]
+	if ok {
+		_, ok = u.conns[c]
+		delete(u.conns, c)
+	}
+	h.mu.Unlock()
+
+	if !ok {
+		return
+	}
+	h.release(c.userID)
+	h.unsubscribeIdle(c.userID, u)
+}
+
+// unsubscribeIdle ends the subscription to the channel of the user once u holds
+// no streams, and forgets u. u stays registered until the subscription ended,
+// so a join meanwhile finds it and subscribes again after.
+func (h *Hub) unsubscribeIdle(userID int64, u *userConns) {
+	u.subMu.Lock()
+	defer u.subMu.Unlock()
+
+	h.mu.Lock()
+	idle := u.idle()
+	h.mu.Unlock()
+	if !idle {
+		return
+	}
+
+	if u.subscribed {
+		if err := h.sub.Unsubscribe(context.Background(), channel(userID)); err != nil {
+			logs.Warnf("unsubscribe task events failed, userID=%d, err=%v", userID, err)
+		}
+		u.subscribed = false
+	}
+
+	h.mu.Lock()
+	if u.idle() && h.users[userID] == u {
+		delete(h.users, userID)
+	}
+	h.mu.Unlock()
+}
+
+// Heartbeat is the interval streams send heartbeats at.
+func (h *Hub) Heartbeat() time.Duration {
+	return h.heartbeat
+}
+
+// Events delivers the live events of the user.
+func (c *Conn) Events() <-chan *model.TaskEvent {
+	return c.events
+}
+
+// Dropped is closed once the hub gave up on the connection.
+func (c *Conn) Dropped() <-chan struct{} {
+	return c.dropped
+}
+
+func (h *Hub) run(ctx context.Context) {
+	go func() {
+		<-ctx.Done()
+		_ = h.sub.Close()
+	}()
+
+	for msg := range h.sub.Channel() {
+		userID, err := conv.StrToInt64(strings.TrimPrefix(msg.Channel, consts.TaskEventChannelPrefix))
+		if err != nil {
+			continue
+		}
+		event := &model.TaskEvent{}
+		if err := json.Unmarshal(msg.Payload, event); err != nil {
+			logs.Warnf("decode task event failed, channel=%s, err=%v", msg.Channel, err)
+			continue
+		}
+		h.dispatch(userID, event)
+	}
+
+	// the subscription is gone, let the clients reconnect elsewhere
+	var dropped []*Conn
+	h.mu.Lock()
+	for userID, u := range h.users {
+		for c := range u.conns {
+			close(c.dropped)
+			dropped = append(dropped, c)
+		}
+		clear(u.conns)
+		delete(h.users, userID)
+	}
+	h.mu.Unlock()
+
+	for _, c := range dropped {
+		h.release(c.userID)
+	}
+}
+
+func (h *Hub) dispatch(userID int64, event *model.TaskEvent) {
+	var dropped int
+	h.mu.Lock()
+	u, ok := h.users[userID]
+	if ok {
+		for c := range u.conns {
+			select {
+			case c.events <- event:
+			default:
+				// a stalled client must not hold up the others
+				delete(u.conns, c)
+				close(c.dropped)
+				dropped++
+			}
+		}
+	}
+	h.mu.Unlock()
+
+	if dropped == 0 {
+		return
+	}
+	for range dropped {
+		h.release(userID)
+	}
+	h.unsubscribeIdle(userID, u)
+}
+
+// acquire counts a new stream of the user, it fails once the user holds
+// maxConns.
+func (h *Hub) acquire(ctx context.Context, userID int64) error {
+	key := connsKey(userID)
+	n, err := h.counter.Incr(ctx, key).Result()
+	if err != nil {
+		return err
+	}
+	if err := h.counter.Expire(ctx, key, h.connsTTL()).Err(); err != nil {
+		logs.Warnf("refresh task stream count failed, userID=%d, err=%v", userID, err)
+	}
+	if n > int64(h.maxConns) {
+		h.release(userID)
+		return errorx.New(errno.ErrTaskStreamLimitCode, errorx.KVf("limit", "%d", h.maxConns))
+	}
+
+	return nil
+}
+
+// release uncounts a stream of the user.
+func (h *Hub) release(userID int64) {
+	ctx := context.Background()
+	key := connsKey(userID)
+	n, err := h.counter.IncrBy(ctx, key, -1).Result()
+	if err != nil {
+		logs.Warnf("release task stream failed, userID=%d, err=%v", userID, err)
+		return
+	}
+	// the count expired while the stream was open
+	if n < 0 {
+		_ = h.counter.Del(ctx, key).Err()
+	}
+}
+
+// refresh keeps the stream counts of the users with streams on this gateway
+// alive until ctx is done.
+func (h *Hub) refresh(ctx context.Context) {
+	ticker := time.NewTicker(h.heartbeat)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		h.mu.Lock()
+		userIDs := make([]int64, 0, len(h.users))
+		for userID := range h.users {
+			userIDs = append(userIDs, userID)
+		}
+		h.mu.Unlock()
+
+		for _, userID := range userIDs {
+			if err := h.counter.Expire(ctx, connsKey(userID), h.connsTTL()).Err(); err != nil {
+				logs.Warnf("refresh task stream count failed, userID=%d, err=%v", userID, err)
+			}
+		}
+	}
+}
+
+func (h *Hub) connsTTL() time.Duration {
+	return connsTTLBeats * h.heartbeat
+}
+
+func connsKey(userID int64) string {
+	return consts.TaskStreamConnsPrefix + conv.Int64ToStr(userID)
+}
+
+func channel(userID int64) string {
+	return consts.TaskEventChannelPrefix + conv.Int64ToStr(userID)
+}
@@ -0,0 +1,371 @@
+package stream
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/crazyfrankie/zrpc-todolist/infra/contract/cache"
+	"github.com/crazyfrankie/zrpc-todolist/infra/contract/pubsub"
+	"github.com/crazyfrankie/zrpc-todolist/interfaces/task/model"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/errorx"
+	"github.com/crazyfrankie/zrpc-todolist/types/consts"
+	"github.com/crazyfrankie/zrpc-todolist/types/errno"
+)
+
+const waitTimeout = time.Second
+
+// memPubSub delivers the published messages to the subscriptions of the channel.
+type memPubSub struct {
+	mu   sync.Mutex
+	subs []*memSubscription
+}
+
+func (p *memPubSub) Publish(_ context.Context, channel string, payload []byte) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for _, sub := range p.subs {
+		if sub.subscribed(channel) {
+			sub.ch <- &pubsub.Message{Channel: channel, Payload: payload}
+		}
+	}
+	return nil
+}
+
+func (p *memPubSub) Subscribe(ctx context.Context, channels ...string) (pubsub.Subscription, error) {
+	sub := &memSubscription{channels: make(map[string]bool), ch: make(chan *pubsub.Message, 256)}
+	_ = sub.Subscribe(ctx, channels...)
+
+	p.mu.Lock()
+	p.subs = append(p.subs, sub)
+	p.mu.Unlock()
+	return sub, nil
+}
+
+type memSubscription struct {
+	mu       sync.Mutex
+	channels map[string]bool
+	ch       chan *pubsub.Message
+	// err fails the subscriptions
+	err error
+}
+
+func (s *memSubscription) subscribed(channel string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.channels[channel]
+}
+
+func (s *memSubscription) Subscribe(_ context.Context, channels ...string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.err != nil {
+		return s.err
+	}
+	for _, c := range channels {
+		s.channels[c] = true
+	}
+	return nil
+}
+
+func (s *memSubscription) Unsubscribe(_ context.Context, channels ...string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, c := range channels {
+		delete(s.channels, c)
+	}
+	return nil
+}
+
+func (s *memSubscription) Channel() <-chan *pubsub.Message {
+	return s.ch
+}
+
+func (s *memSubscription) Close() error {
+	return nil
+}
+
+// memCounter is the cache the gateways share, only counters are supported.
+type memCounter struct {
+	cache.Cmdable
+
+	mu   sync.Mutex
+	vals map[string]int64
+	ttls map[string]time.Duration
+	// stall holds up the calls for stalledKey until it is closed
+	stalledKey string
+	stall      chan struct{}
+}
+
+func newMemCounter() *memCounter {
+	return &memCounter{vals: make(map[string]int64), ttls: make(map[string]time.Duration)}
+}
+
+func (m *memCounter) Incr(ctx context.Context, key string) cache.IntCmd {
+	return m.IncrBy(ctx, key, 1)
+}
+
+func (m *memCounter) IncrBy(_ context.Context, key string, value int64) cache.IntCmd {
+	if key == m.stalledKey {
+		<-m.stall
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.vals[key] += value
+	return intCmd(m.vals[key])
+}
+
+func (m *memCounter) Expire(_ context.Context, key string, expiration time.Duration) cache.BoolCmd {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.ttls[key] = expiration
+	return boolCmd(true)
+}
+
+func (m *memCounter) Del(_ context.Context, keys ...string) cache.IntCmd {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, key := range keys {
+		delete(m.vals, key)
+	}
+	return intCmd(len(keys))
+}
+
+func (m *memCounter) count(userID int64) int64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.vals[connsKey(userID)]
+}
+
+type intCmd int64
+
+func (c intCmd) Err() error             { return nil }
+func (c intCmd) Result() (int64, error) { return int64(c), nil }
+
+type boolCmd bool
+
+func (c boolCmd) Err() error            { return nil }
+func (c boolCmd) Result() (bool, error) { return bool(c), nil }
+
+func newTestHub(t *testing.T, ps *memPubSub, counter *memCounter, maxConns int) *Hub {
+	t.Helper()
+
+	t.Setenv(consts.StreamMaxConns, strconv.Itoa(maxConns))
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	h, err := NewHub(ctx, ps, counter)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return h
+}
+
+func join(t *testing.T, h *Hub, userID int64) *Conn {
+	t.Helper()
+
+	c, err := h.Join(context.Background(), userID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func publish(t *testing.T, ps *memPubSub, userID int64, event *model.TaskEvent) {
+	t.Helper()
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ps.Publish(context.Background(), channel(userID), payload); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func receive(t *testing.T, c *Conn) *model.TaskEvent {
+	t.Helper()
+
+	select {
+	case event := <-c.Events():
+		return event
+	case <-time.After(waitTimeout):
+		t.Fatal("no event received")
+		return nil
+	}
+}
+
+func TestHubFanOut(t *testing.T) {
+	ps := &memPubSub{}
+	h := newTestHub(t, ps, newMemCounter(), 5)
+
+	first, second := join(t, h, 1), join(t, h, 1)
+	other := join(t, h, 2)
+
+	publish(t, ps, 1, &model.TaskEvent{ID: 7, Type: "updated", TaskID: 42})
+	for _, c := range []*Conn{first, second} {
+		if got := receive(t, c); got.ID != 7 || got.TaskID != 42 {
+			t.Errorf("event = %+v, want event 7 of task 42", got)
+		}
+	}
+
+	// the stream of another user only sees its own events
+	publish(t, ps, 2, &model.TaskEvent{ID: 8, Type: "created", TaskID: 43})
+	if got := receive(t, other); got.ID != 8 {
+		t.Errorf("other user got event %d, want 8", got.ID)
+	}
+	select {
+	case event := <-first.Events():
+		t.Errorf("user 1 got event %d of user 2", event.ID)
+	default:
+	}
+}
+
+func TestHubDropsSlowConsumer(t *testing.T) {
+	ps := &memPubSub{}
+	counter := newMemCounter()
+	h := newTestHub(t, ps, counter, 5)
+
+	slow, fast := join(t, h, 1), join(t, h, 1)
+
+	// the fast stream reads each event before the next one, the slow stream
+	// overflows with the last
+	for i := range connBuffer + 1 {
+		publish(t, ps, 1, &model.TaskEvent{ID: int64(i + 1), Type: "updated"})
+		if got := receive(t, fast); got.ID != int64(i+1) {
+			t.Fatalf("fast stream got event %d, want %d", got.ID, i+1)
+		}
+	}
+
+	select {
+	case <-slow.Dropped():
+	case <-time.After(waitTimeout):
+		t.Fatal("slow stream was not dropped")
+	}
+	select {
+	case <-fast.Dropped():
+		t.Error("fast stream was dropped")
+	default:
+	}
+
+	// the dropped stream gave its slot back, leaving it again is a no-op
+	if n := counter.count(1); n != 1 {
+		t.Errorf("count = %d, want 1", n)
+	}
+	h.Leave(slow)
+	if n := counter.count(1); n != 1 {
+		t.Errorf("count after leaving the dropped stream = %d, want 1", n)
+	}
+}
+
+func TestHubLimitAcrossGateways(t *testing.T) {
+	ps := &memPubSub{}
+	counter := newMemCounter()
+	gateway1 := newTestHub(t, ps, counter, 2)
+	gateway2 := newTestHub(t, ps, counter, 2)
+
+	c := join(t, gateway1, 1)
+	join(t, gateway2, 1)
+
+	_, err := gateway1.Join(context.Background(), 1)
+	var statusErr errorx.StatusError
+	if !errors.As(err, &statusErr) || statusErr.Code() != errno.ErrTaskStreamLimitCode {
+		t.Fatalf("third stream: err = %v, want code %d", err, errno.ErrTaskStreamLimitCode)
+	}
+	if n := counter.count(1); n != 2 {
+		t.Errorf("count after the rejected stream = %d, want 2", n)
+	}
+	if ttl := counter.ttls[connsKey(1)]; ttl != connsTTLBeats*gateway1.Heartbeat() {
+		t.Errorf("count ttl = %v, want %v", ttl, connsTTLBeats*gateway1.Heartbeat())
+	}
+	// other users are counted on their own
+	join(t, gateway2, 2)
+
+	gateway1.Leave(c)
+	join(t, gateway2, 1)
+}
+
+func TestHubUnsubscribesWithTheLastStream(t *testing.T) {
+	ps := &memPubSub{}
+	h := newTestHub(t, ps, newMemCounter(), 5)
+	sub := ps.subs[0]
+
+	first, second := join(t, h, 1), join(t, h, 1)
+	h.Leave(first)
+	if !sub.subscribed(channel(1)) {
+		t.Fatal("unsubscribed while a stream is open")
+	}
+	h.Leave(second)
+	if sub.subscribed(channel(1)) {
+		t.Error("still subscribed without streams")
+	}
+}
+
+func TestHubJoinSubscribeFailure(t *testing.T) {
+	ps := &memPubSub{}
+	counter := newMemCounter()
+	h := newTestHub(t, ps, counter, 5)
+	sub := ps.subs[0]
+
+	sub.err = errors.New("connection reset")
+	if _, err := h.Join(context.Background(), 1); err == nil {
+		t.Fatal("Join() succeeded without a subscription")
+	}
+	// the failed join gave its slot back
+	if n := counter.count(1); n != 0 {
+		t.Errorf("count after the failed join = %d, want 0", n)
+	}
+
+	sub.err = nil
+	c := join(t, h, 1)
+	publish(t, ps, 1, &model.TaskEvent{ID: 9, Type: "updated"})
+	if got := receive(t, c); got.ID != 9 {
+		t.Errorf("event = %d, want 9", got.ID)
+	}
+}
+
+func TestHubStalledCacheHoldsUpOneUser(t *testing.T) {
+	ps := &memPubSub{}
+	counter := newMemCounter()
+	h := newTestHub(t, ps, counter, 5)
+
+	other := join(t, h, 2)
+	counter.stalledKey, counter.stall = connsKey(1), make(chan struct{})
+
+	joined := make(chan error, 1)
+	go func() {
+		_, err := h.Join(context.Background(), 1)
+		joined <- err
+	}()
+
+	// the join waiting on the cache leaves the hub to the others
+	publish(t, ps, 2, &model.TaskEvent{ID: 10, Type: "updated"})
+	if got := receive(t, other); got.ID != 10 {
+		t.Errorf("event = %d, want 10", got.ID)
+	}
+	done := make(chan struct{})
+	go func() {
+		h.Leave(join(t, h, 3))
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(waitTimeout):
+		t.Fatal("another user's join waited for the stalled one")
+	}
+
+	close(counter.stall)
+	select {
+	case err := <-joined:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(waitTimeout):
+		t.Fatal("stalled join never finished")
+	}
+}
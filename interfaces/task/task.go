@@ -5,7 +5,10 @@ import (
 	"net/http"
 
 	"github.com/crazyfrankie/zrpc"
+	"github.com/crazyfrankie/zrpc-todolist/infra/impl/cache/redis"
+	pubsubimpl "github.com/crazyfrankie/zrpc-todolist/infra/impl/pubsub"
 	"github.com/crazyfrankie/zrpc-todolist/interfaces/task/handler"
+	"github.com/crazyfrankie/zrpc-todolist/interfaces/task/stream"
 	"github.com/crazyfrankie/zrpc-todolist/pkg/gin/middleware"
 	"github.com/crazyfrankie/zrpc-todolist/protocol/auth"
 	"github.com/crazyfrankie/zrpc-todolist/protocol/task"
@@ -27,12 +30,20 @@ func Start(ctx context.Context, getConn func(service string) (zrpc.ClientInterfa
 
 	taskCli := task.NewTaskServiceClient(taskCC)
 	authCli := auth.NewAuthServiceClient(authCC)
-	taskHdl := handler.NewTaskHandler(taskCli)
+	streamHub, err := stream.NewHub(ctx, pubsubimpl.New(), redis.New())
+	if err != nil {
+		return nil, err
+	}
+	taskHdl := handler.NewTaskHandler(taskCli, streamHub)
 	authHdl, err := middleware.NewAuthnHandler(authCli)
 	if err != nil {
 		return nil, err
 	}
 
+	// calendar apps can't send a Bearer token, the feed token in the path is enough
+	authHdl.IgnorePath([]string{"/api/task/calendar/:file"})
+	// browsers can't set headers on EventSource and WebSocket connections
+	authHdl.QueryTokenPath([]string{"/api/task/stream", "/api/task/stream/ws"})
 	middlewares = append(middlewares, authHdl.Auth())
 
 	srv.Use(middlewares...)
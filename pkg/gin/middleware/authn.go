@@ -14,14 +14,21 @@ import (
 )
 
 type AuthnHandler struct {
-	noAuthPaths map[string]struct{}
-	authClient  auth.AuthServiceClient
+	noAuthPaths     map[string]struct{}
+	queryTokenPaths map[string]struct{}
+	authClient      auth.AuthServiceClient
 }
 
 func NewAuthnHandler(authClient auth.AuthServiceClient) (*AuthnHandler, error) {
-	return &AuthnHandler{authClient: authClient, noAuthPaths: make(map[string]struct{})}, nil
+	return &AuthnHandler{
+		authClient:      authClient,
+		noAuthPaths:     make(map[string]struct{}),
+		queryTokenPaths: make(map[string]struct{}),
+	}, nil
 }
 
+// IgnorePath skips the authentication of the paths, a path is either a request
+// path or a route such as "/api/task/calendar/:file".
 func (h *AuthnHandler) IgnorePath(paths []string) *AuthnHandler {
 	for _, path := range paths {
 		h.noAuthPaths[path] = struct{}{}
@@ -29,21 +36,29 @@ func (h *AuthnHandler) IgnorePath(paths []string) *AuthnHandler {
 	return h
 }
 
+// QueryTokenPath accepts the access token in the access_token query parameter
+// on the paths, for the WebSocket and Server-Sent Events clients of browsers
+// which can't set headers. Paths are matched like in IgnorePath.
+func (h *AuthnHandler) QueryTokenPath(paths []string) *AuthnHandler {
+	for _, path := range paths {
+		h.queryTokenPaths[path] = struct{}{}
+	}
+	return h
+}
+
 func (h *AuthnHandler) Auth() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		md := metadata.New(map[string]string{
 			"user_agent": c.Request.UserAgent(),
 		})
 
-		currentPath := c.Request.URL.Path
-
-		if _, ok := h.noAuthPaths[currentPath]; ok {
+		if h.ignored(c) {
 			c.Request = c.Request.WithContext(h.storeUserInfo(c, md))
 			c.Next()
 			return
 		}
 
-		accessToken, err := getAccessToken(c)
+		accessToken, err := h.getAccessToken(c)
 		if err != nil {
 			response.Unauthorized(c)
 			return
@@ -61,13 +76,28 @@ func (h *AuthnHandler) Auth() gin.HandlerFunc {
 	}
 }
 
+func (h *AuthnHandler) ignored(c *gin.Context) bool {
+	return matchPath(h.noAuthPaths, c)
+}
+
+func matchPath(paths map[string]struct{}, c *gin.Context) bool {
+	if _, ok := paths[c.Request.URL.Path]; ok {
+		return true
+	}
+	_, ok := paths[c.FullPath()]
+	return ok
+}
+
 func (h *AuthnHandler) storeUserInfo(c *gin.Context, md metadata.MD) context.Context {
 	return metadata.NewOutgoingContext(c.Request.Context(), md)
 }
 
-func getAccessToken(c *gin.Context) (string, error) {
+func (h *AuthnHandler) getAccessToken(c *gin.Context) (string, error) {
 	tokenHeader := c.GetHeader("Authorization")
 	if tokenHeader == "" {
+		if token := c.Query("access_token"); token != "" && matchPath(h.queryTokenPaths, c) {
+			return token, nil
+		}
 		return "", errors.New("no auth")
 	}
 
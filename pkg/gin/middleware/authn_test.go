@@ -0,0 +1,64 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/crazyfrankie/zrpc-todolist/protocol/auth"
+)
+
+type tokenParser struct {
+	auth.AuthServiceClient
+}
+
+func (tokenParser) ParseToken(_ context.Context, in *auth.ParseTokenRequest) (*auth.ParseTokenResponse, error) {
+	if in.GetToken() != "valid" {
+		return nil, errors.New("invalid token")
+	}
+	return &auth.ParseTokenResponse{UserID: 1}, nil
+}
+
+func TestAuthQueryToken(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	h, _ := NewAuthnHandler(tokenParser{})
+	h.QueryTokenPath([]string{"/api/task/stream", "/api/task/stream/ws"})
+	r := gin.New()
+	r.Use(h.Auth())
+	for _, path := range []string{"/api/task/stream", "/api/task/stream/ws", "/api/task/list"} {
+		r.GET(path, func(c *gin.Context) { c.Status(http.StatusOK) })
+	}
+
+	tests := []struct {
+		name   string
+		target string
+		header string
+		want   int
+	}{
+		{name: "header", target: "/api/task/list", header: "Bearer valid", want: http.StatusOK},
+		{name: "query on the event stream", target: "/api/task/stream?access_token=valid", want: http.StatusOK},
+		{name: "query on the websocket", target: "/api/task/stream/ws?access_token=valid", want: http.StatusOK},
+		{name: "query elsewhere", target: "/api/task/list?access_token=valid", want: http.StatusUnauthorized},
+		{name: "invalid query token", target: "/api/task/stream?access_token=forged", want: http.StatusUnauthorized},
+		{name: "no token", target: "/api/task/stream", want: http.StatusUnauthorized},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, tt.target, nil)
+			if tt.header != "" {
+				req.Header.Set("Authorization", tt.header)
+			}
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			if w.Code != tt.want {
+				t.Errorf("status = %d, want %d", w.Code, tt.want)
+			}
+		})
+	}
+}
@@ -35,6 +35,21 @@ func InvalidParamError(c *gin.Context, message string) {
 	})
 }
 
+func NotFound(c *gin.Context, err error) {
+	ginJSON(c, http.StatusNotFound, ParseError(err))
+}
+
+func PreconditionFailed(c *gin.Context, err error) {
+	ginJSON(c, http.StatusPreconditionFailed, ParseError(err))
+}
+
+func TooManyRequests(c *gin.Context, code int32, message string) {
+	ginJSON(c, http.StatusTooManyRequests, &Response{
+		Code:    code,
+		Message: message,
+	})
+}
+
 func Unauthorized(c *gin.Context) {
 	abortGinJSON(c, http.StatusUnauthorized, &Response{
 		Code:    UnauthorizedCode,
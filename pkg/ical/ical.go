@@ -0,0 +1,591 @@
+// Package ical reads and writes the VTODO components of RFC 5545 calendars,
+// and writes VEVENT components for calendar apps which don't show todos. Only
+// the properties used by tasks are supported, the other components and
+// properties are skipped when parsing.
+package ical
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// Todo statuses.
+const (
+	StatusNeedsAction = "NEEDS-ACTION"
+	StatusInProcess   = "IN-PROCESS"
+	StatusCompleted   = "COMPLETED"
+	StatusCancelled   = "CANCELLED"
+)
+
+type Todo struct {
+	UID         string
+	Summary     string
+	Description string
+	Status      string
+	// Priority ranges from 1 (highest) to 9 (lowest), zero means undefined.
+	Priority int
+
+	// Due is zero for todos without a due time. TimeZone is the IANA zone the
+	// due time is written in, empty writes it in UTC.
+	Due      time.Time
+	TimeZone string
+	// Alarm is the time of the reminder, zero for none.
+	Alarm time.Time
+	RRule string
+
+	Categories   []string
+	Created      time.Time
+	LastModified time.Time
+
+	// X holds the extension properties, keys include the X- prefix.
+	X map[string]string
+}
+
+// Event is a point in time on the calendar, such as the due time of a task.
+type Event struct {
+	UID         string
+	Summary     string
+	Description string
+
+	// End is zero for an event lasting no time. TimeZone is the IANA zone the
+	// times are written in, empty writes them in UTC.
+	Start    time.Time
+	End      time.Time
+	TimeZone string
+	// Alarm is the time of the reminder, zero for none.
+	Alarm time.Time
+	RRule string
+
+	Categories   []string
+	Created      time.Time
+	LastModified time.Time
+}
+
+const (
+	dateTimeLayout    = "20060102T150405"
+	utcDateTimeLayout = "20060102T150405Z"
+	dateLayout        = "20060102"
+	// maxLineOctets is the length lines are folded at, without the CRLF.
+	maxLineOctets = 75
+)
+
+// Writer writes todos and events as a VCALENDAR, Close must be called to end it.
+type Writer struct {
+	w      *bufio.Writer
+	prodID string
+	props  [][2]string
+	begun  bool
+}
+
+func NewWriter(w io.Writer, prodID string) *Writer {
+	return &Writer{w: bufio.NewWriter(w), prodID: prodID}
+}
+
+func (w *Writer) begin() {
+	if w.begun {
+		return
+	}
+	w.begun = true
+	w.line("BEGIN:VCALENDAR")
+	w.line("VERSION:2.0")
+	w.line("PRODID:" + escapeText(w.prodID))
+	w.line("CALSCALE:GREGORIAN")
+	for _, prop := range w.props {
+		w.line(prop[0] + ":" + escapeText(prop[1]))
+	}
+}
+
+// SetProperty adds a property of the calendar such as X-WR-CALNAME, name may
+// carry parameters. It has no effect once something was written.
+func (w *Writer) SetProperty(name, value string) {
+	w.props = append(w.props, [2]string{name, value})
+}
+
+func (w *Writer) Write(todo *Todo) error {
+	w.begin()
+
+	w.line("BEGIN:VTODO")
+	w.header(todo.UID, todo.Summary, todo.Description, todo.Created, todo.LastModified)
+	if todo.Status != "" {
+		w.line("STATUS:" + todo.Status)
+	}
+	if todo.Priority > 0 {
+		w.line("PRIORITY:" + strconv.Itoa(todo.Priority))
+	}
+	if !todo.Due.IsZero() {
+		w.line("DUE" + formatTime(todo.Due, todo.TimeZone))
+	}
+	if todo.RRule != "" {
+		w.line("RRULE:" + todo.RRule)
+	}
+	w.categories(todo.Categories)
+	keys := make([]string, 0, len(todo.X))
+	for k := range todo.X {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		w.line(k + ":" + escapeText(todo.X[k]))
+	}
+	w.alarm(todo.Alarm, todo.Summary)
+	w.line("END:VTODO")
+
+	return w.w.Flush()
+}
+
+// WriteEvent writes the event as a VEVENT which doesn't block time.
+func (w *Writer) WriteEvent(event *Event) error {
+	w.begin()
+
+	w.line("BEGIN:VEVENT")
+	w.header(event.UID, event.Summary, event.Description, event.Created, event.LastModified)
+	w.line("DTSTART" + formatTime(event.Start, event.TimeZone))
+	if !event.End.IsZero() {
+		w.line("DTEND" + formatTime(event.End, event.TimeZone))
+	}
+	w.line("TRANSP:TRANSPARENT")
+	if event.RRule != "" {
+		w.line("RRULE:" + event.RRule)
+	}
+	w.categories(event.Categories)
+	w.alarm(event.Alarm, event.Summary)
+	w.line("END:VEVENT")
+
+	return w.w.Flush()
+}
+
+// header writes the properties todos and events share, DTSTAMP is the last
+// modification so an unchanged component is written the same every time.
+func (w *Writer) header(uid, summary, description string, created, lastModified time.Time) {
+	w.line("UID:" + escapeText(uid))
+	stamp := lastModified
+	if stamp.IsZero() {
+		stamp = time.Now()
+	}
+	w.line("DTSTAMP:" + stamp.UTC().Format(utcDateTimeLayout))
+	if !created.IsZero() {
+		w.line("CREATED:" + created.UTC().Format(utcDateTimeLayout))
+	}
+	if !lastModified.IsZero() {
+		w.line("LAST-MODIFIED:" + lastModified.UTC().Format(utcDateTimeLayout))
+	}
+	w.line("SUMMARY:" + escapeText(summary))
+	if description != "" {
+		w.line("DESCRIPTION:" + escapeText(description))
+	}
+}
+
+func (w *Writer) categories(categories []string) {
+	if len(categories) == 0 {
+		return
+	}
+	values := make([]string, len(categories))
+	for i, c := range categories {
+		values[i] = escapeText(c)
+	}
+	w.line("CATEGORIES:" + strings.Join(values, ","))
+}
+
+func (w *Writer) alarm(at time.Time, summary string) {
+	if at.IsZero() {
+		return
+	}
+	w.line("BEGIN:VALARM")
+	w.line("ACTION:DISPLAY")
+	w.line("DESCRIPTION:" + escapeText(summary))
+	w.line("TRIGGER;VALUE=DATE-TIME:" + at.UTC().Format(utcDateTimeLayout))
+	w.line("END:VALARM")
+}
+
+// Close ends the calendar and flushes it.
+func (w *Writer) Close() error {
+	w.begin()
+	w.line("END:VCALENDAR")
+
+	return w.w.Flush()
+}
+
+// line writes a content line folded at maxLineOctets, write errors are kept by
+// the bufio.Writer and returned by the next Flush.
+func (w *Writer) line(s string) {
+	for len(s) > maxLineOctets {
+		n := maxLineOctets
+		for n > 0 && !utf8.RuneStart(s[n]) {
+			n--
+		}
+		w.w.WriteString(s[:n])
+		w.w.WriteString("\r\n ")
+		s = s[n:]
+	}
+	w.w.WriteString(s)
+	w.w.WriteString("\r\n")
+}
+
+func formatTime(t time.Time, timeZone string) string {
+	if timeZone != "" {
+		if loc, err := time.LoadLocation(timeZone); err == nil {
+			return ";TZID=" + timeZone + ":" + t.In(loc).Format(dateTimeLayout)
+		}
+	}
+
+	return ":" + t.UTC().Format(utcDateTimeLayout)
+}
+
+func escapeText(s string) string {
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`).Replace(s)
+}
+
+// ParseError reports a malformed content line.
+type ParseError struct {
+	Line int
+	Err  error
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("ical: line %d: %v", e.Line, e.Err)
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+type contentLine struct {
+	num    int
+	name   string
+	params map[string]string
+	value  string
+}
+
+// Parse reads the todos of a calendar.
+func Parse(r io.Reader) ([]*Todo, error) {
+	lines, err := readLines(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var todos []*Todo
+	var todo *Todo
+	// alarm is the VALARM being read, nil outside of one
+	var alarm *contentLine
+	depth := 0
+	for _, l := range lines {
+		switch l.name {
+		case "BEGIN":
+			depth++
+			switch {
+			case strings.EqualFold(l.value, "VTODO") && todo == nil:
+				todo = &Todo{}
+			case strings.EqualFold(l.value, "VALARM") && todo != nil:
+				alarm = &contentLine{}
+			}
+			continue
+		case "END":
+			depth--
+			if depth < 0 {
+				return nil, &ParseError{Line: l.num, Err: errors.New("unexpected END")}
+			}
+			switch {
+			case strings.EqualFold(l.value, "VALARM") && alarm != nil:
+				if alarm.name != "" && todo.Alarm.IsZero() {
+					todo.Alarm, err = alarmTime(alarm, todo.Due)
+					if err != nil {
+						return nil, &ParseError{Line: alarm.num, Err: err}
+					}
+				}
+				alarm = nil
+			case strings.EqualFold(l.value, "VTODO") && todo != nil:
+				todos = append(todos, todo)
+				todo = nil
+			}
+			continue
+		}
+		if todo == nil {
+			continue
+		}
+		if alarm != nil {
+			if l.name == "TRIGGER" {
+				*alarm = l
+			}
+			continue
+		}
+		if err := setProperty(todo, l); err != nil {
+			return nil, &ParseError{Line: l.num, Err: err}
+		}
+	}
+	if depth != 0 || todo != nil {
+		return nil, errors.New("ical: unterminated component")
+	}
+
+	return todos, nil
+}
+
+// readLines unfolds and splits the content lines.
+func readLines(r io.Reader) ([]contentLine, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1<<20)
+
+	var lines []contentLine
+	var cur strings.Builder
+	start, num := 0, 0
+	flush := func() error {
+		if cur.Len() == 0 {
+			return nil
+		}
+		l, err := parseLine(cur.String())
+		if err != nil {
+			return &ParseError{Line: start, Err: err}
+		}
+		l.num = start
+		lines = append(lines, l)
+		cur.Reset()
+		return nil
+	}
+	for scanner.Scan() {
+		num++
+		text := strings.TrimSuffix(scanner.Text(), "\r")
+		if num == 1 {
+			text = strings.TrimPrefix(text, "\ufeff")
+		}
+		if strings.HasPrefix(text, " ") || strings.HasPrefix(text, "\t") {
+			cur.WriteString(text[1:])
+			continue
+		}
+		if err := flush(); err != nil {
+			return nil, err
+		}
+		start = num
+		cur.WriteString(text)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if err := flush(); err != nil {
+		return nil, err
+	}
+
+	return lines, nil
+}
+
+func parseLine(s string) (contentLine, error) {
+	// the value starts at the first colon outside of a quoted parameter value
+	quoted := false
+	colon := -1
+	for i := 0; i < len(s) && colon < 0; i++ {
+		switch s[i] {
+		case '"':
+			quoted = !quoted
+		case ':':
+			if !quoted {
+				colon = i
+			}
+		}
+	}
+	if colon < 0 {
+		return contentLine{}, fmt.Errorf("missing value in %q", s)
+	}
+
+	parts := strings.Split(s[:colon], ";")
+	l := contentLine{
+		name:  strings.ToUpper(parts[0]),
+		value: s[colon+1:],
+	}
+	if l.name == "" {
+		return contentLine{}, errors.New("missing property name")
+	}
+	for _, p := range parts[1:] {
+		k, v, ok := strings.Cut(p, "=")
+		if !ok {
+			return contentLine{}, fmt.Errorf("invalid parameter %q", p)
+		}
+		if l.params == nil {
+			l.params = make(map[string]string)
+		}
+		l.params[strings.ToUpper(k)] = strings.Trim(v, `"`)
+	}
+
+	return l, nil
+}
+
+func setProperty(todo *Todo, l contentLine) error {
+	var err error
+	switch l.name {
+	case "UID":
+		todo.UID = unescapeText(l.value)
+	case "SUMMARY":
+		todo.Summary = unescapeText(l.value)
+	case "DESCRIPTION":
+		todo.Description = unescapeText(l.value)
+	case "STATUS":
+		todo.Status = strings.ToUpper(l.value)
+	case "PRIORITY":
+		todo.Priority, err = strconv.Atoi(l.value)
+		if err == nil && (todo.Priority < 0 || todo.Priority > 9) {
+			err = fmt.Errorf("invalid priority %d", todo.Priority)
+		}
+	case "DUE":
+		todo.Due, err = parseTime(l)
+		todo.TimeZone = l.params["TZID"]
+	case "RRULE":
+		todo.RRule = l.value
+	case "CATEGORIES":
+		for _, c := range splitText(l.value) {
+			if c = strings.TrimSpace(c); c != "" {
+				todo.Categories = append(todo.Categories, c)
+			}
+		}
+	case "CREATED":
+		todo.Created, err = parseTime(l)
+	case "LAST-MODIFIED":
+		todo.LastModified, err = parseTime(l)
+	default:
+		if strings.HasPrefix(l.name, "X-") {
+			if todo.X == nil {
+				todo.X = make(map[string]string)
+			}
+			todo.X[l.name] = unescapeText(l.value)
+		}
+	}
+
+	return err
+}
+
+// parseTime reads a DATE or DATE-TIME value, floating times are read as UTC.
+func parseTime(l contentLine) (time.Time, error) {
+	if l.params["VALUE"] == "DATE" || len(l.value) == len(dateLayout) {
+		return time.Parse(dateLayout, l.value)
+	}
+	if strings.HasSuffix(l.value, "Z") {
+		return time.Parse(utcDateTimeLayout, l.value)
+	}
+
+	loc := time.UTC
+	if tzid := l.params["TZID"]; tzid != "" {
+		var err error
+		loc, err = time.LoadLocation(tzid)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("unknown time zone %q", tzid)
+		}
+	}
+
+	return time.ParseInLocation(dateTimeLayout, l.value, loc)
+}
+
+// alarmTime resolves a TRIGGER. Todos have no start here, so relative triggers
+// are taken from the due time and dropped without one.
+func alarmTime(trigger *contentLine, due time.Time) (time.Time, error) {
+	if trigger.params["VALUE"] == "DATE-TIME" {
+		return parseTime(*trigger)
+	}
+	if due.IsZero() {
+		return time.Time{}, nil
+	}
+
+	d, err := parseDuration(trigger.value)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return due.Add(d), nil
+}
+
+// parseDuration reads a dur-value such as -PT15M or P1DT2H.
+func parseDuration(s string) (time.Duration, error) {
+	orig := s
+	sign := time.Duration(1)
+	switch {
+	case strings.HasPrefix(s, "-"):
+		sign, s = -1, s[1:]
+	case strings.HasPrefix(s, "+"):
+		s = s[1:]
+	}
+	if !strings.HasPrefix(s, "P") || len(s) < 3 {
+		return 0, fmt.Errorf("invalid duration %q", orig)
+	}
+	s = s[1:]
+
+	var d time.Duration
+	inTime := false
+	for s != "" {
+		if s[0] == 'T' {
+			inTime, s = true, s[1:]
+			continue
+		}
+		i := 0
+		for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+			i++
+		}
+		if i == 0 || i == len(s) {
+			return 0, fmt.Errorf("invalid duration %q", orig)
+		}
+		n, err := strconv.Atoi(s[:i])
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration %q", orig)
+		}
+		var unit time.Duration
+		switch {
+		case s[i] == 'W' && !inTime:
+			unit = 7 * 24 * time.Hour
+		case s[i] == 'D' && !inTime:
+			unit = 24 * time.Hour
+		case s[i] == 'H' && inTime:
+			unit = time.Hour
+		case s[i] == 'M' && inTime:
+			unit = time.Minute
+		case s[i] == 'S' && inTime:
+			unit = time.Second
+		default:
+			return 0, fmt.Errorf("invalid duration %q", orig)
+		}
+		d += time.Duration(n) * unit
+		s = s[i+1:]
+	}
+
+	return sign * d, nil
+}
+
+func unescapeText(s string) string {
+	if !strings.Contains(s, `\`) {
+		return s
+	}
+
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] != '\\' || i == len(s)-1 {
+			b.WriteByte(s[i])
+			continue
+		}
+		i++
+		switch s[i] {
+		case 'n', 'N':
+			b.WriteByte('\n')
+		default:
+			b.WriteByte(s[i])
+		}
+	}
+
+	return b.String()
+}
+
+// splitText splits a list of text values on the commas which are not escaped.
+func splitText(s string) []string {
+	var values []string
+	start := 0
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case ',':
+			values = append(values, unescapeText(s[start:i]))
+			start = i + 1
+		}
+	}
+
+	return append(values, unescapeText(s[start:]))
+}
@@ -0,0 +1,67 @@
+package outbox
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/crazyfrankie/zrpc-todolist/infra/contract/eventbus"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/logs"
+)
+
+const resubscribeDelay = 5 * time.Second
+
+// Consume runs handler on the events of the topic as a member of the group
+// until ctx is done, subscribing again whenever the subscription fails. Events
+// arrive at least once, handlers with side effects which must not repeat are
+// wrapped with Idempotent.
+func Consume(ctx context.Context, bus eventbus.Subscriber, topic, group string, handler eventbus.Handler) {
+	for {
+		err := bus.Subscribe(ctx, topic, group, handler)
+		if ctx.Err() != nil {
+			return
+		}
+		logs.CtxErrorf(ctx, "subscribe to events failed, topic=%s, group=%s, err=%v", topic, group, err)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(resubscribeDelay):
+		}
+	}
+}
+
+type txKey struct{}
+
+// Idempotent wraps handler so that it succeeds once per event for the group,
+// the redeliveries after that are skipped. The event is marked handled in a
+// transaction around handler, which TxFromContext hands to it so the writes
+// made by handler commit along with the mark. A failed handler leaves no mark.
+func Idempotent(db *gorm.DB, group string, handler eventbus.Handler) eventbus.Handler {
+	return func(ctx context.Context, event *eventbus.Event) error {
+		return db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			// a concurrent delivery of the event waits here until the first one is done
+			res := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&Consumption{
+				ConsumerGroup: group,
+				EventID:       event.ID,
+				ConsumedAt:    time.Now().UnixMilli(),
+			})
+			if res.Error != nil {
+				return res.Error
+			}
+			if res.RowsAffected == 0 {
+				return nil
+			}
+
+			return handler(context.WithValue(ctx, txKey{}, tx), event)
+		})
+	}
+}
+
+// TxFromContext returns the transaction an Idempotent handler runs in.
+func TxFromContext(ctx context.Context) (*gorm.DB, bool) {
+	tx, ok := ctx.Value(txKey{}).(*gorm.DB)
+	return tx, ok
+}
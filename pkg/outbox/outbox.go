@@ -0,0 +1,71 @@
+// Package outbox makes domain events as durable as the changes they describe.
+// Events are written to the outbox table in the transaction of the change, a
+// relay publishes them to the event bus once committed, and consumers are
+// helped to handle the redeliveries that at-least-once publishing implies.
+package outbox
+
+import (
+	"strconv"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/crazyfrankie/zrpc-todolist/infra/contract/eventbus"
+)
+
+// Message is an event in the outbox table.
+type Message struct {
+	ID          int64  `gorm:"column:id;primaryKey;autoIncrement:true"`
+	Topic       string `gorm:"column:topic;not null"`
+	EventKey    string `gorm:"column:event_key;not null"`
+	Payload     []byte `gorm:"column:payload;not null"`
+	CreatedAt   int64  `gorm:"column:created_at;not null"`
+	PublishedAt int64  `gorm:"column:published_at;not null"`
+}
+
+func (*Message) TableName() string {
+	return "outbox_event"
+}
+
+// Consumption marks an event handled by a consumer group.
+type Consumption struct {
+	ConsumerGroup string `gorm:"column:consumer_group;primaryKey"`
+	EventID       string `gorm:"column:event_id;primaryKey"`
+	ConsumedAt    int64  `gorm:"column:consumed_at;not null"`
+}
+
+func (*Consumption) TableName() string {
+	return "outbox_consumption"
+}
+
+// Add writes the events to the outbox within tx, they are published once tx
+// commits. The IDs of the events are assigned by the outbox.
+func Add(tx *gorm.DB, events ...*eventbus.Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	now := time.Now().UnixMilli()
+	msgs := make([]*Message, 0, len(events))
+	for _, event := range events {
+		msgs = append(msgs, &Message{
+			Topic:     event.Topic,
+			EventKey:  event.Key,
+			Payload:   event.Payload,
+			CreatedAt: now,
+		})
+	}
+
+	// a fresh statement, tx may carry the model of the caller
+	return tx.Session(&gorm.Session{NewDB: true}).Create(&msgs).Error
+}
+
+func messageToEvent(msg *Message) *eventbus.Event {
+	return &eventbus.Event{
+		ID:        strconv.FormatInt(msg.ID, 10),
+		Topic:     msg.Topic,
+		Key:       msg.EventKey,
+		Payload:   msg.Payload,
+		CreatedAt: msg.CreatedAt,
+	}
+}
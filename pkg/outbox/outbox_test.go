@@ -0,0 +1,321 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"github.com/crazyfrankie/zrpc-todolist/infra/contract/eventbus"
+	"github.com/crazyfrankie/zrpc-todolist/infra/impl/eventbus/memory"
+)
+
+const (
+	testTopic   = "test.events"
+	waitTimeout = 2 * time.Second
+)
+
+// handled is a write of a handler, it commits along with the consumption mark.
+type handled struct {
+	ID      int64  `gorm:"primaryKey;autoIncrement"`
+	EventID string `gorm:"not null"`
+}
+
+func newTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{Logger: logger.Discard})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// every connection to :memory: opens a database of its own
+	sqlDB.SetMaxOpenConns(1)
+	t.Cleanup(func() { _ = sqlDB.Close() })
+
+	if err := db.AutoMigrate(&Message{}, &Consumption{}, &handled{}); err != nil {
+		t.Fatal(err)
+	}
+	return db
+}
+
+func addEvents(t *testing.T, db *gorm.DB, keys ...string) {
+	t.Helper()
+
+	err := db.Transaction(func(tx *gorm.DB) error {
+		for _, key := range keys {
+			if err := Add(tx, &eventbus.Event{Topic: testTopic, Key: key, Payload: []byte(key)}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func pending(t *testing.T, db *gorm.DB) int64 {
+	t.Helper()
+
+	var n int64
+	if err := db.Model(&Message{}).Where("published_at = ?", 0).Count(&n).Error; err != nil {
+		t.Fatal(err)
+	}
+	return n
+}
+
+// collector records the keys of the events it handles, in order.
+type collector struct {
+	mu   sync.Mutex
+	keys []string
+	got  chan struct{}
+}
+
+func newCollector() *collector {
+	return &collector{got: make(chan struct{}, 1024)}
+}
+
+func (c *collector) handle(_ context.Context, event *eventbus.Event) error {
+	c.mu.Lock()
+	c.keys = append(c.keys, event.Key)
+	c.mu.Unlock()
+	c.got <- struct{}{}
+	return nil
+}
+
+func (c *collector) wait(t *testing.T, n int) []string {
+	t.Helper()
+
+	for range n {
+		select {
+		case <-c.got:
+		case <-time.After(waitTimeout):
+			t.Fatalf("handled %v, want %d events", c.keys, n)
+		}
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return slices.Clone(c.keys)
+}
+
+func consume(t *testing.T, bus eventbus.Subscriber, group string, handler eventbus.Handler) {
+	t.Helper()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	go Consume(ctx, bus, testTopic, group, handler)
+}
+
+// flakyPublisher fails the first publishes.
+type flakyPublisher struct {
+	eventbus.Publisher
+	failures int
+}
+
+func (p *flakyPublisher) Publish(ctx context.Context, events ...*eventbus.Event) error {
+	if p.failures > 0 {
+		p.failures--
+		return errors.New("event bus unavailable")
+	}
+	return p.Publisher.Publish(ctx, events...)
+}
+
+func TestRelayPublishesInOrder(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+	bus := memory.New()
+	r := NewRelay(db, bus)
+
+	addEvents(t, db, "a", "b", "c")
+	// the events of a rolled back change are never published
+	_ = db.Transaction(func(tx *gorm.DB) error {
+		if err := Add(tx, &eventbus.Event{Topic: testTopic, Key: "rolled back"}); err != nil {
+			return err
+		}
+		return errors.New("change failed")
+	})
+	addEvents(t, db, "d")
+
+	if n, err := r.relay(ctx); err != nil || n != 4 {
+		t.Fatalf("relay = %d, %v, want 4 events", n, err)
+	}
+	if n := pending(t, db); n != 0 {
+		t.Errorf("%d events still pending", n)
+	}
+	if n, err := r.relay(ctx); err != nil || n != 0 {
+		t.Errorf("second relay = %d, %v, want nothing left", n, err)
+	}
+
+	c := newCollector()
+	consume(t, bus, "group", c.handle)
+	if got := c.wait(t, 4); !slices.Equal(got, []string{"a", "b", "c", "d"}) {
+		t.Errorf("handled %v, want [a b c d]", got)
+	}
+}
+
+func TestRelayBatches(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+	r := NewRelay(db, memory.New())
+
+	keys := make([]string, relayBatchSize+5)
+	for i := range keys {
+		keys[i] = "k"
+	}
+	addEvents(t, db, keys...)
+
+	for _, want := range []int{relayBatchSize, 5, 0} {
+		if n, err := r.relay(ctx); err != nil || n != want {
+			t.Fatalf("relay = %d, %v, want %d events", n, err, want)
+		}
+	}
+}
+
+func TestRelayRetriesFailedPublish(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+	bus := memory.New()
+	r := NewRelay(db, &flakyPublisher{Publisher: bus, failures: 1})
+
+	addEvents(t, db, "a", "b")
+	if _, err := r.relay(ctx); err == nil {
+		t.Fatal("relay succeeded with the event bus down")
+	}
+	if n := pending(t, db); n != 2 {
+		t.Fatalf("%d events pending after the failed publish, want 2", n)
+	}
+	if n, err := r.relay(ctx); err != nil || n != 2 {
+		t.Fatalf("relay = %d, %v, want 2 events", n, err)
+	}
+
+	c := newCollector()
+	consume(t, bus, "group", c.handle)
+	if got := c.wait(t, 2); !slices.Equal(got, []string{"a", "b"}) {
+		t.Errorf("handled %v, want [a b]", got)
+	}
+}
+
+func TestConsumeRedeliversFailedEvents(t *testing.T) {
+	bus := memory.New()
+	if err := bus.Publish(context.Background(), &eventbus.Event{Topic: testTopic, Key: "a"}); err != nil {
+		t.Fatal(err)
+	}
+
+	c := newCollector()
+	failures := 2
+	consume(t, bus, "group", func(ctx context.Context, event *eventbus.Event) error {
+		if err := c.handle(ctx, event); err != nil {
+			return err
+		}
+		if failures > 0 {
+			failures--
+			return errors.New("handler failed")
+		}
+		return nil
+	})
+	if got := c.wait(t, 3); !slices.Equal(got, []string{"a", "a", "a"}) {
+		t.Errorf("handled %v, want the event three times", got)
+	}
+}
+
+func TestIdempotent(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+
+	calls := 0
+	fail := false
+	handler := func(ctx context.Context, event *eventbus.Event) error {
+		calls++
+		tx, ok := TxFromContext(ctx)
+		if !ok {
+			return errors.New("no transaction")
+		}
+		if err := tx.Create(&handled{EventID: event.ID}).Error; err != nil {
+			return err
+		}
+		if fail {
+			return errors.New("handler failed")
+		}
+		return nil
+	}
+	first := Idempotent(db, "first", handler)
+	second := Idempotent(db, "second", handler)
+	event := &eventbus.Event{ID: "1", Topic: testTopic}
+
+	// a failed attempt leaves neither the mark nor the writes of the handler
+	fail = true
+	if err := first(ctx, event); err == nil {
+		t.Fatal("failed handler reported success")
+	}
+	fail = false
+
+	for range 3 {
+		if err := first(ctx, event); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := second(ctx, event); err != nil {
+		t.Fatal(err)
+	}
+	if err := first(ctx, &eventbus.Event{ID: "2", Topic: testTopic}); err != nil {
+		t.Fatal(err)
+	}
+
+	// the failure, one run per group for event 1 and one for event 2
+	if calls != 4 {
+		t.Errorf("handler ran %d times, want 4", calls)
+	}
+	var writes int64
+	if err := db.Model(&handled{}).Count(&writes).Error; err != nil {
+		t.Fatal(err)
+	}
+	if writes != 3 {
+		t.Errorf("%d handler writes committed, want 3", writes)
+	}
+	var marks int64
+	if err := db.Model(&Consumption{}).Count(&marks).Error; err != nil {
+		t.Fatal(err)
+	}
+	if marks != 3 {
+		t.Errorf("%d consumption marks, want 3", marks)
+	}
+}
+
+func TestIdempotentOverRedeliveries(t *testing.T) {
+	db := newTestDB(t)
+	bus := memory.New()
+	r := NewRelay(db, bus)
+
+	addEvents(t, db, "a", "b")
+	if _, err := r.relay(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	// the relay lost track of the publish and sends the events again
+	if err := db.Model(&Message{}).Where("1 = 1").Update("published_at", 0).Error; err != nil {
+		t.Fatal(err)
+	}
+	if _, err := r.relay(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	// events arrive in order, once c is handled the redeliveries are behind
+	addEvents(t, db, "c")
+	if _, err := r.relay(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	c := newCollector()
+	consume(t, bus, "group", Idempotent(db, "group", c.handle))
+	if got := c.wait(t, 3); !slices.Equal(got, []string{"a", "b", "c"}) {
+		t.Errorf("handled %v, want [a b c] once each", got)
+	}
+}
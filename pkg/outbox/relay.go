@@ -0,0 +1,119 @@
+package outbox
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/crazyfrankie/zrpc-todolist/infra/contract/eventbus"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/lang/slice"
+	"github.com/crazyfrankie/zrpc-todolist/pkg/logs"
+	"github.com/crazyfrankie/zrpc-todolist/types/consts"
+)
+
+const (
+	defaultRelayInterval = time.Second
+	relayBatchSize       = 100
+	// retention is how long published events and consumption marks are kept,
+	// well past the time the event bus takes to retry a failed event.
+	retention       = 7 * 24 * time.Hour
+	cleanupInterval = time.Hour
+	cleanupBatch    = 1000
+)
+
+// Relay publishes the committed events of the outbox to the event bus. Every
+// replica of a service may run one, a pending event is locked by one relay at a
+// time, and it is published again if marking it published fails.
+type Relay struct {
+	db       *gorm.DB
+	bus      eventbus.Publisher
+	interval time.Duration
+}
+
+func NewRelay(db *gorm.DB, bus eventbus.Publisher) *Relay {
+	interval, err := time.ParseDuration(os.Getenv(consts.OutboxRelayInterval))
+	if err != nil || interval <= 0 {
+		interval = defaultRelayInterval
+	}
+
+	return &Relay{db: db, bus: bus, interval: interval}
+}
+
+func (r *Relay) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+	cleanupTicker := time.NewTicker(cleanupInterval)
+	defer cleanupTicker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			// drain the backlog before waiting for the next tick
+			for {
+				n, err := r.relay(ctx)
+				if err != nil {
+					logs.CtxErrorf(ctx, "relay outbox events failed, err=%v", err)
+				}
+				if err != nil || n < relayBatchSize {
+					break
+				}
+			}
+		case now := <-cleanupTicker.C:
+			r.cleanup(ctx, now.Add(-retention).UnixMilli())
+		}
+	}
+}
+
+// relay publishes a batch of pending events and returns its size.
+func (r *Relay) relay(ctx context.Context) (int, error) {
+	var n int
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var msgs []*Message
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("published_at = ?", 0).Order("id").Limit(relayBatchSize).Find(&msgs).Error
+		if err != nil || len(msgs) == 0 {
+			return err
+		}
+
+		if err := r.bus.Publish(ctx, slice.Transform(msgs, messageToEvent)...); err != nil {
+			return err
+		}
+		n = len(msgs)
+
+		ids := slice.Transform(msgs, func(msg *Message) int64 { return msg.ID })
+		return tx.Model(&Message{}).Where("id IN ?", ids).Update("published_at", time.Now().UnixMilli()).Error
+	})
+
+	return n, err
+}
+
+// cleanup deletes the published events and the consumption marks older than
+// before, in batches so the tables aren't locked for long.
+func (r *Relay) cleanup(ctx context.Context, before int64) {
+	db := r.db.WithContext(ctx)
+	for {
+		res := db.Where("published_at > ? AND published_at < ?", 0, before).Limit(cleanupBatch).Delete(&Message{})
+		if res.Error != nil {
+			logs.CtxWarnf(ctx, "clean up outbox events failed, err=%v", res.Error)
+			break
+		}
+		if res.RowsAffected < cleanupBatch {
+			break
+		}
+	}
+	for {
+		res := db.Where("consumed_at < ?", before).Limit(cleanupBatch).Delete(&Consumption{})
+		if res.Error != nil {
+			logs.CtxWarnf(ctx, "clean up outbox consumptions failed, err=%v", res.Error)
+			break
+		}
+		if res.RowsAffected < cleanupBatch {
+			break
+		}
+	}
+}
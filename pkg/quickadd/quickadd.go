@@ -0,0 +1,639 @@
+// Package quickadd parses a one line task such as
+// "Pay rent tomorrow 9am #home !high every month" into its title, due time,
+// tags, priority and recurrence. Parsing is deterministic, the result only
+// depends on the text and the reference time, whose location is the one of
+// the user.
+//
+// The supported phrases are:
+//
+//   - due dates: today, tomorrow, monday, next week, next month, jan 5,
+//     5 january 2027 and 2027-01-05, optionally after on, by or due. Weekday
+//     abbreviations such as fri only count after on, by, due, this or next.
+//   - times of day: 9am, 9:30pm, 21:00, noon, and bare hours after at.
+//   - relative due times: in 2 hours, in 3 days, in a week.
+//   - recurrence: every day, every other week, every 3 months, every weekday,
+//     every weekend, every monday and thursday.
+//   - tags: #home.
+//   - priority: !low, !medium, !high, !urgent, or !4 (low) to !1 (urgent).
+//
+// Only the first phrase of each kind is used, later ones are left in the title.
+package quickadd
+
+import (
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/crazyfrankie/zrpc-todolist/pkg/rrule"
+)
+
+type Kind int
+
+const (
+	Due Kind = iota + 1
+	Tag
+	Priority
+	Recurrence
+)
+
+func (k Kind) String() string {
+	switch k {
+	case Due:
+		return "due"
+	case Tag:
+		return "tag"
+	case Priority:
+		return "priority"
+	case Recurrence:
+		return "recurrence"
+	default:
+		return "unknown"
+	}
+}
+
+// Token is a phrase taken out of the text. Start and End are rune offsets into
+// the text, End is exclusive.
+type Token struct {
+	Kind  Kind
+	Start int
+	End   int
+	Text  string
+}
+
+type Result struct {
+	// Title is the text left once the tokens are taken out.
+	Title string
+	// Due is zero when the text names no due time. AllDay reports a due date
+	// without a time of day, it is due at the end of that day.
+	Due    time.Time
+	AllDay bool
+	Tags   []string
+	// Priority is low, medium, high or urgent, empty when not given.
+	Priority string
+	// Rule is nil for one-off tasks, recurring tasks always have a due time.
+	Rule   *rrule.Rule
+	Tokens []Token
+}
+
+// endOfDay is the time of day all day tasks are due at.
+const endOfDay = 23*time.Hour + 59*time.Minute
+
+var months = map[string]time.Month{
+	"jan": time.January, "january": time.January,
+	"feb": time.February, "february": time.February,
+	"mar": time.March, "march": time.March,
+	"apr": time.April, "april": time.April,
+	"may": time.May,
+	"jun": time.June, "june": time.June,
+	"jul": time.July, "july": time.July,
+	"aug": time.August, "august": time.August,
+	"sep": time.September, "sept": time.September, "september": time.September,
+	"oct": time.October, "october": time.October,
+	"nov": time.November, "november": time.November,
+	"dec": time.December, "december": time.December,
+}
+
+var weekdays = map[string]time.Weekday{
+	"monday": time.Monday, "tuesday": time.Tuesday, "wednesday": time.Wednesday,
+	"thursday": time.Thursday, "friday": time.Friday, "saturday": time.Saturday,
+	"sunday": time.Sunday,
+}
+
+var weekdayAbbrs = map[string]time.Weekday{
+	"mon": time.Monday, "tue": time.Tuesday, "tues": time.Tuesday, "wed": time.Wednesday,
+	"thu": time.Thursday, "thur": time.Thursday, "thurs": time.Thursday,
+	"fri": time.Friday, "sat": time.Saturday, "sun": time.Sunday,
+}
+
+var priorities = map[string]string{
+	"low": "low", "medium": "medium", "med": "medium", "high": "high", "urgent": "urgent",
+	"4": "low", "3": "medium", "2": "high", "1": "urgent",
+}
+
+// units maps the time units of relative phrases, months and years are counted
+// in calendar months.
+var units = map[string]struct {
+	d      time.Duration
+	months int
+}{
+	"minute": {d: time.Minute}, "min": {d: time.Minute},
+	"hour": {d: time.Hour}, "hr": {d: time.Hour},
+	"day":   {d: 24 * time.Hour},
+	"week":  {d: 7 * 24 * time.Hour},
+	"month": {months: 1},
+	"year":  {months: 12},
+}
+
+var frequencies = map[string]rrule.Frequency{
+	"day": rrule.Daily, "week": rrule.Weekly, "month": rrule.Monthly, "year": rrule.Yearly,
+}
+
+type word struct {
+	text  string
+	key   string
+	start int
+	end   int
+}
+
+type parser struct {
+	text  []rune
+	words []word
+	used  []bool
+	now   time.Time
+	today time.Time
+	res   *Result
+
+	date    time.Time
+	hasDate bool
+	clock   time.Duration
+	hasTime bool
+	// exact is set by relative phrases in minutes or hours
+	exact time.Time
+}
+
+func Parse(text string, now time.Time) *Result {
+	p := &parser{
+		text:  []rune(text),
+		now:   now,
+		today: time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location()),
+		res:   &Result{},
+	}
+	p.split()
+
+	for i := 0; i < len(p.words); {
+		if n := p.match(i); n > 0 {
+			i += n
+			continue
+		}
+		i++
+	}
+	p.finish()
+
+	return p.res
+}
+
+// split breaks the text into words, keys are lower cased without trailing
+// punctuation.
+func (p *parser) split() {
+	start := -1
+	for i := 0; i <= len(p.text); i++ {
+		if i < len(p.text) && !unicode.IsSpace(p.text[i]) {
+			if start < 0 {
+				start = i
+			}
+			continue
+		}
+		if start >= 0 {
+			text := string(p.text[start:i])
+			p.words = append(p.words, word{
+				text:  text,
+				key:   strings.ToLower(strings.TrimRight(text, ",.;:?")),
+				start: start,
+				end:   i,
+			})
+			start = -1
+		}
+	}
+	p.used = make([]bool, len(p.words))
+}
+
+func (p *parser) key(i int) string {
+	if i < len(p.words) {
+		return p.words[i].key
+	}
+	return ""
+}
+
+// take marks n words starting at i as a token of the given kind.
+func (p *parser) take(kind Kind, i, n int) int {
+	for j := i; j < i+n; j++ {
+		p.used[j] = true
+	}
+	start, end := p.words[i].start, p.words[i+n-1].end
+	p.res.Tokens = append(p.res.Tokens, Token{
+		Kind:  kind,
+		Start: start,
+		End:   end,
+		Text:  string(p.text[start:end]),
+	})
+	return n
+}
+
+func (p *parser) match(i int) int {
+	key := p.key(i)
+	switch {
+	case strings.HasPrefix(key, "#"):
+		return p.matchTag(i)
+	case strings.HasPrefix(key, "!"):
+		return p.matchPriority(i)
+	}
+
+	if p.res.Rule == nil {
+		if n, rule := p.matchRecurrence(i); n > 0 {
+			p.res.Rule = rule
+			return p.take(Recurrence, i, n)
+		}
+	}
+	if key == "in" && !p.hasDate && !p.hasTime && p.exact.IsZero() {
+		if n, d, months := p.matchDuration(i + 1); n > 0 {
+			if months > 0 || d >= 24*time.Hour {
+				p.date, p.hasDate = p.today.AddDate(0, months, int(d/(24*time.Hour))), true
+			} else {
+				p.exact = p.now.Add(d).Truncate(time.Minute)
+			}
+			return p.take(Due, i, n+1)
+		}
+	}
+
+	return p.matchMore(i)
+}
+
+// matchMore matches the date and time phrases which are still missing.
+func (p *parser) matchMore(i int) int {
+	if !p.exact.IsZero() {
+		return 0
+	}
+
+	key := p.key(i)
+	prefixed := key == "on" || key == "by" || key == "due"
+	if !p.hasDate {
+		if n, date := p.matchDate(i, false); n > 0 {
+			p.date, p.hasDate = date, true
+			return p.take(Due, i, n)
+		}
+		if prefixed {
+			if n, date := p.matchDate(i+1, true); n > 0 {
+				p.date, p.hasDate = date, true
+				return p.take(Due, i, n+1)
+			}
+		}
+	}
+	if !p.hasTime {
+		if n, clock := p.matchTime(i, false); n > 0 {
+			p.clock, p.hasTime = clock, true
+			return p.take(Due, i, n)
+		}
+		if key == "at" || key == "@" || key == "by" {
+			if n, clock := p.matchTime(i+1, true); n > 0 {
+				p.clock, p.hasTime = clock, true
+				return p.take(Due, i, n+1)
+			}
+		}
+	}
+
+	return 0
+}
+
+func (p *parser) matchTag(i int) int {
+	name := strings.TrimRight(strings.TrimPrefix(p.words[i].text, "#"), ",.;:!?")
+	if name == "" || strings.HasPrefix(name, "#") {
+		return 0
+	}
+	for _, tag := range p.res.Tags {
+		if strings.EqualFold(tag, name) {
+			return p.take(Tag, i, 1)
+		}
+	}
+	p.res.Tags = append(p.res.Tags, name)
+
+	return p.take(Tag, i, 1)
+}
+
+func (p *parser) matchPriority(i int) int {
+	priority, ok := priorities[strings.TrimPrefix(p.key(i), "!")]
+	if !ok || p.res.Priority != "" {
+		return 0
+	}
+	p.res.Priority = priority
+
+	return p.take(Priority, i, 1)
+}
+
+// matchDate matches a date phrase at i. Weekday abbreviations only match when
+// the phrase follows a prefix such as on.
+func (p *parser) matchDate(i int, prefixed bool) (int, time.Time) {
+	key := p.key(i)
+	switch key {
+	case "today":
+		return 1, p.today
+	case "tomorrow", "tmr", "tmrw":
+		return 1, p.today.AddDate(0, 0, 1)
+	case "weekend":
+		return 1, p.nextWeekday(time.Saturday, true)
+	case "next":
+		switch next := p.key(i + 1); next {
+		case "week":
+			// the monday of next week
+			return 2, p.nextWeekday(time.Monday, false)
+		case "month":
+			return 2, time.Date(p.today.Year(), p.today.Month()+1, 1, 0, 0, 0, 0, p.today.Location())
+		case "year":
+			return 2, time.Date(p.today.Year()+1, time.January, 1, 0, 0, 0, 0, p.today.Location())
+		default:
+			if wd, ok := parseWeekday(next, true); ok {
+				return 2, p.nextWeekday(wd, false)
+			}
+		}
+		return 0, time.Time{}
+	case "this":
+		next := p.key(i + 1)
+		if next == "weekend" {
+			return 2, p.nextWeekday(time.Saturday, true)
+		}
+		if wd, ok := parseWeekday(next, true); ok {
+			return 2, p.nextWeekday(wd, true)
+		}
+		return 0, time.Time{}
+	}
+
+	if wd, ok := parseWeekday(key, prefixed); ok {
+		return 1, p.nextWeekday(wd, false)
+	}
+	if date, err := time.ParseInLocation(time.DateOnly, key, p.today.Location()); err == nil {
+		return 1, date
+	}
+
+	// jan 5 [2027] or 5 jan [2027]
+	if m, ok := months[key]; ok {
+		if day, ok := parseDay(p.key(i + 1)); ok {
+			return p.calendarDate(i+2, 2, m, day)
+		}
+	}
+	if day, ok := parseDay(key); ok {
+		if m, ok := months[p.key(i+1)]; ok {
+			return p.calendarDate(i+2, 2, m, day)
+		}
+	}
+
+	return 0, time.Time{}
+}
+
+// calendarDate completes a month and day matched in n words with the year at
+// i. Without a year the next such date from today on is used.
+func (p *parser) calendarDate(i, n int, m time.Month, day int) (int, time.Time) {
+	loc := p.today.Location()
+	if year, err := strconv.Atoi(p.key(i)); err == nil && len(p.key(i)) == 4 {
+		date := time.Date(year, m, day, 0, 0, 0, 0, loc)
+		if date.Day() != day {
+			return 0, time.Time{}
+		}
+		return n + 1, date
+	}
+
+	year := p.today.Year()
+	date := time.Date(year, m, day, 0, 0, 0, 0, loc)
+	if date.Day() != day {
+		// february 29 outside of a leap year
+		for date.Day() != day && year < p.today.Year()+8 {
+			year++
+			date = time.Date(year, m, day, 0, 0, 0, 0, loc)
+		}
+		if date.Day() != day {
+			return 0, time.Time{}
+		}
+	}
+	if date.Before(p.today) {
+		date = date.AddDate(1, 0, 0)
+		if date.Day() != day {
+			return 0, time.Time{}
+		}
+	}
+
+	return n, date
+}
+
+// matchTime matches a time of day at i, bare hours only match when the phrase
+// follows at.
+func (p *parser) matchTime(i int, bare bool) (int, time.Duration) {
+	key := p.key(i)
+	if key == "noon" {
+		return 1, 12 * time.Hour
+	}
+	if key == "" || key[0] < '0' || key[0] > '9' {
+		return 0, 0
+	}
+
+	n := 1
+	num, suffix := splitDigits(key)
+	if suffix == "" {
+		if s := p.key(i + 1); s == "am" || s == "pm" {
+			suffix, n = s, 2
+		}
+	}
+	hourText, minuteText, hasMinute := strings.Cut(num, ":")
+	hour, err := strconv.Atoi(hourText)
+	if err != nil || len(hourText) > 2 {
+		return 0, 0
+	}
+	minute := 0
+	if hasMinute {
+		if minute, err = strconv.Atoi(minuteText); err != nil || len(minuteText) != 2 || minute > 59 {
+			return 0, 0
+		}
+	}
+
+	switch suffix {
+	case "am", "a":
+		if hour < 1 || hour > 12 {
+			return 0, 0
+		}
+		hour %= 12
+	case "pm", "p":
+		if hour < 1 || hour > 12 {
+			return 0, 0
+		}
+		hour = hour%12 + 12
+	case "":
+		if !hasMinute && !bare || hour > 23 {
+			return 0, 0
+		}
+	default:
+		return 0, 0
+	}
+
+	return n, time.Duration(hour)*time.Hour + time.Duration(minute)*time.Minute
+}
+
+// matchDuration matches an amount and a unit at i such as "2 hours" or "a week".
+func (p *parser) matchDuration(i int) (int, time.Duration, int) {
+	amount := 0
+	switch key := p.key(i); key {
+	case "a", "an":
+		amount = 1
+	default:
+		var err error
+		if amount, err = strconv.Atoi(key); err != nil || amount < 1 || amount > 999 {
+			return 0, 0, 0
+		}
+	}
+
+	unit, ok := units[strings.TrimSuffix(p.key(i+1), "s")]
+	if !ok {
+		return 0, 0, 0
+	}
+
+	return 2, time.Duration(amount) * unit.d, amount * unit.months
+}
+
+// matchRecurrence matches a phrase starting with every.
+func (p *parser) matchRecurrence(i int) (int, *rrule.Rule) {
+	if p.key(i) != "every" {
+		return 0, nil
+	}
+
+	next := p.key(i + 1)
+	if freq, ok := frequencies[next]; ok {
+		return 2, &rrule.Rule{Freq: freq, Interval: 1}
+	}
+	switch next {
+	case "other":
+		if freq, ok := frequencies[p.key(i+2)]; ok {
+			return 3, &rrule.Rule{Freq: freq, Interval: 2}
+		}
+		return 0, nil
+	case "weekday", "weekdays":
+		return 2, weeklyRule(time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday)
+	case "weekend":
+		return 2, weeklyRule(time.Saturday, time.Sunday)
+	}
+
+	if interval, err := strconv.Atoi(next); err == nil && interval >= 1 && interval <= 999 {
+		if freq, ok := frequencies[strings.TrimSuffix(p.key(i+2), "s")]; ok {
+			return 3, &rrule.Rule{Freq: freq, Interval: interval}
+		}
+		return 0, nil
+	}
+
+	// every monday, wednesday and friday
+	var days []time.Weekday
+	j := i + 1
+	for {
+		wd, ok := parseWeekday(p.key(j), true)
+		if !ok {
+			break
+		}
+		days = append(days, wd)
+		j++
+		if p.key(j) == "and" {
+			if _, ok := parseWeekday(p.key(j+1), true); ok {
+				j++
+			}
+		}
+	}
+	if len(days) == 0 {
+		return 0, nil
+	}
+
+	return j - i, weeklyRule(days...)
+}
+
+// finish builds the title and the due time.
+func (p *parser) finish() {
+	var title []string
+	for i, w := range p.words {
+		if !p.used[i] {
+			title = append(title, w.text)
+		}
+	}
+	p.res.Title = strings.Join(title, " ")
+
+	if !p.exact.IsZero() {
+		p.res.Due = p.exact
+		return
+	}
+	if !p.hasDate && !p.hasTime && p.res.Rule == nil {
+		return
+	}
+
+	clock := endOfDay
+	if p.hasTime {
+		clock = p.clock
+	}
+	date := p.date
+	if !p.hasDate {
+		// the first day from today on whose due time is still ahead, and which
+		// matches the recurrence
+		date = p.today
+		for d := 0; d <= 7; d++ {
+			date = p.today.AddDate(0, 0, d)
+			if p.at(date, clock).After(p.now) && (p.res.Rule == nil || matchesWeekday(p.res.Rule, date.Weekday())) {
+				break
+			}
+		}
+	}
+
+	p.res.Due = p.at(date, clock)
+	p.res.AllDay = !p.hasTime
+}
+
+// at returns the wall clock time of day on date.
+func (p *parser) at(date time.Time, clock time.Duration) time.Time {
+	return time.Date(date.Year(), date.Month(), date.Day(),
+		int(clock/time.Hour), int(clock%time.Hour/time.Minute), 0, 0, date.Location())
+}
+
+// nextWeekday returns the next wd after today, or from today on if
+// includeToday is set.
+func (p *parser) nextWeekday(wd time.Weekday, includeToday bool) time.Time {
+	days := (int(wd) - int(p.today.Weekday()) + 7) % 7
+	if days == 0 && !includeToday {
+		days = 7
+	}
+	return p.today.AddDate(0, 0, days)
+}
+
+func parseWeekday(key string, abbr bool) (time.Weekday, bool) {
+	if wd, ok := weekdays[key]; ok {
+		return wd, true
+	}
+	if abbr {
+		wd, ok := weekdayAbbrs[key]
+		return wd, ok
+	}
+	return 0, false
+}
+
+// parseDay reads a day of month such as 5 or 5th.
+func parseDay(key string) (int, bool) {
+	num, suffix := splitDigits(key)
+	switch suffix {
+	case "", "st", "nd", "rd", "th":
+	default:
+		return 0, false
+	}
+	day, err := strconv.Atoi(num)
+	if err != nil || day < 1 || day > 31 {
+		return 0, false
+	}
+	return day, true
+}
+
+// splitDigits splits a key into its leading digits and colons and the rest.
+func splitDigits(key string) (string, string) {
+	i := 0
+	for i < len(key) && (key[i] >= '0' && key[i] <= '9' || key[i] == ':') {
+		i++
+	}
+	return key[:i], key[i:]
+}
+
+func weeklyRule(days ...time.Weekday) *rrule.Rule {
+	rule := &rrule.Rule{Freq: rrule.Weekly, Interval: 1}
+	for _, wd := range days {
+		rule.ByDay = append(rule.ByDay, rrule.WeekdayNum{Weekday: wd})
+	}
+	return rule
+}
+
+func matchesWeekday(rule *rrule.Rule, wd time.Weekday) bool {
+	if len(rule.ByDay) == 0 {
+		return true
+	}
+	for _, d := range rule.ByDay {
+		if d.Weekday == wd {
+			return true
+		}
+	}
+	return false
+}
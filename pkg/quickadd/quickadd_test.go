@@ -0,0 +1,151 @@
+package quickadd
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+// now is a Wednesday afternoon in the user's time zone.
+func testNow(t *testing.T) time.Time {
+	t.Helper()
+
+	loc, err := time.LoadLocation("Asia/Shanghai")
+	if err != nil {
+		t.Skipf("time zone not available: %v", err)
+	}
+	return time.Date(2026, time.October, 14, 15, 30, 0, 0, loc)
+}
+
+func TestParse(t *testing.T) {
+	now := testNow(t)
+
+	tests := []struct {
+		text     string
+		title    string
+		due      string // in the location of now, empty without a due time
+		allDay   bool
+		tags     []string
+		priority string
+		rule     string
+	}{
+		// no tokens
+		{text: "", title: ""},
+		{text: "Pay rent", title: "Pay rent"},
+		{text: "Buy fri tickets", title: "Buy fri tickets"},
+		{text: "every", title: "every"},
+
+		// relative dates
+		{text: "Call mom today", title: "Call mom", due: "2026-10-14 23:59", allDay: true},
+		{text: "Call mom tomorrow 9am", title: "Call mom", due: "2026-10-15 09:00"},
+		{text: "Gym friday", title: "Gym", due: "2026-10-16 23:59", allDay: true},
+		{text: "Gym wednesday", title: "Gym", due: "2026-10-21 23:59", allDay: true},
+		{text: "Report due fri", title: "Report", due: "2026-10-16 23:59", allDay: true},
+		{text: "Report this wed", title: "Report", due: "2026-10-14 23:59", allDay: true},
+		{text: "Plan next tue", title: "Plan", due: "2026-10-20 23:59", allDay: true},
+		{text: "Review next week", title: "Review", due: "2026-10-19 23:59", allDay: true},
+		{text: "Budget next month", title: "Budget", due: "2026-11-01 23:59", allDay: true},
+		{text: "Hike this weekend", title: "Hike", due: "2026-10-17 23:59", allDay: true},
+		{text: "Tea in 2 hours", title: "Tea", due: "2026-10-14 17:30"},
+		{text: "Trip in 3 days", title: "Trip", due: "2026-10-17 23:59", allDay: true},
+		{text: "Renew in a month", title: "Renew", due: "2026-11-14 23:59", allDay: true},
+
+		// absolute dates and times
+		{text: "Taxes 2026-12-01 21:00", title: "Taxes", due: "2026-12-01 21:00"},
+		{text: "Dentist jan 5 at 15", title: "Dentist", due: "2027-01-05 15:00"},
+		{text: "Dentist 5th january 2027 9:30pm", title: "Dentist", due: "2027-01-05 21:30"},
+		{text: "Party oct 1", title: "Party", due: "2027-10-01 23:59", allDay: true},
+		{text: "Party oct 20", title: "Party", due: "2026-10-20 23:59", allDay: true},
+		{text: "Birthday feb 29", title: "Birthday", due: "2028-02-29 23:59", allDay: true},
+		{text: "Nothing on feb 30 2027", title: "Nothing on feb 30 2027"},
+		{text: "Call at 5pm", title: "Call", due: "2026-10-14 17:00"},
+		{text: "Lunch noon", title: "Lunch", due: "2026-10-15 12:00"},
+		{text: "Ship 25:00", title: "Ship 25:00"},
+		{text: "Meet tomorrow tomorrow", title: "Meet tomorrow", due: "2026-10-15 23:59", allDay: true},
+
+		// tags and priorities
+		{text: "Paint #home #Home #diy", title: "Paint", tags: []string{"home", "diy"}},
+		{text: "Fix #, now", title: "Fix #, now"},
+		{text: "Fix !1 bug", title: "Fix bug", priority: "urgent"},
+		{text: "Fix !high !low bug", title: "Fix !low bug", priority: "high"},
+		{text: "Fix !9 bug", title: "Fix !9 bug"},
+
+		// recurrence
+		{text: "Rent every month", title: "Rent", due: "2026-10-14 23:59", allDay: true, rule: "FREQ=MONTHLY"},
+		{text: "Water plants every other week", title: "Water plants", due: "2026-10-14 23:59", allDay: true, rule: "FREQ=WEEKLY;INTERVAL=2"},
+		{text: "Backup every 3 months", title: "Backup", due: "2026-10-14 23:59", allDay: true, rule: "FREQ=MONTHLY;INTERVAL=3"},
+		{text: "Standup every weekday 9:30", title: "Standup", due: "2026-10-15 09:30", rule: "FREQ=WEEKLY;BYDAY=MO,TU,WE,TH,FR"},
+		{text: "Chores every weekend", title: "Chores", due: "2026-10-17 23:59", allDay: true, rule: "FREQ=WEEKLY;BYDAY=SA,SU"},
+		{text: "Yoga every monday and thu 7am", title: "Yoga", due: "2026-10-15 07:00", rule: "FREQ=WEEKLY;BYDAY=MO,TH"},
+		{text: "Report every friday jan 8", title: "Report", due: "2027-01-08 23:59", allDay: true, rule: "FREQ=WEEKLY;BYDAY=FR"},
+
+		// everything at once
+		{
+			text: "Pay rent tomorrow 9am #home !high every month", title: "Pay rent",
+			due: "2026-10-15 09:00", tags: []string{"home"}, priority: "high", rule: "FREQ=MONTHLY",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			res := Parse(tt.text, now)
+
+			if res.Title != tt.title {
+				t.Errorf("title = %q, want %q", res.Title, tt.title)
+			}
+			due := ""
+			if !res.Due.IsZero() {
+				if res.Due.Location() != now.Location() {
+					t.Errorf("due in %v, want %v", res.Due.Location(), now.Location())
+				}
+				due = res.Due.Format("2006-01-02 15:04")
+			}
+			if due != tt.due || res.AllDay != tt.allDay {
+				t.Errorf("due = %q all day %v, want %q all day %v", due, res.AllDay, tt.due, tt.allDay)
+			}
+			if !slices.Equal(res.Tags, tt.tags) {
+				t.Errorf("tags = %q, want %q", res.Tags, tt.tags)
+			}
+			if res.Priority != tt.priority {
+				t.Errorf("priority = %q, want %q", res.Priority, tt.priority)
+			}
+			rule := ""
+			if res.Rule != nil {
+				rule = res.Rule.String()
+			}
+			if rule != tt.rule {
+				t.Errorf("rule = %q, want %q", rule, tt.rule)
+			}
+		})
+	}
+}
+
+func TestParseTokens(t *testing.T) {
+	tests := []struct {
+		text string
+		want []Token
+	}{
+		{
+			text: "Pay rent tomorrow 9am #home",
+			want: []Token{
+				{Kind: Due, Start: 9, End: 17, Text: "tomorrow"},
+				{Kind: Due, Start: 18, End: 21, Text: "9am"},
+				{Kind: Tag, Start: 22, End: 27, Text: "#home"},
+			},
+		},
+		{
+			// offsets count runes
+			text: "买菜 #家 every other day !2",
+			want: []Token{
+				{Kind: Tag, Start: 3, End: 5, Text: "#家"},
+				{Kind: Recurrence, Start: 6, End: 21, Text: "every other day"},
+				{Kind: Priority, Start: 22, End: 24, Text: "!2"},
+			},
+		},
+		{text: "Plain title"},
+	}
+	for _, tt := range tests {
+		if got := Parse(tt.text, testNow(t)).Tokens; !slices.Equal(got, tt.want) {
+			t.Errorf("Parse(%q).Tokens = %+v, want %+v", tt.text, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,442 @@
+// Package rrule implements the subset of RFC 5545 recurrence rules used by
+// recurring tasks: FREQ (DAILY, WEEKLY, MONTHLY, YEARLY), INTERVAL, BYDAY,
+// COUNT and UNTIL. Weeks start on Monday.
+package rrule
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type Frequency int
+
+const (
+	Daily Frequency = iota + 1
+	Weekly
+	Monthly
+	Yearly
+)
+
+func (f Frequency) String() string {
+	switch f {
+	case Daily:
+		return "DAILY"
+	case Weekly:
+		return "WEEKLY"
+	case Monthly:
+		return "MONTHLY"
+	case Yearly:
+		return "YEARLY"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+// WeekdayNum is a BYDAY entry. N is the ordinal of the weekday within the
+// month (MONTHLY) or year (YEARLY), negative values count from the end and
+// zero means every such weekday.
+type WeekdayNum struct {
+	Weekday time.Weekday
+	N       int
+}
+
+type Rule struct {
+	Freq     Frequency
+	Interval int
+	ByDay    []WeekdayNum
+	Count    int
+	// Until is inclusive, zero means unbounded.
+	Until time.Time
+
+	// floatingUntil reports whether Until has no zone of its own and must be
+	// read as a wall clock in the location of the series start.
+	floatingUntil bool
+}
+
+const (
+	utcLayout      = "20060102T150405Z"
+	floatingLayout = "20060102T150405"
+	dateLayout     = "20060102"
+
+	// maxEmptyPeriods bounds the search for the next occurrence of rules that
+	// rarely or never match, such as the fifth Monday of a month.
+	maxEmptyPeriods = 1000
+)
+
+var weekdayNames = [...]string{"SU", "MO", "TU", "WE", "TH", "FR", "SA"}
+
+// Parse parses a rule such as "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE;COUNT=10",
+// an optional "RRULE:" prefix is accepted.
+func Parse(s string) (*Rule, error) {
+	s = strings.ToUpper(strings.TrimSpace(s))
+	s = strings.TrimPrefix(s, "RRULE:")
+	if s == "" {
+		return nil, errors.New("rrule: empty rule")
+	}
+
+	r := &Rule{Interval: 1}
+	seen := make(map[string]bool)
+	for _, part := range strings.Split(s, ";") {
+		if part == "" {
+			continue
+		}
+		name, value, ok := strings.Cut(part, "=")
+		if !ok || value == "" {
+			return nil, fmt.Errorf("rrule: malformed part %q", part)
+		}
+		if seen[name] {
+			return nil, fmt.Errorf("rrule: duplicate part %s", name)
+		}
+		seen[name] = true
+
+		var err error
+		switch name {
+		case "FREQ":
+			r.Freq, err = parseFreq(value)
+		case "INTERVAL":
+			r.Interval, err = parsePositive(name, value)
+		case "COUNT":
+			r.Count, err = parsePositive(name, value)
+		case "BYDAY":
+			r.ByDay, err = parseByDay(value)
+		case "UNTIL":
+			r.Until, r.floatingUntil, err = parseUntil(value)
+		case "WKST":
+			if value != "MO" {
+				err = fmt.Errorf("rrule: unsupported WKST %s", value)
+			}
+		default:
+			err = fmt.Errorf("rrule: unsupported part %s", name)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := r.validate(); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func (r *Rule) validate() error {
+	if r.Freq < Daily || r.Freq > Yearly {
+		return errors.New("rrule: FREQ is required")
+	}
+	if r.Interval < 1 {
+		return errors.New("rrule: INTERVAL must be positive")
+	}
+	if r.Count > 0 && !r.Until.IsZero() {
+		return errors.New("rrule: COUNT and UNTIL are mutually exclusive")
+	}
+
+	maxN := 0
+	switch r.Freq {
+	case Monthly:
+		maxN = 5
+	case Yearly:
+		maxN = 53
+	}
+	for _, d := range r.ByDay {
+		if d.N != 0 && maxN == 0 {
+			return fmt.Errorf("rrule: BYDAY ordinals are not allowed with FREQ=%s", r.Freq)
+		}
+		if d.N > maxN || d.N < -maxN {
+			return fmt.Errorf("rrule: BYDAY ordinal %d out of range", d.N)
+		}
+	}
+
+	return nil
+}
+
+// String returns the normalized form of the rule.
+func (r *Rule) String() string {
+	parts := []string{"FREQ=" + r.Freq.String()}
+	if r.Interval > 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(r.Interval))
+	}
+	if len(r.ByDay) > 0 {
+		days := make([]string, 0, len(r.ByDay))
+		for _, d := range r.ByDay {
+			day := weekdayNames[d.Weekday]
+			if d.N != 0 {
+				day = strconv.Itoa(d.N) + day
+			}
+			days = append(days, day)
+		}
+		parts = append(parts, "BYDAY="+strings.Join(days, ","))
+	}
+	if r.Count > 0 {
+		parts = append(parts, "COUNT="+strconv.Itoa(r.Count))
+	}
+	if !r.Until.IsZero() {
+		if r.floatingUntil {
+			parts = append(parts, "UNTIL="+r.Until.Format(floatingLayout))
+		} else {
+			parts = append(parts, "UNTIL="+r.Until.UTC().Format(utcLayout))
+		}
+	}
+
+	return strings.Join(parts, ";")
+}
+
+// After returns at most n occurrences of the series starting at start that
+// are strictly after t.
+func (r *Rule) After(start, t time.Time, n int) []time.Time {
+	var res []time.Time
+	it := r.Iterator(start)
+	for len(res) < n {
+		o, ok := it.Next()
+		if !ok {
+			break
+		}
+		if o.After(t) {
+			res = append(res, o)
+		}
+	}
+
+	return res
+}
+
+// Iterator returns an iterator over the occurrences of the series starting
+// at start. The start itself is always the first occurrence, all others are
+// computed in the location of start, keeping its wall clock time.
+func (r *Rule) Iterator(start time.Time) *Iterator {
+	it := &Iterator{rule: r, start: start}
+	if !r.Until.IsZero() {
+		it.until = r.Until
+		if r.floatingUntil {
+			u := r.Until
+			it.until = time.Date(u.Year(), u.Month(), u.Day(), u.Hour(), u.Minute(), u.Second(), 0, start.Location())
+		}
+	}
+
+	return it
+}
+
+type Iterator struct {
+	rule  *Rule
+	start time.Time
+	until time.Time
+
+	period  int
+	pending []time.Time
+	emitted int
+	done    bool
+}
+
+// Next returns the next occurrence, false once the series has ended.
+func (it *Iterator) Next() (time.Time, bool) {
+	if it.done {
+		return time.Time{}, false
+	}
+	if it.rule.Count > 0 && it.emitted >= it.rule.Count {
+		it.done = true
+		return time.Time{}, false
+	}
+	if it.emitted == 0 {
+		it.emitted++
+		return it.start, true
+	}
+
+	for empty := 0; len(it.pending) == 0; empty++ {
+		if empty >= maxEmptyPeriods {
+			it.done = true
+			return time.Time{}, false
+		}
+		for _, o := range it.expand(it.period) {
+			if o.After(it.start) {
+				it.pending = append(it.pending, o)
+			}
+		}
+		it.period++
+	}
+
+	o := it.pending[0]
+	it.pending = it.pending[1:]
+	if !it.until.IsZero() && o.After(it.until) {
+		it.done = true
+		return time.Time{}, false
+	}
+	it.emitted++
+
+	return o, true
+}
+
+// expand returns the candidates of the nth period in chronological order.
+func (it *Iterator) expand(period int) []time.Time {
+	r, start := it.rule, it.start
+	step := period * r.Interval
+	y, m, d := start.Date()
+
+	switch r.Freq {
+	case Daily:
+		day := it.at(y, m, d+step)
+		if len(r.ByDay) > 0 && !r.matchWeekday(day.Weekday()) {
+			return nil
+		}
+		return []time.Time{day}
+
+	case Weekly:
+		monday := d - weekdayOffset(start.Weekday()) + step*7
+		if len(r.ByDay) == 0 {
+			return []time.Time{it.at(y, m, monday+weekdayOffset(start.Weekday()))}
+		}
+		offsets := make([]int, 0, len(r.ByDay))
+		for _, wd := range r.ByDay {
+			offsets = append(offsets, weekdayOffset(wd.Weekday))
+		}
+		sort.Ints(offsets)
+		res := make([]time.Time, 0, len(offsets))
+		for i, off := range offsets {
+			if i > 0 && off == offsets[i-1] {
+				continue
+			}
+			res = append(res, it.at(y, m, monday+off))
+		}
+		return res
+
+	case Monthly:
+		first := time.Date(y, m+time.Month(step), 1, 0, 0, 0, 0, time.UTC)
+		days := daysIn(first.Year(), first.Month())
+		if len(r.ByDay) == 0 {
+			if d > days {
+				return nil
+			}
+			return []time.Time{it.at(first.Year(), first.Month(), d)}
+		}
+		var res []time.Time
+		for day := 1; day <= days; day++ {
+			wd := time.Date(first.Year(), first.Month(), day, 0, 0, 0, 0, time.UTC).Weekday()
+			if r.matchNth(wd, (day-1)/7+1, (days-day)/7+1) {
+				res = append(res, it.at(first.Year(), first.Month(), day))
+			}
+		}
+		return res
+
+	case Yearly:
+		year := y + step
+		if len(r.ByDay) == 0 {
+			if d > daysIn(year, m) {
+				return nil
+			}
+			return []time.Time{it.at(year, m, d)}
+		}
+		days := time.Date(year, time.December, 31, 0, 0, 0, 0, time.UTC).YearDay()
+		var res []time.Time
+		for yday := 1; yday <= days; yday++ {
+			wd := time.Date(year, time.January, yday, 0, 0, 0, 0, time.UTC).Weekday()
+			if r.matchNth(wd, (yday-1)/7+1, (days-yday)/7+1) {
+				res = append(res, it.at(year, time.January, yday))
+			}
+		}
+		return res
+	}
+
+	return nil
+}
+
+// at returns the given day at the wall clock time of the series start.
+func (it *Iterator) at(y int, m time.Month, d int) time.Time {
+	hh, mm, ss := it.start.Clock()
+	return time.Date(y, m, d, hh, mm, ss, 0, it.start.Location())
+}
+
+func (r *Rule) matchWeekday(wd time.Weekday) bool {
+	for _, d := range r.ByDay {
+		if d.Weekday == wd {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Rule) matchNth(wd time.Weekday, nth, nthFromEnd int) bool {
+	for _, d := range r.ByDay {
+		if d.Weekday != wd {
+			continue
+		}
+		if d.N == 0 || d.N == nth || d.N == -nthFromEnd {
+			return true
+		}
+	}
+	return false
+}
+
+// weekdayOffset returns the number of days since Monday.
+func weekdayOffset(wd time.Weekday) int {
+	return (int(wd) + 6) % 7
+}
+
+func daysIn(year int, m time.Month) int {
+	return time.Date(year, m+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+func parseFreq(value string) (Frequency, error) {
+	for f := Daily; f <= Yearly; f++ {
+		if f.String() == value {
+			return f, nil
+		}
+	}
+	return 0, fmt.Errorf("rrule: unsupported FREQ %s", value)
+}
+
+func parsePositive(name, value string) (int, error) {
+	n, err := strconv.Atoi(value)
+	if err != nil || n < 1 {
+		return 0, fmt.Errorf("rrule: %s must be a positive integer", name)
+	}
+	return n, nil
+}
+
+func parseByDay(value string) ([]WeekdayNum, error) {
+	var res []WeekdayNum
+	for _, item := range strings.Split(value, ",") {
+		if len(item) < 2 {
+			return nil, fmt.Errorf("rrule: invalid BYDAY %q", item)
+		}
+		name, num := item[len(item)-2:], item[:len(item)-2]
+
+		wd := -1
+		for i, n := range weekdayNames {
+			if n == name {
+				wd = i
+				break
+			}
+		}
+		if wd < 0 {
+			return nil, fmt.Errorf("rrule: invalid BYDAY %q", item)
+		}
+
+		n := 0
+		if num != "" {
+			var err error
+			n, err = strconv.Atoi(num)
+			if err != nil || n == 0 {
+				return nil, fmt.Errorf("rrule: invalid BYDAY %q", item)
+			}
+		}
+		res = append(res, WeekdayNum{Weekday: time.Weekday(wd), N: n})
+	}
+
+	return res, nil
+}
+
+// parseUntil accepts UTC and floating date-times, a date covers the whole day.
+func parseUntil(value string) (time.Time, bool, error) {
+	if t, err := time.Parse(utcLayout, value); err == nil {
+		return t, false, nil
+	}
+	if t, err := time.Parse(floatingLayout, value); err == nil {
+		return t, true, nil
+	}
+	if t, err := time.Parse(dateLayout, value); err == nil {
+		return t.Add(24*time.Hour - time.Second), true, nil
+	}
+	return time.Time{}, false, fmt.Errorf("rrule: invalid UNTIL %s", value)
+}
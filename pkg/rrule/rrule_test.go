@@ -0,0 +1,279 @@
+package rrule
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func mustLoad(t *testing.T, name string) *time.Location {
+	t.Helper()
+
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		t.Skipf("time zone %s not available: %v", name, err)
+	}
+	return loc
+}
+
+// expand returns at most limit occurrences formatted in the location of start.
+func expand(t *testing.T, rule string, start time.Time, limit int) []string {
+	t.Helper()
+
+	r, err := Parse(rule)
+	if err != nil {
+		t.Fatalf("Parse(%q): %v", rule, err)
+	}
+	var res []string
+	it := r.Iterator(start)
+	for len(res) < limit {
+		o, ok := it.Next()
+		if !ok {
+			break
+		}
+		res = append(res, o.In(start.Location()).Format(floatingLayout))
+	}
+	return res
+}
+
+// at9 returns the days at 09:00, the wall clock time of the series below.
+func at9(days ...string) []string {
+	res := make([]string, len(days))
+	for i, d := range days {
+		res[i] = d + "T090000"
+	}
+	return res
+}
+
+// The examples of RFC 5545 section 3.8.5.3 which fit the supported subset.
+func TestRFC5545Examples(t *testing.T) {
+	ny := mustLoad(t, "America/New_York")
+	start := func(day int) time.Time { return time.Date(1997, time.September, day, 9, 0, 0, 0, ny) }
+
+	tests := []struct {
+		name  string
+		rule  string
+		start time.Time
+		limit int
+		want  []string
+	}{
+		{
+			name: "daily for 10 occurrences", rule: "FREQ=DAILY;COUNT=10", start: start(2), limit: 20,
+			want: at9("19970902", "19970903", "19970904", "19970905", "19970906", "19970907", "19970908", "19970909", "19970910", "19970911"),
+		},
+		{
+			name: "every other day", rule: "FREQ=DAILY;INTERVAL=2", start: start(2), limit: 4,
+			want: at9("19970902", "19970904", "19970906", "19970908"),
+		},
+		{
+			name: "every 10 days, 5 occurrences", rule: "FREQ=DAILY;INTERVAL=10;COUNT=5", start: start(2), limit: 20,
+			want: at9("19970902", "19970912", "19970922", "19971002", "19971012"),
+		},
+		{
+			name: "weekly for 10 occurrences across the DST change", rule: "FREQ=WEEKLY;COUNT=10", start: start(2), limit: 20,
+			want: at9("19970902", "19970909", "19970916", "19970923", "19970930", "19971007", "19971014", "19971021", "19971028", "19971104"),
+		},
+		{
+			name: "weekly on Tuesday and Thursday", rule: "FREQ=WEEKLY;COUNT=10;BYDAY=TU,TH", start: start(2), limit: 20,
+			want: at9("19970902", "19970904", "19970909", "19970911", "19970916", "19970918", "19970923", "19970925", "19970930", "19971002"),
+		},
+		{
+			name: "every other week on Monday, Wednesday and Friday until December 24", start: start(1), limit: 50,
+			rule: "FREQ=WEEKLY;INTERVAL=2;UNTIL=19971224T000000Z;BYDAY=MO,WE,FR",
+			want: at9("19970901", "19970903", "19970905", "19970915", "19970917", "19970919", "19970929",
+				"19971001", "19971003", "19971013", "19971015", "19971017", "19971027", "19971029", "19971031",
+				"19971110", "19971112", "19971114", "19971124", "19971126", "19971128",
+				"19971208", "19971210", "19971212", "19971222"),
+		},
+		{
+			name: "monthly on the first Friday", rule: "FREQ=MONTHLY;COUNT=10;BYDAY=1FR", start: start(5), limit: 20,
+			want: at9("19970905", "19971003", "19971107", "19971205", "19980102", "19980206", "19980306", "19980403", "19980501", "19980605"),
+		},
+		{
+			name: "every other month on the first and last Sunday", rule: "FREQ=MONTHLY;INTERVAL=2;COUNT=10;BYDAY=1SU,-1SU", start: start(7), limit: 20,
+			want: at9("19970907", "19970928", "19971102", "19971130", "19980104", "19980125", "19980301", "19980329", "19980503", "19980531"),
+		},
+		{
+			name: "monthly on the second-to-last Monday", rule: "FREQ=MONTHLY;COUNT=6;BYDAY=-2MO", start: start(22), limit: 20,
+			want: at9("19970922", "19971020", "19971117", "19971222", "19980119", "19980216"),
+		},
+		{
+			name: "every 20th Monday of the year", rule: "FREQ=YEARLY;BYDAY=20MO", limit: 3,
+			start: time.Date(1997, time.May, 19, 9, 0, 0, 0, ny),
+			want:  at9("19970519", "19980518", "19990517"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := expand(t, tt.rule, tt.start, tt.limit); !slices.Equal(got, tt.want) {
+				t.Errorf("occurrences = %v\nwant %v", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("daily until December 24", func(t *testing.T) {
+		got := expand(t, "FREQ=DAILY;UNTIL=19971224T000000Z", start(2), 200)
+		if len(got) != 113 || got[0] != "19970902T090000" || got[len(got)-1] != "19971223T090000" {
+			t.Errorf("got %d occurrences from %s to %s, want 113 from 19970902T090000 to 19971223T090000",
+				len(got), got[0], got[len(got)-1])
+		}
+	})
+}
+
+func TestIterator(t *testing.T) {
+	shanghai := mustLoad(t, "Asia/Shanghai")
+
+	tests := []struct {
+		name  string
+		rule  string
+		start time.Time
+		limit int
+		want  []string
+	}{
+		{
+			name: "last Friday of the month", rule: "FREQ=MONTHLY;BYDAY=-1FR", limit: 5,
+			start: time.Date(2026, time.January, 30, 9, 0, 0, 0, time.UTC),
+			want:  at9("20260130", "20260227", "20260327", "20260424", "20260529"),
+		},
+		{
+			name: "the 31st skips shorter months", rule: "FREQ=MONTHLY;COUNT=5", limit: 10,
+			start: time.Date(2026, time.January, 31, 9, 0, 0, 0, time.UTC),
+			want:  at9("20260131", "20260331", "20260531", "20260731", "20260831"),
+		},
+		{
+			name: "February 29 only in leap years", rule: "FREQ=YEARLY;COUNT=3", limit: 10,
+			start: time.Date(2024, time.February, 29, 9, 0, 0, 0, time.UTC),
+			want:  at9("20240229", "20280229", "20320229"),
+		},
+		{
+			name: "fifth Monday, months without one are skipped", rule: "FREQ=MONTHLY;BYDAY=5MO", limit: 5,
+			start: time.Date(2026, time.January, 5, 9, 0, 0, 0, time.UTC),
+			want:  at9("20260105", "20260330", "20260629", "20260831", "20261130"),
+		},
+		{
+			name: "weekdays", rule: "FREQ=DAILY;BYDAY=MO,TU,WE,TH,FR", limit: 6,
+			start: time.Date(2026, time.January, 1, 9, 0, 0, 0, time.UTC),
+			want:  at9("20260101", "20260102", "20260105", "20260106", "20260107", "20260108"),
+		},
+		{
+			name: "weekly BYDAY before the start weekday begins next week", rule: "FREQ=WEEKLY;BYDAY=MO,SU", limit: 4,
+			start: time.Date(2026, time.January, 7, 9, 0, 0, 0, time.UTC),
+			want:  at9("20260107", "20260111", "20260112", "20260118"),
+		},
+		{
+			name: "UTC UNTIL is inclusive", rule: "FREQ=DAILY;UNTIL=20260103T090000Z", limit: 10,
+			start: time.Date(2026, time.January, 1, 9, 0, 0, 0, time.UTC),
+			want:  at9("20260101", "20260102", "20260103"),
+		},
+		{
+			name: "a date UNTIL covers the whole day in the start zone", rule: "FREQ=DAILY;UNTIL=20260103", limit: 10,
+			start: time.Date(2026, time.January, 1, 9, 0, 0, 0, shanghai),
+			want:  at9("20260101", "20260102", "20260103"),
+		},
+		{
+			name: "COUNT includes the start", rule: "FREQ=WEEKLY;COUNT=1", limit: 10,
+			start: time.Date(2026, time.January, 1, 9, 0, 0, 0, time.UTC),
+			want:  at9("20260101"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := expand(t, tt.rule, tt.start, tt.limit); !slices.Equal(got, tt.want) {
+				t.Errorf("occurrences = %v\nwant %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIteratorGivesUpOnRulesNeverMatching(t *testing.T) {
+	// every seventh day from a Monday is never a Tuesday
+	r, err := Parse("FREQ=DAILY;INTERVAL=7;BYDAY=TU")
+	if err != nil {
+		t.Fatal(err)
+	}
+	start := time.Date(2026, time.January, 5, 9, 0, 0, 0, time.UTC)
+	it := r.Iterator(start)
+
+	if o, ok := it.Next(); !ok || !o.Equal(start) {
+		t.Fatalf("first occurrence = %v, %v, want the start", o, ok)
+	}
+	if o, ok := it.Next(); ok {
+		t.Fatalf("next occurrence = %v, want none", o)
+	}
+	if it.period != maxEmptyPeriods {
+		t.Errorf("searched %d periods, want %d", it.period, maxEmptyPeriods)
+	}
+	if _, ok := it.Next(); ok {
+		t.Error("iterator resumed after giving up")
+	}
+}
+
+func TestAfter(t *testing.T) {
+	r, err := Parse("FREQ=WEEKLY")
+	if err != nil {
+		t.Fatal(err)
+	}
+	start := time.Date(2026, time.January, 1, 9, 0, 0, 0, time.UTC)
+
+	got := r.After(start, time.Date(2026, time.January, 15, 9, 0, 0, 0, time.UTC), 2)
+	want := []time.Time{
+		time.Date(2026, time.January, 22, 9, 0, 0, 0, time.UTC),
+		time.Date(2026, time.January, 29, 9, 0, 0, 0, time.UTC),
+	}
+	if !slices.EqualFunc(got, want, time.Time.Equal) {
+		t.Errorf("After = %v, want %v", got, want)
+	}
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"FREQ=DAILY", "FREQ=DAILY"},
+		{"rrule:freq=monthly;interval=1;byday=1mo,-1fr;count=3", "FREQ=MONTHLY;BYDAY=1MO,-1FR;COUNT=3"},
+		{"FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE;WKST=MO", "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE"},
+		{"FREQ=YEARLY;UNTIL=20261231T235959Z", "FREQ=YEARLY;UNTIL=20261231T235959Z"},
+		{"FREQ=DAILY;UNTIL=20261231T090000", "FREQ=DAILY;UNTIL=20261231T090000"},
+		{"FREQ=DAILY;UNTIL=20261231", "FREQ=DAILY;UNTIL=20261231T235959"},
+	}
+	for _, tt := range tests {
+		r, err := Parse(tt.in)
+		if err != nil {
+			t.Errorf("Parse(%q): %v", tt.in, err)
+			continue
+		}
+		if got := r.String(); got != tt.want {
+			t.Errorf("Parse(%q).String() = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		in, err string
+	}{
+		{"", "empty rule"},
+		{"INTERVAL=2", "FREQ is required"},
+		{"FREQ=HOURLY", "unsupported FREQ"},
+		{"FREQ=DAILY;FREQ=WEEKLY", "duplicate part"},
+		{"FREQ=DAILY;COUNT", "malformed part"},
+		{"FREQ=DAILY;COUNT=0", "COUNT must be a positive integer"},
+		{"FREQ=DAILY;INTERVAL=-1", "INTERVAL must be a positive integer"},
+		{"FREQ=DAILY;COUNT=3;UNTIL=20261231", "mutually exclusive"},
+		{"FREQ=DAILY;UNTIL=tomorrow", "invalid UNTIL"},
+		{"FREQ=WEEKLY;BYDAY=XX", "invalid BYDAY"},
+		{"FREQ=WEEKLY;BYDAY=0MO", "invalid BYDAY"},
+		{"FREQ=WEEKLY;BYDAY=1MO", "ordinals are not allowed"},
+		{"FREQ=MONTHLY;BYDAY=6MO", "out of range"},
+		{"FREQ=YEARLY;BYDAY=-54MO", "out of range"},
+		{"FREQ=WEEKLY;WKST=SU", "unsupported WKST"},
+		{"FREQ=MONTHLY;BYMONTHDAY=1", "unsupported part"},
+	}
+	for _, tt := range tests {
+		_, err := Parse(tt.in)
+		if err == nil || !strings.Contains(err.Error(), tt.err) {
+			t.Errorf("Parse(%q) error = %v, want %q", tt.in, err, tt.err)
+		}
+	}
+}
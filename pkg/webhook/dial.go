@@ -0,0 +1,97 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+)
+
+// ErrForbiddenAddress is returned for a webhook which is, or resolves to, an
+// address of the host itself or of a private network.
+var ErrForbiddenAddress = errors.New("webhook: address not allowed")
+
+// reservedNets are the ranges refused on top of the ones net.IP tells about.
+var reservedNets = []*net.IPNet{
+	mustParseCIDR("0.0.0.0/8"),     // this network
+	mustParseCIDR("100.64.0.0/10"), // shared address space of carrier NATs
+}
+
+// AllowedIP reports whether webhooks may be posted to ip, loopback, link-local,
+// private, unspecified and multicast addresses are refused.
+func AllowedIP(ip net.IP) bool {
+	if ip == nil || ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsMulticast() {
+		return false
+	}
+	for _, n := range reservedNets {
+		if n.Contains(ip) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// CheckHost resolves host and fails with ErrForbiddenAddress unless all of
+// its addresses are allowed.
+func CheckHost(ctx context.Context, host string) error {
+	if ip := net.ParseIP(host); ip != nil {
+		if !AllowedIP(ip) {
+			return ErrForbiddenAddress
+		}
+		return nil
+	}
+
+	addrs, err := net.DefaultResolver.LookupIPAddr(ctx, host)
+	if err != nil {
+		return err
+	}
+	for _, addr := range addrs {
+		if !AllowedIP(addr.IP) {
+			return ErrForbiddenAddress
+		}
+	}
+
+	return nil
+}
+
+// guardedTransport returns a clone of base which only connects to the
+// addresses allow accepts. The address is checked once resolved, right before
+// connecting, so a name which resolves elsewhere by the time of the delivery
+// can't reach the private network either.
+func guardedTransport(base *http.Transport, allow func(net.IP) bool) *http.Transport {
+	dialer := &net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if !allow(net.ParseIP(host)) {
+				return ErrForbiddenAddress
+			}
+			return nil
+		},
+	}
+
+	t := base.Clone()
+	t.DialContext = dialer.DialContext
+	t.DialTLSContext = nil
+	// a proxy would connect on our behalf, past the check
+	t.Proxy = nil
+
+	return t
+}
+
+func mustParseCIDR(s string) *net.IPNet {
+	_, n, err := net.ParseCIDR(s)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
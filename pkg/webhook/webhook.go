@@ -0,0 +1,156 @@
+// Package webhook posts signed events to the URLs users subscribe, and checks
+// the signature on the receiving end.
+//
+// A request carries the time it was signed in TimestampHeader, in Unix seconds,
+// and SignatureHeader holds "sha256=" followed by the hex HMAC-SHA256 of the
+// timestamp, a dot and the body, keyed by the secret of the webhook.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	SignatureHeader = "X-Webhook-Signature"
+	TimestampHeader = "X-Webhook-Timestamp"
+	EventHeader     = "X-Webhook-Event"
+	DeliveryHeader  = "X-Webhook-Delivery"
+
+	signaturePrefix = "sha256="
+	defaultTimeout  = 10 * time.Second
+	// maxResponseBody bounds the response kept to tell why a delivery failed.
+	maxResponseBody = 256
+)
+
+var (
+	ErrMissingSignature = errors.New("webhook: missing signature")
+	ErrInvalidSignature = errors.New("webhook: invalid signature")
+	ErrExpiredSignature = errors.New("webhook: signature timestamp out of tolerance")
+)
+
+// Sign returns the signature of body sent at timestamp.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify checks the signature of a request received at now, a request signed
+// more than tolerance away from now is rejected so it can't be replayed later.
+func Verify(secret string, header http.Header, body []byte, now time.Time, tolerance time.Duration) error {
+	signature, ts := header.Get(SignatureHeader), header.Get(TimestampHeader)
+	if signature == "" || ts == "" {
+		return ErrMissingSignature
+	}
+	timestamp, err := strconv.ParseInt(ts, 10, 64)
+	if err != nil {
+		return ErrInvalidSignature
+	}
+	if d := now.Sub(time.Unix(timestamp, 0)); d > tolerance || d < -tolerance {
+		return ErrExpiredSignature
+	}
+	if !hmac.Equal([]byte(signature), []byte(Sign(secret, timestamp, body))) {
+		return ErrInvalidSignature
+	}
+
+	return nil
+}
+
+// Request is an event to post to a webhook.
+type Request struct {
+	URL        string
+	Secret     string
+	Event      string
+	DeliveryID string
+	Body       []byte
+}
+
+// StatusError is returned for a response outside 2xx.
+type StatusError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *StatusError) Error() string {
+	if e.Body == "" {
+		return fmt.Sprintf("unexpected status %d", e.StatusCode)
+	}
+	return fmt.Sprintf("unexpected status %d: %s", e.StatusCode, e.Body)
+}
+
+// Sender posts requests to webhooks.
+type Sender struct {
+	client *http.Client
+}
+
+// NewSender returns a sender posting with client, nil means a client with a
+// 10 seconds timeout. Redirects are never followed, they fail the delivery.
+// Only the addresses allow accepts are connected to, nil means AllowedIP. The
+// transport of client is replaced by a guarded clone of it, or of
+// http.DefaultTransport unless it is an *http.Transport.
+func NewSender(client *http.Client, allow func(net.IP) bool) *Sender {
+	if client == nil {
+		client = &http.Client{Timeout: defaultTimeout}
+	}
+	if allow == nil {
+		allow = AllowedIP
+	}
+	base, ok := client.Transport.(*http.Transport)
+	if !ok {
+		base = http.DefaultTransport.(*http.Transport)
+	}
+	c := *client
+	c.Transport = guardedTransport(base, allow)
+	c.CheckRedirect = func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	}
+
+	return &Sender{client: &c}
+}
+
+// Send signs and posts the request, it returns the status of the response
+// along with a *StatusError unless it is 2xx. The status is zero if no
+// response came back.
+func (s *Sender) Send(ctx context.Context, req *Request) (int, error) {
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, req.URL, bytes.NewReader(req.Body))
+	if err != nil {
+		return 0, err
+	}
+	timestamp := time.Now().Unix()
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("User-Agent", "zrpc-todolist-webhook")
+	httpReq.Header.Set(EventHeader, req.Event)
+	httpReq.Header.Set(DeliveryHeader, req.DeliveryID)
+	httpReq.Header.Set(TimestampHeader, strconv.FormatInt(timestamp, 10))
+	httpReq.Header.Set(SignatureHeader, Sign(req.Secret, timestamp, req.Body))
+
+	resp, err := s.client.Do(httpReq)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		// drain the body so the connection is reused
+		_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+		return resp.StatusCode, nil
+	}
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, maxResponseBody))
+
+	return resp.StatusCode, &StatusError{StatusCode: resp.StatusCode, Body: strings.TrimSpace(string(body))}
+}
@@ -0,0 +1,184 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+const testSecret = "whsec_0123456789abcdef"
+
+func allowAll(net.IP) bool { return true }
+
+func TestSendSigned(t *testing.T) {
+	body := []byte(`{"type":"task.created"}`)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got, _ := io.ReadAll(r.Body)
+		if err := Verify(testSecret, r.Header, got, time.Now(), time.Minute); err != nil {
+			t.Errorf("Verify() = %v", err)
+		}
+		if err := Verify("whsec_another_secret", r.Header, got, time.Now(), time.Minute); !errors.Is(err, ErrInvalidSignature) {
+			t.Errorf("Verify() with another secret = %v, want %v", err, ErrInvalidSignature)
+		}
+		if string(got) != string(body) {
+			t.Errorf("body = %s, want %s", got, body)
+		}
+		if r.Header.Get(EventHeader) != "task.created" || r.Header.Get(DeliveryHeader) != "42" {
+			t.Errorf("event = %q, delivery = %q", r.Header.Get(EventHeader), r.Header.Get(DeliveryHeader))
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	status, err := NewSender(nil, allowAll).Send(context.Background(), &Request{
+		URL:        srv.URL,
+		Secret:     testSecret,
+		Event:      "task.created",
+		DeliveryID: "42",
+		Body:       body,
+	})
+	if err != nil || status != http.StatusNoContent {
+		t.Fatalf("Send() = %d, %v, want %d", status, err, http.StatusNoContent)
+	}
+}
+
+func TestSendStatusError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/moved" {
+			http.Redirect(w, r, "/", http.StatusFound)
+			return
+		}
+		http.Error(w, "  down for maintenance  ", http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	sender := NewSender(nil, allowAll)
+	tests := []struct {
+		path   string
+		status int
+		body   string
+	}{
+		{"/", http.StatusServiceUnavailable, "down for maintenance"},
+		// redirects fail the delivery instead of being followed
+		{"/moved", http.StatusFound, ""},
+	}
+	for _, tt := range tests {
+		status, err := sender.Send(context.Background(), &Request{URL: srv.URL + tt.path, Secret: testSecret})
+		var statusErr *StatusError
+		if !errors.As(err, &statusErr) {
+			t.Fatalf("Send(%s) err = %v, want *StatusError", tt.path, err)
+		}
+		if status != tt.status || statusErr.StatusCode != tt.status || statusErr.Body != tt.body {
+			t.Errorf("Send(%s) = %d, %+v, want %d %q", tt.path, status, statusErr, tt.status, tt.body)
+		}
+	}
+}
+
+func TestSendForbiddenAddress(t *testing.T) {
+	var hits atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+	}))
+	defer srv.Close()
+
+	_, port, _ := net.SplitHostPort(strings.TrimPrefix(srv.URL, "http://"))
+	// the name is only resolved when dialing, as it would be after a rebinding
+	for _, rawURL := range []string{srv.URL, "http://localhost:" + port} {
+		status, err := NewSender(srv.Client(), nil).Send(context.Background(), &Request{URL: rawURL, Secret: testSecret})
+		if status != 0 || !errors.Is(err, ErrForbiddenAddress) {
+			t.Errorf("Send(%s) = %d, %v, want %v", rawURL, status, err, ErrForbiddenAddress)
+		}
+	}
+	if n := hits.Load(); n != 0 {
+		t.Fatalf("receiver got %d requests, want 0", n)
+	}
+}
+
+func TestAllowedIP(t *testing.T) {
+	tests := []struct {
+		ip   string
+		want bool
+	}{
+		{"93.184.216.34", true},
+		{"2606:2800:220:1:248:1893:25c8:1946", true},
+		{"127.0.0.1", false},
+		{"127.8.8.8", false},
+		{"::1", false},
+		{"::ffff:127.0.0.1", false},
+		{"0.0.0.0", false},
+		{"0.1.2.3", false},
+		{"::", false},
+		{"10.1.2.3", false},
+		{"172.16.0.1", false},
+		{"192.168.1.1", false},
+		{"fd00::1", false},
+		{"100.64.0.1", false},
+		{"169.254.169.254", false},
+		{"fe80::1", false},
+		{"224.0.0.1", false},
+		{"ff02::1", false},
+	}
+	for _, tt := range tests {
+		if got := AllowedIP(net.ParseIP(tt.ip)); got != tt.want {
+			t.Errorf("AllowedIP(%s) = %v, want %v", tt.ip, got, tt.want)
+		}
+	}
+	if AllowedIP(nil) {
+		t.Error("AllowedIP(nil) = true, want false")
+	}
+}
+
+func TestCheckHost(t *testing.T) {
+	tests := []struct {
+		host string
+		want error
+	}{
+		{"93.184.216.34", nil},
+		{"10.0.0.1", ErrForbiddenAddress},
+		{"::1", ErrForbiddenAddress},
+		{"localhost", ErrForbiddenAddress},
+	}
+	for _, tt := range tests {
+		if err := CheckHost(context.Background(), tt.host); !errors.Is(err, tt.want) {
+			t.Errorf("CheckHost(%s) = %v, want %v", tt.host, err, tt.want)
+		}
+	}
+}
+
+func TestVerify(t *testing.T) {
+	body := []byte(`{}`)
+	now := time.Unix(1_800_000_000, 0)
+	signed := func(ts int64, signature string) http.Header {
+		h := http.Header{}
+		h.Set(TimestampHeader, strconv.FormatInt(ts, 10))
+		h.Set(SignatureHeader, signature)
+		return h
+	}
+	ts := now.Unix()
+
+	tests := []struct {
+		name   string
+		header http.Header
+		want   error
+	}{
+		{"valid", signed(ts, Sign(testSecret, ts, body)), nil},
+		{"missing", http.Header{}, ErrMissingSignature},
+		{"tampered", signed(ts, Sign(testSecret, ts, []byte(`{"a":1}`))), ErrInvalidSignature},
+		{"bad timestamp", http.Header{TimestampHeader: {"soon"}, SignatureHeader: {"sha256=00"}}, ErrInvalidSignature},
+		{"expired", signed(ts-301, Sign(testSecret, ts-301, body)), ErrExpiredSignature},
+		{"ahead", signed(ts+301, Sign(testSecret, ts+301, body)), ErrExpiredSignature},
+	}
+	for _, tt := range tests {
+		if err := Verify(testSecret, tt.header, body, now, 5*time.Minute); !errors.Is(err, tt.want) {
+			t.Errorf("%s: Verify() = %v, want %v", tt.name, err, tt.want)
+		}
+	}
+}
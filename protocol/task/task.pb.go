@@ -21,33 +21,8170 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// TaskPriority values match the persisted task priority.
+type TaskPriority int32
+
+const (
+	TaskPriority_TASK_PRIORITY_NONE   TaskPriority = 0
+	TaskPriority_TASK_PRIORITY_LOW    TaskPriority = 1
+	TaskPriority_TASK_PRIORITY_MEDIUM TaskPriority = 2
+	TaskPriority_TASK_PRIORITY_HIGH   TaskPriority = 3
+	TaskPriority_TASK_PRIORITY_URGENT TaskPriority = 4
+)
+
+// Enum value maps for TaskPriority.
+var (
+	TaskPriority_name = map[int32]string{
+		0: "TASK_PRIORITY_NONE",
+		1: "TASK_PRIORITY_LOW",
+		2: "TASK_PRIORITY_MEDIUM",
+		3: "TASK_PRIORITY_HIGH",
+		4: "TASK_PRIORITY_URGENT",
+	}
+	TaskPriority_value = map[string]int32{
+		"TASK_PRIORITY_NONE":   0,
+		"TASK_PRIORITY_LOW":    1,
+		"TASK_PRIORITY_MEDIUM": 2,
+		"TASK_PRIORITY_HIGH":   3,
+		"TASK_PRIORITY_URGENT": 4,
+	}
+)
+
+func (x TaskPriority) Enum() *TaskPriority {
+	p := new(TaskPriority)
+	*p = x
+	return p
+}
+
+func (x TaskPriority) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TaskPriority) Descriptor() protoreflect.EnumDescriptor {
+	return file_idl_task_proto_enumTypes[0].Descriptor()
+}
+
+func (TaskPriority) Type() protoreflect.EnumType {
+	return &file_idl_task_proto_enumTypes[0]
+}
+
+func (x TaskPriority) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TaskPriority.Descriptor instead.
+func (TaskPriority) EnumDescriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{0}
+}
+
+// TaskStatus values match the persisted task status.
+type TaskStatus int32
+
+const (
+	TaskStatus_TASK_STATUS_TODO        TaskStatus = 0
+	TaskStatus_TASK_STATUS_DONE        TaskStatus = 1
+	TaskStatus_TASK_STATUS_IN_PROGRESS TaskStatus = 2
+	TaskStatus_TASK_STATUS_ARCHIVED    TaskStatus = 3
+	TaskStatus_TASK_STATUS_TRASHED     TaskStatus = 4
+)
+
+// Enum value maps for TaskStatus.
+var (
+	TaskStatus_name = map[int32]string{
+		0: "TASK_STATUS_TODO",
+		1: "TASK_STATUS_DONE",
+		2: "TASK_STATUS_IN_PROGRESS",
+		3: "TASK_STATUS_ARCHIVED",
+		4: "TASK_STATUS_TRASHED",
+	}
+	TaskStatus_value = map[string]int32{
+		"TASK_STATUS_TODO":        0,
+		"TASK_STATUS_DONE":        1,
+		"TASK_STATUS_IN_PROGRESS": 2,
+		"TASK_STATUS_ARCHIVED":    3,
+		"TASK_STATUS_TRASHED":     4,
+	}
+)
+
+func (x TaskStatus) Enum() *TaskStatus {
+	p := new(TaskStatus)
+	*p = x
+	return p
+}
+
+func (x TaskStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TaskStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_idl_task_proto_enumTypes[1].Descriptor()
+}
+
+func (TaskStatus) Type() protoreflect.EnumType {
+	return &file_idl_task_proto_enumTypes[1]
+}
+
+func (x TaskStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TaskStatus.Descriptor instead.
+func (TaskStatus) EnumDescriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{1}
+}
+
+type SortField int32
+
+const (
+	SortField_SORT_FIELD_CREATED_AT SortField = 0
+	SortField_SORT_FIELD_UPDATED_AT SortField = 1
+	// SORT_FIELD_PRIORITY lists the most urgent tasks first in descending order.
+	SortField_SORT_FIELD_PRIORITY SortField = 2
+	// SORT_FIELD_RANK follows the manual order in ascending order.
+	SortField_SORT_FIELD_RANK SortField = 3
+)
+
+// Enum value maps for SortField.
+var (
+	SortField_name = map[int32]string{
+		0: "SORT_FIELD_CREATED_AT",
+		1: "SORT_FIELD_UPDATED_AT",
+		2: "SORT_FIELD_PRIORITY",
+		3: "SORT_FIELD_RANK",
+	}
+	SortField_value = map[string]int32{
+		"SORT_FIELD_CREATED_AT": 0,
+		"SORT_FIELD_UPDATED_AT": 1,
+		"SORT_FIELD_PRIORITY":   2,
+		"SORT_FIELD_RANK":       3,
+	}
+)
+
+func (x SortField) Enum() *SortField {
+	p := new(SortField)
+	*p = x
+	return p
+}
+
+func (x SortField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SortField) Descriptor() protoreflect.EnumDescriptor {
+	return file_idl_task_proto_enumTypes[2].Descriptor()
+}
+
+func (SortField) Type() protoreflect.EnumType {
+	return &file_idl_task_proto_enumTypes[2]
+}
+
+func (x SortField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SortField.Descriptor instead.
+func (SortField) EnumDescriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{2}
+}
+
+type SortOrder int32
+
+const (
+	SortOrder_SORT_ORDER_DESC SortOrder = 0
+	SortOrder_SORT_ORDER_ASC  SortOrder = 1
+)
+
+// Enum value maps for SortOrder.
+var (
+	SortOrder_name = map[int32]string{
+		0: "SORT_ORDER_DESC",
+		1: "SORT_ORDER_ASC",
+	}
+	SortOrder_value = map[string]int32{
+		"SORT_ORDER_DESC": 0,
+		"SORT_ORDER_ASC":  1,
+	}
+)
+
+func (x SortOrder) Enum() *SortOrder {
+	p := new(SortOrder)
+	*p = x
+	return p
+}
+
+func (x SortOrder) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SortOrder) Descriptor() protoreflect.EnumDescriptor {
+	return file_idl_task_proto_enumTypes[3].Descriptor()
+}
+
+func (SortOrder) Type() protoreflect.EnumType {
+	return &file_idl_task_proto_enumTypes[3]
+}
+
+func (x SortOrder) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SortOrder.Descriptor instead.
+func (SortOrder) EnumDescriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{3}
+}
+
+// UpdateScope tells whether an update of a recurring task applies to this
+// occurrence only or to every open occurrence of the series. Changing the
+// recurrence of a series requires UPDATE_SCOPE_SERIES.
+type UpdateScope int32
+
+const (
+	UpdateScope_UPDATE_SCOPE_THIS   UpdateScope = 0
+	UpdateScope_UPDATE_SCOPE_SERIES UpdateScope = 1
+)
+
+// Enum value maps for UpdateScope.
+var (
+	UpdateScope_name = map[int32]string{
+		0: "UPDATE_SCOPE_THIS",
+		1: "UPDATE_SCOPE_SERIES",
+	}
+	UpdateScope_value = map[string]int32{
+		"UPDATE_SCOPE_THIS":   0,
+		"UPDATE_SCOPE_SERIES": 1,
+	}
+)
+
+func (x UpdateScope) Enum() *UpdateScope {
+	p := new(UpdateScope)
+	*p = x
+	return p
+}
+
+func (x UpdateScope) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UpdateScope) Descriptor() protoreflect.EnumDescriptor {
+	return file_idl_task_proto_enumTypes[4].Descriptor()
+}
+
+func (UpdateScope) Type() protoreflect.EnumType {
+	return &file_idl_task_proto_enumTypes[4]
+}
+
+func (x UpdateScope) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UpdateScope.Descriptor instead.
+func (UpdateScope) EnumDescriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{4}
+}
+
+type DueView int32
+
+const (
+	DueView_DUE_VIEW_OVERDUE DueView = 0
+	DueView_DUE_VIEW_TODAY   DueView = 1
+)
+
+// Enum value maps for DueView.
+var (
+	DueView_name = map[int32]string{
+		0: "DUE_VIEW_OVERDUE",
+		1: "DUE_VIEW_TODAY",
+	}
+	DueView_value = map[string]int32{
+		"DUE_VIEW_OVERDUE": 0,
+		"DUE_VIEW_TODAY":   1,
+	}
+)
+
+func (x DueView) Enum() *DueView {
+	p := new(DueView)
+	*p = x
+	return p
+}
+
+func (x DueView) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DueView) Descriptor() protoreflect.EnumDescriptor {
+	return file_idl_task_proto_enumTypes[5].Descriptor()
+}
+
+func (DueView) Type() protoreflect.EnumType {
+	return &file_idl_task_proto_enumTypes[5]
+}
+
+func (x DueView) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DueView.Descriptor instead.
+func (DueView) EnumDescriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{5}
+}
+
+// ActivityAction values match the persisted activity action.
+type ActivityAction int32
+
+const (
+	ActivityAction_ACTIVITY_ACTION_CREATED ActivityAction = 0
+	ActivityAction_ACTIVITY_ACTION_UPDATED ActivityAction = 1
+)
+
+// Enum value maps for ActivityAction.
+var (
+	ActivityAction_name = map[int32]string{
+		0: "ACTIVITY_ACTION_CREATED",
+		1: "ACTIVITY_ACTION_UPDATED",
+	}
+	ActivityAction_value = map[string]int32{
+		"ACTIVITY_ACTION_CREATED": 0,
+		"ACTIVITY_ACTION_UPDATED": 1,
+	}
+)
+
+func (x ActivityAction) Enum() *ActivityAction {
+	p := new(ActivityAction)
+	*p = x
+	return p
+}
+
+func (x ActivityAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ActivityAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_idl_task_proto_enumTypes[6].Descriptor()
+}
+
+func (ActivityAction) Type() protoreflect.EnumType {
+	return &file_idl_task_proto_enumTypes[6]
+}
+
+func (x ActivityAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ActivityAction.Descriptor instead.
+func (ActivityAction) EnumDescriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{6}
+}
+
+// ActivitySource values match the persisted activity source.
+type ActivitySource int32
+
+const (
+	ActivitySource_ACTIVITY_SOURCE_API       ActivitySource = 0
+	ActivitySource_ACTIVITY_SOURCE_SCHEDULER ActivitySource = 1
+)
+
+// Enum value maps for ActivitySource.
+var (
+	ActivitySource_name = map[int32]string{
+		0: "ACTIVITY_SOURCE_API",
+		1: "ACTIVITY_SOURCE_SCHEDULER",
+	}
+	ActivitySource_value = map[string]int32{
+		"ACTIVITY_SOURCE_API":       0,
+		"ACTIVITY_SOURCE_SCHEDULER": 1,
+	}
+)
+
+func (x ActivitySource) Enum() *ActivitySource {
+	p := new(ActivitySource)
+	*p = x
+	return p
+}
+
+func (x ActivitySource) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ActivitySource) Descriptor() protoreflect.EnumDescriptor {
+	return file_idl_task_proto_enumTypes[7].Descriptor()
+}
+
+func (ActivitySource) Type() protoreflect.EnumType {
+	return &file_idl_task_proto_enumTypes[7]
+}
+
+func (x ActivitySource) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ActivitySource.Descriptor instead.
+func (ActivitySource) EnumDescriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{7}
+}
+
+// TransferFormat is the file format tasks are exported to and imported from.
+type TransferFormat int32
+
+const (
+	TransferFormat_TRANSFER_FORMAT_JSON TransferFormat = 0
+	TransferFormat_TRANSFER_FORMAT_CSV  TransferFormat = 1
+	TransferFormat_TRANSFER_FORMAT_ICAL TransferFormat = 2
+)
+
+// Enum value maps for TransferFormat.
+var (
+	TransferFormat_name = map[int32]string{
+		0: "TRANSFER_FORMAT_JSON",
+		1: "TRANSFER_FORMAT_CSV",
+		2: "TRANSFER_FORMAT_ICAL",
+	}
+	TransferFormat_value = map[string]int32{
+		"TRANSFER_FORMAT_JSON": 0,
+		"TRANSFER_FORMAT_CSV":  1,
+		"TRANSFER_FORMAT_ICAL": 2,
+	}
+)
+
+func (x TransferFormat) Enum() *TransferFormat {
+	p := new(TransferFormat)
+	*p = x
+	return p
+}
+
+func (x TransferFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TransferFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_idl_task_proto_enumTypes[8].Descriptor()
+}
+
+func (TransferFormat) Type() protoreflect.EnumType {
+	return &file_idl_task_proto_enumTypes[8]
+}
+
+func (x TransferFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TransferFormat.Descriptor instead.
+func (TransferFormat) EnumDescriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{8}
+}
+
+type QuickAddTokenKind int32
+
+const (
+	QuickAddTokenKind_QUICK_ADD_TOKEN_KIND_DUE        QuickAddTokenKind = 0
+	QuickAddTokenKind_QUICK_ADD_TOKEN_KIND_TAG        QuickAddTokenKind = 1
+	QuickAddTokenKind_QUICK_ADD_TOKEN_KIND_PRIORITY   QuickAddTokenKind = 2
+	QuickAddTokenKind_QUICK_ADD_TOKEN_KIND_RECURRENCE QuickAddTokenKind = 3
+)
+
+// Enum value maps for QuickAddTokenKind.
+var (
+	QuickAddTokenKind_name = map[int32]string{
+		0: "QUICK_ADD_TOKEN_KIND_DUE",
+		1: "QUICK_ADD_TOKEN_KIND_TAG",
+		2: "QUICK_ADD_TOKEN_KIND_PRIORITY",
+		3: "QUICK_ADD_TOKEN_KIND_RECURRENCE",
+	}
+	QuickAddTokenKind_value = map[string]int32{
+		"QUICK_ADD_TOKEN_KIND_DUE":        0,
+		"QUICK_ADD_TOKEN_KIND_TAG":        1,
+		"QUICK_ADD_TOKEN_KIND_PRIORITY":   2,
+		"QUICK_ADD_TOKEN_KIND_RECURRENCE": 3,
+	}
+)
+
+func (x QuickAddTokenKind) Enum() *QuickAddTokenKind {
+	p := new(QuickAddTokenKind)
+	*p = x
+	return p
+}
+
+func (x QuickAddTokenKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (QuickAddTokenKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_idl_task_proto_enumTypes[9].Descriptor()
+}
+
+func (QuickAddTokenKind) Type() protoreflect.EnumType {
+	return &file_idl_task_proto_enumTypes[9]
+}
+
+func (x QuickAddTokenKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use QuickAddTokenKind.Descriptor instead.
+func (QuickAddTokenKind) EnumDescriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{9}
+}
+
+type ClientChangeOp int32
+
+const (
+	ClientChangeOp_CLIENT_CHANGE_OP_CREATE ClientChangeOp = 0
+	ClientChangeOp_CLIENT_CHANGE_OP_UPDATE ClientChangeOp = 1
+	ClientChangeOp_CLIENT_CHANGE_OP_DELETE ClientChangeOp = 2
+)
+
+// Enum value maps for ClientChangeOp.
+var (
+	ClientChangeOp_name = map[int32]string{
+		0: "CLIENT_CHANGE_OP_CREATE",
+		1: "CLIENT_CHANGE_OP_UPDATE",
+		2: "CLIENT_CHANGE_OP_DELETE",
+	}
+	ClientChangeOp_value = map[string]int32{
+		"CLIENT_CHANGE_OP_CREATE": 0,
+		"CLIENT_CHANGE_OP_UPDATE": 1,
+		"CLIENT_CHANGE_OP_DELETE": 2,
+	}
+)
+
+func (x ClientChangeOp) Enum() *ClientChangeOp {
+	p := new(ClientChangeOp)
+	*p = x
+	return p
+}
+
+func (x ClientChangeOp) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ClientChangeOp) Descriptor() protoreflect.EnumDescriptor {
+	return file_idl_task_proto_enumTypes[10].Descriptor()
+}
+
+func (ClientChangeOp) Type() protoreflect.EnumType {
+	return &file_idl_task_proto_enumTypes[10]
+}
+
+func (x ClientChangeOp) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ClientChangeOp.Descriptor instead.
+func (ClientChangeOp) EnumDescriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{10}
+}
+
+type TaskEventType int32
+
+const (
+	TaskEventType_TASK_EVENT_CREATED        TaskEventType = 0
+	TaskEventType_TASK_EVENT_UPDATED        TaskEventType = 1
+	TaskEventType_TASK_EVENT_STATUS_CHANGED TaskEventType = 2
+	TaskEventType_TASK_EVENT_DELETED        TaskEventType = 3
+)
+
+// Enum value maps for TaskEventType.
+var (
+	TaskEventType_name = map[int32]string{
+		0: "TASK_EVENT_CREATED",
+		1: "TASK_EVENT_UPDATED",
+		2: "TASK_EVENT_STATUS_CHANGED",
+		3: "TASK_EVENT_DELETED",
+	}
+	TaskEventType_value = map[string]int32{
+		"TASK_EVENT_CREATED":        0,
+		"TASK_EVENT_UPDATED":        1,
+		"TASK_EVENT_STATUS_CHANGED": 2,
+		"TASK_EVENT_DELETED":        3,
+	}
+)
+
+func (x TaskEventType) Enum() *TaskEventType {
+	p := new(TaskEventType)
+	*p = x
+	return p
+}
+
+func (x TaskEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TaskEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_idl_task_proto_enumTypes[11].Descriptor()
+}
+
+func (TaskEventType) Type() protoreflect.EnumType {
+	return &file_idl_task_proto_enumTypes[11]
+}
+
+func (x TaskEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TaskEventType.Descriptor instead.
+func (TaskEventType) EnumDescriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{11}
+}
+
+// WebhookDeliveryStatus DEAD deliveries ran out of attempts, they are only sent
+// again when replayed.
+type WebhookDeliveryStatus int32
+
+const (
+	WebhookDeliveryStatus_WEBHOOK_DELIVERY_PENDING   WebhookDeliveryStatus = 0
+	WebhookDeliveryStatus_WEBHOOK_DELIVERY_SUCCEEDED WebhookDeliveryStatus = 1
+	WebhookDeliveryStatus_WEBHOOK_DELIVERY_DEAD      WebhookDeliveryStatus = 2
+)
+
+// Enum value maps for WebhookDeliveryStatus.
+var (
+	WebhookDeliveryStatus_name = map[int32]string{
+		0: "WEBHOOK_DELIVERY_PENDING",
+		1: "WEBHOOK_DELIVERY_SUCCEEDED",
+		2: "WEBHOOK_DELIVERY_DEAD",
+	}
+	WebhookDeliveryStatus_value = map[string]int32{
+		"WEBHOOK_DELIVERY_PENDING":   0,
+		"WEBHOOK_DELIVERY_SUCCEEDED": 1,
+		"WEBHOOK_DELIVERY_DEAD":      2,
+	}
+)
+
+func (x WebhookDeliveryStatus) Enum() *WebhookDeliveryStatus {
+	p := new(WebhookDeliveryStatus)
+	*p = x
+	return p
+}
+
+func (x WebhookDeliveryStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WebhookDeliveryStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_idl_task_proto_enumTypes[12].Descriptor()
+}
+
+func (WebhookDeliveryStatus) Type() protoreflect.EnumType {
+	return &file_idl_task_proto_enumTypes[12]
+}
+
+func (x WebhookDeliveryStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WebhookDeliveryStatus.Descriptor instead.
+func (WebhookDeliveryStatus) EnumDescriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{12}
+}
+
+// Recurrence is an RFC 5545 RRULE subset: FREQ (DAILY, WEEKLY, MONTHLY, YEARLY),
+// INTERVAL, BYDAY, COUNT and UNTIL, e.g. "FREQ=WEEKLY;BYDAY=MO,WE;COUNT=10".
+type Recurrence struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Rule  string                 `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	// IANA time zone name the rule is evaluated in, defaults to UTC.
+	TimeZone      string `protobuf:"bytes,2,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Recurrence) Reset() {
+	*x = Recurrence{}
+	mi := &file_idl_task_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Recurrence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Recurrence) ProtoMessage() {}
+
+func (x *Recurrence) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Recurrence.ProtoReflect.Descriptor instead.
+func (*Recurrence) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Recurrence) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+func (x *Recurrence) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+// Tag labels the tasks of a user, its name is unique per user.
+type Tag struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	TagID int64                  `protobuf:"varint,1,opt,name=tagID,proto3" json:"tagID,omitempty"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// color is a #RRGGBB hex color, empty means the default one.
+	Color string `protobuf:"bytes,3,opt,name=color,proto3" json:"color,omitempty"`
+	// task_count is the number of live tasks carrying the tag, only filled by ListTags.
+	TaskCount     int64 `protobuf:"varint,4,opt,name=task_count,json=taskCount,proto3" json:"task_count,omitempty"`
+	CreatedAt     int64 `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     int64 `protobuf:"varint,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Tag) Reset() {
+	*x = Tag{}
+	mi := &file_idl_task_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Tag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tag) ProtoMessage() {}
+
+func (x *Tag) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tag.ProtoReflect.Descriptor instead.
+func (*Tag) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Tag) GetTagID() int64 {
+	if x != nil {
+		return x.TagID
+	}
+	return 0
+}
+
+func (x *Tag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Tag) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+func (x *Tag) GetTaskCount() int64 {
+	if x != nil {
+		return x.TaskCount
+	}
+	return 0
+}
+
+func (x *Tag) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Tag) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+// Project groups tasks. Every user has an inbox project, which takes the tasks
+// created without a project and can't be archived or deleted.
+type Project struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectID     int64                  `protobuf:"varint,1,opt,name=projectID,proto3" json:"projectID,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Position      int64                  `protobuf:"varint,3,opt,name=position,proto3" json:"position,omitempty"`
+	Inbox         bool                   `protobuf:"varint,4,opt,name=inbox,proto3" json:"inbox,omitempty"`
+	Archived      bool                   `protobuf:"varint,5,opt,name=archived,proto3" json:"archived,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     int64                  `protobuf:"varint,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Project) Reset() {
+	*x = Project{}
+	mi := &file_idl_task_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Project) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Project) ProtoMessage() {}
+
+func (x *Project) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Project.ProtoReflect.Descriptor instead.
+func (*Project) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Project) GetProjectID() int64 {
+	if x != nil {
+		return x.ProjectID
+	}
+	return 0
+}
+
+func (x *Project) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Project) GetPosition() int64 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *Project) GetInbox() bool {
+	if x != nil {
+		return x.Inbox
+	}
+	return false
+}
+
+func (x *Project) GetArchived() bool {
+	if x != nil {
+		return x.Archived
+	}
+	return false
+}
+
+func (x *Project) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Project) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+// ChecklistItem is a lightweight step inside a task.
+type ChecklistItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ItemID        int64                  `protobuf:"varint,1,opt,name=itemID,proto3" json:"itemID,omitempty"`
+	TaskID        int64                  `protobuf:"varint,2,opt,name=taskID,proto3" json:"taskID,omitempty"`
+	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	Checked       bool                   `protobuf:"varint,4,opt,name=checked,proto3" json:"checked,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     int64                  `protobuf:"varint,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChecklistItem) Reset() {
+	*x = ChecklistItem{}
+	mi := &file_idl_task_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChecklistItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChecklistItem) ProtoMessage() {}
+
+func (x *ChecklistItem) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChecklistItem.ProtoReflect.Descriptor instead.
+func (*ChecklistItem) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ChecklistItem) GetItemID() int64 {
+	if x != nil {
+		return x.ItemID
+	}
+	return 0
+}
+
+func (x *ChecklistItem) GetTaskID() int64 {
+	if x != nil {
+		return x.TaskID
+	}
+	return 0
+}
+
+func (x *ChecklistItem) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *ChecklistItem) GetChecked() bool {
+	if x != nil {
+		return x.Checked
+	}
+	return false
+}
+
+func (x *ChecklistItem) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *ChecklistItem) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+// Comment is a note in the discussion thread of a task.
+type Comment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CommentID     int64                  `protobuf:"varint,1,opt,name=commentID,proto3" json:"commentID,omitempty"`
+	TaskID        int64                  `protobuf:"varint,2,opt,name=taskID,proto3" json:"taskID,omitempty"`
+	UserID        int64                  `protobuf:"varint,3,opt,name=userID,proto3" json:"userID,omitempty"`
+	Content       string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     int64                  `protobuf:"varint,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Comment) Reset() {
+	*x = Comment{}
+	mi := &file_idl_task_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Comment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Comment) ProtoMessage() {}
+
+func (x *Comment) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Comment.ProtoReflect.Descriptor instead.
+func (*Comment) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Comment) GetCommentID() int64 {
+	if x != nil {
+		return x.CommentID
+	}
+	return 0
+}
+
+func (x *Comment) GetTaskID() int64 {
+	if x != nil {
+		return x.TaskID
+	}
+	return 0
+}
+
+func (x *Comment) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *Comment) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *Comment) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Comment) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+// Attachment is a file attached to a task, size is in bytes.
+type Attachment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AttachmentID  int64                  `protobuf:"varint,1,opt,name=attachmentID,proto3" json:"attachmentID,omitempty"`
+	TaskID        int64                  `protobuf:"varint,2,opt,name=taskID,proto3" json:"taskID,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	ContentType   string                 `protobuf:"bytes,4,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Size          int64                  `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Attachment) Reset() {
+	*x = Attachment{}
+	mi := &file_idl_task_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Attachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Attachment) ProtoMessage() {}
+
+func (x *Attachment) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Attachment.ProtoReflect.Descriptor instead.
+func (*Attachment) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Attachment) GetAttachmentID() int64 {
+	if x != nil {
+		return x.AttachmentID
+	}
+	return 0
+}
+
+func (x *Attachment) GetTaskID() int64 {
+	if x != nil {
+		return x.TaskID
+	}
+	return 0
+}
+
+func (x *Attachment) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Attachment) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *Attachment) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *Attachment) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
 type Task struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	TaskID     int64                  `protobuf:"varint,1,opt,name=taskID,proto3" json:"taskID,omitempty"`
+	Title      string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Content    string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	CreatedAt  int64                  `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt  int64                  `protobuf:"varint,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	DueAt      *int64                 `protobuf:"varint,7,opt,name=due_at,json=dueAt,proto3,oneof" json:"due_at,omitempty"`
+	RemindAt   *int64                 `protobuf:"varint,8,opt,name=remind_at,json=remindAt,proto3,oneof" json:"remind_at,omitempty"`
+	Recurrence *Recurrence            `protobuf:"bytes,9,opt,name=recurrence,proto3" json:"recurrence,omitempty"`
+	SeriesId   int64                  `protobuf:"varint,10,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`
+	Status     TaskStatus             `protobuf:"varint,11,opt,name=status,proto3,enum=task.TaskStatus" json:"status,omitempty"`
+	// version increases on every write, see UpdateTaskRequest.version.
+	Version int64  `protobuf:"varint,12,opt,name=version,proto3" json:"version,omitempty"`
+	Tags    []*Tag `protobuf:"bytes,13,rep,name=tags,proto3" json:"tags,omitempty"`
+	// project_id zero means the inbox.
+	ProjectId int64 `protobuf:"varint,14,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	// parent_id zero means a top level task.
+	ParentId int64 `protobuf:"varint,15,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	// auto_complete finishes the task once all of its subtasks and checklist items are done.
+	AutoComplete bool `protobuf:"varint,16,opt,name=auto_complete,json=autoComplete,proto3" json:"auto_complete,omitempty"`
+	// completion_percent is the share of done subtasks and checked checklist items.
+	CompletionPercent int32            `protobuf:"varint,17,opt,name=completion_percent,json=completionPercent,proto3" json:"completion_percent,omitempty"`
+	Checklist         []*ChecklistItem `protobuf:"bytes,18,rep,name=checklist,proto3" json:"checklist,omitempty"`
+	// children holds the subtasks, only filled by ListTasks with tree.
+	Children []*Task `protobuf:"bytes,19,rep,name=children,proto3" json:"children,omitempty"`
+	// blocker_ids are the tasks which have to be done first, blocked tells
+	// whether any of them is still open.
+	BlockerIds []int64      `protobuf:"varint,20,rep,packed,name=blocker_ids,json=blockerIds,proto3" json:"blocker_ids,omitempty"`
+	Blocked    bool         `protobuf:"varint,21,opt,name=blocked,proto3" json:"blocked,omitempty"`
+	Priority   TaskPriority `protobuf:"varint,22,opt,name=priority,proto3,enum=task.TaskPriority" json:"priority,omitempty"`
+	// rank orders the tasks of a user manually, ranks compare as byte strings.
+	Rank          string `protobuf:"bytes,23,opt,name=rank,proto3" json:"rank,omitempty"`
+	CommentCount  int64  `protobuf:"varint,24,opt,name=comment_count,json=commentCount,proto3" json:"comment_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Task) Reset() {
+	*x = Task{}
+	mi := &file_idl_task_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Task) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Task) ProtoMessage() {}
+
+func (x *Task) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Task.ProtoReflect.Descriptor instead.
+func (*Task) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Task) GetTaskID() int64 {
+	if x != nil {
+		return x.TaskID
+	}
+	return 0
+}
+
+func (x *Task) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Task) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *Task) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Task) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *Task) GetDueAt() int64 {
+	if x != nil && x.DueAt != nil {
+		return *x.DueAt
+	}
+	return 0
+}
+
+func (x *Task) GetRemindAt() int64 {
+	if x != nil && x.RemindAt != nil {
+		return *x.RemindAt
+	}
+	return 0
+}
+
+func (x *Task) GetRecurrence() *Recurrence {
+	if x != nil {
+		return x.Recurrence
+	}
+	return nil
+}
+
+func (x *Task) GetSeriesId() int64 {
+	if x != nil {
+		return x.SeriesId
+	}
+	return 0
+}
+
+func (x *Task) GetStatus() TaskStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TaskStatus_TASK_STATUS_TODO
+}
+
+func (x *Task) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Task) GetTags() []*Tag {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Task) GetProjectId() int64 {
+	if x != nil {
+		return x.ProjectId
+	}
+	return 0
+}
+
+func (x *Task) GetParentId() int64 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *Task) GetAutoComplete() bool {
+	if x != nil {
+		return x.AutoComplete
+	}
+	return false
+}
+
+func (x *Task) GetCompletionPercent() int32 {
+	if x != nil {
+		return x.CompletionPercent
+	}
+	return 0
+}
+
+func (x *Task) GetChecklist() []*ChecklistItem {
+	if x != nil {
+		return x.Checklist
+	}
+	return nil
+}
+
+func (x *Task) GetChildren() []*Task {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+func (x *Task) GetBlockerIds() []int64 {
+	if x != nil {
+		return x.BlockerIds
+	}
+	return nil
+}
+
+func (x *Task) GetBlocked() bool {
+	if x != nil {
+		return x.Blocked
+	}
+	return false
+}
+
+func (x *Task) GetPriority() TaskPriority {
+	if x != nil {
+		return x.Priority
+	}
+	return TaskPriority_TASK_PRIORITY_NONE
+}
+
+func (x *Task) GetRank() string {
+	if x != nil {
+		return x.Rank
+	}
+	return ""
+}
+
+func (x *Task) GetCommentCount() int64 {
+	if x != nil {
+		return x.CommentCount
+	}
+	return 0
+}
+
+type AddTaskRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Title    string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Content  string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	DueAt    *int64                 `protobuf:"varint,3,opt,name=due_at,json=dueAt,proto3,oneof" json:"due_at,omitempty"`
+	RemindAt *int64                 `protobuf:"varint,4,opt,name=remind_at,json=remindAt,proto3,oneof" json:"remind_at,omitempty"`
+	// recurrence requires due_at, which is the first occurrence.
+	Recurrence *Recurrence `protobuf:"bytes,5,opt,name=recurrence,proto3" json:"recurrence,omitempty"`
+	TagIds     []int64     `protobuf:"varint,6,rep,packed,name=tag_ids,json=tagIds,proto3" json:"tag_ids,omitempty"`
+	// project_id zero puts the task in the inbox.
+	ProjectId int64 `protobuf:"varint,7,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	// parent_id makes the task a subtask, it lives in the project of its parent.
+	ParentId      int64        `protobuf:"varint,8,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	AutoComplete  bool         `protobuf:"varint,9,opt,name=auto_complete,json=autoComplete,proto3" json:"auto_complete,omitempty"`
+	Priority      TaskPriority `protobuf:"varint,10,opt,name=priority,proto3,enum=task.TaskPriority" json:"priority,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddTaskRequest) Reset() {
+	*x = AddTaskRequest{}
+	mi := &file_idl_task_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTaskRequest) ProtoMessage() {}
+
+func (x *AddTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTaskRequest.ProtoReflect.Descriptor instead.
+func (*AddTaskRequest) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *AddTaskRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *AddTaskRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *AddTaskRequest) GetDueAt() int64 {
+	if x != nil && x.DueAt != nil {
+		return *x.DueAt
+	}
+	return 0
+}
+
+func (x *AddTaskRequest) GetRemindAt() int64 {
+	if x != nil && x.RemindAt != nil {
+		return *x.RemindAt
+	}
+	return 0
+}
+
+func (x *AddTaskRequest) GetRecurrence() *Recurrence {
+	if x != nil {
+		return x.Recurrence
+	}
+	return nil
+}
+
+func (x *AddTaskRequest) GetTagIds() []int64 {
+	if x != nil {
+		return x.TagIds
+	}
+	return nil
+}
+
+func (x *AddTaskRequest) GetProjectId() int64 {
+	if x != nil {
+		return x.ProjectId
+	}
+	return 0
+}
+
+func (x *AddTaskRequest) GetParentId() int64 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *AddTaskRequest) GetAutoComplete() bool {
+	if x != nil {
+		return x.AutoComplete
+	}
+	return false
+}
+
+func (x *AddTaskRequest) GetPriority() TaskPriority {
+	if x != nil {
+		return x.Priority
+	}
+	return TaskPriority_TASK_PRIORITY_NONE
+}
+
+type AddTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *Task                  `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddTaskResponse) Reset() {
+	*x = AddTaskResponse{}
+	mi := &file_idl_task_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTaskResponse) ProtoMessage() {}
+
+func (x *AddTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTaskResponse.ProtoReflect.Descriptor instead.
+func (*AddTaskResponse) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *AddTaskResponse) GetData() *Task {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// ListOption controls cursor pagination, ordering and time range filtering of task lists.
+// All time ranges are unix seconds and inclusive, zero means unbounded.
+type ListOption struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Cursor        string                 `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	SortField     SortField              `protobuf:"varint,3,opt,name=sort_field,json=sortField,proto3,enum=task.SortField" json:"sort_field,omitempty"`
+	SortOrder     SortOrder              `protobuf:"varint,4,opt,name=sort_order,json=sortOrder,proto3,enum=task.SortOrder" json:"sort_order,omitempty"`
+	CreatedAfter  int64                  `protobuf:"varint,5,opt,name=created_after,json=createdAfter,proto3" json:"created_after,omitempty"`
+	CreatedBefore int64                  `protobuf:"varint,6,opt,name=created_before,json=createdBefore,proto3" json:"created_before,omitempty"`
+	UpdatedAfter  int64                  `protobuf:"varint,7,opt,name=updated_after,json=updatedAfter,proto3" json:"updated_after,omitempty"`
+	UpdatedBefore int64                  `protobuf:"varint,8,opt,name=updated_before,json=updatedBefore,proto3" json:"updated_before,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOption) Reset() {
+	*x = ListOption{}
+	mi := &file_idl_task_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOption) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOption) ProtoMessage() {}
+
+func (x *ListOption) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOption.ProtoReflect.Descriptor instead.
+func (*ListOption) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListOption) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListOption) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListOption) GetSortField() SortField {
+	if x != nil {
+		return x.SortField
+	}
+	return SortField_SORT_FIELD_CREATED_AT
+}
+
+func (x *ListOption) GetSortOrder() SortOrder {
+	if x != nil {
+		return x.SortOrder
+	}
+	return SortOrder_SORT_ORDER_DESC
+}
+
+func (x *ListOption) GetCreatedAfter() int64 {
+	if x != nil {
+		return x.CreatedAfter
+	}
+	return 0
+}
+
+func (x *ListOption) GetCreatedBefore() int64 {
+	if x != nil {
+		return x.CreatedBefore
+	}
+	return 0
+}
+
+func (x *ListOption) GetUpdatedAfter() int64 {
+	if x != nil {
+		return x.UpdatedAfter
+	}
+	return 0
+}
+
+func (x *ListOption) GetUpdatedBefore() int64 {
+	if x != nil {
+		return x.UpdatedBefore
+	}
+	return 0
+}
+
+type GetTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskID        int64                  `protobuf:"varint,1,opt,name=taskID,proto3" json:"taskID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTaskRequest) Reset() {
+	*x = GetTaskRequest{}
+	mi := &file_idl_task_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTaskRequest) ProtoMessage() {}
+
+func (x *GetTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTaskRequest.ProtoReflect.Descriptor instead.
+func (*GetTaskRequest) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetTaskRequest) GetTaskID() int64 {
+	if x != nil {
+		return x.TaskID
+	}
+	return 0
+}
+
+type GetTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *Task                  `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTaskResponse) Reset() {
+	*x = GetTaskResponse{}
+	mi := &file_idl_task_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTaskResponse) ProtoMessage() {}
+
+func (x *GetTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTaskResponse.ProtoReflect.Descriptor instead.
+func (*GetTaskResponse) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetTaskResponse) GetData() *Task {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ListTasksRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Option *ListOption            `protobuf:"bytes,1,opt,name=option,proto3" json:"option,omitempty"`
+	// statuses filters the listed tasks, empty means todo and in progress.
+	Statuses []TaskStatus `protobuf:"varint,2,rep,packed,name=statuses,proto3,enum=task.TaskStatus" json:"statuses,omitempty"`
+	// tag_ids keeps the tasks carrying any of the tags.
+	TagIds []int64 `protobuf:"varint,3,rep,packed,name=tag_ids,json=tagIds,proto3" json:"tag_ids,omitempty"`
+	// project_id scopes the list to a project, zero means all projects.
+	ProjectId int64 `protobuf:"varint,4,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	// tree pages through the top level tasks and nests their subtasks in children.
+	Tree bool `protobuf:"varint,5,opt,name=tree,proto3" json:"tree,omitempty"`
+	// actionable drops the tasks with open blockers.
+	Actionable    bool `protobuf:"varint,6,opt,name=actionable,proto3" json:"actionable,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTasksRequest) Reset() {
+	*x = ListTasksRequest{}
+	mi := &file_idl_task_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTasksRequest) ProtoMessage() {}
+
+func (x *ListTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTasksRequest.ProtoReflect.Descriptor instead.
+func (*ListTasksRequest) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListTasksRequest) GetOption() *ListOption {
+	if x != nil {
+		return x.Option
+	}
+	return nil
+}
+
+func (x *ListTasksRequest) GetStatuses() []TaskStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *ListTasksRequest) GetTagIds() []int64 {
+	if x != nil {
+		return x.TagIds
+	}
+	return nil
+}
+
+func (x *ListTasksRequest) GetProjectId() int64 {
+	if x != nil {
+		return x.ProjectId
+	}
+	return 0
+}
+
+func (x *ListTasksRequest) GetTree() bool {
+	if x != nil {
+		return x.Tree
+	}
+	return false
+}
+
+func (x *ListTasksRequest) GetActionable() bool {
+	if x != nil {
+		return x.Actionable
+	}
+	return false
+}
+
+type ListTasksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*Task                `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	HasMore       bool                   `protobuf:"varint,3,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTasksResponse) Reset() {
+	*x = ListTasksResponse{}
+	mi := &file_idl_task_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTasksResponse) ProtoMessage() {}
+
+func (x *ListTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTasksResponse.ProtoReflect.Descriptor instead.
+func (*ListTasksResponse) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListTasksResponse) GetData() []*Task {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ListTasksResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *ListTasksResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+type UpdateTaskRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TaskID          int64                  `protobuf:"varint,1,opt,name=taskID,proto3" json:"taskID,omitempty"`
+	Content         *string                `protobuf:"bytes,2,opt,name=content,proto3,oneof" json:"content,omitempty"`
+	Title           *string                `protobuf:"bytes,3,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	DueAt           *int64                 `protobuf:"varint,4,opt,name=due_at,json=dueAt,proto3,oneof" json:"due_at,omitempty"`
+	RemindAt        *int64                 `protobuf:"varint,5,opt,name=remind_at,json=remindAt,proto3,oneof" json:"remind_at,omitempty"`
+	ClearDueAt      bool                   `protobuf:"varint,6,opt,name=clear_due_at,json=clearDueAt,proto3" json:"clear_due_at,omitempty"`
+	ClearRemindAt   bool                   `protobuf:"varint,7,opt,name=clear_remind_at,json=clearRemindAt,proto3" json:"clear_remind_at,omitempty"`
+	Recurrence      *Recurrence            `protobuf:"bytes,8,opt,name=recurrence,proto3" json:"recurrence,omitempty"`
+	ClearRecurrence bool                   `protobuf:"varint,9,opt,name=clear_recurrence,json=clearRecurrence,proto3" json:"clear_recurrence,omitempty"`
+	Scope           UpdateScope            `protobuf:"varint,10,opt,name=scope,proto3,enum=task.UpdateScope" json:"scope,omitempty"`
+	// version makes the update fail with a conflict unless the task is still at this version.
+	Version *int64 `protobuf:"varint,11,opt,name=version,proto3,oneof" json:"version,omitempty"`
+	// attach_tag_ids and detach_tag_ids change the tags of the task, or of every
+	// open occurrence with UPDATE_SCOPE_SERIES.
+	AttachTagIds []int64 `protobuf:"varint,12,rep,packed,name=attach_tag_ids,json=attachTagIds,proto3" json:"attach_tag_ids,omitempty"`
+	DetachTagIds []int64 `protobuf:"varint,13,rep,packed,name=detach_tag_ids,json=detachTagIds,proto3" json:"detach_tag_ids,omitempty"`
+	// project_id moves the task, or every open occurrence with UPDATE_SCOPE_SERIES,
+	// to another project, zero means the inbox.
+	ProjectId *int64 `protobuf:"varint,14,opt,name=project_id,json=projectId,proto3,oneof" json:"project_id,omitempty"`
+	// parent_id moves the task with its subtasks under another task, zero makes
+	// it a top level task. Subtasks can't change their project on their own.
+	ParentId      *int64        `protobuf:"varint,15,opt,name=parent_id,json=parentId,proto3,oneof" json:"parent_id,omitempty"`
+	AutoComplete  *bool         `protobuf:"varint,16,opt,name=auto_complete,json=autoComplete,proto3,oneof" json:"auto_complete,omitempty"`
+	Priority      *TaskPriority `protobuf:"varint,17,opt,name=priority,proto3,enum=task.TaskPriority,oneof" json:"priority,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTaskRequest) Reset() {
+	*x = UpdateTaskRequest{}
+	mi := &file_idl_task_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTaskRequest) ProtoMessage() {}
+
+func (x *UpdateTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTaskRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTaskRequest) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *UpdateTaskRequest) GetTaskID() int64 {
+	if x != nil {
+		return x.TaskID
+	}
+	return 0
+}
+
+func (x *UpdateTaskRequest) GetContent() string {
+	if x != nil && x.Content != nil {
+		return *x.Content
+	}
+	return ""
+}
+
+func (x *UpdateTaskRequest) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+func (x *UpdateTaskRequest) GetDueAt() int64 {
+	if x != nil && x.DueAt != nil {
+		return *x.DueAt
+	}
+	return 0
+}
+
+func (x *UpdateTaskRequest) GetRemindAt() int64 {
+	if x != nil && x.RemindAt != nil {
+		return *x.RemindAt
+	}
+	return 0
+}
+
+func (x *UpdateTaskRequest) GetClearDueAt() bool {
+	if x != nil {
+		return x.ClearDueAt
+	}
+	return false
+}
+
+func (x *UpdateTaskRequest) GetClearRemindAt() bool {
+	if x != nil {
+		return x.ClearRemindAt
+	}
+	return false
+}
+
+func (x *UpdateTaskRequest) GetRecurrence() *Recurrence {
+	if x != nil {
+		return x.Recurrence
+	}
+	return nil
+}
+
+func (x *UpdateTaskRequest) GetClearRecurrence() bool {
+	if x != nil {
+		return x.ClearRecurrence
+	}
+	return false
+}
+
+func (x *UpdateTaskRequest) GetScope() UpdateScope {
+	if x != nil {
+		return x.Scope
+	}
+	return UpdateScope_UPDATE_SCOPE_THIS
+}
+
+func (x *UpdateTaskRequest) GetVersion() int64 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+func (x *UpdateTaskRequest) GetAttachTagIds() []int64 {
+	if x != nil {
+		return x.AttachTagIds
+	}
+	return nil
+}
+
+func (x *UpdateTaskRequest) GetDetachTagIds() []int64 {
+	if x != nil {
+		return x.DetachTagIds
+	}
+	return nil
+}
+
+func (x *UpdateTaskRequest) GetProjectId() int64 {
+	if x != nil && x.ProjectId != nil {
+		return *x.ProjectId
+	}
+	return 0
+}
+
+func (x *UpdateTaskRequest) GetParentId() int64 {
+	if x != nil && x.ParentId != nil {
+		return *x.ParentId
+	}
+	return 0
+}
+
+func (x *UpdateTaskRequest) GetAutoComplete() bool {
+	if x != nil && x.AutoComplete != nil {
+		return *x.AutoComplete
+	}
+	return false
+}
+
+func (x *UpdateTaskRequest) GetPriority() TaskPriority {
+	if x != nil && x.Priority != nil {
+		return *x.Priority
+	}
+	return TaskPriority_TASK_PRIORITY_NONE
+}
+
+type UpdateTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTaskResponse) Reset() {
+	*x = UpdateTaskResponse{}
+	mi := &file_idl_task_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTaskResponse) ProtoMessage() {}
+
+func (x *UpdateTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTaskResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTaskResponse) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{15}
+}
+
+type UpdateTaskStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskID        int64                  `protobuf:"varint,1,opt,name=taskID,proto3" json:"taskID,omitempty"`
+	Status        TaskStatus             `protobuf:"varint,2,opt,name=status,proto3,enum=task.TaskStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTaskStatusRequest) Reset() {
+	*x = UpdateTaskStatusRequest{}
+	mi := &file_idl_task_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTaskStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTaskStatusRequest) ProtoMessage() {}
+
+func (x *UpdateTaskStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTaskStatusRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTaskStatusRequest) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *UpdateTaskStatusRequest) GetTaskID() int64 {
+	if x != nil {
+		return x.TaskID
+	}
+	return 0
+}
+
+func (x *UpdateTaskStatusRequest) GetStatus() TaskStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TaskStatus_TASK_STATUS_TODO
+}
+
+type UpdateTaskStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTaskStatusResponse) Reset() {
+	*x = UpdateTaskStatusResponse{}
+	mi := &file_idl_task_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTaskStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTaskStatusResponse) ProtoMessage() {}
+
+func (x *UpdateTaskStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTaskStatusResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTaskStatusResponse) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{17}
+}
+
+// RecycleBinRequest lists the deleted tasks, they are purged after the retention period.
+type RecycleBinRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Option        *ListOption            `protobuf:"bytes,1,opt,name=option,proto3" json:"option,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecycleBinRequest) Reset() {
+	*x = RecycleBinRequest{}
+	mi := &file_idl_task_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecycleBinRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecycleBinRequest) ProtoMessage() {}
+
+func (x *RecycleBinRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecycleBinRequest.ProtoReflect.Descriptor instead.
+func (*RecycleBinRequest) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RecycleBinRequest) GetOption() *ListOption {
+	if x != nil {
+		return x.Option
+	}
+	return nil
+}
+
+type RecycleBinResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*Task                `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	HasMore       bool                   `protobuf:"varint,3,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecycleBinResponse) Reset() {
+	*x = RecycleBinResponse{}
+	mi := &file_idl_task_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecycleBinResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecycleBinResponse) ProtoMessage() {}
+
+func (x *RecycleBinResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecycleBinResponse.ProtoReflect.Descriptor instead.
+func (*RecycleBinResponse) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *RecycleBinResponse) GetData() []*Task {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *RecycleBinResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *RecycleBinResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+type SearchTasksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keyword       string                 `protobuf:"bytes,1,opt,name=keyword,proto3" json:"keyword,omitempty"`
+	Statuses      []TaskStatus           `protobuf:"varint,2,rep,packed,name=statuses,proto3,enum=task.TaskStatus" json:"statuses,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchTasksRequest) Reset() {
+	*x = SearchTasksRequest{}
+	mi := &file_idl_task_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchTasksRequest) ProtoMessage() {}
+
+func (x *SearchTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchTasksRequest.ProtoReflect.Descriptor instead.
+func (*SearchTasksRequest) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SearchTasksRequest) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+func (x *SearchTasksRequest) GetStatuses() []TaskStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *SearchTasksRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *SearchTasksRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type SearchHit struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Task             *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	Score            float64                `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	TitleHighlight   string                 `protobuf:"bytes,3,opt,name=title_highlight,json=titleHighlight,proto3" json:"title_highlight,omitempty"`
+	ContentHighlight string                 `protobuf:"bytes,4,opt,name=content_highlight,json=contentHighlight,proto3" json:"content_highlight,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SearchHit) Reset() {
+	*x = SearchHit{}
+	mi := &file_idl_task_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchHit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchHit) ProtoMessage() {}
+
+func (x *SearchHit) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchHit.ProtoReflect.Descriptor instead.
+func (*SearchHit) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *SearchHit) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+func (x *SearchHit) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *SearchHit) GetTitleHighlight() string {
+	if x != nil {
+		return x.TitleHighlight
+	}
+	return ""
+}
+
+func (x *SearchHit) GetContentHighlight() string {
+	if x != nil {
+		return x.ContentHighlight
+	}
+	return ""
+}
+
+type SearchTasksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*SearchHit           `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchTasksResponse) Reset() {
+	*x = SearchTasksResponse{}
+	mi := &file_idl_task_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchTasksResponse) ProtoMessage() {}
+
+func (x *SearchTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchTasksResponse.ProtoReflect.Descriptor instead.
+func (*SearchTasksResponse) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SearchTasksResponse) GetData() []*SearchHit {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *SearchTasksResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type ListDueTasksRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	View  DueView                `protobuf:"varint,1,opt,name=view,proto3,enum=task.DueView" json:"view,omitempty"`
+	// IANA time zone name used to determine "today", defaults to UTC.
+	TimeZone      string `protobuf:"bytes,2,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDueTasksRequest) Reset() {
+	*x = ListDueTasksRequest{}
+	mi := &file_idl_task_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDueTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDueTasksRequest) ProtoMessage() {}
+
+func (x *ListDueTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDueTasksRequest.ProtoReflect.Descriptor instead.
+func (*ListDueTasksRequest) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListDueTasksRequest) GetView() DueView {
+	if x != nil {
+		return x.View
+	}
+	return DueView_DUE_VIEW_OVERDUE
+}
+
+func (x *ListDueTasksRequest) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+type ListDueTasksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*Task                `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDueTasksResponse) Reset() {
+	*x = ListDueTasksResponse{}
+	mi := &file_idl_task_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDueTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDueTasksResponse) ProtoMessage() {}
+
+func (x *ListDueTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDueTasksResponse.ProtoReflect.Descriptor instead.
+func (*ListDueTasksResponse) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListDueTasksResponse) GetData() []*Task {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// PreviewOccurrencesRequest previews the occurrences after the current one of
+// a recurring task, or the first occurrences of recurrence starting at start.
+type PreviewOccurrencesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskID        int64                  `protobuf:"varint,1,opt,name=taskID,proto3" json:"taskID,omitempty"`
+	Recurrence    *Recurrence            `protobuf:"bytes,2,opt,name=recurrence,proto3" json:"recurrence,omitempty"`
+	Start         int64                  `protobuf:"varint,3,opt,name=start,proto3" json:"start,omitempty"`
+	Count         int32                  `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreviewOccurrencesRequest) Reset() {
+	*x = PreviewOccurrencesRequest{}
+	mi := &file_idl_task_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewOccurrencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewOccurrencesRequest) ProtoMessage() {}
+
+func (x *PreviewOccurrencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewOccurrencesRequest.ProtoReflect.Descriptor instead.
+func (*PreviewOccurrencesRequest) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *PreviewOccurrencesRequest) GetTaskID() int64 {
+	if x != nil {
+		return x.TaskID
+	}
+	return 0
+}
+
+func (x *PreviewOccurrencesRequest) GetRecurrence() *Recurrence {
+	if x != nil {
+		return x.Recurrence
+	}
+	return nil
+}
+
+func (x *PreviewOccurrencesRequest) GetStart() int64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *PreviewOccurrencesRequest) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type PreviewOccurrencesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Occurrences   []int64                `protobuf:"varint,1,rep,packed,name=occurrences,proto3" json:"occurrences,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreviewOccurrencesResponse) Reset() {
+	*x = PreviewOccurrencesResponse{}
+	mi := &file_idl_task_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewOccurrencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewOccurrencesResponse) ProtoMessage() {}
+
+func (x *PreviewOccurrencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewOccurrencesResponse.ProtoReflect.Descriptor instead.
+func (*PreviewOccurrencesResponse) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *PreviewOccurrencesResponse) GetOccurrences() []int64 {
+	if x != nil {
+		return x.Occurrences
+	}
+	return nil
+}
+
+type DeleteTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskID        int64                  `protobuf:"varint,1,opt,name=taskID,proto3" json:"taskID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTaskRequest) Reset() {
+	*x = DeleteTaskRequest{}
+	mi := &file_idl_task_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTaskRequest) ProtoMessage() {}
+
+func (x *DeleteTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTaskRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTaskRequest) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *DeleteTaskRequest) GetTaskID() int64 {
+	if x != nil {
+		return x.TaskID
+	}
+	return 0
+}
+
+type DeleteTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTaskResponse) Reset() {
+	*x = DeleteTaskResponse{}
+	mi := &file_idl_task_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTaskResponse) ProtoMessage() {}
+
+func (x *DeleteTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTaskResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTaskResponse) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{28}
+}
+
+type RestoreTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskID        int64                  `protobuf:"varint,1,opt,name=taskID,proto3" json:"taskID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreTaskRequest) Reset() {
+	*x = RestoreTaskRequest{}
+	mi := &file_idl_task_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreTaskRequest) ProtoMessage() {}
+
+func (x *RestoreTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreTaskRequest.ProtoReflect.Descriptor instead.
+func (*RestoreTaskRequest) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *RestoreTaskRequest) GetTaskID() int64 {
+	if x != nil {
+		return x.TaskID
+	}
+	return 0
+}
+
+type RestoreTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreTaskResponse) Reset() {
+	*x = RestoreTaskResponse{}
+	mi := &file_idl_task_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreTaskResponse) ProtoMessage() {}
+
+func (x *RestoreTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreTaskResponse.ProtoReflect.Descriptor instead.
+func (*RestoreTaskResponse) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{30}
+}
+
+type PurgeTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskID        int64                  `protobuf:"varint,1,opt,name=taskID,proto3" json:"taskID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeTaskRequest) Reset() {
+	*x = PurgeTaskRequest{}
+	mi := &file_idl_task_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeTaskRequest) ProtoMessage() {}
+
+func (x *PurgeTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeTaskRequest.ProtoReflect.Descriptor instead.
+func (*PurgeTaskRequest) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *PurgeTaskRequest) GetTaskID() int64 {
+	if x != nil {
+		return x.TaskID
+	}
+	return 0
+}
+
+type PurgeTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeTaskResponse) Reset() {
+	*x = PurgeTaskResponse{}
+	mi := &file_idl_task_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeTaskResponse) ProtoMessage() {}
+
+func (x *PurgeTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeTaskResponse.ProtoReflect.Descriptor instead.
+func (*PurgeTaskResponse) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{32}
+}
+
+type EmptyRecycleBinRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EmptyRecycleBinRequest) Reset() {
+	*x = EmptyRecycleBinRequest{}
+	mi := &file_idl_task_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmptyRecycleBinRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmptyRecycleBinRequest) ProtoMessage() {}
+
+func (x *EmptyRecycleBinRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmptyRecycleBinRequest.ProtoReflect.Descriptor instead.
+func (*EmptyRecycleBinRequest) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{33}
+}
+
+type EmptyRecycleBinResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Purged        int64                  `protobuf:"varint,1,opt,name=purged,proto3" json:"purged,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EmptyRecycleBinResponse) Reset() {
+	*x = EmptyRecycleBinResponse{}
+	mi := &file_idl_task_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmptyRecycleBinResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmptyRecycleBinResponse) ProtoMessage() {}
+
+func (x *EmptyRecycleBinResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmptyRecycleBinResponse.ProtoReflect.Descriptor instead.
+func (*EmptyRecycleBinResponse) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *EmptyRecycleBinResponse) GetPurged() int64 {
+	if x != nil {
+		return x.Purged
+	}
+	return 0
+}
+
+type CreateTagRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Color         string                 `protobuf:"bytes,2,opt,name=color,proto3" json:"color,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTagRequest) Reset() {
+	*x = CreateTagRequest{}
+	mi := &file_idl_task_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTagRequest) ProtoMessage() {}
+
+func (x *CreateTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTagRequest.ProtoReflect.Descriptor instead.
+func (*CreateTagRequest) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *CreateTagRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateTagRequest) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+type CreateTagResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *Tag                   `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTagResponse) Reset() {
+	*x = CreateTagResponse{}
+	mi := &file_idl_task_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTagResponse) ProtoMessage() {}
+
+func (x *CreateTagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTagResponse.ProtoReflect.Descriptor instead.
+func (*CreateTagResponse) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *CreateTagResponse) GetData() *Tag {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// UpdateTagRequest renames and/or recolors a tag.
+type UpdateTagRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TagID         int64                  `protobuf:"varint,1,opt,name=tagID,proto3" json:"tagID,omitempty"`
+	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Color         *string                `protobuf:"bytes,3,opt,name=color,proto3,oneof" json:"color,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTagRequest) Reset() {
+	*x = UpdateTagRequest{}
+	mi := &file_idl_task_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTagRequest) ProtoMessage() {}
+
+func (x *UpdateTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTagRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTagRequest) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *UpdateTagRequest) GetTagID() int64 {
+	if x != nil {
+		return x.TagID
+	}
+	return 0
+}
+
+func (x *UpdateTagRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateTagRequest) GetColor() string {
+	if x != nil && x.Color != nil {
+		return *x.Color
+	}
+	return ""
+}
+
+type UpdateTagResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *Tag                   `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTagResponse) Reset() {
+	*x = UpdateTagResponse{}
+	mi := &file_idl_task_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTagResponse) ProtoMessage() {}
+
+func (x *UpdateTagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTagResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTagResponse) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *UpdateTagResponse) GetData() *Tag {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// DeleteTagRequest deletes a tag, its tasks are kept.
+type DeleteTagRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TagID         int64                  `protobuf:"varint,1,opt,name=tagID,proto3" json:"tagID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTagRequest) Reset() {
+	*x = DeleteTagRequest{}
+	mi := &file_idl_task_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTagRequest) ProtoMessage() {}
+
+func (x *DeleteTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTagRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTagRequest) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *DeleteTagRequest) GetTagID() int64 {
+	if x != nil {
+		return x.TagID
+	}
+	return 0
+}
+
+type DeleteTagResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTagResponse) Reset() {
+	*x = DeleteTagResponse{}
+	mi := &file_idl_task_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTagResponse) ProtoMessage() {}
+
+func (x *DeleteTagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTagResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTagResponse) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{40}
+}
+
+type ListTagsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTagsRequest) Reset() {
+	*x = ListTagsRequest{}
+	mi := &file_idl_task_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagsRequest) ProtoMessage() {}
+
+func (x *ListTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagsRequest.ProtoReflect.Descriptor instead.
+func (*ListTagsRequest) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{41}
+}
+
+type ListTagsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*Tag                 `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTagsResponse) Reset() {
+	*x = ListTagsResponse{}
+	mi := &file_idl_task_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagsResponse) ProtoMessage() {}
+
+func (x *ListTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagsResponse.ProtoReflect.Descriptor instead.
+func (*ListTagsResponse) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ListTagsResponse) GetData() []*Tag {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type CreateProjectRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateProjectRequest) Reset() {
+	*x = CreateProjectRequest{}
+	mi := &file_idl_task_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProjectRequest) ProtoMessage() {}
+
+func (x *CreateProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProjectRequest.ProtoReflect.Descriptor instead.
+func (*CreateProjectRequest) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *CreateProjectRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CreateProjectResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *Project               `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateProjectResponse) Reset() {
+	*x = CreateProjectResponse{}
+	mi := &file_idl_task_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProjectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProjectResponse) ProtoMessage() {}
+
+func (x *CreateProjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProjectResponse.ProtoReflect.Descriptor instead.
+func (*CreateProjectResponse) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *CreateProjectResponse) GetData() *Project {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type RenameProjectRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectID     int64                  `protobuf:"varint,1,opt,name=projectID,proto3" json:"projectID,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameProjectRequest) Reset() {
+	*x = RenameProjectRequest{}
+	mi := &file_idl_task_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameProjectRequest) ProtoMessage() {}
+
+func (x *RenameProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameProjectRequest.ProtoReflect.Descriptor instead.
+func (*RenameProjectRequest) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *RenameProjectRequest) GetProjectID() int64 {
+	if x != nil {
+		return x.ProjectID
+	}
+	return 0
+}
+
+func (x *RenameProjectRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type RenameProjectResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *Project               `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameProjectResponse) Reset() {
+	*x = RenameProjectResponse{}
+	mi := &file_idl_task_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameProjectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameProjectResponse) ProtoMessage() {}
+
+func (x *RenameProjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameProjectResponse.ProtoReflect.Descriptor instead.
+func (*RenameProjectResponse) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *RenameProjectResponse) GetData() *Project {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// ArchiveProjectRequest archives or unarchives a project, archived projects take no new tasks.
+type ArchiveProjectRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectID     int64                  `protobuf:"varint,1,opt,name=projectID,proto3" json:"projectID,omitempty"`
+	Archived      bool                   `protobuf:"varint,2,opt,name=archived,proto3" json:"archived,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchiveProjectRequest) Reset() {
+	*x = ArchiveProjectRequest{}
+	mi := &file_idl_task_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveProjectRequest) ProtoMessage() {}
+
+func (x *ArchiveProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveProjectRequest.ProtoReflect.Descriptor instead.
+func (*ArchiveProjectRequest) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ArchiveProjectRequest) GetProjectID() int64 {
+	if x != nil {
+		return x.ProjectID
+	}
+	return 0
+}
+
+func (x *ArchiveProjectRequest) GetArchived() bool {
+	if x != nil {
+		return x.Archived
+	}
+	return false
+}
+
+type ArchiveProjectResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchiveProjectResponse) Reset() {
+	*x = ArchiveProjectResponse{}
+	mi := &file_idl_task_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveProjectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveProjectResponse) ProtoMessage() {}
+
+func (x *ArchiveProjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveProjectResponse.ProtoReflect.Descriptor instead.
+func (*ArchiveProjectResponse) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{48}
+}
+
+// ReorderProjectsRequest puts the given projects in the given order, the others keep their places.
+type ReorderProjectsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectIds    []int64                `protobuf:"varint,1,rep,packed,name=project_ids,json=projectIds,proto3" json:"project_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderProjectsRequest) Reset() {
+	*x = ReorderProjectsRequest{}
+	mi := &file_idl_task_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderProjectsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderProjectsRequest) ProtoMessage() {}
+
+func (x *ReorderProjectsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderProjectsRequest.ProtoReflect.Descriptor instead.
+func (*ReorderProjectsRequest) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ReorderProjectsRequest) GetProjectIds() []int64 {
+	if x != nil {
+		return x.ProjectIds
+	}
+	return nil
+}
+
+type ReorderProjectsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderProjectsResponse) Reset() {
+	*x = ReorderProjectsResponse{}
+	mi := &file_idl_task_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderProjectsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderProjectsResponse) ProtoMessage() {}
+
+func (x *ReorderProjectsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderProjectsResponse.ProtoReflect.Descriptor instead.
+func (*ReorderProjectsResponse) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{50}
+}
+
+// DeleteProjectRequest deletes a project, its tasks move to move_to_project_id,
+// or go to the recycle bin if it is zero.
+type DeleteProjectRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ProjectID       int64                  `protobuf:"varint,1,opt,name=projectID,proto3" json:"projectID,omitempty"`
+	MoveToProjectId int64                  `protobuf:"varint,2,opt,name=move_to_project_id,json=moveToProjectId,proto3" json:"move_to_project_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DeleteProjectRequest) Reset() {
+	*x = DeleteProjectRequest{}
+	mi := &file_idl_task_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProjectRequest) ProtoMessage() {}
+
+func (x *DeleteProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProjectRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProjectRequest) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *DeleteProjectRequest) GetProjectID() int64 {
+	if x != nil {
+		return x.ProjectID
+	}
+	return 0
+}
+
+func (x *DeleteProjectRequest) GetMoveToProjectId() int64 {
+	if x != nil {
+		return x.MoveToProjectId
+	}
+	return 0
+}
+
+type DeleteProjectResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProjectResponse) Reset() {
+	*x = DeleteProjectResponse{}
+	mi := &file_idl_task_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProjectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProjectResponse) ProtoMessage() {}
+
+func (x *DeleteProjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProjectResponse.ProtoReflect.Descriptor instead.
+func (*DeleteProjectResponse) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{52}
+}
+
+type ListProjectsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	IncludeArchived bool                   `protobuf:"varint,1,opt,name=include_archived,json=includeArchived,proto3" json:"include_archived,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListProjectsRequest) Reset() {
+	*x = ListProjectsRequest{}
+	mi := &file_idl_task_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProjectsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProjectsRequest) ProtoMessage() {}
+
+func (x *ListProjectsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProjectsRequest.ProtoReflect.Descriptor instead.
+func (*ListProjectsRequest) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ListProjectsRequest) GetIncludeArchived() bool {
+	if x != nil {
+		return x.IncludeArchived
+	}
+	return false
+}
+
+type ListProjectsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*Project             `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProjectsResponse) Reset() {
+	*x = ListProjectsResponse{}
+	mi := &file_idl_task_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProjectsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProjectsResponse) ProtoMessage() {}
+
+func (x *ListProjectsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProjectsResponse.ProtoReflect.Descriptor instead.
+func (*ListProjectsResponse) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ListProjectsResponse) GetData() []*Project {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type AddChecklistItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskID        int64                  `protobuf:"varint,1,opt,name=taskID,proto3" json:"taskID,omitempty"`
+	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddChecklistItemRequest) Reset() {
+	*x = AddChecklistItemRequest{}
+	mi := &file_idl_task_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddChecklistItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddChecklistItemRequest) ProtoMessage() {}
+
+func (x *AddChecklistItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddChecklistItemRequest.ProtoReflect.Descriptor instead.
+func (*AddChecklistItemRequest) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *AddChecklistItemRequest) GetTaskID() int64 {
+	if x != nil {
+		return x.TaskID
+	}
+	return 0
+}
+
+func (x *AddChecklistItemRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type AddChecklistItemResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *ChecklistItem         `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddChecklistItemResponse) Reset() {
+	*x = AddChecklistItemResponse{}
+	mi := &file_idl_task_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddChecklistItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddChecklistItemResponse) ProtoMessage() {}
+
+func (x *AddChecklistItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddChecklistItemResponse.ProtoReflect.Descriptor instead.
+func (*AddChecklistItemResponse) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *AddChecklistItemResponse) GetData() *ChecklistItem {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type UpdateChecklistItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ItemID        int64                  `protobuf:"varint,1,opt,name=itemID,proto3" json:"itemID,omitempty"`
+	Content       *string                `protobuf:"bytes,2,opt,name=content,proto3,oneof" json:"content,omitempty"`
+	Checked       *bool                  `protobuf:"varint,3,opt,name=checked,proto3,oneof" json:"checked,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateChecklistItemRequest) Reset() {
+	*x = UpdateChecklistItemRequest{}
+	mi := &file_idl_task_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateChecklistItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateChecklistItemRequest) ProtoMessage() {}
+
+func (x *UpdateChecklistItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateChecklistItemRequest.ProtoReflect.Descriptor instead.
+func (*UpdateChecklistItemRequest) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *UpdateChecklistItemRequest) GetItemID() int64 {
+	if x != nil {
+		return x.ItemID
+	}
+	return 0
+}
+
+func (x *UpdateChecklistItemRequest) GetContent() string {
+	if x != nil && x.Content != nil {
+		return *x.Content
+	}
+	return ""
+}
+
+func (x *UpdateChecklistItemRequest) GetChecked() bool {
+	if x != nil && x.Checked != nil {
+		return *x.Checked
+	}
+	return false
+}
+
+type UpdateChecklistItemResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *ChecklistItem         `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateChecklistItemResponse) Reset() {
+	*x = UpdateChecklistItemResponse{}
+	mi := &file_idl_task_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateChecklistItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateChecklistItemResponse) ProtoMessage() {}
+
+func (x *UpdateChecklistItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateChecklistItemResponse.ProtoReflect.Descriptor instead.
+func (*UpdateChecklistItemResponse) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *UpdateChecklistItemResponse) GetData() *ChecklistItem {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type DeleteChecklistItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ItemID        int64                  `protobuf:"varint,1,opt,name=itemID,proto3" json:"itemID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteChecklistItemRequest) Reset() {
+	*x = DeleteChecklistItemRequest{}
+	mi := &file_idl_task_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteChecklistItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteChecklistItemRequest) ProtoMessage() {}
+
+func (x *DeleteChecklistItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteChecklistItemRequest.ProtoReflect.Descriptor instead.
+func (*DeleteChecklistItemRequest) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *DeleteChecklistItemRequest) GetItemID() int64 {
+	if x != nil {
+		return x.ItemID
+	}
+	return 0
+}
+
+type DeleteChecklistItemResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteChecklistItemResponse) Reset() {
+	*x = DeleteChecklistItemResponse{}
+	mi := &file_idl_task_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteChecklistItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteChecklistItemResponse) ProtoMessage() {}
+
+func (x *DeleteChecklistItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteChecklistItemResponse.ProtoReflect.Descriptor instead.
+func (*DeleteChecklistItemResponse) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{60}
+}
+
+// AddDependencyRequest makes blockerID block taskID, taskID can't be done until
+// blockerID is. Dependencies creating a cycle are rejected.
+type AddDependencyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskID        int64                  `protobuf:"varint,1,opt,name=taskID,proto3" json:"taskID,omitempty"`
+	BlockerID     int64                  `protobuf:"varint,2,opt,name=blockerID,proto3" json:"blockerID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddDependencyRequest) Reset() {
+	*x = AddDependencyRequest{}
+	mi := &file_idl_task_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddDependencyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddDependencyRequest) ProtoMessage() {}
+
+func (x *AddDependencyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddDependencyRequest.ProtoReflect.Descriptor instead.
+func (*AddDependencyRequest) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *AddDependencyRequest) GetTaskID() int64 {
+	if x != nil {
+		return x.TaskID
+	}
+	return 0
+}
+
+func (x *AddDependencyRequest) GetBlockerID() int64 {
+	if x != nil {
+		return x.BlockerID
+	}
+	return 0
+}
+
+type AddDependencyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddDependencyResponse) Reset() {
+	*x = AddDependencyResponse{}
+	mi := &file_idl_task_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddDependencyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddDependencyResponse) ProtoMessage() {}
+
+func (x *AddDependencyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddDependencyResponse.ProtoReflect.Descriptor instead.
+func (*AddDependencyResponse) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{62}
+}
+
+type RemoveDependencyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskID        int64                  `protobuf:"varint,1,opt,name=taskID,proto3" json:"taskID,omitempty"`
+	BlockerID     int64                  `protobuf:"varint,2,opt,name=blockerID,proto3" json:"blockerID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveDependencyRequest) Reset() {
+	*x = RemoveDependencyRequest{}
+	mi := &file_idl_task_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveDependencyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveDependencyRequest) ProtoMessage() {}
+
+func (x *RemoveDependencyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveDependencyRequest.ProtoReflect.Descriptor instead.
+func (*RemoveDependencyRequest) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *RemoveDependencyRequest) GetTaskID() int64 {
+	if x != nil {
+		return x.TaskID
+	}
+	return 0
+}
+
+func (x *RemoveDependencyRequest) GetBlockerID() int64 {
+	if x != nil {
+		return x.BlockerID
+	}
+	return 0
+}
+
+type RemoveDependencyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveDependencyResponse) Reset() {
+	*x = RemoveDependencyResponse{}
+	mi := &file_idl_task_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveDependencyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveDependencyResponse) ProtoMessage() {}
+
+func (x *RemoveDependencyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveDependencyResponse.ProtoReflect.Descriptor instead.
+func (*RemoveDependencyResponse) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{64}
+}
+
+// MoveTaskRequest places the task between before_id and after_id in the manual
+// order, zero for either means the start or the end of the list.
+type MoveTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskID        int64                  `protobuf:"varint,1,opt,name=taskID,proto3" json:"taskID,omitempty"`
+	BeforeId      int64                  `protobuf:"varint,2,opt,name=before_id,json=beforeId,proto3" json:"before_id,omitempty"`
+	AfterId       int64                  `protobuf:"varint,3,opt,name=after_id,json=afterId,proto3" json:"after_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveTaskRequest) Reset() {
+	*x = MoveTaskRequest{}
+	mi := &file_idl_task_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveTaskRequest) ProtoMessage() {}
+
+func (x *MoveTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveTaskRequest.ProtoReflect.Descriptor instead.
+func (*MoveTaskRequest) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *MoveTaskRequest) GetTaskID() int64 {
+	if x != nil {
+		return x.TaskID
+	}
+	return 0
+}
+
+func (x *MoveTaskRequest) GetBeforeId() int64 {
+	if x != nil {
+		return x.BeforeId
+	}
+	return 0
+}
+
+func (x *MoveTaskRequest) GetAfterId() int64 {
+	if x != nil {
+		return x.AfterId
+	}
+	return 0
+}
+
+type MoveTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *Task                  `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveTaskResponse) Reset() {
+	*x = MoveTaskResponse{}
+	mi := &file_idl_task_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveTaskResponse) ProtoMessage() {}
+
+func (x *MoveTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveTaskResponse.ProtoReflect.Descriptor instead.
+func (*MoveTaskResponse) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *MoveTaskResponse) GetData() *Task {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// BoardColumn is a kanban column of a project showing the tasks in its status.
+// A task shows in the column it was moved to while it keeps the status of that
+// column, otherwise in the first column of its status.
+type BoardColumn struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ColumnID  int64                  `protobuf:"varint,1,opt,name=columnID,proto3" json:"columnID,omitempty"`
+	ProjectId int64                  `protobuf:"varint,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Name      string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Status    TaskStatus             `protobuf:"varint,4,opt,name=status,proto3,enum=task.TaskStatus" json:"status,omitempty"`
+	Position  int64                  `protobuf:"varint,5,opt,name=position,proto3" json:"position,omitempty"`
+	// wip_limit bounds the tasks moved into the column, 0 means no limit.
+	WipLimit      int32   `protobuf:"varint,6,opt,name=wip_limit,json=wipLimit,proto3" json:"wip_limit,omitempty"`
+	Tasks         []*Task `protobuf:"bytes,7,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	CreatedAt     int64   `protobuf:"varint,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     int64   `protobuf:"varint,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BoardColumn) Reset() {
+	*x = BoardColumn{}
+	mi := &file_idl_task_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BoardColumn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoardColumn) ProtoMessage() {}
+
+func (x *BoardColumn) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoardColumn.ProtoReflect.Descriptor instead.
+func (*BoardColumn) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *BoardColumn) GetColumnID() int64 {
+	if x != nil {
+		return x.ColumnID
+	}
+	return 0
+}
+
+func (x *BoardColumn) GetProjectId() int64 {
+	if x != nil {
+		return x.ProjectId
+	}
+	return 0
+}
+
+func (x *BoardColumn) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BoardColumn) GetStatus() TaskStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TaskStatus_TASK_STATUS_TODO
+}
+
+func (x *BoardColumn) GetPosition() int64 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *BoardColumn) GetWipLimit() int32 {
+	if x != nil {
+		return x.WipLimit
+	}
+	return 0
+}
+
+func (x *BoardColumn) GetTasks() []*Task {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+func (x *BoardColumn) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *BoardColumn) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+type Board struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     int64                  `protobuf:"varint,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Columns       []*BoardColumn         `protobuf:"bytes,2,rep,name=columns,proto3" json:"columns,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Board) Reset() {
+	*x = Board{}
+	mi := &file_idl_task_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Board) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Board) ProtoMessage() {}
+
+func (x *Board) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Board.ProtoReflect.Descriptor instead.
+func (*Board) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *Board) GetProjectId() int64 {
+	if x != nil {
+		return x.ProjectId
+	}
+	return 0
+}
+
+func (x *Board) GetColumns() []*BoardColumn {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+// GetBoardRequest returns the board of a project, 0 means the inbox. A board
+// without columns gets To Do, In Progress and Done.
+type GetBoardRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     int64                  `protobuf:"varint,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBoardRequest) Reset() {
+	*x = GetBoardRequest{}
+	mi := &file_idl_task_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBoardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBoardRequest) ProtoMessage() {}
+
+func (x *GetBoardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBoardRequest.ProtoReflect.Descriptor instead.
+func (*GetBoardRequest) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *GetBoardRequest) GetProjectId() int64 {
+	if x != nil {
+		return x.ProjectId
+	}
+	return 0
+}
+
+type GetBoardResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *Board                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBoardResponse) Reset() {
+	*x = GetBoardResponse{}
+	mi := &file_idl_task_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBoardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBoardResponse) ProtoMessage() {}
+
+func (x *GetBoardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBoardResponse.ProtoReflect.Descriptor instead.
+func (*GetBoardResponse) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *GetBoardResponse) GetData() *Board {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// CreateColumnRequest appends a column to the board, status is one of todo,
+// in progress and done.
+type CreateColumnRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     int64                  `protobuf:"varint,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Status        TaskStatus             `protobuf:"varint,3,opt,name=status,proto3,enum=task.TaskStatus" json:"status,omitempty"`
+	WipLimit      int32                  `protobuf:"varint,4,opt,name=wip_limit,json=wipLimit,proto3" json:"wip_limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateColumnRequest) Reset() {
+	*x = CreateColumnRequest{}
+	mi := &file_idl_task_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateColumnRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateColumnRequest) ProtoMessage() {}
+
+func (x *CreateColumnRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateColumnRequest.ProtoReflect.Descriptor instead.
+func (*CreateColumnRequest) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *CreateColumnRequest) GetProjectId() int64 {
+	if x != nil {
+		return x.ProjectId
+	}
+	return 0
+}
+
+func (x *CreateColumnRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateColumnRequest) GetStatus() TaskStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TaskStatus_TASK_STATUS_TODO
+}
+
+func (x *CreateColumnRequest) GetWipLimit() int32 {
+	if x != nil {
+		return x.WipLimit
+	}
+	return 0
+}
+
+type CreateColumnResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *BoardColumn           `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateColumnResponse) Reset() {
+	*x = CreateColumnResponse{}
+	mi := &file_idl_task_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateColumnResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateColumnResponse) ProtoMessage() {}
+
+func (x *CreateColumnResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateColumnResponse.ProtoReflect.Descriptor instead.
+func (*CreateColumnResponse) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *CreateColumnResponse) GetData() *BoardColumn {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type UpdateColumnRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ColumnID      int64                  `protobuf:"varint,1,opt,name=columnID,proto3" json:"columnID,omitempty"`
+	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	WipLimit      *int32                 `protobuf:"varint,3,opt,name=wip_limit,json=wipLimit,proto3,oneof" json:"wip_limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateColumnRequest) Reset() {
+	*x = UpdateColumnRequest{}
+	mi := &file_idl_task_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateColumnRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateColumnRequest) ProtoMessage() {}
+
+func (x *UpdateColumnRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateColumnRequest.ProtoReflect.Descriptor instead.
+func (*UpdateColumnRequest) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *UpdateColumnRequest) GetColumnID() int64 {
+	if x != nil {
+		return x.ColumnID
+	}
+	return 0
+}
+
+func (x *UpdateColumnRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateColumnRequest) GetWipLimit() int32 {
+	if x != nil && x.WipLimit != nil {
+		return *x.WipLimit
+	}
+	return 0
+}
+
+type UpdateColumnResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *BoardColumn           `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateColumnResponse) Reset() {
+	*x = UpdateColumnResponse{}
+	mi := &file_idl_task_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateColumnResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateColumnResponse) ProtoMessage() {}
+
+func (x *UpdateColumnResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateColumnResponse.ProtoReflect.Descriptor instead.
+func (*UpdateColumnResponse) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *UpdateColumnResponse) GetData() *BoardColumn {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// ReorderColumnsRequest puts the given columns in the given order, the others keep their places.
+type ReorderColumnsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     int64                  `protobuf:"varint,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	ColumnIds     []int64                `protobuf:"varint,2,rep,packed,name=column_ids,json=columnIds,proto3" json:"column_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderColumnsRequest) Reset() {
+	*x = ReorderColumnsRequest{}
+	mi := &file_idl_task_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderColumnsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderColumnsRequest) ProtoMessage() {}
+
+func (x *ReorderColumnsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderColumnsRequest.ProtoReflect.Descriptor instead.
+func (*ReorderColumnsRequest) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *ReorderColumnsRequest) GetProjectId() int64 {
+	if x != nil {
+		return x.ProjectId
+	}
+	return 0
+}
+
+func (x *ReorderColumnsRequest) GetColumnIds() []int64 {
+	if x != nil {
+		return x.ColumnIds
+	}
+	return nil
+}
+
+type ReorderColumnsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderColumnsResponse) Reset() {
+	*x = ReorderColumnsResponse{}
+	mi := &file_idl_task_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderColumnsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderColumnsResponse) ProtoMessage() {}
+
+func (x *ReorderColumnsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderColumnsResponse.ProtoReflect.Descriptor instead.
+func (*ReorderColumnsResponse) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{76}
+}
+
+// DeleteColumnRequest deletes a column, its tasks move to the first column of
+// their status. The last column of a board can't be deleted.
+type DeleteColumnRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ColumnID      int64                  `protobuf:"varint,1,opt,name=columnID,proto3" json:"columnID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteColumnRequest) Reset() {
+	*x = DeleteColumnRequest{}
+	mi := &file_idl_task_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteColumnRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteColumnRequest) ProtoMessage() {}
+
+func (x *DeleteColumnRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteColumnRequest.ProtoReflect.Descriptor instead.
+func (*DeleteColumnRequest) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *DeleteColumnRequest) GetColumnID() int64 {
+	if x != nil {
+		return x.ColumnID
+	}
+	return 0
+}
+
+type DeleteColumnResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteColumnResponse) Reset() {
+	*x = DeleteColumnResponse{}
+	mi := &file_idl_task_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteColumnResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteColumnResponse) ProtoMessage() {}
+
+func (x *DeleteColumnResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteColumnResponse.ProtoReflect.Descriptor instead.
+func (*DeleteColumnResponse) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{78}
+}
+
+// MoveCardRequest moves the task into the column and its status, between
+// before_id and after_id. Zero for both puts it at the end of the column. The
+// move fails once the column reached its WIP limit.
+type MoveCardRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskID        int64                  `protobuf:"varint,1,opt,name=taskID,proto3" json:"taskID,omitempty"`
+	ColumnId      int64                  `protobuf:"varint,2,opt,name=column_id,json=columnId,proto3" json:"column_id,omitempty"`
+	BeforeId      int64                  `protobuf:"varint,3,opt,name=before_id,json=beforeId,proto3" json:"before_id,omitempty"`
+	AfterId       int64                  `protobuf:"varint,4,opt,name=after_id,json=afterId,proto3" json:"after_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveCardRequest) Reset() {
+	*x = MoveCardRequest{}
+	mi := &file_idl_task_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveCardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveCardRequest) ProtoMessage() {}
+
+func (x *MoveCardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveCardRequest.ProtoReflect.Descriptor instead.
+func (*MoveCardRequest) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *MoveCardRequest) GetTaskID() int64 {
+	if x != nil {
+		return x.TaskID
+	}
+	return 0
+}
+
+func (x *MoveCardRequest) GetColumnId() int64 {
+	if x != nil {
+		return x.ColumnId
+	}
+	return 0
+}
+
+func (x *MoveCardRequest) GetBeforeId() int64 {
+	if x != nil {
+		return x.BeforeId
+	}
+	return 0
+}
+
+func (x *MoveCardRequest) GetAfterId() int64 {
+	if x != nil {
+		return x.AfterId
+	}
+	return 0
+}
+
+type MoveCardResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *Task                  `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveCardResponse) Reset() {
+	*x = MoveCardResponse{}
+	mi := &file_idl_task_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveCardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveCardResponse) ProtoMessage() {}
+
+func (x *MoveCardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveCardResponse.ProtoReflect.Descriptor instead.
+func (*MoveCardResponse) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *MoveCardResponse) GetData() *Task {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// FieldChange is a changed task field with its values formatted as text, an
+// absent value is unset. Times are unix seconds.
+type FieldChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	OldValue      *string                `protobuf:"bytes,2,opt,name=old_value,json=oldValue,proto3,oneof" json:"old_value,omitempty"`
+	NewValue      *string                `protobuf:"bytes,3,opt,name=new_value,json=newValue,proto3,oneof" json:"new_value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FieldChange) Reset() {
+	*x = FieldChange{}
+	mi := &file_idl_task_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FieldChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldChange) ProtoMessage() {}
+
+func (x *FieldChange) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldChange.ProtoReflect.Descriptor instead.
+func (*FieldChange) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *FieldChange) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *FieldChange) GetOldValue() string {
+	if x != nil && x.OldValue != nil {
+		return *x.OldValue
+	}
+	return ""
+}
+
+func (x *FieldChange) GetNewValue() string {
+	if x != nil && x.NewValue != nil {
+		return *x.NewValue
+	}
+	return ""
+}
+
+// TaskActivity is an entry of the activity log of a task, version is the
+// revision the change left the task at.
+type TaskActivity struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ActivityID    int64                  `protobuf:"varint,1,opt,name=activityID,proto3" json:"activityID,omitempty"`
+	TaskID        int64                  `protobuf:"varint,2,opt,name=taskID,proto3" json:"taskID,omitempty"`
+	UserId        int64                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Action        ActivityAction         `protobuf:"varint,4,opt,name=action,proto3,enum=task.ActivityAction" json:"action,omitempty"`
+	Source        ActivitySource         `protobuf:"varint,5,opt,name=source,proto3,enum=task.ActivitySource" json:"source,omitempty"`
+	Version       int64                  `protobuf:"varint,6,opt,name=version,proto3" json:"version,omitempty"`
+	Changes       []*FieldChange         `protobuf:"bytes,7,rep,name=changes,proto3" json:"changes,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskActivity) Reset() {
+	*x = TaskActivity{}
+	mi := &file_idl_task_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskActivity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskActivity) ProtoMessage() {}
+
+func (x *TaskActivity) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskActivity.ProtoReflect.Descriptor instead.
+func (*TaskActivity) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *TaskActivity) GetActivityID() int64 {
+	if x != nil {
+		return x.ActivityID
+	}
+	return 0
+}
+
+func (x *TaskActivity) GetTaskID() int64 {
+	if x != nil {
+		return x.TaskID
+	}
+	return 0
+}
+
+func (x *TaskActivity) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *TaskActivity) GetAction() ActivityAction {
+	if x != nil {
+		return x.Action
+	}
+	return ActivityAction_ACTIVITY_ACTION_CREATED
+}
+
+func (x *TaskActivity) GetSource() ActivitySource {
+	if x != nil {
+		return x.Source
+	}
+	return ActivitySource_ACTIVITY_SOURCE_API
+}
+
+func (x *TaskActivity) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *TaskActivity) GetChanges() []*FieldChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *TaskActivity) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+// GetTaskHistoryRequest pages through the activity log of a task, newest first.
+type GetTaskHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskID        int64                  `protobuf:"varint,1,opt,name=taskID,proto3" json:"taskID,omitempty"`
+	Cursor        string                 `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTaskHistoryRequest) Reset() {
+	*x = GetTaskHistoryRequest{}
+	mi := &file_idl_task_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTaskHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTaskHistoryRequest) ProtoMessage() {}
+
+func (x *GetTaskHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTaskHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetTaskHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *GetTaskHistoryRequest) GetTaskID() int64 {
+	if x != nil {
+		return x.TaskID
+	}
+	return 0
+}
+
+func (x *GetTaskHistoryRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *GetTaskHistoryRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type GetTaskHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*TaskActivity        `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	HasMore       bool                   `protobuf:"varint,3,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTaskHistoryResponse) Reset() {
+	*x = GetTaskHistoryResponse{}
+	mi := &file_idl_task_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTaskHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTaskHistoryResponse) ProtoMessage() {}
+
+func (x *GetTaskHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTaskHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetTaskHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *GetTaskHistoryResponse) GetData() []*TaskActivity {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *GetTaskHistoryResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *GetTaskHistoryResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+// RevertTaskRequest brings the fields of the task back to a revision from its
+// history, the recurrence is not reverted.
+type RevertTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskID        int64                  `protobuf:"varint,1,opt,name=taskID,proto3" json:"taskID,omitempty"`
+	Version       int64                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevertTaskRequest) Reset() {
+	*x = RevertTaskRequest{}
+	mi := &file_idl_task_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevertTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevertTaskRequest) ProtoMessage() {}
+
+func (x *RevertTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevertTaskRequest.ProtoReflect.Descriptor instead.
+func (*RevertTaskRequest) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *RevertTaskRequest) GetTaskID() int64 {
+	if x != nil {
+		return x.TaskID
+	}
+	return 0
+}
+
+func (x *RevertTaskRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type RevertTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *Task                  `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevertTaskResponse) Reset() {
+	*x = RevertTaskResponse{}
+	mi := &file_idl_task_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevertTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevertTaskResponse) ProtoMessage() {}
+
+func (x *RevertTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevertTaskResponse.ProtoReflect.Descriptor instead.
+func (*RevertTaskResponse) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *RevertTaskResponse) GetData() *Task {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type AddCommentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskID        int64                  `protobuf:"varint,1,opt,name=taskID,proto3" json:"taskID,omitempty"`
+	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddCommentRequest) Reset() {
+	*x = AddCommentRequest{}
+	mi := &file_idl_task_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCommentRequest) ProtoMessage() {}
+
+func (x *AddCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCommentRequest.ProtoReflect.Descriptor instead.
+func (*AddCommentRequest) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *AddCommentRequest) GetTaskID() int64 {
+	if x != nil {
+		return x.TaskID
+	}
+	return 0
+}
+
+func (x *AddCommentRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type AddCommentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *Comment               `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddCommentResponse) Reset() {
+	*x = AddCommentResponse{}
+	mi := &file_idl_task_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddCommentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCommentResponse) ProtoMessage() {}
+
+func (x *AddCommentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCommentResponse.ProtoReflect.Descriptor instead.
+func (*AddCommentResponse) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *AddCommentResponse) GetData() *Comment {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type UpdateCommentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CommentID     int64                  `protobuf:"varint,1,opt,name=commentID,proto3" json:"commentID,omitempty"`
+	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCommentRequest) Reset() {
+	*x = UpdateCommentRequest{}
+	mi := &file_idl_task_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCommentRequest) ProtoMessage() {}
+
+func (x *UpdateCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCommentRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCommentRequest) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *UpdateCommentRequest) GetCommentID() int64 {
+	if x != nil {
+		return x.CommentID
+	}
+	return 0
+}
+
+func (x *UpdateCommentRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type UpdateCommentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *Comment               `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCommentResponse) Reset() {
+	*x = UpdateCommentResponse{}
+	mi := &file_idl_task_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCommentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCommentResponse) ProtoMessage() {}
+
+func (x *UpdateCommentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCommentResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCommentResponse) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *UpdateCommentResponse) GetData() *Comment {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type DeleteCommentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CommentID     int64                  `protobuf:"varint,1,opt,name=commentID,proto3" json:"commentID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCommentRequest) Reset() {
+	*x = DeleteCommentRequest{}
+	mi := &file_idl_task_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCommentRequest) ProtoMessage() {}
+
+func (x *DeleteCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCommentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCommentRequest) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *DeleteCommentRequest) GetCommentID() int64 {
+	if x != nil {
+		return x.CommentID
+	}
+	return 0
+}
+
+type DeleteCommentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCommentResponse) Reset() {
+	*x = DeleteCommentResponse{}
+	mi := &file_idl_task_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCommentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCommentResponse) ProtoMessage() {}
+
+func (x *DeleteCommentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCommentResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCommentResponse) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{92}
+}
+
+// ListCommentsRequest pages through the comments on a task, oldest first.
+type ListCommentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskID        int64                  `protobuf:"varint,1,opt,name=taskID,proto3" json:"taskID,omitempty"`
+	Cursor        string                 `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCommentsRequest) Reset() {
+	*x = ListCommentsRequest{}
+	mi := &file_idl_task_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCommentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCommentsRequest) ProtoMessage() {}
+
+func (x *ListCommentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCommentsRequest.ProtoReflect.Descriptor instead.
+func (*ListCommentsRequest) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *ListCommentsRequest) GetTaskID() int64 {
+	if x != nil {
+		return x.TaskID
+	}
+	return 0
+}
+
+func (x *ListCommentsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListCommentsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListCommentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*Comment             `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	HasMore       bool                   `protobuf:"varint,3,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCommentsResponse) Reset() {
+	*x = ListCommentsResponse{}
+	mi := &file_idl_task_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCommentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCommentsResponse) ProtoMessage() {}
+
+func (x *ListCommentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCommentsResponse.ProtoReflect.Descriptor instead.
+func (*ListCommentsResponse) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *ListCommentsResponse) GetData() []*Comment {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ListCommentsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *ListCommentsResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+// UploadAttachmentRequest attaches a file of at most 4MB to a task, the content
+// type is detected from the content. Images, PDFs and plain text are accepted.
+type UploadAttachmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskID        int64                  `protobuf:"varint,1,opt,name=taskID,proto3" json:"taskID,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Content       []byte                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadAttachmentRequest) Reset() {
+	*x = UploadAttachmentRequest{}
+	mi := &file_idl_task_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadAttachmentRequest) ProtoMessage() {}
+
+func (x *UploadAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*UploadAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *UploadAttachmentRequest) GetTaskID() int64 {
+	if x != nil {
+		return x.TaskID
+	}
+	return 0
+}
+
+func (x *UploadAttachmentRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UploadAttachmentRequest) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+type UploadAttachmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *Attachment            `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadAttachmentResponse) Reset() {
+	*x = UploadAttachmentResponse{}
+	mi := &file_idl_task_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadAttachmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadAttachmentResponse) ProtoMessage() {}
+
+func (x *UploadAttachmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadAttachmentResponse.ProtoReflect.Descriptor instead.
+func (*UploadAttachmentResponse) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *UploadAttachmentResponse) GetData() *Attachment {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ListAttachmentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskID        int64                  `protobuf:"varint,1,opt,name=taskID,proto3" json:"taskID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAttachmentsRequest) Reset() {
+	*x = ListAttachmentsRequest{}
+	mi := &file_idl_task_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAttachmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAttachmentsRequest) ProtoMessage() {}
+
+func (x *ListAttachmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAttachmentsRequest.ProtoReflect.Descriptor instead.
+func (*ListAttachmentsRequest) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *ListAttachmentsRequest) GetTaskID() int64 {
+	if x != nil {
+		return x.TaskID
+	}
+	return 0
+}
+
+type ListAttachmentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*Attachment          `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAttachmentsResponse) Reset() {
+	*x = ListAttachmentsResponse{}
+	mi := &file_idl_task_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAttachmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAttachmentsResponse) ProtoMessage() {}
+
+func (x *ListAttachmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAttachmentsResponse.ProtoReflect.Descriptor instead.
+func (*ListAttachmentsResponse) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *ListAttachmentsResponse) GetData() []*Attachment {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type GetAttachmentURLRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AttachmentID  int64                  `protobuf:"varint,1,opt,name=attachmentID,proto3" json:"attachmentID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAttachmentURLRequest) Reset() {
+	*x = GetAttachmentURLRequest{}
+	mi := &file_idl_task_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAttachmentURLRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAttachmentURLRequest) ProtoMessage() {}
+
+func (x *GetAttachmentURLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAttachmentURLRequest.ProtoReflect.Descriptor instead.
+func (*GetAttachmentURLRequest) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *GetAttachmentURLRequest) GetAttachmentID() int64 {
+	if x != nil {
+		return x.AttachmentID
+	}
+	return 0
+}
+
+// GetAttachmentURLResponse holds a presigned download URL valid for 15 minutes.
+type GetAttachmentURLResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAttachmentURLResponse) Reset() {
+	*x = GetAttachmentURLResponse{}
+	mi := &file_idl_task_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAttachmentURLResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAttachmentURLResponse) ProtoMessage() {}
+
+func (x *GetAttachmentURLResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAttachmentURLResponse.ProtoReflect.Descriptor instead.
+func (*GetAttachmentURLResponse) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *GetAttachmentURLResponse) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type DeleteAttachmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AttachmentID  int64                  `protobuf:"varint,1,opt,name=attachmentID,proto3" json:"attachmentID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAttachmentRequest) Reset() {
+	*x = DeleteAttachmentRequest{}
+	mi := &file_idl_task_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAttachmentRequest) ProtoMessage() {}
+
+func (x *DeleteAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *DeleteAttachmentRequest) GetAttachmentID() int64 {
+	if x != nil {
+		return x.AttachmentID
+	}
+	return 0
+}
+
+type DeleteAttachmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAttachmentResponse) Reset() {
+	*x = DeleteAttachmentResponse{}
+	mi := &file_idl_task_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAttachmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAttachmentResponse) ProtoMessage() {}
+
+func (x *DeleteAttachmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAttachmentResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAttachmentResponse) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{102}
+}
+
+// BatchResult is the outcome of one item of a batch, in request order. code and
+// message tell why the item was skipped, code is zero for applied items.
+type BatchResult struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	TaskID  int64                  `protobuf:"varint,1,opt,name=taskID,proto3" json:"taskID,omitempty"`
+	Code    int32                  `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	Message string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	// data is the created task, only filled by BatchCreateTasks.
+	Data          *Task `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchResult) Reset() {
+	*x = BatchResult{}
+	mi := &file_idl_task_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchResult) ProtoMessage() {}
+
+func (x *BatchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchResult.ProtoReflect.Descriptor instead.
+func (*BatchResult) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *BatchResult) GetTaskID() int64 {
+	if x != nil {
+		return x.TaskID
+	}
+	return 0
+}
+
+func (x *BatchResult) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *BatchResult) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *BatchResult) GetData() *Task {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// Batch requests hold at most 100 items. The valid items are applied in one
+// transaction, the others are reported in the results.
+type BatchCreateTasksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tasks         []*AddTaskRequest      `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchCreateTasksRequest) Reset() {
+	*x = BatchCreateTasksRequest{}
+	mi := &file_idl_task_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchCreateTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCreateTasksRequest) ProtoMessage() {}
+
+func (x *BatchCreateTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCreateTasksRequest.ProtoReflect.Descriptor instead.
+func (*BatchCreateTasksRequest) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *BatchCreateTasksRequest) GetTasks() []*AddTaskRequest {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+type BatchCreateTasksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*BatchResult         `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchCreateTasksResponse) Reset() {
+	*x = BatchCreateTasksResponse{}
+	mi := &file_idl_task_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchCreateTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCreateTasksResponse) ProtoMessage() {}
+
+func (x *BatchCreateTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCreateTasksResponse.ProtoReflect.Descriptor instead.
+func (*BatchCreateTasksResponse) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *BatchCreateTasksResponse) GetData() []*BatchResult {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// BatchUpdateStatusRequest can't trash tasks, BatchDelete does.
+type BatchUpdateStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskIds       []int64                `protobuf:"varint,1,rep,packed,name=task_ids,json=taskIds,proto3" json:"task_ids,omitempty"`
+	Status        TaskStatus             `protobuf:"varint,2,opt,name=status,proto3,enum=task.TaskStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchUpdateStatusRequest) Reset() {
+	*x = BatchUpdateStatusRequest{}
+	mi := &file_idl_task_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchUpdateStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUpdateStatusRequest) ProtoMessage() {}
+
+func (x *BatchUpdateStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUpdateStatusRequest.ProtoReflect.Descriptor instead.
+func (*BatchUpdateStatusRequest) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *BatchUpdateStatusRequest) GetTaskIds() []int64 {
+	if x != nil {
+		return x.TaskIds
+	}
+	return nil
+}
+
+func (x *BatchUpdateStatusRequest) GetStatus() TaskStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TaskStatus_TASK_STATUS_TODO
+}
+
+type BatchUpdateStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*BatchResult         `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchUpdateStatusResponse) Reset() {
+	*x = BatchUpdateStatusResponse{}
+	mi := &file_idl_task_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchUpdateStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUpdateStatusResponse) ProtoMessage() {}
+
+func (x *BatchUpdateStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUpdateStatusResponse.ProtoReflect.Descriptor instead.
+func (*BatchUpdateStatusResponse) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *BatchUpdateStatusResponse) GetData() []*BatchResult {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type BatchDeleteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskIds       []int64                `protobuf:"varint,1,rep,packed,name=task_ids,json=taskIds,proto3" json:"task_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchDeleteRequest) Reset() {
+	*x = BatchDeleteRequest{}
+	mi := &file_idl_task_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchDeleteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchDeleteRequest) ProtoMessage() {}
+
+func (x *BatchDeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchDeleteRequest.ProtoReflect.Descriptor instead.
+func (*BatchDeleteRequest) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *BatchDeleteRequest) GetTaskIds() []int64 {
+	if x != nil {
+		return x.TaskIds
+	}
+	return nil
+}
+
+type BatchDeleteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*BatchResult         `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchDeleteResponse) Reset() {
+	*x = BatchDeleteResponse{}
+	mi := &file_idl_task_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchDeleteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchDeleteResponse) ProtoMessage() {}
+
+func (x *BatchDeleteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchDeleteResponse.ProtoReflect.Descriptor instead.
+func (*BatchDeleteResponse) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *BatchDeleteResponse) GetData() []*BatchResult {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// BatchMoveRequest moves top level tasks with their subtasks to a project,
+// project_id zero meaning the inbox.
+type BatchMoveRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskIds       []int64                `protobuf:"varint,1,rep,packed,name=task_ids,json=taskIds,proto3" json:"task_ids,omitempty"`
+	ProjectId     int64                  `protobuf:"varint,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchMoveRequest) Reset() {
+	*x = BatchMoveRequest{}
+	mi := &file_idl_task_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchMoveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchMoveRequest) ProtoMessage() {}
+
+func (x *BatchMoveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchMoveRequest.ProtoReflect.Descriptor instead.
+func (*BatchMoveRequest) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *BatchMoveRequest) GetTaskIds() []int64 {
+	if x != nil {
+		return x.TaskIds
+	}
+	return nil
+}
+
+func (x *BatchMoveRequest) GetProjectId() int64 {
+	if x != nil {
+		return x.ProjectId
+	}
+	return 0
+}
+
+type BatchMoveResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*BatchResult         `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchMoveResponse) Reset() {
+	*x = BatchMoveResponse{}
+	mi := &file_idl_task_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchMoveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchMoveResponse) ProtoMessage() {}
+
+func (x *BatchMoveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchMoveResponse.ProtoReflect.Descriptor instead.
+func (*BatchMoveResponse) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *BatchMoveResponse) GetData() []*BatchResult {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// ExportTasksRequest exports every task of the user, including the recycle bin.
+type ExportTasksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Format        TransferFormat         `protobuf:"varint,1,opt,name=format,proto3,enum=task.TransferFormat" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportTasksRequest) Reset() {
+	*x = ExportTasksRequest{}
+	mi := &file_idl_task_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportTasksRequest) ProtoMessage() {}
+
+func (x *ExportTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportTasksRequest.ProtoReflect.Descriptor instead.
+func (*ExportTasksRequest) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *ExportTasksRequest) GetFormat() TransferFormat {
+	if x != nil {
+		return x.Format
+	}
+	return TransferFormat_TRANSFER_FORMAT_JSON
+}
+
+// ExportTasksResponse holds a presigned download URL valid for an hour.
+type ExportTasksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportTasksResponse) Reset() {
+	*x = ExportTasksResponse{}
+	mi := &file_idl_task_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportTasksResponse) ProtoMessage() {}
+
+func (x *ExportTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportTasksResponse.ProtoReflect.Descriptor instead.
+func (*ExportTasksResponse) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *ExportTasksResponse) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+// ImportTasksRequest creates the tasks of a file of at most 1000 tasks and 4MB.
+// Missing projects and tags are created by name, subtasks are imported as top
+// level tasks. A dry run only checks the rows.
+type ImportTasksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Format        TransferFormat         `protobuf:"varint,1,opt,name=format,proto3,enum=task.TransferFormat" json:"format,omitempty"`
+	Content       []byte                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	DryRun        bool                   `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportTasksRequest) Reset() {
+	*x = ImportTasksRequest{}
+	mi := &file_idl_task_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportTasksRequest) ProtoMessage() {}
+
+func (x *ImportTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportTasksRequest.ProtoReflect.Descriptor instead.
+func (*ImportTasksRequest) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *ImportTasksRequest) GetFormat() TransferFormat {
+	if x != nil {
+		return x.Format
+	}
+	return TransferFormat_TRANSFER_FORMAT_JSON
+}
+
+func (x *ImportTasksRequest) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *ImportTasksRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+// ImportRowError tells why a row was skipped, rows count from 1.
+type ImportRowError struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Row           int32                  `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportRowError) Reset() {
+	*x = ImportRowError{}
+	mi := &file_idl_task_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportRowError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportRowError) ProtoMessage() {}
+
+func (x *ImportRowError) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportRowError.ProtoReflect.Descriptor instead.
+func (*ImportRowError) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *ImportRowError) GetRow() int32 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *ImportRowError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// ImportTasksResponse counts the created tasks, or the tasks a dry run would
+// create. Rows matching an existing task or an earlier row by title and due time
+// are skipped as duplicates.
+type ImportTasksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         int32                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Created       int32                  `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
+	Duplicates    []int32                `protobuf:"varint,3,rep,packed,name=duplicates,proto3" json:"duplicates,omitempty"`
+	Errors        []*ImportRowError      `protobuf:"bytes,4,rep,name=errors,proto3" json:"errors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportTasksResponse) Reset() {
+	*x = ImportTasksResponse{}
+	mi := &file_idl_task_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportTasksResponse) ProtoMessage() {}
+
+func (x *ImportTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportTasksResponse.ProtoReflect.Descriptor instead.
+func (*ImportTasksResponse) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *ImportTasksResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ImportTasksResponse) GetCreated() int32 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *ImportTasksResponse) GetDuplicates() []int32 {
+	if x != nil {
+		return x.Duplicates
+	}
+	return nil
+}
+
+func (x *ImportTasksResponse) GetErrors() []*ImportRowError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+// QuickAddTaskRequest creates a task from one line of text such as
+// "Pay rent tomorrow 9am #home !high every month". Dates are read in time_zone,
+// an IANA name defaulting to UTC, missing tags are created.
+type QuickAddTaskRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Text     string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	TimeZone string                 `protobuf:"bytes,2,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	// project_id zero puts the task in the inbox.
+	ProjectId     int64 `protobuf:"varint,3,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuickAddTaskRequest) Reset() {
+	*x = QuickAddTaskRequest{}
+	mi := &file_idl_task_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuickAddTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuickAddTaskRequest) ProtoMessage() {}
+
+func (x *QuickAddTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuickAddTaskRequest.ProtoReflect.Descriptor instead.
+func (*QuickAddTaskRequest) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *QuickAddTaskRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *QuickAddTaskRequest) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *QuickAddTaskRequest) GetProjectId() int64 {
+	if x != nil {
+		return x.ProjectId
+	}
+	return 0
+}
+
+// QuickAddToken is a phrase taken out of the text, start and end are offsets in
+// Unicode code points and end is exclusive.
+type QuickAddToken struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          QuickAddTokenKind      `protobuf:"varint,1,opt,name=kind,proto3,enum=task.QuickAddTokenKind" json:"kind,omitempty"`
+	Start         int32                  `protobuf:"varint,2,opt,name=start,proto3" json:"start,omitempty"`
+	End           int32                  `protobuf:"varint,3,opt,name=end,proto3" json:"end,omitempty"`
+	Text          string                 `protobuf:"bytes,4,opt,name=text,proto3" json:"text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuickAddToken) Reset() {
+	*x = QuickAddToken{}
+	mi := &file_idl_task_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuickAddToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuickAddToken) ProtoMessage() {}
+
+func (x *QuickAddToken) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuickAddToken.ProtoReflect.Descriptor instead.
+func (*QuickAddToken) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *QuickAddToken) GetKind() QuickAddTokenKind {
+	if x != nil {
+		return x.Kind
+	}
+	return QuickAddTokenKind_QUICK_ADD_TOKEN_KIND_DUE
+}
+
+func (x *QuickAddToken) GetStart() int32 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *QuickAddToken) GetEnd() int32 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+func (x *QuickAddToken) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+// QuickAddTaskResponse holds the created task and the recognized phrases for
+// highlighting, all_day tells the text had a date but no time.
+type QuickAddTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *Task                  `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Tokens        []*QuickAddToken       `protobuf:"bytes,2,rep,name=tokens,proto3" json:"tokens,omitempty"`
+	AllDay        bool                   `protobuf:"varint,3,opt,name=all_day,json=allDay,proto3" json:"all_day,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuickAddTaskResponse) Reset() {
+	*x = QuickAddTaskResponse{}
+	mi := &file_idl_task_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuickAddTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuickAddTaskResponse) ProtoMessage() {}
+
+func (x *QuickAddTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuickAddTaskResponse.ProtoReflect.Descriptor instead.
+func (*QuickAddTaskResponse) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *QuickAddTaskResponse) GetData() *Task {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *QuickAddTaskResponse) GetTokens() []*QuickAddToken {
+	if x != nil {
+		return x.Tokens
+	}
+	return nil
+}
+
+func (x *QuickAddTaskResponse) GetAllDay() bool {
+	if x != nil {
+		return x.AllDay
+	}
+	return false
+}
+
+// SyncTasksRequest pages through the changes of the tasks of the user after
+// since_token. An empty token starts with a snapshot of the live tasks, the
+// changes made since follow it. Keep calling with next_token while has_more is
+// set, then keep the token for the next sync. page_size defaults to 100, at most 500.
+type SyncTasksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SinceToken    string                 `protobuf:"bytes,1,opt,name=since_token,json=sinceToken,proto3" json:"since_token,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncTasksRequest) Reset() {
+	*x = SyncTasksRequest{}
+	mi := &file_idl_task_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncTasksRequest) ProtoMessage() {}
+
+func (x *SyncTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncTasksRequest.ProtoReflect.Descriptor instead.
+func (*SyncTasksRequest) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *SyncTasksRequest) GetSinceToken() string {
+	if x != nil {
+		return x.SinceToken
+	}
+	return ""
+}
+
+func (x *SyncTasksRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// TaskChange is the current state of a changed task. Tasks moved to the recycle
+// bin or purged are tombstones with deleted set and no data.
+type TaskChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskID        int64                  `protobuf:"varint,1,opt,name=taskID,proto3" json:"taskID,omitempty"`
+	Deleted       bool                   `protobuf:"varint,2,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	Data          *Task                  `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskChange) Reset() {
+	*x = TaskChange{}
+	mi := &file_idl_task_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskChange) ProtoMessage() {}
+
+func (x *TaskChange) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[121]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskChange.ProtoReflect.Descriptor instead.
+func (*TaskChange) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *TaskChange) GetTaskID() int64 {
+	if x != nil {
+		return x.TaskID
+	}
+	return 0
+}
+
+func (x *TaskChange) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
+func (x *TaskChange) GetData() *Task {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type SyncTasksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Changes       []*TaskChange          `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	NextToken     string                 `protobuf:"bytes,2,opt,name=next_token,json=nextToken,proto3" json:"next_token,omitempty"`
+	HasMore       bool                   `protobuf:"varint,3,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncTasksResponse) Reset() {
+	*x = SyncTasksResponse{}
+	mi := &file_idl_task_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncTasksResponse) ProtoMessage() {}
+
+func (x *SyncTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[122]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncTasksResponse.ProtoReflect.Descriptor instead.
+func (*SyncTasksResponse) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *SyncTasksResponse) GetChanges() []*TaskChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *SyncTasksResponse) GetNextToken() string {
+	if x != nil {
+		return x.NextToken
+	}
+	return ""
+}
+
+func (x *SyncTasksResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+// ClientTaskChange is a change made by a client while offline. Updates and deletes
+// name the task by taskID and only apply while it is still at base_version, the
+// version the client changed.
+type ClientTaskChange struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Op          ClientChangeOp         `protobuf:"varint,1,opt,name=op,proto3,enum=task.ClientChangeOp" json:"op,omitempty"`
+	TaskID      int64                  `protobuf:"varint,2,opt,name=taskID,proto3" json:"taskID,omitempty"`
+	BaseVersion int64                  `protobuf:"varint,3,opt,name=base_version,json=baseVersion,proto3" json:"base_version,omitempty"`
+	// create is the task to create with CLIENT_CHANGE_OP_CREATE.
+	Create *AddTaskRequest `protobuf:"bytes,4,opt,name=create,proto3" json:"create,omitempty"`
+	// update holds the changed fields with CLIENT_CHANGE_OP_UPDATE, its taskID and
+	// version are ignored.
+	Update *UpdateTaskRequest `protobuf:"bytes,5,opt,name=update,proto3" json:"update,omitempty"`
+	// status moves the task with CLIENT_CHANGE_OP_UPDATE.
+	Status        *TaskStatus `protobuf:"varint,6,opt,name=status,proto3,enum=task.TaskStatus,oneof" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClientTaskChange) Reset() {
+	*x = ClientTaskChange{}
+	mi := &file_idl_task_proto_msgTypes[123]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClientTaskChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientTaskChange) ProtoMessage() {}
+
+func (x *ClientTaskChange) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[123]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientTaskChange.ProtoReflect.Descriptor instead.
+func (*ClientTaskChange) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *ClientTaskChange) GetOp() ClientChangeOp {
+	if x != nil {
+		return x.Op
+	}
+	return ClientChangeOp_CLIENT_CHANGE_OP_CREATE
+}
+
+func (x *ClientTaskChange) GetTaskID() int64 {
+	if x != nil {
+		return x.TaskID
+	}
+	return 0
+}
+
+func (x *ClientTaskChange) GetBaseVersion() int64 {
+	if x != nil {
+		return x.BaseVersion
+	}
+	return 0
+}
+
+func (x *ClientTaskChange) GetCreate() *AddTaskRequest {
+	if x != nil {
+		return x.Create
+	}
+	return nil
+}
+
+func (x *ClientTaskChange) GetUpdate() *UpdateTaskRequest {
+	if x != nil {
+		return x.Update
+	}
+	return nil
+}
+
+func (x *ClientTaskChange) GetStatus() TaskStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return TaskStatus_TASK_STATUS_TODO
+}
+
+// PushTaskChangesRequest holds at most 100 changes, they are applied one by one
+// in order.
+type PushTaskChangesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Changes       []*ClientTaskChange    `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PushTaskChangesRequest) Reset() {
+	*x = PushTaskChangesRequest{}
+	mi := &file_idl_task_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PushTaskChangesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushTaskChangesRequest) ProtoMessage() {}
+
+func (x *PushTaskChangesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[124]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushTaskChangesRequest.ProtoReflect.Descriptor instead.
+func (*PushTaskChangesRequest) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *PushTaskChangesRequest) GetChanges() []*ClientTaskChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+// PushTaskChangesResponse reports the changes in request order. A conflicting
+// change has the code of a version conflict and carries the current task in
+// data, the others carry the task after the change.
+type PushTaskChangesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*BatchResult         `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PushTaskChangesResponse) Reset() {
+	*x = PushTaskChangesResponse{}
+	mi := &file_idl_task_proto_msgTypes[125]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PushTaskChangesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushTaskChangesResponse) ProtoMessage() {}
+
+func (x *PushTaskChangesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[125]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushTaskChangesResponse.ProtoReflect.Descriptor instead.
+func (*PushTaskChangesResponse) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *PushTaskChangesResponse) GetData() []*BatchResult {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// TaskEvent tells a client a task changed. id is the sequence of the change in
+// the change log of the user.
+type TaskEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type          TaskEventType          `protobuf:"varint,2,opt,name=type,proto3,enum=task.TaskEventType" json:"type,omitempty"`
+	TaskID        int64                  `protobuf:"varint,3,opt,name=taskID,proto3" json:"taskID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskEvent) Reset() {
+	*x = TaskEvent{}
+	mi := &file_idl_task_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskEvent) ProtoMessage() {}
+
+func (x *TaskEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[126]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskEvent.ProtoReflect.Descriptor instead.
+func (*TaskEvent) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *TaskEvent) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TaskEvent) GetType() TaskEventType {
+	if x != nil {
+		return x.Type
+	}
+	return TaskEventType_TASK_EVENT_CREATED
+}
+
+func (x *TaskEvent) GetTaskID() int64 {
+	if x != nil {
+		return x.TaskID
+	}
+	return 0
+}
+
+// ListTaskEventsRequest replays the task events after after_id, the last one a
+// client saw. limit defaults to 100, at most 500.
+type ListTaskEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AfterId       int64                  `protobuf:"varint,1,opt,name=after_id,json=afterId,proto3" json:"after_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTaskEventsRequest) Reset() {
+	*x = ListTaskEventsRequest{}
+	mi := &file_idl_task_proto_msgTypes[127]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTaskEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTaskEventsRequest) ProtoMessage() {}
+
+func (x *ListTaskEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[127]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTaskEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListTaskEventsRequest) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *ListTaskEventsRequest) GetAfterId() int64 {
+	if x != nil {
+		return x.AfterId
+	}
+	return 0
+}
+
+func (x *ListTaskEventsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// ListTaskEventsResponse keeps the last event of a task only, as an update or a
+// deletion.
+type ListTaskEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*TaskEvent           `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	HasMore       bool                   `protobuf:"varint,2,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTaskEventsResponse) Reset() {
+	*x = ListTaskEventsResponse{}
+	mi := &file_idl_task_proto_msgTypes[128]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTaskEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTaskEventsResponse) ProtoMessage() {}
+
+func (x *ListTaskEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[128]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTaskEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListTaskEventsResponse) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *ListTaskEventsResponse) GetData() []*TaskEvent {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ListTaskEventsResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+// Webhook posts the task events of event_types to url, empty means every event.
+// Requests carry the event in X-Webhook-Event, the delivery id in
+// X-Webhook-Delivery, the Unix time in X-Webhook-Timestamp and in
+// X-Webhook-Signature "sha256=" followed by the hex HMAC-SHA256 of the
+// timestamp, a dot and the body keyed by the secret. The secret is only
+// returned by CreateWebhook.
+type Webhook struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WebhookID     int64                  `protobuf:"varint,1,opt,name=webhookID,proto3" json:"webhookID,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	EventTypes    []TaskEventType        `protobuf:"varint,3,rep,packed,name=event_types,json=eventTypes,proto3,enum=task.TaskEventType" json:"event_types,omitempty"`
+	Secret        string                 `protobuf:"bytes,4,opt,name=secret,proto3" json:"secret,omitempty"`
+	Enabled       bool                   `protobuf:"varint,5,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     int64                  `protobuf:"varint,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Webhook) Reset() {
+	*x = Webhook{}
+	mi := &file_idl_task_proto_msgTypes[129]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Webhook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Webhook) ProtoMessage() {}
+
+func (x *Webhook) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[129]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Webhook.ProtoReflect.Descriptor instead.
+func (*Webhook) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *Webhook) GetWebhookID() int64 {
+	if x != nil {
+		return x.WebhookID
+	}
+	return 0
+}
+
+func (x *Webhook) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Webhook) GetEventTypes() []TaskEventType {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *Webhook) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *Webhook) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *Webhook) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Webhook) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+// CreateWebhookRequest subscribes url to the task events, a secret of 16 to 128
+// characters is generated unless one is given. A user holds at most 10 webhooks.
+type CreateWebhookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	EventTypes    []TaskEventType        `protobuf:"varint,2,rep,packed,name=event_types,json=eventTypes,proto3,enum=task.TaskEventType" json:"event_types,omitempty"`
+	Secret        string                 `protobuf:"bytes,3,opt,name=secret,proto3" json:"secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWebhookRequest) Reset() {
+	*x = CreateWebhookRequest{}
+	mi := &file_idl_task_proto_msgTypes[130]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWebhookRequest) ProtoMessage() {}
+
+func (x *CreateWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[130]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWebhookRequest.ProtoReflect.Descriptor instead.
+func (*CreateWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *CreateWebhookRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *CreateWebhookRequest) GetEventTypes() []TaskEventType {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *CreateWebhookRequest) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+type CreateWebhookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *Webhook               `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWebhookResponse) Reset() {
+	*x = CreateWebhookResponse{}
+	mi := &file_idl_task_proto_msgTypes[131]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWebhookResponse) ProtoMessage() {}
+
+func (x *CreateWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[131]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWebhookResponse.ProtoReflect.Descriptor instead.
+func (*CreateWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *CreateWebhookResponse) GetData() *Webhook {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// UpdateWebhookRequest changes the given fields, event_types replaces the
+// subscribed events unless it is empty, all_event_types subscribes every event.
+type UpdateWebhookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WebhookID     int64                  `protobuf:"varint,1,opt,name=webhookID,proto3" json:"webhookID,omitempty"`
+	Url           *string                `protobuf:"bytes,2,opt,name=url,proto3,oneof" json:"url,omitempty"`
+	EventTypes    []TaskEventType        `protobuf:"varint,3,rep,packed,name=event_types,json=eventTypes,proto3,enum=task.TaskEventType" json:"event_types,omitempty"`
+	AllEventTypes bool                   `protobuf:"varint,4,opt,name=all_event_types,json=allEventTypes,proto3" json:"all_event_types,omitempty"`
+	Secret        *string                `protobuf:"bytes,5,opt,name=secret,proto3,oneof" json:"secret,omitempty"`
+	Enabled       *bool                  `protobuf:"varint,6,opt,name=enabled,proto3,oneof" json:"enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateWebhookRequest) Reset() {
+	*x = UpdateWebhookRequest{}
+	mi := &file_idl_task_proto_msgTypes[132]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWebhookRequest) ProtoMessage() {}
+
+func (x *UpdateWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[132]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWebhookRequest.ProtoReflect.Descriptor instead.
+func (*UpdateWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *UpdateWebhookRequest) GetWebhookID() int64 {
+	if x != nil {
+		return x.WebhookID
+	}
+	return 0
+}
+
+func (x *UpdateWebhookRequest) GetUrl() string {
+	if x != nil && x.Url != nil {
+		return *x.Url
+	}
+	return ""
+}
+
+func (x *UpdateWebhookRequest) GetEventTypes() []TaskEventType {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *UpdateWebhookRequest) GetAllEventTypes() bool {
+	if x != nil {
+		return x.AllEventTypes
+	}
+	return false
+}
+
+func (x *UpdateWebhookRequest) GetSecret() string {
+	if x != nil && x.Secret != nil {
+		return *x.Secret
+	}
+	return ""
+}
+
+func (x *UpdateWebhookRequest) GetEnabled() bool {
+	if x != nil && x.Enabled != nil {
+		return *x.Enabled
+	}
+	return false
+}
+
+type UpdateWebhookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *Webhook               `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateWebhookResponse) Reset() {
+	*x = UpdateWebhookResponse{}
+	mi := &file_idl_task_proto_msgTypes[133]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWebhookResponse) ProtoMessage() {}
+
+func (x *UpdateWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[133]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWebhookResponse.ProtoReflect.Descriptor instead.
+func (*UpdateWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *UpdateWebhookResponse) GetData() *Webhook {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type DeleteWebhookRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TaskID        int64                  `protobuf:"varint,1,opt,name=taskID,proto3" json:"taskID,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
-	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
-	CreatedAt     int64                  `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     int64                  `protobuf:"varint,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	WebhookID     int64                  `protobuf:"varint,1,opt,name=webhookID,proto3" json:"webhookID,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Task) Reset() {
-	*x = Task{}
-	mi := &file_idl_task_proto_msgTypes[0]
+func (x *DeleteWebhookRequest) Reset() {
+	*x = DeleteWebhookRequest{}
+	mi := &file_idl_task_proto_msgTypes[134]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Task) String() string {
+func (x *DeleteWebhookRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Task) ProtoMessage() {}
+func (*DeleteWebhookRequest) ProtoMessage() {}
 
-func (x *Task) ProtoReflect() protoreflect.Message {
-	mi := &file_idl_task_proto_msgTypes[0]
+func (x *DeleteWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[134]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -58,76 +8195,431 @@ func (x *Task) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Task.ProtoReflect.Descriptor instead.
-func (*Task) Descriptor() ([]byte, []int) {
-	return file_idl_task_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use DeleteWebhookRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{134}
 }
 
-func (x *Task) GetTaskID() int64 {
+func (x *DeleteWebhookRequest) GetWebhookID() int64 {
 	if x != nil {
-		return x.TaskID
+		return x.WebhookID
 	}
 	return 0
 }
 
-func (x *Task) GetTitle() string {
+type DeleteWebhookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWebhookResponse) Reset() {
+	*x = DeleteWebhookResponse{}
+	mi := &file_idl_task_proto_msgTypes[135]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebhookResponse) ProtoMessage() {}
+
+func (x *DeleteWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[135]
 	if x != nil {
-		return x.Title
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWebhookResponse.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{135}
+}
+
+type ListWebhooksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhooksRequest) Reset() {
+	*x = ListWebhooksRequest{}
+	mi := &file_idl_task_proto_msgTypes[136]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhooksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhooksRequest) ProtoMessage() {}
+
+func (x *ListWebhooksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[136]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhooksRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhooksRequest) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{136}
+}
+
+type ListWebhooksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*Webhook             `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhooksResponse) Reset() {
+	*x = ListWebhooksResponse{}
+	mi := &file_idl_task_proto_msgTypes[137]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhooksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhooksResponse) ProtoMessage() {}
+
+func (x *ListWebhooksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[137]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhooksResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhooksResponse) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *ListWebhooksResponse) GetData() []*Webhook {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// WebhookDelivery is a task event posted, or to be posted, to a webhook.
+// Failed attempts are retried with exponential backoff for about an hour.
+// response_status and last_error tell how the last attempt went.
+type WebhookDelivery struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	DeliveryID     int64                  `protobuf:"varint,1,opt,name=deliveryID,proto3" json:"deliveryID,omitempty"`
+	WebhookID      int64                  `protobuf:"varint,2,opt,name=webhookID,proto3" json:"webhookID,omitempty"`
+	EventId        string                 `protobuf:"bytes,3,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	EventType      TaskEventType          `protobuf:"varint,4,opt,name=event_type,json=eventType,proto3,enum=task.TaskEventType" json:"event_type,omitempty"`
+	Payload        string                 `protobuf:"bytes,5,opt,name=payload,proto3" json:"payload,omitempty"`
+	Status         WebhookDeliveryStatus  `protobuf:"varint,6,opt,name=status,proto3,enum=task.WebhookDeliveryStatus" json:"status,omitempty"`
+	Attempts       int32                  `protobuf:"varint,7,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	NextAttemptAt  int64                  `protobuf:"varint,8,opt,name=next_attempt_at,json=nextAttemptAt,proto3" json:"next_attempt_at,omitempty"`
+	ResponseStatus int32                  `protobuf:"varint,9,opt,name=response_status,json=responseStatus,proto3" json:"response_status,omitempty"`
+	LastError      string                 `protobuf:"bytes,10,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	CreatedAt      int64                  `protobuf:"varint,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      int64                  `protobuf:"varint,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	DeliveredAt    int64                  `protobuf:"varint,13,opt,name=delivered_at,json=deliveredAt,proto3" json:"delivered_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *WebhookDelivery) Reset() {
+	*x = WebhookDelivery{}
+	mi := &file_idl_task_proto_msgTypes[138]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookDelivery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookDelivery) ProtoMessage() {}
+
+func (x *WebhookDelivery) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[138]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookDelivery.ProtoReflect.Descriptor instead.
+func (*WebhookDelivery) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *WebhookDelivery) GetDeliveryID() int64 {
+	if x != nil {
+		return x.DeliveryID
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetWebhookID() int64 {
+	if x != nil {
+		return x.WebhookID
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetEventId() string {
+	if x != nil {
+		return x.EventId
 	}
 	return ""
 }
 
-func (x *Task) GetContent() string {
+func (x *WebhookDelivery) GetEventType() TaskEventType {
 	if x != nil {
-		return x.Content
+		return x.EventType
+	}
+	return TaskEventType_TASK_EVENT_CREATED
+}
+
+func (x *WebhookDelivery) GetPayload() string {
+	if x != nil {
+		return x.Payload
 	}
 	return ""
 }
 
-func (x *Task) GetStatus() string {
+func (x *WebhookDelivery) GetStatus() WebhookDeliveryStatus {
 	if x != nil {
 		return x.Status
 	}
+	return WebhookDeliveryStatus_WEBHOOK_DELIVERY_PENDING
+}
+
+func (x *WebhookDelivery) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetNextAttemptAt() int64 {
+	if x != nil {
+		return x.NextAttemptAt
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetResponseStatus() int32 {
+	if x != nil {
+		return x.ResponseStatus
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
 	return ""
 }
 
-func (x *Task) GetCreatedAt() int64 {
+func (x *WebhookDelivery) GetCreatedAt() int64 {
 	if x != nil {
 		return x.CreatedAt
 	}
 	return 0
 }
 
-func (x *Task) GetUpdatedAt() int64 {
+func (x *WebhookDelivery) GetUpdatedAt() int64 {
 	if x != nil {
 		return x.UpdatedAt
 	}
 	return 0
 }
 
-type AddTaskRequest struct {
+func (x *WebhookDelivery) GetDeliveredAt() int64 {
+	if x != nil {
+		return x.DeliveredAt
+	}
+	return 0
+}
+
+// ListWebhookDeliveriesRequest pages through the deliveries of a webhook newest
+// first, status filters them when set.
+type ListWebhookDeliveriesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	WebhookID     int64                  `protobuf:"varint,1,opt,name=webhookID,proto3" json:"webhookID,omitempty"`
+	Status        *WebhookDeliveryStatus `protobuf:"varint,2,opt,name=status,proto3,enum=task.WebhookDeliveryStatus,oneof" json:"status,omitempty"`
+	Cursor        string                 `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddTaskRequest) Reset() {
-	*x = AddTaskRequest{}
-	mi := &file_idl_task_proto_msgTypes[1]
+func (x *ListWebhookDeliveriesRequest) Reset() {
+	*x = ListWebhookDeliveriesRequest{}
+	mi := &file_idl_task_proto_msgTypes[139]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookDeliveriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookDeliveriesRequest) ProtoMessage() {}
+
+func (x *ListWebhookDeliveriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[139]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookDeliveriesRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeliveriesRequest) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *ListWebhookDeliveriesRequest) GetWebhookID() int64 {
+	if x != nil {
+		return x.WebhookID
+	}
+	return 0
+}
+
+func (x *ListWebhookDeliveriesRequest) GetStatus() WebhookDeliveryStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return WebhookDeliveryStatus_WEBHOOK_DELIVERY_PENDING
+}
+
+func (x *ListWebhookDeliveriesRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListWebhookDeliveriesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListWebhookDeliveriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*WebhookDelivery     `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	HasMore       bool                   `protobuf:"varint,3,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhookDeliveriesResponse) Reset() {
+	*x = ListWebhookDeliveriesResponse{}
+	mi := &file_idl_task_proto_msgTypes[140]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookDeliveriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookDeliveriesResponse) ProtoMessage() {}
+
+func (x *ListWebhookDeliveriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[140]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookDeliveriesResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeliveriesResponse) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *ListWebhookDeliveriesResponse) GetData() []*WebhookDelivery {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ListWebhookDeliveriesResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *ListWebhookDeliveriesResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+// ReplayWebhookDeliveryRequest sends the delivery again with a fresh set of
+// attempts, whatever its status.
+type ReplayWebhookDeliveryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeliveryID    int64                  `protobuf:"varint,1,opt,name=deliveryID,proto3" json:"deliveryID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplayWebhookDeliveryRequest) Reset() {
+	*x = ReplayWebhookDeliveryRequest{}
+	mi := &file_idl_task_proto_msgTypes[141]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddTaskRequest) String() string {
+func (x *ReplayWebhookDeliveryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddTaskRequest) ProtoMessage() {}
+func (*ReplayWebhookDeliveryRequest) ProtoMessage() {}
 
-func (x *AddTaskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_idl_task_proto_msgTypes[1]
+func (x *ReplayWebhookDeliveryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[141]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -138,47 +8630,40 @@ func (x *AddTaskRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddTaskRequest.ProtoReflect.Descriptor instead.
-func (*AddTaskRequest) Descriptor() ([]byte, []int) {
-	return file_idl_task_proto_rawDescGZIP(), []int{1}
-}
-
-func (x *AddTaskRequest) GetTitle() string {
-	if x != nil {
-		return x.Title
-	}
-	return ""
+// Deprecated: Use ReplayWebhookDeliveryRequest.ProtoReflect.Descriptor instead.
+func (*ReplayWebhookDeliveryRequest) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{141}
 }
 
-func (x *AddTaskRequest) GetContent() string {
+func (x *ReplayWebhookDeliveryRequest) GetDeliveryID() int64 {
 	if x != nil {
-		return x.Content
+		return x.DeliveryID
 	}
-	return ""
+	return 0
 }
 
-type AddTaskResponse struct {
+type ReplayWebhookDeliveryResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          *Task                  `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Data          *WebhookDelivery       `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddTaskResponse) Reset() {
-	*x = AddTaskResponse{}
-	mi := &file_idl_task_proto_msgTypes[2]
+func (x *ReplayWebhookDeliveryResponse) Reset() {
+	*x = ReplayWebhookDeliveryResponse{}
+	mi := &file_idl_task_proto_msgTypes[142]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddTaskResponse) String() string {
+func (x *ReplayWebhookDeliveryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddTaskResponse) ProtoMessage() {}
+func (*ReplayWebhookDeliveryResponse) ProtoMessage() {}
 
-func (x *AddTaskResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_idl_task_proto_msgTypes[2]
+func (x *ReplayWebhookDeliveryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[142]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -189,39 +8674,41 @@ func (x *AddTaskResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddTaskResponse.ProtoReflect.Descriptor instead.
-func (*AddTaskResponse) Descriptor() ([]byte, []int) {
-	return file_idl_task_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use ReplayWebhookDeliveryResponse.ProtoReflect.Descriptor instead.
+func (*ReplayWebhookDeliveryResponse) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{142}
 }
 
-func (x *AddTaskResponse) GetData() *Task {
+func (x *ReplayWebhookDeliveryResponse) GetData() *WebhookDelivery {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-type ListTasksRequest struct {
+// CreateCalendarFeedRequest gives the user a secret token to subscribe to their
+// dated tasks from calendar apps, a user has at most one feed.
+type CreateCalendarFeedRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListTasksRequest) Reset() {
-	*x = ListTasksRequest{}
-	mi := &file_idl_task_proto_msgTypes[3]
+func (x *CreateCalendarFeedRequest) Reset() {
+	*x = CreateCalendarFeedRequest{}
+	mi := &file_idl_task_proto_msgTypes[143]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListTasksRequest) String() string {
+func (x *CreateCalendarFeedRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTasksRequest) ProtoMessage() {}
+func (*CreateCalendarFeedRequest) ProtoMessage() {}
 
-func (x *ListTasksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_idl_task_proto_msgTypes[3]
+func (x *CreateCalendarFeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[143]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -232,33 +8719,34 @@ func (x *ListTasksRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTasksRequest.ProtoReflect.Descriptor instead.
-func (*ListTasksRequest) Descriptor() ([]byte, []int) {
-	return file_idl_task_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use CreateCalendarFeedRequest.ProtoReflect.Descriptor instead.
+func (*CreateCalendarFeedRequest) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{143}
 }
 
-type ListTasksResponse struct {
+// CreateCalendarFeedResponse holds the token, it is not returned again.
+type CreateCalendarFeedResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          []*Task                `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListTasksResponse) Reset() {
-	*x = ListTasksResponse{}
-	mi := &file_idl_task_proto_msgTypes[4]
+func (x *CreateCalendarFeedResponse) Reset() {
+	*x = CreateCalendarFeedResponse{}
+	mi := &file_idl_task_proto_msgTypes[144]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListTasksResponse) String() string {
+func (x *CreateCalendarFeedResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTasksResponse) ProtoMessage() {}
+func (*CreateCalendarFeedResponse) ProtoMessage() {}
 
-func (x *ListTasksResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_idl_task_proto_msgTypes[4]
+func (x *CreateCalendarFeedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[144]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -269,42 +8757,40 @@ func (x *ListTasksResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTasksResponse.ProtoReflect.Descriptor instead.
-func (*ListTasksResponse) Descriptor() ([]byte, []int) {
-	return file_idl_task_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use CreateCalendarFeedResponse.ProtoReflect.Descriptor instead.
+func (*CreateCalendarFeedResponse) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{144}
 }
 
-func (x *ListTasksResponse) GetData() []*Task {
+func (x *CreateCalendarFeedResponse) GetToken() string {
 	if x != nil {
-		return x.Data
+		return x.Token
 	}
-	return nil
+	return ""
 }
 
-type UpdateTaskRequest struct {
+// RotateCalendarFeedRequest replaces the token of the feed, the old one stops working.
+type RotateCalendarFeedRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TaskID        int64                  `protobuf:"varint,1,opt,name=taskID,proto3" json:"taskID,omitempty"`
-	Content       *string                `protobuf:"bytes,2,opt,name=content,proto3,oneof" json:"content,omitempty"`
-	Title         *string                `protobuf:"bytes,3,opt,name=title,proto3,oneof" json:"title,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateTaskRequest) Reset() {
-	*x = UpdateTaskRequest{}
-	mi := &file_idl_task_proto_msgTypes[5]
+func (x *RotateCalendarFeedRequest) Reset() {
+	*x = RotateCalendarFeedRequest{}
+	mi := &file_idl_task_proto_msgTypes[145]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateTaskRequest) String() string {
+func (x *RotateCalendarFeedRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateTaskRequest) ProtoMessage() {}
+func (*RotateCalendarFeedRequest) ProtoMessage() {}
 
-func (x *UpdateTaskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_idl_task_proto_msgTypes[5]
+func (x *RotateCalendarFeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[145]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -315,53 +8801,33 @@ func (x *UpdateTaskRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateTaskRequest.ProtoReflect.Descriptor instead.
-func (*UpdateTaskRequest) Descriptor() ([]byte, []int) {
-	return file_idl_task_proto_rawDescGZIP(), []int{5}
-}
-
-func (x *UpdateTaskRequest) GetTaskID() int64 {
-	if x != nil {
-		return x.TaskID
-	}
-	return 0
-}
-
-func (x *UpdateTaskRequest) GetContent() string {
-	if x != nil && x.Content != nil {
-		return *x.Content
-	}
-	return ""
-}
-
-func (x *UpdateTaskRequest) GetTitle() string {
-	if x != nil && x.Title != nil {
-		return *x.Title
-	}
-	return ""
+// Deprecated: Use RotateCalendarFeedRequest.ProtoReflect.Descriptor instead.
+func (*RotateCalendarFeedRequest) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{145}
 }
 
-type UpdateTaskResponse struct {
+type RotateCalendarFeedResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateTaskResponse) Reset() {
-	*x = UpdateTaskResponse{}
-	mi := &file_idl_task_proto_msgTypes[6]
+func (x *RotateCalendarFeedResponse) Reset() {
+	*x = RotateCalendarFeedResponse{}
+	mi := &file_idl_task_proto_msgTypes[146]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateTaskResponse) String() string {
+func (x *RotateCalendarFeedResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateTaskResponse) ProtoMessage() {}
+func (*RotateCalendarFeedResponse) ProtoMessage() {}
 
-func (x *UpdateTaskResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_idl_task_proto_msgTypes[6]
+func (x *RotateCalendarFeedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[146]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -372,34 +8838,39 @@ func (x *UpdateTaskResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateTaskResponse.ProtoReflect.Descriptor instead.
-func (*UpdateTaskResponse) Descriptor() ([]byte, []int) {
-	return file_idl_task_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use RotateCalendarFeedResponse.ProtoReflect.Descriptor instead.
+func (*RotateCalendarFeedResponse) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{146}
 }
 
-type UpdateTaskStatusRequest struct {
+func (x *RotateCalendarFeedResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type RevokeCalendarFeedRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TaskID        int64                  `protobuf:"varint,1,opt,name=taskID,proto3" json:"taskID,omitempty"`
-	Status        int32                  `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateTaskStatusRequest) Reset() {
-	*x = UpdateTaskStatusRequest{}
-	mi := &file_idl_task_proto_msgTypes[7]
+func (x *RevokeCalendarFeedRequest) Reset() {
+	*x = RevokeCalendarFeedRequest{}
+	mi := &file_idl_task_proto_msgTypes[147]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateTaskStatusRequest) String() string {
+func (x *RevokeCalendarFeedRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateTaskStatusRequest) ProtoMessage() {}
+func (*RevokeCalendarFeedRequest) ProtoMessage() {}
 
-func (x *UpdateTaskStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_idl_task_proto_msgTypes[7]
+func (x *RevokeCalendarFeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[147]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -410,46 +8881,32 @@ func (x *UpdateTaskStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateTaskStatusRequest.ProtoReflect.Descriptor instead.
-func (*UpdateTaskStatusRequest) Descriptor() ([]byte, []int) {
-	return file_idl_task_proto_rawDescGZIP(), []int{7}
-}
-
-func (x *UpdateTaskStatusRequest) GetTaskID() int64 {
-	if x != nil {
-		return x.TaskID
-	}
-	return 0
-}
-
-func (x *UpdateTaskStatusRequest) GetStatus() int32 {
-	if x != nil {
-		return x.Status
-	}
-	return 0
+// Deprecated: Use RevokeCalendarFeedRequest.ProtoReflect.Descriptor instead.
+func (*RevokeCalendarFeedRequest) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{147}
 }
 
-type UpdateTaskStatusResponse struct {
+type RevokeCalendarFeedResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateTaskStatusResponse) Reset() {
-	*x = UpdateTaskStatusResponse{}
-	mi := &file_idl_task_proto_msgTypes[8]
+func (x *RevokeCalendarFeedResponse) Reset() {
+	*x = RevokeCalendarFeedResponse{}
+	mi := &file_idl_task_proto_msgTypes[148]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateTaskStatusResponse) String() string {
+func (x *RevokeCalendarFeedResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateTaskStatusResponse) ProtoMessage() {}
+func (*RevokeCalendarFeedResponse) ProtoMessage() {}
 
-func (x *UpdateTaskStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_idl_task_proto_msgTypes[8]
+func (x *RevokeCalendarFeedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[148]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -460,32 +8917,34 @@ func (x *UpdateTaskStatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateTaskStatusResponse.ProtoReflect.Descriptor instead.
-func (*UpdateTaskStatusResponse) Descriptor() ([]byte, []int) {
-	return file_idl_task_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use RevokeCalendarFeedResponse.ProtoReflect.Descriptor instead.
+func (*RevokeCalendarFeedResponse) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{148}
 }
 
-type RecycleBinRequest struct {
+// GetCalendarFeedRequest is authorized by the token alone.
+type GetCalendarFeedRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RecycleBinRequest) Reset() {
-	*x = RecycleBinRequest{}
-	mi := &file_idl_task_proto_msgTypes[9]
+func (x *GetCalendarFeedRequest) Reset() {
+	*x = GetCalendarFeedRequest{}
+	mi := &file_idl_task_proto_msgTypes[149]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RecycleBinRequest) String() string {
+func (x *GetCalendarFeedRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RecycleBinRequest) ProtoMessage() {}
+func (*GetCalendarFeedRequest) ProtoMessage() {}
 
-func (x *RecycleBinRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_idl_task_proto_msgTypes[9]
+func (x *GetCalendarFeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[149]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -496,33 +8955,43 @@ func (x *RecycleBinRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RecycleBinRequest.ProtoReflect.Descriptor instead.
-func (*RecycleBinRequest) Descriptor() ([]byte, []int) {
-	return file_idl_task_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use GetCalendarFeedRequest.ProtoReflect.Descriptor instead.
+func (*GetCalendarFeedRequest) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{149}
 }
 
-type RecycleBinResponse struct {
+func (x *GetCalendarFeedRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+// GetCalendarFeedResponse holds the iCalendar file of the tasks due around now,
+// etag changes along with the content.
+type GetCalendarFeedResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          []*Task                `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Content       []byte                 `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	Etag          string                 `protobuf:"bytes,2,opt,name=etag,proto3" json:"etag,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RecycleBinResponse) Reset() {
-	*x = RecycleBinResponse{}
-	mi := &file_idl_task_proto_msgTypes[10]
+func (x *GetCalendarFeedResponse) Reset() {
+	*x = GetCalendarFeedResponse{}
+	mi := &file_idl_task_proto_msgTypes[150]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RecycleBinResponse) String() string {
+func (x *GetCalendarFeedResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RecycleBinResponse) ProtoMessage() {}
+func (*GetCalendarFeedResponse) ProtoMessage() {}
 
-func (x *RecycleBinResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_idl_task_proto_msgTypes[10]
+func (x *GetCalendarFeedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_idl_task_proto_msgTypes[150]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -533,66 +9002,778 @@ func (x *RecycleBinResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RecycleBinResponse.ProtoReflect.Descriptor instead.
-func (*RecycleBinResponse) Descriptor() ([]byte, []int) {
-	return file_idl_task_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use GetCalendarFeedResponse.ProtoReflect.Descriptor instead.
+func (*GetCalendarFeedResponse) Descriptor() ([]byte, []int) {
+	return file_idl_task_proto_rawDescGZIP(), []int{150}
 }
 
-func (x *RecycleBinResponse) GetData() []*Task {
+func (x *GetCalendarFeedResponse) GetContent() []byte {
 	if x != nil {
-		return x.Data
+		return x.Content
 	}
 	return nil
 }
 
+func (x *GetCalendarFeedResponse) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
 var File_idl_task_proto protoreflect.FileDescriptor
 
 const file_idl_task_proto_rawDesc = "" +
 	"\n" +
-	"\x0eidl/task.proto\x12\x04task\"\xa4\x01\n" +
+	"\x0eidl/task.proto\x12\x04task\"=\n" +
+	"\n" +
+	"Recurrence\x12\x12\n" +
+	"\x04rule\x18\x01 \x01(\tR\x04rule\x12\x1b\n" +
+	"\ttime_zone\x18\x02 \x01(\tR\btimeZone\"\xa2\x01\n" +
+	"\x03Tag\x12\x14\n" +
+	"\x05tagID\x18\x01 \x01(\x03R\x05tagID\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05color\x18\x03 \x01(\tR\x05color\x12\x1d\n" +
+	"\n" +
+	"task_count\x18\x04 \x01(\x03R\ttaskCount\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\x03R\tupdatedAt\"\xc7\x01\n" +
+	"\aProject\x12\x1c\n" +
+	"\tprojectID\x18\x01 \x01(\x03R\tprojectID\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\bposition\x18\x03 \x01(\x03R\bposition\x12\x14\n" +
+	"\x05inbox\x18\x04 \x01(\bR\x05inbox\x12\x1a\n" +
+	"\barchived\x18\x05 \x01(\bR\barchived\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\x03R\tupdatedAt\"\xb1\x01\n" +
+	"\rChecklistItem\x12\x16\n" +
+	"\x06itemID\x18\x01 \x01(\x03R\x06itemID\x12\x16\n" +
+	"\x06taskID\x18\x02 \x01(\x03R\x06taskID\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\tR\acontent\x12\x18\n" +
+	"\achecked\x18\x04 \x01(\bR\achecked\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\x03R\tupdatedAt\"\xaf\x01\n" +
+	"\aComment\x12\x1c\n" +
+	"\tcommentID\x18\x01 \x01(\x03R\tcommentID\x12\x16\n" +
+	"\x06taskID\x18\x02 \x01(\x03R\x06taskID\x12\x16\n" +
+	"\x06userID\x18\x03 \x01(\x03R\x06userID\x12\x18\n" +
+	"\acontent\x18\x04 \x01(\tR\acontent\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\x03R\tupdatedAt\"\xb2\x01\n" +
+	"\n" +
+	"Attachment\x12\"\n" +
+	"\fattachmentID\x18\x01 \x01(\x03R\fattachmentID\x12\x16\n" +
+	"\x06taskID\x18\x02 \x01(\x03R\x06taskID\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12!\n" +
+	"\fcontent_type\x18\x04 \x01(\tR\vcontentType\x12\x12\n" +
+	"\x04size\x18\x05 \x01(\x03R\x04size\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\x03R\tcreatedAt\"\xaa\x06\n" +
 	"\x04Task\x12\x16\n" +
 	"\x06taskID\x18\x01 \x01(\x03R\x06taskID\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x18\n" +
-	"\acontent\x18\x03 \x01(\tR\acontent\x12\x16\n" +
-	"\x06status\x18\x04 \x01(\tR\x06status\x12\x1d\n" +
+	"\acontent\x18\x03 \x01(\tR\acontent\x12\x1d\n" +
 	"\n" +
 	"created_at\x18\x05 \x01(\x03R\tcreatedAt\x12\x1d\n" +
 	"\n" +
-	"updated_at\x18\x06 \x01(\x03R\tupdatedAt\"@\n" +
+	"updated_at\x18\x06 \x01(\x03R\tupdatedAt\x12\x1a\n" +
+	"\x06due_at\x18\a \x01(\x03H\x00R\x05dueAt\x88\x01\x01\x12 \n" +
+	"\tremind_at\x18\b \x01(\x03H\x01R\bremindAt\x88\x01\x01\x120\n" +
+	"\n" +
+	"recurrence\x18\t \x01(\v2\x10.task.RecurrenceR\n" +
+	"recurrence\x12\x1b\n" +
+	"\tseries_id\x18\n" +
+	" \x01(\x03R\bseriesId\x12(\n" +
+	"\x06status\x18\v \x01(\x0e2\x10.task.TaskStatusR\x06status\x12\x18\n" +
+	"\aversion\x18\f \x01(\x03R\aversion\x12\x1d\n" +
+	"\x04tags\x18\r \x03(\v2\t.task.TagR\x04tags\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x0e \x01(\x03R\tprojectId\x12\x1b\n" +
+	"\tparent_id\x18\x0f \x01(\x03R\bparentId\x12#\n" +
+	"\rauto_complete\x18\x10 \x01(\bR\fautoComplete\x12-\n" +
+	"\x12completion_percent\x18\x11 \x01(\x05R\x11completionPercent\x121\n" +
+	"\tchecklist\x18\x12 \x03(\v2\x13.task.ChecklistItemR\tchecklist\x12&\n" +
+	"\bchildren\x18\x13 \x03(\v2\n" +
+	".task.TaskR\bchildren\x12\x1f\n" +
+	"\vblocker_ids\x18\x14 \x03(\x03R\n" +
+	"blockerIds\x12\x18\n" +
+	"\ablocked\x18\x15 \x01(\bR\ablocked\x12.\n" +
+	"\bpriority\x18\x16 \x01(\x0e2\x12.task.TaskPriorityR\bpriority\x12\x12\n" +
+	"\x04rank\x18\x17 \x01(\tR\x04rank\x12#\n" +
+	"\rcomment_count\x18\x18 \x01(\x03R\fcommentCountB\t\n" +
+	"\a_due_atB\f\n" +
+	"\n" +
+	"_remind_atJ\x04\b\x04\x10\x05\"\xf3\x02\n" +
 	"\x0eAddTaskRequest\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12\x18\n" +
-	"\acontent\x18\x02 \x01(\tR\acontent\"1\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\x12\x1a\n" +
+	"\x06due_at\x18\x03 \x01(\x03H\x00R\x05dueAt\x88\x01\x01\x12 \n" +
+	"\tremind_at\x18\x04 \x01(\x03H\x01R\bremindAt\x88\x01\x01\x120\n" +
+	"\n" +
+	"recurrence\x18\x05 \x01(\v2\x10.task.RecurrenceR\n" +
+	"recurrence\x12\x17\n" +
+	"\atag_ids\x18\x06 \x03(\x03R\x06tagIds\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\a \x01(\x03R\tprojectId\x12\x1b\n" +
+	"\tparent_id\x18\b \x01(\x03R\bparentId\x12#\n" +
+	"\rauto_complete\x18\t \x01(\bR\fautoComplete\x12.\n" +
+	"\bpriority\x18\n" +
+	" \x01(\x0e2\x12.task.TaskPriorityR\bpriorityB\t\n" +
+	"\a_due_atB\f\n" +
+	"\n" +
+	"_remind_at\"1\n" +
 	"\x0fAddTaskResponse\x12\x1e\n" +
 	"\x04data\x18\x01 \x01(\v2\n" +
-	".task.TaskR\x04data\"\x12\n" +
-	"\x10ListTasksRequest\"3\n" +
+	".task.TaskR\x04data\"\xb9\x02\n" +
+	"\n" +
+	"ListOption\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x16\n" +
+	"\x06cursor\x18\x02 \x01(\tR\x06cursor\x12.\n" +
+	"\n" +
+	"sort_field\x18\x03 \x01(\x0e2\x0f.task.SortFieldR\tsortField\x12.\n" +
+	"\n" +
+	"sort_order\x18\x04 \x01(\x0e2\x0f.task.SortOrderR\tsortOrder\x12#\n" +
+	"\rcreated_after\x18\x05 \x01(\x03R\fcreatedAfter\x12%\n" +
+	"\x0ecreated_before\x18\x06 \x01(\x03R\rcreatedBefore\x12#\n" +
+	"\rupdated_after\x18\a \x01(\x03R\fupdatedAfter\x12%\n" +
+	"\x0eupdated_before\x18\b \x01(\x03R\rupdatedBefore\"(\n" +
+	"\x0eGetTaskRequest\x12\x16\n" +
+	"\x06taskID\x18\x01 \x01(\x03R\x06taskID\"1\n" +
+	"\x0fGetTaskResponse\x12\x1e\n" +
+	"\x04data\x18\x01 \x01(\v2\n" +
+	".task.TaskR\x04data\"\xd6\x01\n" +
+	"\x10ListTasksRequest\x12(\n" +
+	"\x06option\x18\x01 \x01(\v2\x10.task.ListOptionR\x06option\x12,\n" +
+	"\bstatuses\x18\x02 \x03(\x0e2\x10.task.TaskStatusR\bstatuses\x12\x17\n" +
+	"\atag_ids\x18\x03 \x03(\x03R\x06tagIds\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x04 \x01(\x03R\tprojectId\x12\x12\n" +
+	"\x04tree\x18\x05 \x01(\bR\x04tree\x12\x1e\n" +
+	"\n" +
+	"actionable\x18\x06 \x01(\bR\n" +
+	"actionable\"o\n" +
 	"\x11ListTasksResponse\x12\x1e\n" +
 	"\x04data\x18\x01 \x03(\v2\n" +
-	".task.TaskR\x04data\"{\n" +
+	".task.TaskR\x04data\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\x12\x19\n" +
+	"\bhas_more\x18\x03 \x01(\bR\ahasMore\"\xfa\x05\n" +
 	"\x11UpdateTaskRequest\x12\x16\n" +
 	"\x06taskID\x18\x01 \x01(\x03R\x06taskID\x12\x1d\n" +
 	"\acontent\x18\x02 \x01(\tH\x00R\acontent\x88\x01\x01\x12\x19\n" +
-	"\x05title\x18\x03 \x01(\tH\x01R\x05title\x88\x01\x01B\n" +
+	"\x05title\x18\x03 \x01(\tH\x01R\x05title\x88\x01\x01\x12\x1a\n" +
+	"\x06due_at\x18\x04 \x01(\x03H\x02R\x05dueAt\x88\x01\x01\x12 \n" +
+	"\tremind_at\x18\x05 \x01(\x03H\x03R\bremindAt\x88\x01\x01\x12 \n" +
+	"\fclear_due_at\x18\x06 \x01(\bR\n" +
+	"clearDueAt\x12&\n" +
+	"\x0fclear_remind_at\x18\a \x01(\bR\rclearRemindAt\x120\n" +
+	"\n" +
+	"recurrence\x18\b \x01(\v2\x10.task.RecurrenceR\n" +
+	"recurrence\x12)\n" +
+	"\x10clear_recurrence\x18\t \x01(\bR\x0fclearRecurrence\x12'\n" +
+	"\x05scope\x18\n" +
+	" \x01(\x0e2\x11.task.UpdateScopeR\x05scope\x12\x1d\n" +
+	"\aversion\x18\v \x01(\x03H\x04R\aversion\x88\x01\x01\x12$\n" +
+	"\x0eattach_tag_ids\x18\f \x03(\x03R\fattachTagIds\x12$\n" +
+	"\x0edetach_tag_ids\x18\r \x03(\x03R\fdetachTagIds\x12\"\n" +
+	"\n" +
+	"project_id\x18\x0e \x01(\x03H\x05R\tprojectId\x88\x01\x01\x12 \n" +
+	"\tparent_id\x18\x0f \x01(\x03H\x06R\bparentId\x88\x01\x01\x12(\n" +
+	"\rauto_complete\x18\x10 \x01(\bH\aR\fautoComplete\x88\x01\x01\x123\n" +
+	"\bpriority\x18\x11 \x01(\x0e2\x12.task.TaskPriorityH\bR\bpriority\x88\x01\x01B\n" +
 	"\n" +
 	"\b_contentB\b\n" +
-	"\x06_title\"\x14\n" +
-	"\x12UpdateTaskResponse\"I\n" +
+	"\x06_titleB\t\n" +
+	"\a_due_atB\f\n" +
+	"\n" +
+	"_remind_atB\n" +
+	"\n" +
+	"\b_versionB\r\n" +
+	"\v_project_idB\f\n" +
+	"\n" +
+	"_parent_idB\x10\n" +
+	"\x0e_auto_completeB\v\n" +
+	"\t_priority\"\x14\n" +
+	"\x12UpdateTaskResponse\"[\n" +
 	"\x17UpdateTaskStatusRequest\x12\x16\n" +
-	"\x06taskID\x18\x01 \x01(\x03R\x06taskID\x12\x16\n" +
-	"\x06status\x18\x02 \x01(\x05R\x06status\"\x1a\n" +
-	"\x18UpdateTaskStatusResponse\"\x13\n" +
-	"\x11RecycleBinRequest\"4\n" +
+	"\x06taskID\x18\x01 \x01(\x03R\x06taskID\x12(\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x10.task.TaskStatusR\x06status\"\x1a\n" +
+	"\x18UpdateTaskStatusResponse\"=\n" +
+	"\x11RecycleBinRequest\x12(\n" +
+	"\x06option\x18\x01 \x01(\v2\x10.task.ListOptionR\x06option\"p\n" +
 	"\x12RecycleBinResponse\x12\x1e\n" +
 	"\x04data\x18\x01 \x03(\v2\n" +
-	".task.TaskR\x04data2\xd8\x02\n" +
+	".task.TaskR\x04data\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\x12\x19\n" +
+	"\bhas_more\x18\x03 \x01(\bR\ahasMore\"\x8d\x01\n" +
+	"\x12SearchTasksRequest\x12\x18\n" +
+	"\akeyword\x18\x01 \x01(\tR\akeyword\x12,\n" +
+	"\bstatuses\x18\x02 \x03(\x0e2\x10.task.TaskStatusR\bstatuses\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\x97\x01\n" +
+	"\tSearchHit\x12\x1e\n" +
+	"\x04task\x18\x01 \x01(\v2\n" +
+	".task.TaskR\x04task\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x01R\x05score\x12'\n" +
+	"\x0ftitle_highlight\x18\x03 \x01(\tR\x0etitleHighlight\x12+\n" +
+	"\x11content_highlight\x18\x04 \x01(\tR\x10contentHighlight\"P\n" +
+	"\x13SearchTasksResponse\x12#\n" +
+	"\x04data\x18\x01 \x03(\v2\x0f.task.SearchHitR\x04data\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\"U\n" +
+	"\x13ListDueTasksRequest\x12!\n" +
+	"\x04view\x18\x01 \x01(\x0e2\r.task.DueViewR\x04view\x12\x1b\n" +
+	"\ttime_zone\x18\x02 \x01(\tR\btimeZone\"6\n" +
+	"\x14ListDueTasksResponse\x12\x1e\n" +
+	"\x04data\x18\x01 \x03(\v2\n" +
+	".task.TaskR\x04data\"\x91\x01\n" +
+	"\x19PreviewOccurrencesRequest\x12\x16\n" +
+	"\x06taskID\x18\x01 \x01(\x03R\x06taskID\x120\n" +
+	"\n" +
+	"recurrence\x18\x02 \x01(\v2\x10.task.RecurrenceR\n" +
+	"recurrence\x12\x14\n" +
+	"\x05start\x18\x03 \x01(\x03R\x05start\x12\x14\n" +
+	"\x05count\x18\x04 \x01(\x05R\x05count\">\n" +
+	"\x1aPreviewOccurrencesResponse\x12 \n" +
+	"\voccurrences\x18\x01 \x03(\x03R\voccurrences\"+\n" +
+	"\x11DeleteTaskRequest\x12\x16\n" +
+	"\x06taskID\x18\x01 \x01(\x03R\x06taskID\"\x14\n" +
+	"\x12DeleteTaskResponse\",\n" +
+	"\x12RestoreTaskRequest\x12\x16\n" +
+	"\x06taskID\x18\x01 \x01(\x03R\x06taskID\"\x15\n" +
+	"\x13RestoreTaskResponse\"*\n" +
+	"\x10PurgeTaskRequest\x12\x16\n" +
+	"\x06taskID\x18\x01 \x01(\x03R\x06taskID\"\x13\n" +
+	"\x11PurgeTaskResponse\"\x18\n" +
+	"\x16EmptyRecycleBinRequest\"1\n" +
+	"\x17EmptyRecycleBinResponse\x12\x16\n" +
+	"\x06purged\x18\x01 \x01(\x03R\x06purged\"<\n" +
+	"\x10CreateTagRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05color\x18\x02 \x01(\tR\x05color\"2\n" +
+	"\x11CreateTagResponse\x12\x1d\n" +
+	"\x04data\x18\x01 \x01(\v2\t.task.TagR\x04data\"o\n" +
+	"\x10UpdateTagRequest\x12\x14\n" +
+	"\x05tagID\x18\x01 \x01(\x03R\x05tagID\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12\x19\n" +
+	"\x05color\x18\x03 \x01(\tH\x01R\x05color\x88\x01\x01B\a\n" +
+	"\x05_nameB\b\n" +
+	"\x06_color\"2\n" +
+	"\x11UpdateTagResponse\x12\x1d\n" +
+	"\x04data\x18\x01 \x01(\v2\t.task.TagR\x04data\"(\n" +
+	"\x10DeleteTagRequest\x12\x14\n" +
+	"\x05tagID\x18\x01 \x01(\x03R\x05tagID\"\x13\n" +
+	"\x11DeleteTagResponse\"\x11\n" +
+	"\x0fListTagsRequest\"1\n" +
+	"\x10ListTagsResponse\x12\x1d\n" +
+	"\x04data\x18\x01 \x03(\v2\t.task.TagR\x04data\"*\n" +
+	"\x14CreateProjectRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\":\n" +
+	"\x15CreateProjectResponse\x12!\n" +
+	"\x04data\x18\x01 \x01(\v2\r.task.ProjectR\x04data\"H\n" +
+	"\x14RenameProjectRequest\x12\x1c\n" +
+	"\tprojectID\x18\x01 \x01(\x03R\tprojectID\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\":\n" +
+	"\x15RenameProjectResponse\x12!\n" +
+	"\x04data\x18\x01 \x01(\v2\r.task.ProjectR\x04data\"Q\n" +
+	"\x15ArchiveProjectRequest\x12\x1c\n" +
+	"\tprojectID\x18\x01 \x01(\x03R\tprojectID\x12\x1a\n" +
+	"\barchived\x18\x02 \x01(\bR\barchived\"\x18\n" +
+	"\x16ArchiveProjectResponse\"9\n" +
+	"\x16ReorderProjectsRequest\x12\x1f\n" +
+	"\vproject_ids\x18\x01 \x03(\x03R\n" +
+	"projectIds\"\x19\n" +
+	"\x17ReorderProjectsResponse\"a\n" +
+	"\x14DeleteProjectRequest\x12\x1c\n" +
+	"\tprojectID\x18\x01 \x01(\x03R\tprojectID\x12+\n" +
+	"\x12move_to_project_id\x18\x02 \x01(\x03R\x0fmoveToProjectId\"\x17\n" +
+	"\x15DeleteProjectResponse\"@\n" +
+	"\x13ListProjectsRequest\x12)\n" +
+	"\x10include_archived\x18\x01 \x01(\bR\x0fincludeArchived\"9\n" +
+	"\x14ListProjectsResponse\x12!\n" +
+	"\x04data\x18\x01 \x03(\v2\r.task.ProjectR\x04data\"K\n" +
+	"\x17AddChecklistItemRequest\x12\x16\n" +
+	"\x06taskID\x18\x01 \x01(\x03R\x06taskID\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\"C\n" +
+	"\x18AddChecklistItemResponse\x12'\n" +
+	"\x04data\x18\x01 \x01(\v2\x13.task.ChecklistItemR\x04data\"\x8a\x01\n" +
+	"\x1aUpdateChecklistItemRequest\x12\x16\n" +
+	"\x06itemID\x18\x01 \x01(\x03R\x06itemID\x12\x1d\n" +
+	"\acontent\x18\x02 \x01(\tH\x00R\acontent\x88\x01\x01\x12\x1d\n" +
+	"\achecked\x18\x03 \x01(\bH\x01R\achecked\x88\x01\x01B\n" +
+	"\n" +
+	"\b_contentB\n" +
+	"\n" +
+	"\b_checked\"F\n" +
+	"\x1bUpdateChecklistItemResponse\x12'\n" +
+	"\x04data\x18\x01 \x01(\v2\x13.task.ChecklistItemR\x04data\"4\n" +
+	"\x1aDeleteChecklistItemRequest\x12\x16\n" +
+	"\x06itemID\x18\x01 \x01(\x03R\x06itemID\"\x1d\n" +
+	"\x1bDeleteChecklistItemResponse\"L\n" +
+	"\x14AddDependencyRequest\x12\x16\n" +
+	"\x06taskID\x18\x01 \x01(\x03R\x06taskID\x12\x1c\n" +
+	"\tblockerID\x18\x02 \x01(\x03R\tblockerID\"\x17\n" +
+	"\x15AddDependencyResponse\"O\n" +
+	"\x17RemoveDependencyRequest\x12\x16\n" +
+	"\x06taskID\x18\x01 \x01(\x03R\x06taskID\x12\x1c\n" +
+	"\tblockerID\x18\x02 \x01(\x03R\tblockerID\"\x1a\n" +
+	"\x18RemoveDependencyResponse\"a\n" +
+	"\x0fMoveTaskRequest\x12\x16\n" +
+	"\x06taskID\x18\x01 \x01(\x03R\x06taskID\x12\x1b\n" +
+	"\tbefore_id\x18\x02 \x01(\x03R\bbeforeId\x12\x19\n" +
+	"\bafter_id\x18\x03 \x01(\x03R\aafterId\"2\n" +
+	"\x10MoveTaskResponse\x12\x1e\n" +
+	"\x04data\x18\x01 \x01(\v2\n" +
+	".task.TaskR\x04data\"\x9f\x02\n" +
+	"\vBoardColumn\x12\x1a\n" +
+	"\bcolumnID\x18\x01 \x01(\x03R\bcolumnID\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x02 \x01(\x03R\tprojectId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12(\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x10.task.TaskStatusR\x06status\x12\x1a\n" +
+	"\bposition\x18\x05 \x01(\x03R\bposition\x12\x1b\n" +
+	"\twip_limit\x18\x06 \x01(\x05R\bwipLimit\x12 \n" +
+	"\x05tasks\x18\a \x03(\v2\n" +
+	".task.TaskR\x05tasks\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\x03R\tupdatedAt\"S\n" +
+	"\x05Board\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\x03R\tprojectId\x12+\n" +
+	"\acolumns\x18\x02 \x03(\v2\x11.task.BoardColumnR\acolumns\"0\n" +
+	"\x0fGetBoardRequest\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\x03R\tprojectId\"3\n" +
+	"\x10GetBoardResponse\x12\x1f\n" +
+	"\x04data\x18\x01 \x01(\v2\v.task.BoardR\x04data\"\x8f\x01\n" +
+	"\x13CreateColumnRequest\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\x03R\tprojectId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12(\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x10.task.TaskStatusR\x06status\x12\x1b\n" +
+	"\twip_limit\x18\x04 \x01(\x05R\bwipLimit\"=\n" +
+	"\x14CreateColumnResponse\x12%\n" +
+	"\x04data\x18\x01 \x01(\v2\x11.task.BoardColumnR\x04data\"\x83\x01\n" +
+	"\x13UpdateColumnRequest\x12\x1a\n" +
+	"\bcolumnID\x18\x01 \x01(\x03R\bcolumnID\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12 \n" +
+	"\twip_limit\x18\x03 \x01(\x05H\x01R\bwipLimit\x88\x01\x01B\a\n" +
+	"\x05_nameB\f\n" +
+	"\n" +
+	"_wip_limit\"=\n" +
+	"\x14UpdateColumnResponse\x12%\n" +
+	"\x04data\x18\x01 \x01(\v2\x11.task.BoardColumnR\x04data\"U\n" +
+	"\x15ReorderColumnsRequest\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\x03R\tprojectId\x12\x1d\n" +
+	"\n" +
+	"column_ids\x18\x02 \x03(\x03R\tcolumnIds\"\x18\n" +
+	"\x16ReorderColumnsResponse\"1\n" +
+	"\x13DeleteColumnRequest\x12\x1a\n" +
+	"\bcolumnID\x18\x01 \x01(\x03R\bcolumnID\"\x16\n" +
+	"\x14DeleteColumnResponse\"~\n" +
+	"\x0fMoveCardRequest\x12\x16\n" +
+	"\x06taskID\x18\x01 \x01(\x03R\x06taskID\x12\x1b\n" +
+	"\tcolumn_id\x18\x02 \x01(\x03R\bcolumnId\x12\x1b\n" +
+	"\tbefore_id\x18\x03 \x01(\x03R\bbeforeId\x12\x19\n" +
+	"\bafter_id\x18\x04 \x01(\x03R\aafterId\"2\n" +
+	"\x10MoveCardResponse\x12\x1e\n" +
+	"\x04data\x18\x01 \x01(\v2\n" +
+	".task.TaskR\x04data\"\x83\x01\n" +
+	"\vFieldChange\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12 \n" +
+	"\told_value\x18\x02 \x01(\tH\x00R\boldValue\x88\x01\x01\x12 \n" +
+	"\tnew_value\x18\x03 \x01(\tH\x01R\bnewValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_old_valueB\f\n" +
+	"\n" +
+	"_new_value\"\xa1\x02\n" +
+	"\fTaskActivity\x12\x1e\n" +
+	"\n" +
+	"activityID\x18\x01 \x01(\x03R\n" +
+	"activityID\x12\x16\n" +
+	"\x06taskID\x18\x02 \x01(\x03R\x06taskID\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\x03R\x06userId\x12,\n" +
+	"\x06action\x18\x04 \x01(\x0e2\x14.task.ActivityActionR\x06action\x12,\n" +
+	"\x06source\x18\x05 \x01(\x0e2\x14.task.ActivitySourceR\x06source\x12\x18\n" +
+	"\aversion\x18\x06 \x01(\x03R\aversion\x12+\n" +
+	"\achanges\x18\a \x03(\v2\x11.task.FieldChangeR\achanges\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\x03R\tcreatedAt\"d\n" +
+	"\x15GetTaskHistoryRequest\x12\x16\n" +
+	"\x06taskID\x18\x01 \x01(\x03R\x06taskID\x12\x16\n" +
+	"\x06cursor\x18\x02 \x01(\tR\x06cursor\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"|\n" +
+	"\x16GetTaskHistoryResponse\x12&\n" +
+	"\x04data\x18\x01 \x03(\v2\x12.task.TaskActivityR\x04data\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\x12\x19\n" +
+	"\bhas_more\x18\x03 \x01(\bR\ahasMore\"E\n" +
+	"\x11RevertTaskRequest\x12\x16\n" +
+	"\x06taskID\x18\x01 \x01(\x03R\x06taskID\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x03R\aversion\"4\n" +
+	"\x12RevertTaskResponse\x12\x1e\n" +
+	"\x04data\x18\x01 \x01(\v2\n" +
+	".task.TaskR\x04data\"E\n" +
+	"\x11AddCommentRequest\x12\x16\n" +
+	"\x06taskID\x18\x01 \x01(\x03R\x06taskID\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\"7\n" +
+	"\x12AddCommentResponse\x12!\n" +
+	"\x04data\x18\x01 \x01(\v2\r.task.CommentR\x04data\"N\n" +
+	"\x14UpdateCommentRequest\x12\x1c\n" +
+	"\tcommentID\x18\x01 \x01(\x03R\tcommentID\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\":\n" +
+	"\x15UpdateCommentResponse\x12!\n" +
+	"\x04data\x18\x01 \x01(\v2\r.task.CommentR\x04data\"4\n" +
+	"\x14DeleteCommentRequest\x12\x1c\n" +
+	"\tcommentID\x18\x01 \x01(\x03R\tcommentID\"\x17\n" +
+	"\x15DeleteCommentResponse\"b\n" +
+	"\x13ListCommentsRequest\x12\x16\n" +
+	"\x06taskID\x18\x01 \x01(\x03R\x06taskID\x12\x16\n" +
+	"\x06cursor\x18\x02 \x01(\tR\x06cursor\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"u\n" +
+	"\x14ListCommentsResponse\x12!\n" +
+	"\x04data\x18\x01 \x03(\v2\r.task.CommentR\x04data\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\x12\x19\n" +
+	"\bhas_more\x18\x03 \x01(\bR\ahasMore\"_\n" +
+	"\x17UploadAttachmentRequest\x12\x16\n" +
+	"\x06taskID\x18\x01 \x01(\x03R\x06taskID\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\fR\acontent\"@\n" +
+	"\x18UploadAttachmentResponse\x12$\n" +
+	"\x04data\x18\x01 \x01(\v2\x10.task.AttachmentR\x04data\"0\n" +
+	"\x16ListAttachmentsRequest\x12\x16\n" +
+	"\x06taskID\x18\x01 \x01(\x03R\x06taskID\"?\n" +
+	"\x17ListAttachmentsResponse\x12$\n" +
+	"\x04data\x18\x01 \x03(\v2\x10.task.AttachmentR\x04data\"=\n" +
+	"\x17GetAttachmentURLRequest\x12\"\n" +
+	"\fattachmentID\x18\x01 \x01(\x03R\fattachmentID\",\n" +
+	"\x18GetAttachmentURLResponse\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\"=\n" +
+	"\x17DeleteAttachmentRequest\x12\"\n" +
+	"\fattachmentID\x18\x01 \x01(\x03R\fattachmentID\"\x1a\n" +
+	"\x18DeleteAttachmentResponse\"s\n" +
+	"\vBatchResult\x12\x16\n" +
+	"\x06taskID\x18\x01 \x01(\x03R\x06taskID\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12\x1e\n" +
+	"\x04data\x18\x04 \x01(\v2\n" +
+	".task.TaskR\x04data\"E\n" +
+	"\x17BatchCreateTasksRequest\x12*\n" +
+	"\x05tasks\x18\x01 \x03(\v2\x14.task.AddTaskRequestR\x05tasks\"A\n" +
+	"\x18BatchCreateTasksResponse\x12%\n" +
+	"\x04data\x18\x01 \x03(\v2\x11.task.BatchResultR\x04data\"_\n" +
+	"\x18BatchUpdateStatusRequest\x12\x19\n" +
+	"\btask_ids\x18\x01 \x03(\x03R\ataskIds\x12(\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x10.task.TaskStatusR\x06status\"B\n" +
+	"\x19BatchUpdateStatusResponse\x12%\n" +
+	"\x04data\x18\x01 \x03(\v2\x11.task.BatchResultR\x04data\"/\n" +
+	"\x12BatchDeleteRequest\x12\x19\n" +
+	"\btask_ids\x18\x01 \x03(\x03R\ataskIds\"<\n" +
+	"\x13BatchDeleteResponse\x12%\n" +
+	"\x04data\x18\x01 \x03(\v2\x11.task.BatchResultR\x04data\"L\n" +
+	"\x10BatchMoveRequest\x12\x19\n" +
+	"\btask_ids\x18\x01 \x03(\x03R\ataskIds\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x02 \x01(\x03R\tprojectId\":\n" +
+	"\x11BatchMoveResponse\x12%\n" +
+	"\x04data\x18\x01 \x03(\v2\x11.task.BatchResultR\x04data\"B\n" +
+	"\x12ExportTasksRequest\x12,\n" +
+	"\x06format\x18\x01 \x01(\x0e2\x14.task.TransferFormatR\x06format\"'\n" +
+	"\x13ExportTasksResponse\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\"u\n" +
+	"\x12ImportTasksRequest\x12,\n" +
+	"\x06format\x18\x01 \x01(\x0e2\x14.task.TransferFormatR\x06format\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\fR\acontent\x12\x17\n" +
+	"\adry_run\x18\x03 \x01(\bR\x06dryRun\"<\n" +
+	"\x0eImportRowError\x12\x10\n" +
+	"\x03row\x18\x01 \x01(\x05R\x03row\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\x93\x01\n" +
+	"\x13ImportTasksResponse\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x05R\x05total\x12\x18\n" +
+	"\acreated\x18\x02 \x01(\x05R\acreated\x12\x1e\n" +
+	"\n" +
+	"duplicates\x18\x03 \x03(\x05R\n" +
+	"duplicates\x12,\n" +
+	"\x06errors\x18\x04 \x03(\v2\x14.task.ImportRowErrorR\x06errors\"e\n" +
+	"\x13QuickAddTaskRequest\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x12\x1b\n" +
+	"\ttime_zone\x18\x02 \x01(\tR\btimeZone\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x03 \x01(\x03R\tprojectId\"x\n" +
+	"\rQuickAddToken\x12+\n" +
+	"\x04kind\x18\x01 \x01(\x0e2\x17.task.QuickAddTokenKindR\x04kind\x12\x14\n" +
+	"\x05start\x18\x02 \x01(\x05R\x05start\x12\x10\n" +
+	"\x03end\x18\x03 \x01(\x05R\x03end\x12\x12\n" +
+	"\x04text\x18\x04 \x01(\tR\x04text\"|\n" +
+	"\x14QuickAddTaskResponse\x12\x1e\n" +
+	"\x04data\x18\x01 \x01(\v2\n" +
+	".task.TaskR\x04data\x12+\n" +
+	"\x06tokens\x18\x02 \x03(\v2\x13.task.QuickAddTokenR\x06tokens\x12\x17\n" +
+	"\aall_day\x18\x03 \x01(\bR\x06allDay\"P\n" +
+	"\x10SyncTasksRequest\x12\x1f\n" +
+	"\vsince_token\x18\x01 \x01(\tR\n" +
+	"sinceToken\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\"^\n" +
+	"\n" +
+	"TaskChange\x12\x16\n" +
+	"\x06taskID\x18\x01 \x01(\x03R\x06taskID\x12\x18\n" +
+	"\adeleted\x18\x02 \x01(\bR\adeleted\x12\x1e\n" +
+	"\x04data\x18\x03 \x01(\v2\n" +
+	".task.TaskR\x04data\"y\n" +
+	"\x11SyncTasksResponse\x12*\n" +
+	"\achanges\x18\x01 \x03(\v2\x10.task.TaskChangeR\achanges\x12\x1d\n" +
+	"\n" +
+	"next_token\x18\x02 \x01(\tR\tnextToken\x12\x19\n" +
+	"\bhas_more\x18\x03 \x01(\bR\ahasMore\"\x8c\x02\n" +
+	"\x10ClientTaskChange\x12$\n" +
+	"\x02op\x18\x01 \x01(\x0e2\x14.task.ClientChangeOpR\x02op\x12\x16\n" +
+	"\x06taskID\x18\x02 \x01(\x03R\x06taskID\x12!\n" +
+	"\fbase_version\x18\x03 \x01(\x03R\vbaseVersion\x12,\n" +
+	"\x06create\x18\x04 \x01(\v2\x14.task.AddTaskRequestR\x06create\x12/\n" +
+	"\x06update\x18\x05 \x01(\v2\x17.task.UpdateTaskRequestR\x06update\x12-\n" +
+	"\x06status\x18\x06 \x01(\x0e2\x10.task.TaskStatusH\x00R\x06status\x88\x01\x01B\t\n" +
+	"\a_status\"J\n" +
+	"\x16PushTaskChangesRequest\x120\n" +
+	"\achanges\x18\x01 \x03(\v2\x16.task.ClientTaskChangeR\achanges\"@\n" +
+	"\x17PushTaskChangesResponse\x12%\n" +
+	"\x04data\x18\x01 \x03(\v2\x11.task.BatchResultR\x04data\"\\\n" +
+	"\tTaskEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12'\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x13.task.TaskEventTypeR\x04type\x12\x16\n" +
+	"\x06taskID\x18\x03 \x01(\x03R\x06taskID\"H\n" +
+	"\x15ListTaskEventsRequest\x12\x19\n" +
+	"\bafter_id\x18\x01 \x01(\x03R\aafterId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"X\n" +
+	"\x16ListTaskEventsResponse\x12#\n" +
+	"\x04data\x18\x01 \x03(\v2\x0f.task.TaskEventR\x04data\x12\x19\n" +
+	"\bhas_more\x18\x02 \x01(\bR\ahasMore\"\xdf\x01\n" +
+	"\aWebhook\x12\x1c\n" +
+	"\twebhookID\x18\x01 \x01(\x03R\twebhookID\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x124\n" +
+	"\vevent_types\x18\x03 \x03(\x0e2\x13.task.TaskEventTypeR\n" +
+	"eventTypes\x12\x16\n" +
+	"\x06secret\x18\x04 \x01(\tR\x06secret\x12\x18\n" +
+	"\aenabled\x18\x05 \x01(\bR\aenabled\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\x03R\tupdatedAt\"v\n" +
+	"\x14CreateWebhookRequest\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x124\n" +
+	"\vevent_types\x18\x02 \x03(\x0e2\x13.task.TaskEventTypeR\n" +
+	"eventTypes\x12\x16\n" +
+	"\x06secret\x18\x03 \x01(\tR\x06secret\":\n" +
+	"\x15CreateWebhookResponse\x12!\n" +
+	"\x04data\x18\x01 \x01(\v2\r.task.WebhookR\x04data\"\x84\x02\n" +
+	"\x14UpdateWebhookRequest\x12\x1c\n" +
+	"\twebhookID\x18\x01 \x01(\x03R\twebhookID\x12\x15\n" +
+	"\x03url\x18\x02 \x01(\tH\x00R\x03url\x88\x01\x01\x124\n" +
+	"\vevent_types\x18\x03 \x03(\x0e2\x13.task.TaskEventTypeR\n" +
+	"eventTypes\x12&\n" +
+	"\x0fall_event_types\x18\x04 \x01(\bR\rallEventTypes\x12\x1b\n" +
+	"\x06secret\x18\x05 \x01(\tH\x01R\x06secret\x88\x01\x01\x12\x1d\n" +
+	"\aenabled\x18\x06 \x01(\bH\x02R\aenabled\x88\x01\x01B\x06\n" +
+	"\x04_urlB\t\n" +
+	"\a_secretB\n" +
+	"\n" +
+	"\b_enabled\":\n" +
+	"\x15UpdateWebhookResponse\x12!\n" +
+	"\x04data\x18\x01 \x01(\v2\r.task.WebhookR\x04data\"4\n" +
+	"\x14DeleteWebhookRequest\x12\x1c\n" +
+	"\twebhookID\x18\x01 \x01(\x03R\twebhookID\"\x17\n" +
+	"\x15DeleteWebhookResponse\"\x15\n" +
+	"\x13ListWebhooksRequest\"9\n" +
+	"\x14ListWebhooksResponse\x12!\n" +
+	"\x04data\x18\x01 \x03(\v2\r.task.WebhookR\x04data\"\xda\x03\n" +
+	"\x0fWebhookDelivery\x12\x1e\n" +
+	"\n" +
+	"deliveryID\x18\x01 \x01(\x03R\n" +
+	"deliveryID\x12\x1c\n" +
+	"\twebhookID\x18\x02 \x01(\x03R\twebhookID\x12\x19\n" +
+	"\bevent_id\x18\x03 \x01(\tR\aeventId\x122\n" +
+	"\n" +
+	"event_type\x18\x04 \x01(\x0e2\x13.task.TaskEventTypeR\teventType\x12\x18\n" +
+	"\apayload\x18\x05 \x01(\tR\apayload\x123\n" +
+	"\x06status\x18\x06 \x01(\x0e2\x1b.task.WebhookDeliveryStatusR\x06status\x12\x1a\n" +
+	"\battempts\x18\a \x01(\x05R\battempts\x12&\n" +
+	"\x0fnext_attempt_at\x18\b \x01(\x03R\rnextAttemptAt\x12'\n" +
+	"\x0fresponse_status\x18\t \x01(\x05R\x0eresponseStatus\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\n" +
+	" \x01(\tR\tlastError\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\v \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\f \x01(\x03R\tupdatedAt\x12!\n" +
+	"\fdelivered_at\x18\r \x01(\x03R\vdeliveredAt\"\xb6\x01\n" +
+	"\x1cListWebhookDeliveriesRequest\x12\x1c\n" +
+	"\twebhookID\x18\x01 \x01(\x03R\twebhookID\x128\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x1b.task.WebhookDeliveryStatusH\x00R\x06status\x88\x01\x01\x12\x16\n" +
+	"\x06cursor\x18\x03 \x01(\tR\x06cursor\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSizeB\t\n" +
+	"\a_status\"\x86\x01\n" +
+	"\x1dListWebhookDeliveriesResponse\x12)\n" +
+	"\x04data\x18\x01 \x03(\v2\x15.task.WebhookDeliveryR\x04data\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\x12\x19\n" +
+	"\bhas_more\x18\x03 \x01(\bR\ahasMore\">\n" +
+	"\x1cReplayWebhookDeliveryRequest\x12\x1e\n" +
+	"\n" +
+	"deliveryID\x18\x01 \x01(\x03R\n" +
+	"deliveryID\"J\n" +
+	"\x1dReplayWebhookDeliveryResponse\x12)\n" +
+	"\x04data\x18\x01 \x01(\v2\x15.task.WebhookDeliveryR\x04data\"\x1b\n" +
+	"\x19CreateCalendarFeedRequest\"2\n" +
+	"\x1aCreateCalendarFeedResponse\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"\x1b\n" +
+	"\x19RotateCalendarFeedRequest\"2\n" +
+	"\x1aRotateCalendarFeedResponse\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"\x1b\n" +
+	"\x19RevokeCalendarFeedRequest\"\x1c\n" +
+	"\x1aRevokeCalendarFeedResponse\".\n" +
+	"\x16GetCalendarFeedRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"G\n" +
+	"\x17GetCalendarFeedResponse\x12\x18\n" +
+	"\acontent\x18\x01 \x01(\fR\acontent\x12\x12\n" +
+	"\x04etag\x18\x02 \x01(\tR\x04etag*\x89\x01\n" +
+	"\fTaskPriority\x12\x16\n" +
+	"\x12TASK_PRIORITY_NONE\x10\x00\x12\x15\n" +
+	"\x11TASK_PRIORITY_LOW\x10\x01\x12\x18\n" +
+	"\x14TASK_PRIORITY_MEDIUM\x10\x02\x12\x16\n" +
+	"\x12TASK_PRIORITY_HIGH\x10\x03\x12\x18\n" +
+	"\x14TASK_PRIORITY_URGENT\x10\x04*\x88\x01\n" +
+	"\n" +
+	"TaskStatus\x12\x14\n" +
+	"\x10TASK_STATUS_TODO\x10\x00\x12\x14\n" +
+	"\x10TASK_STATUS_DONE\x10\x01\x12\x1b\n" +
+	"\x17TASK_STATUS_IN_PROGRESS\x10\x02\x12\x18\n" +
+	"\x14TASK_STATUS_ARCHIVED\x10\x03\x12\x17\n" +
+	"\x13TASK_STATUS_TRASHED\x10\x04*o\n" +
+	"\tSortField\x12\x19\n" +
+	"\x15SORT_FIELD_CREATED_AT\x10\x00\x12\x19\n" +
+	"\x15SORT_FIELD_UPDATED_AT\x10\x01\x12\x17\n" +
+	"\x13SORT_FIELD_PRIORITY\x10\x02\x12\x13\n" +
+	"\x0fSORT_FIELD_RANK\x10\x03*4\n" +
+	"\tSortOrder\x12\x13\n" +
+	"\x0fSORT_ORDER_DESC\x10\x00\x12\x12\n" +
+	"\x0eSORT_ORDER_ASC\x10\x01*=\n" +
+	"\vUpdateScope\x12\x15\n" +
+	"\x11UPDATE_SCOPE_THIS\x10\x00\x12\x17\n" +
+	"\x13UPDATE_SCOPE_SERIES\x10\x01*3\n" +
+	"\aDueView\x12\x14\n" +
+	"\x10DUE_VIEW_OVERDUE\x10\x00\x12\x12\n" +
+	"\x0eDUE_VIEW_TODAY\x10\x01*J\n" +
+	"\x0eActivityAction\x12\x1b\n" +
+	"\x17ACTIVITY_ACTION_CREATED\x10\x00\x12\x1b\n" +
+	"\x17ACTIVITY_ACTION_UPDATED\x10\x01*H\n" +
+	"\x0eActivitySource\x12\x17\n" +
+	"\x13ACTIVITY_SOURCE_API\x10\x00\x12\x1d\n" +
+	"\x19ACTIVITY_SOURCE_SCHEDULER\x10\x01*]\n" +
+	"\x0eTransferFormat\x12\x18\n" +
+	"\x14TRANSFER_FORMAT_JSON\x10\x00\x12\x17\n" +
+	"\x13TRANSFER_FORMAT_CSV\x10\x01\x12\x18\n" +
+	"\x14TRANSFER_FORMAT_ICAL\x10\x02*\x97\x01\n" +
+	"\x11QuickAddTokenKind\x12\x1c\n" +
+	"\x18QUICK_ADD_TOKEN_KIND_DUE\x10\x00\x12\x1c\n" +
+	"\x18QUICK_ADD_TOKEN_KIND_TAG\x10\x01\x12!\n" +
+	"\x1dQUICK_ADD_TOKEN_KIND_PRIORITY\x10\x02\x12#\n" +
+	"\x1fQUICK_ADD_TOKEN_KIND_RECURRENCE\x10\x03*g\n" +
+	"\x0eClientChangeOp\x12\x1b\n" +
+	"\x17CLIENT_CHANGE_OP_CREATE\x10\x00\x12\x1b\n" +
+	"\x17CLIENT_CHANGE_OP_UPDATE\x10\x01\x12\x1b\n" +
+	"\x17CLIENT_CHANGE_OP_DELETE\x10\x02*v\n" +
+	"\rTaskEventType\x12\x16\n" +
+	"\x12TASK_EVENT_CREATED\x10\x00\x12\x16\n" +
+	"\x12TASK_EVENT_UPDATED\x10\x01\x12\x1d\n" +
+	"\x19TASK_EVENT_STATUS_CHANGED\x10\x02\x12\x16\n" +
+	"\x12TASK_EVENT_DELETED\x10\x03*p\n" +
+	"\x15WebhookDeliveryStatus\x12\x1c\n" +
+	"\x18WEBHOOK_DELIVERY_PENDING\x10\x00\x12\x1e\n" +
+	"\x1aWEBHOOK_DELIVERY_SUCCEEDED\x10\x01\x12\x19\n" +
+	"\x15WEBHOOK_DELIVERY_DEAD\x10\x022\xc5%\n" +
 	"\vTaskService\x126\n" +
-	"\aAddTask\x12\x14.task.AddTaskRequest\x1a\x15.task.AddTaskResponse\x12<\n" +
+	"\aAddTask\x12\x14.task.AddTaskRequest\x1a\x15.task.AddTaskResponse\x126\n" +
+	"\aGetTask\x12\x14.task.GetTaskRequest\x1a\x15.task.GetTaskResponse\x12<\n" +
 	"\tListTasks\x12\x16.task.ListTasksRequest\x1a\x17.task.ListTasksResponse\x12?\n" +
 	"\n" +
 	"UpdateTask\x12\x17.task.UpdateTaskRequest\x1a\x18.task.UpdateTaskResponse\x12Q\n" +
 	"\x10UpdateTaskStatus\x12\x1d.task.UpdateTaskStatusRequest\x1a\x1e.task.UpdateTaskStatusResponse\x12?\n" +
 	"\n" +
-	"RecycleBin\x12\x17.task.RecycleBinRequest\x1a\x18.task.RecycleBinResponseB\aZ\x05/taskb\x06proto3"
+	"RecycleBin\x12\x17.task.RecycleBinRequest\x1a\x18.task.RecycleBinResponse\x12B\n" +
+	"\vSearchTasks\x12\x18.task.SearchTasksRequest\x1a\x19.task.SearchTasksResponse\x12E\n" +
+	"\fListDueTasks\x12\x19.task.ListDueTasksRequest\x1a\x1a.task.ListDueTasksResponse\x12?\n" +
+	"\n" +
+	"DeleteTask\x12\x17.task.DeleteTaskRequest\x1a\x18.task.DeleteTaskResponse\x12B\n" +
+	"\vRestoreTask\x12\x18.task.RestoreTaskRequest\x1a\x19.task.RestoreTaskResponse\x12<\n" +
+	"\tPurgeTask\x12\x16.task.PurgeTaskRequest\x1a\x17.task.PurgeTaskResponse\x12N\n" +
+	"\x0fEmptyRecycleBin\x12\x1c.task.EmptyRecycleBinRequest\x1a\x1d.task.EmptyRecycleBinResponse\x12W\n" +
+	"\x12PreviewOccurrences\x12\x1f.task.PreviewOccurrencesRequest\x1a .task.PreviewOccurrencesResponse\x12<\n" +
+	"\tCreateTag\x12\x16.task.CreateTagRequest\x1a\x17.task.CreateTagResponse\x12<\n" +
+	"\tUpdateTag\x12\x16.task.UpdateTagRequest\x1a\x17.task.UpdateTagResponse\x12<\n" +
+	"\tDeleteTag\x12\x16.task.DeleteTagRequest\x1a\x17.task.DeleteTagResponse\x129\n" +
+	"\bListTags\x12\x15.task.ListTagsRequest\x1a\x16.task.ListTagsResponse\x12H\n" +
+	"\rCreateProject\x12\x1a.task.CreateProjectRequest\x1a\x1b.task.CreateProjectResponse\x12H\n" +
+	"\rRenameProject\x12\x1a.task.RenameProjectRequest\x1a\x1b.task.RenameProjectResponse\x12K\n" +
+	"\x0eArchiveProject\x12\x1b.task.ArchiveProjectRequest\x1a\x1c.task.ArchiveProjectResponse\x12N\n" +
+	"\x0fReorderProjects\x12\x1c.task.ReorderProjectsRequest\x1a\x1d.task.ReorderProjectsResponse\x12H\n" +
+	"\rDeleteProject\x12\x1a.task.DeleteProjectRequest\x1a\x1b.task.DeleteProjectResponse\x12E\n" +
+	"\fListProjects\x12\x19.task.ListProjectsRequest\x1a\x1a.task.ListProjectsResponse\x12Q\n" +
+	"\x10AddChecklistItem\x12\x1d.task.AddChecklistItemRequest\x1a\x1e.task.AddChecklistItemResponse\x12Z\n" +
+	"\x13UpdateChecklistItem\x12 .task.UpdateChecklistItemRequest\x1a!.task.UpdateChecklistItemResponse\x12Z\n" +
+	"\x13DeleteChecklistItem\x12 .task.DeleteChecklistItemRequest\x1a!.task.DeleteChecklistItemResponse\x12H\n" +
+	"\rAddDependency\x12\x1a.task.AddDependencyRequest\x1a\x1b.task.AddDependencyResponse\x12Q\n" +
+	"\x10RemoveDependency\x12\x1d.task.RemoveDependencyRequest\x1a\x1e.task.RemoveDependencyResponse\x129\n" +
+	"\bMoveTask\x12\x15.task.MoveTaskRequest\x1a\x16.task.MoveTaskResponse\x129\n" +
+	"\bGetBoard\x12\x15.task.GetBoardRequest\x1a\x16.task.GetBoardResponse\x12E\n" +
+	"\fCreateColumn\x12\x19.task.CreateColumnRequest\x1a\x1a.task.CreateColumnResponse\x12E\n" +
+	"\fUpdateColumn\x12\x19.task.UpdateColumnRequest\x1a\x1a.task.UpdateColumnResponse\x12K\n" +
+	"\x0eReorderColumns\x12\x1b.task.ReorderColumnsRequest\x1a\x1c.task.ReorderColumnsResponse\x12E\n" +
+	"\fDeleteColumn\x12\x19.task.DeleteColumnRequest\x1a\x1a.task.DeleteColumnResponse\x129\n" +
+	"\bMoveCard\x12\x15.task.MoveCardRequest\x1a\x16.task.MoveCardResponse\x12K\n" +
+	"\x0eGetTaskHistory\x12\x1b.task.GetTaskHistoryRequest\x1a\x1c.task.GetTaskHistoryResponse\x12?\n" +
+	"\n" +
+	"RevertTask\x12\x17.task.RevertTaskRequest\x1a\x18.task.RevertTaskResponse\x12?\n" +
+	"\n" +
+	"AddComment\x12\x17.task.AddCommentRequest\x1a\x18.task.AddCommentResponse\x12H\n" +
+	"\rUpdateComment\x12\x1a.task.UpdateCommentRequest\x1a\x1b.task.UpdateCommentResponse\x12H\n" +
+	"\rDeleteComment\x12\x1a.task.DeleteCommentRequest\x1a\x1b.task.DeleteCommentResponse\x12E\n" +
+	"\fListComments\x12\x19.task.ListCommentsRequest\x1a\x1a.task.ListCommentsResponse\x12Q\n" +
+	"\x10UploadAttachment\x12\x1d.task.UploadAttachmentRequest\x1a\x1e.task.UploadAttachmentResponse\x12N\n" +
+	"\x0fListAttachments\x12\x1c.task.ListAttachmentsRequest\x1a\x1d.task.ListAttachmentsResponse\x12Q\n" +
+	"\x10GetAttachmentURL\x12\x1d.task.GetAttachmentURLRequest\x1a\x1e.task.GetAttachmentURLResponse\x12Q\n" +
+	"\x10DeleteAttachment\x12\x1d.task.DeleteAttachmentRequest\x1a\x1e.task.DeleteAttachmentResponse\x12Q\n" +
+	"\x10BatchCreateTasks\x12\x1d.task.BatchCreateTasksRequest\x1a\x1e.task.BatchCreateTasksResponse\x12T\n" +
+	"\x11BatchUpdateStatus\x12\x1e.task.BatchUpdateStatusRequest\x1a\x1f.task.BatchUpdateStatusResponse\x12B\n" +
+	"\vBatchDelete\x12\x18.task.BatchDeleteRequest\x1a\x19.task.BatchDeleteResponse\x12<\n" +
+	"\tBatchMove\x12\x16.task.BatchMoveRequest\x1a\x17.task.BatchMoveResponse\x12B\n" +
+	"\vExportTasks\x12\x18.task.ExportTasksRequest\x1a\x19.task.ExportTasksResponse\x12B\n" +
+	"\vImportTasks\x12\x18.task.ImportTasksRequest\x1a\x19.task.ImportTasksResponse\x12E\n" +
+	"\fQuickAddTask\x12\x19.task.QuickAddTaskRequest\x1a\x1a.task.QuickAddTaskResponse\x12<\n" +
+	"\tSyncTasks\x12\x16.task.SyncTasksRequest\x1a\x17.task.SyncTasksResponse\x12N\n" +
+	"\x0fPushTaskChanges\x12\x1c.task.PushTaskChangesRequest\x1a\x1d.task.PushTaskChangesResponse\x12K\n" +
+	"\x0eListTaskEvents\x12\x1b.task.ListTaskEventsRequest\x1a\x1c.task.ListTaskEventsResponse\x12H\n" +
+	"\rCreateWebhook\x12\x1a.task.CreateWebhookRequest\x1a\x1b.task.CreateWebhookResponse\x12H\n" +
+	"\rUpdateWebhook\x12\x1a.task.UpdateWebhookRequest\x1a\x1b.task.UpdateWebhookResponse\x12H\n" +
+	"\rDeleteWebhook\x12\x1a.task.DeleteWebhookRequest\x1a\x1b.task.DeleteWebhookResponse\x12E\n" +
+	"\fListWebhooks\x12\x19.task.ListWebhooksRequest\x1a\x1a.task.ListWebhooksResponse\x12`\n" +
+	"\x15ListWebhookDeliveries\x12\".task.ListWebhookDeliveriesRequest\x1a#.task.ListWebhookDeliveriesResponse\x12`\n" +
+	"\x15ReplayWebhookDelivery\x12\".task.ReplayWebhookDeliveryRequest\x1a#.task.ReplayWebhookDeliveryResponse\x12W\n" +
+	"\x12CreateCalendarFeed\x12\x1f.task.CreateCalendarFeedRequest\x1a .task.CreateCalendarFeedResponse\x12W\n" +
+	"\x12RotateCalendarFeed\x12\x1f.task.RotateCalendarFeedRequest\x1a .task.RotateCalendarFeedResponse\x12W\n" +
+	"\x12RevokeCalendarFeed\x12\x1f.task.RevokeCalendarFeedRequest\x1a .task.RevokeCalendarFeedResponse\x12N\n" +
+	"\x0fGetCalendarFeed\x12\x1c.task.GetCalendarFeedRequest\x1a\x1d.task.GetCalendarFeedResponseB\aZ\x05/taskb\x06proto3"
 
 var (
 	file_idl_task_proto_rawDescOnce sync.Once
@@ -606,39 +9787,398 @@ func file_idl_task_proto_rawDescGZIP() []byte {
 	return file_idl_task_proto_rawDescData
 }
 
-var file_idl_task_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_idl_task_proto_enumTypes = make([]protoimpl.EnumInfo, 13)
+var file_idl_task_proto_msgTypes = make([]protoimpl.MessageInfo, 151)
 var file_idl_task_proto_goTypes = []any{
-	(*Task)(nil),                     // 0: task.Task
-	(*AddTaskRequest)(nil),           // 1: task.AddTaskRequest
-	(*AddTaskResponse)(nil),          // 2: task.AddTaskResponse
-	(*ListTasksRequest)(nil),         // 3: task.ListTasksRequest
-	(*ListTasksResponse)(nil),        // 4: task.ListTasksResponse
-	(*UpdateTaskRequest)(nil),        // 5: task.UpdateTaskRequest
-	(*UpdateTaskResponse)(nil),       // 6: task.UpdateTaskResponse
-	(*UpdateTaskStatusRequest)(nil),  // 7: task.UpdateTaskStatusRequest
-	(*UpdateTaskStatusResponse)(nil), // 8: task.UpdateTaskStatusResponse
-	(*RecycleBinRequest)(nil),        // 9: task.RecycleBinRequest
-	(*RecycleBinResponse)(nil),       // 10: task.RecycleBinResponse
+	(TaskPriority)(0),                     // 0: task.TaskPriority
+	(TaskStatus)(0),                       // 1: task.TaskStatus
+	(SortField)(0),                        // 2: task.SortField
+	(SortOrder)(0),                        // 3: task.SortOrder
+	(UpdateScope)(0),                      // 4: task.UpdateScope
+	(DueView)(0),                          // 5: task.DueView
+	(ActivityAction)(0),                   // 6: task.ActivityAction
+	(ActivitySource)(0),                   // 7: task.ActivitySource
+	(TransferFormat)(0),                   // 8: task.TransferFormat
+	(QuickAddTokenKind)(0),                // 9: task.QuickAddTokenKind
+	(ClientChangeOp)(0),                   // 10: task.ClientChangeOp
+	(TaskEventType)(0),                    // 11: task.TaskEventType
+	(WebhookDeliveryStatus)(0),            // 12: task.WebhookDeliveryStatus
+	(*Recurrence)(nil),                    // 13: task.Recurrence
+	(*Tag)(nil),                           // 14: task.Tag
+	(*Project)(nil),                       // 15: task.Project
+	(*ChecklistItem)(nil),                 // 16: task.ChecklistItem
+	(*Comment)(nil),                       // 17: task.Comment
+	(*Attachment)(nil),                    // 18: task.Attachment
+	(*Task)(nil),                          // 19: task.Task
+	(*AddTaskRequest)(nil),                // 20: task.AddTaskRequest
+	(*AddTaskResponse)(nil),               // 21: task.AddTaskResponse
+	(*ListOption)(nil),                    // 22: task.ListOption
+	(*GetTaskRequest)(nil),                // 23: task.GetTaskRequest
+	(*GetTaskResponse)(nil),               // 24: task.GetTaskResponse
+	(*ListTasksRequest)(nil),              // 25: task.ListTasksRequest
+	(*ListTasksResponse)(nil),             // 26: task.ListTasksResponse
+	(*UpdateTaskRequest)(nil),             // 27: task.UpdateTaskRequest
+	(*UpdateTaskResponse)(nil),            // 28: task.UpdateTaskResponse
+	(*UpdateTaskStatusRequest)(nil),       // 29: task.UpdateTaskStatusRequest
+	(*UpdateTaskStatusResponse)(nil),      // 30: task.UpdateTaskStatusResponse
+	(*RecycleBinRequest)(nil),             // 31: task.RecycleBinRequest
+	(*RecycleBinResponse)(nil),            // 32: task.RecycleBinResponse
+	(*SearchTasksRequest)(nil),            // 33: task.SearchTasksRequest
+	(*SearchHit)(nil),                     // 34: task.SearchHit
+	(*SearchTasksResponse)(nil),           // 35: task.SearchTasksResponse
+	(*ListDueTasksRequest)(nil),           // 36: task.ListDueTasksRequest
+	(*ListDueTasksResponse)(nil),          // 37: task.ListDueTasksResponse
+	(*PreviewOccurrencesRequest)(nil),     // 38: task.PreviewOccurrencesRequest
+	(*PreviewOccurrencesResponse)(nil),    // 39: task.PreviewOccurrencesResponse
+	(*DeleteTaskRequest)(nil),             // 40: task.DeleteTaskRequest
+	(*DeleteTaskResponse)(nil),            // 41: task.DeleteTaskResponse
+	(*RestoreTaskRequest)(nil),            // 42: task.RestoreTaskRequest
+	(*RestoreTaskResponse)(nil),           // 43: task.RestoreTaskResponse
+	(*PurgeTaskRequest)(nil),              // 44: task.PurgeTaskRequest
+	(*PurgeTaskResponse)(nil),             // 45: task.PurgeTaskResponse
+	(*EmptyRecycleBinRequest)(nil),        // 46: task.EmptyRecycleBinRequest
+	(*EmptyRecycleBinResponse)(nil),       // 47: task.EmptyRecycleBinResponse
+	(*CreateTagRequest)(nil),              // 48: task.CreateTagRequest
+	(*CreateTagResponse)(nil),             // 49: task.CreateTagResponse
+	(*UpdateTagRequest)(nil),              // 50: task.UpdateTagRequest
+	(*UpdateTagResponse)(nil),             // 51: task.UpdateTagResponse
+	(*DeleteTagRequest)(nil),              // 52: task.DeleteTagRequest
+	(*DeleteTagResponse)(nil),             // 53: task.DeleteTagResponse
+	(*ListTagsRequest)(nil),               // 54: task.ListTagsRequest
+	(*ListTagsResponse)(nil),              // 55: task.ListTagsResponse
+	(*CreateProjectRequest)(nil),          // 56: task.CreateProjectRequest
+	(*CreateProjectResponse)(nil),         // 57: task.CreateProjectResponse
+	(*RenameProjectRequest)(nil),          // 58: task.RenameProjectRequest
+	(*RenameProjectResponse)(nil),         // 59: task.RenameProjectResponse
+	(*ArchiveProjectRequest)(nil),         // 60: task.ArchiveProjectRequest
+	(*ArchiveProjectResponse)(nil),        // 61: task.ArchiveProjectResponse
+	(*ReorderProjectsRequest)(nil),        // 62: task.ReorderProjectsRequest
+	(*ReorderProjectsResponse)(nil),       // 63: task.ReorderProjectsResponse
+	(*DeleteProjectRequest)(nil),          // 64: task.DeleteProjectRequest
+	(*DeleteProjectResponse)(nil),         // 65: task.DeleteProjectResponse
+	(*ListProjectsRequest)(nil),           // 66: task.ListProjectsRequest
+	(*ListProjectsResponse)(nil),          // 67: task.ListProjectsResponse
+	(*AddChecklistItemRequest)(nil),       // 68: task.AddChecklistItemRequest
+	(*AddChecklistItemResponse)(nil),      // 69: task.AddChecklistItemResponse
+	(*UpdateChecklistItemRequest)(nil),    // 70: task.UpdateChecklistItemRequest
+	(*UpdateChecklistItemResponse)(nil),   // 71: task.UpdateChecklistItemResponse
+	(*DeleteChecklistItemRequest)(nil),    // 72: task.DeleteChecklistItemRequest
+	(*DeleteChecklistItemResponse)(nil),   // 73: task.DeleteChecklistItemResponse
+	(*AddDependencyRequest)(nil),          // 74: task.AddDependencyRequest
+	(*AddDependencyResponse)(nil),         // 75: task.AddDependencyResponse
+	(*RemoveDependencyRequest)(nil),       // 76: task.RemoveDependencyRequest
+	(*RemoveDependencyResponse)(nil),      // 77: task.RemoveDependencyResponse
+	(*MoveTaskRequest)(nil),               // 78: task.MoveTaskRequest
+	(*MoveTaskResponse)(nil),              // 79: task.MoveTaskResponse
+	(*BoardColumn)(nil),                   // 80: task.BoardColumn
+	(*Board)(nil),                         // 81: task.Board
+	(*GetBoardRequest)(nil),               // 82: task.GetBoardRequest
+	(*GetBoardResponse)(nil),              // 83: task.GetBoardResponse
+	(*CreateColumnRequest)(nil),           // 84: task.CreateColumnRequest
+	(*CreateColumnResponse)(nil),          // 85: task.CreateColumnResponse
+	(*UpdateColumnRequest)(nil),           // 86: task.UpdateColumnRequest
+	(*UpdateColumnResponse)(nil),          // 87: task.UpdateColumnResponse
+	(*ReorderColumnsRequest)(nil),         // 88: task.ReorderColumnsRequest
+	(*ReorderColumnsResponse)(nil),        // 89: task.ReorderColumnsResponse
+	(*DeleteColumnRequest)(nil),           // 90: task.DeleteColumnRequest
+	(*DeleteColumnResponse)(nil),          // 91: task.DeleteColumnResponse
+	(*MoveCardRequest)(nil),               // 92: task.MoveCardRequest
+	(*MoveCardResponse)(nil),              // 93: task.MoveCardResponse
+	(*FieldChange)(nil),                   // 94: task.FieldChange
+	(*TaskActivity)(nil),                  // 95: task.TaskActivity
+	(*GetTaskHistoryRequest)(nil),         // 96: task.GetTaskHistoryRequest
+	(*GetTaskHistoryResponse)(nil),        // 97: task.GetTaskHistoryResponse
+	(*RevertTaskRequest)(nil),             // 98: task.RevertTaskRequest
+	(*RevertTaskResponse)(nil),            // 99: task.RevertTaskResponse
+	(*AddCommentRequest)(nil),             // 100: task.AddCommentRequest
+	(*AddCommentResponse)(nil),            // 101: task.AddCommentResponse
+	(*UpdateCommentRequest)(nil),          // 102: task.UpdateCommentRequest
+	(*UpdateCommentResponse)(nil),         // 103: task.UpdateCommentResponse
+	(*DeleteCommentRequest)(nil),          // 104: task.DeleteCommentRequest
+	(*DeleteCommentResponse)(nil),         // 105: task.DeleteCommentResponse
+	(*ListCommentsRequest)(nil),           // 106: task.ListCommentsRequest
+	(*ListCommentsResponse)(nil),          // 107: task.ListCommentsResponse
+	(*UploadAttachmentRequest)(nil),       // 108: task.UploadAttachmentRequest
+	(*UploadAttachmentResponse)(nil),      // 109: task.UploadAttachmentResponse
+	(*ListAttachmentsRequest)(nil),        // 110: task.ListAttachmentsRequest
+	(*ListAttachmentsResponse)(nil),       // 111: task.ListAttachmentsResponse
+	(*GetAttachmentURLRequest)(nil),       // 112: task.GetAttachmentURLRequest
+	(*GetAttachmentURLResponse)(nil),      // 113: task.GetAttachmentURLResponse
+	(*DeleteAttachmentRequest)(nil),       // 114: task.DeleteAttachmentRequest
+	(*DeleteAttachmentResponse)(nil),      // 115: task.DeleteAttachmentResponse
+	(*BatchResult)(nil),                   // 116: task.BatchResult
+	(*BatchCreateTasksRequest)(nil),       // 117: task.BatchCreateTasksRequest
+	(*BatchCreateTasksResponse)(nil),      // 118: task.BatchCreateTasksResponse
+	(*BatchUpdateStatusRequest)(nil),      // 119: task.BatchUpdateStatusRequest
+	(*BatchUpdateStatusResponse)(nil),     // 120: task.BatchUpdateStatusResponse
+	(*BatchDeleteRequest)(nil),            // 121: task.BatchDeleteRequest
+	(*BatchDeleteResponse)(nil),           // 122: task.BatchDeleteResponse
+	(*BatchMoveRequest)(nil),              // 123: task.BatchMoveRequest
+	(*BatchMoveResponse)(nil),             // 124: task.BatchMoveResponse
+	(*ExportTasksRequest)(nil),            // 125: task.ExportTasksRequest
+	(*ExportTasksResponse)(nil),           // 126: task.ExportTasksResponse
+	(*ImportTasksRequest)(nil),            // 127: task.ImportTasksRequest
+	(*ImportRowError)(nil),                // 128: task.ImportRowError
+	(*ImportTasksResponse)(nil),           // 129: task.ImportTasksResponse
+	(*QuickAddTaskRequest)(nil),           // 130: task.QuickAddTaskRequest
+	(*QuickAddToken)(nil),                 // 131: task.QuickAddToken
+	(*QuickAddTaskResponse)(nil),          // 132: task.QuickAddTaskResponse
+	(*SyncTasksRequest)(nil),              // 133: task.SyncTasksRequest
+	(*TaskChange)(nil),                    // 134: task.TaskChange
+	(*SyncTasksResponse)(nil),             // 135: task.SyncTasksResponse
+	(*ClientTaskChange)(nil),              // 136: task.ClientTaskChange
+	(*PushTaskChangesRequest)(nil),        // 137: task.PushTaskChangesRequest
+	(*PushTaskChangesResponse)(nil),       // 138: task.PushTaskChangesResponse
+	(*TaskEvent)(nil),                     // 139: task.TaskEvent
+	(*ListTaskEventsRequest)(nil),         // 140: task.ListTaskEventsRequest
+	(*ListTaskEventsResponse)(nil),        // 141: task.ListTaskEventsResponse
+	(*Webhook)(nil),                       // 142: task.Webhook
+	(*CreateWebhookRequest)(nil),          // 143: task.CreateWebhookRequest
+	(*CreateWebhookResponse)(nil),         // 144: task.CreateWebhookResponse
+	(*UpdateWebhookRequest)(nil),          // 145: task.UpdateWebhookRequest
+	(*UpdateWebhookResponse)(nil),         // 146: task.UpdateWebhookResponse
+	(*DeleteWebhookRequest)(nil),          // 147: task.DeleteWebhookRequest
+	(*DeleteWebhookResponse)(nil),         // 148: task.DeleteWebhookResponse
+	(*ListWebhooksRequest)(nil),           // 149: task.ListWebhooksRequest
+	(*ListWebhooksResponse)(nil),          // 150: task.ListWebhooksResponse
+	(*WebhookDelivery)(nil),               // 151: task.WebhookDelivery
+	(*ListWebhookDeliveriesRequest)(nil),  // 152: task.ListWebhookDeliveriesRequest
+	(*ListWebhookDeliveriesResponse)(nil), // 153: task.ListWebhookDeliveriesResponse
+	(*ReplayWebhookDeliveryRequest)(nil),  // 154: task.ReplayWebhookDeliveryRequest
+	(*ReplayWebhookDeliveryResponse)(nil), // 155: task.ReplayWebhookDeliveryResponse
+	(*CreateCalendarFeedRequest)(nil),     // 156: task.CreateCalendarFeedRequest
+	(*CreateCalendarFeedResponse)(nil),    // 157: task.CreateCalendarFeedResponse
+	(*RotateCalendarFeedRequest)(nil),     // 158: task.RotateCalendarFeedRequest
+	(*RotateCalendarFeedResponse)(nil),    // 159: task.RotateCalendarFeedResponse
+	(*RevokeCalendarFeedRequest)(nil),     // 160: task.RevokeCalendarFeedRequest
+	(*RevokeCalendarFeedResponse)(nil),    // 161: task.RevokeCalendarFeedResponse
+	(*GetCalendarFeedRequest)(nil),        // 162: task.GetCalendarFeedRequest
+	(*GetCalendarFeedResponse)(nil),       // 163: task.GetCalendarFeedResponse
 }
 var file_idl_task_proto_depIdxs = []int32{
-	0,  // 0: task.AddTaskResponse.data:type_name -> task.Task
-	0,  // 1: task.ListTasksResponse.data:type_name -> task.Task
-	0,  // 2: task.RecycleBinResponse.data:type_name -> task.Task
-	1,  // 3: task.TaskService.AddTask:input_type -> task.AddTaskRequest
-	3,  // 4: task.TaskService.ListTasks:input_type -> task.ListTasksRequest
-	5,  // 5: task.TaskService.UpdateTask:input_type -> task.UpdateTaskRequest
-	7,  // 6: task.TaskService.UpdateTaskStatus:input_type -> task.UpdateTaskStatusRequest
-	9,  // 7: task.TaskService.RecycleBin:input_type -> task.RecycleBinRequest
-	2,  // 8: task.TaskService.AddTask:output_type -> task.AddTaskResponse
-	4,  // 9: task.TaskService.ListTasks:output_type -> task.ListTasksResponse
-	6,  // 10: task.TaskService.UpdateTask:output_type -> task.UpdateTaskResponse
-	8,  // 11: task.TaskService.UpdateTaskStatus:output_type -> task.UpdateTaskStatusResponse
-	10, // 12: task.TaskService.RecycleBin:output_type -> task.RecycleBinResponse
-	8,  // [8:13] is the sub-list for method output_type
-	3,  // [3:8] is the sub-list for method input_type
-	3,  // [3:3] is the sub-list for extension type_name
-	3,  // [3:3] is the sub-list for extension extendee
-	0,  // [0:3] is the sub-list for field type_name
+	13,  // 0: task.Task.recurrence:type_name -> task.Recurrence
+	1,   // 1: task.Task.status:type_name -> task.TaskStatus
+	14,  // 2: task.Task.tags:type_name -> task.Tag
+	16,  // 3: task.Task.checklist:type_name -> task.ChecklistItem
+	19,  // 4: task.Task.children:type_name -> task.Task
+	0,   // 5: task.Task.priority:type_name -> task.TaskPriority
+	13,  // 6: task.AddTaskRequest.recurrence:type_name -> task.Recurrence
+	0,   // 7: task.AddTaskRequest.priority:type_name -> task.TaskPriority
+	19,  // 8: task.AddTaskResponse.data:type_name -> task.Task
+	2,   // 9: task.ListOption.sort_field:type_name -> task.SortField
+	3,   // 10: task.ListOption.sort_order:type_name -> task.SortOrder
+	19,  // 11: task.GetTaskResponse.data:type_name -> task.Task
+	22,  // 12: task.ListTasksRequest.option:type_name -> task.ListOption
+	1,   // 13: task.ListTasksRequest.statuses:type_name -> task.TaskStatus
+	19,  // 14: task.ListTasksResponse.data:type_name -> task.Task
+	13,  // 15: task.UpdateTaskRequest.recurrence:type_name -> task.Recurrence
+	4,   // 16: task.UpdateTaskRequest.scope:type_name -> task.UpdateScope
+	0,   // 17: task.UpdateTaskRequest.priority:type_name -> task.TaskPriority
+	1,   // 18: task.UpdateTaskStatusRequest.status:type_name -> task.TaskStatus
+	22,  // 19: task.RecycleBinRequest.option:type_name -> task.ListOption
+	19,  // 20: task.RecycleBinResponse.data:type_name -> task.Task
+	1,   // 21: task.SearchTasksRequest.statuses:type_name -> task.TaskStatus
+	19,  // 22: task.SearchHit.task:type_name -> task.Task
+	34,  // 23: task.SearchTasksResponse.data:type_name -> task.SearchHit
+	5,   // 24: task.ListDueTasksRequest.view:type_name -> task.DueView
+	19,  // 25: task.ListDueTasksResponse.data:type_name -> task.Task
+	13,  // 26: task.PreviewOccurrencesRequest.recurrence:type_name -> task.Recurrence
+	14,  // 27: task.CreateTagResponse.data:type_name -> task.Tag
+	14,  // 28: task.UpdateTagResponse.data:type_name -> task.Tag
+	14,  // 29: task.ListTagsResponse.data:type_name -> task.Tag
+	15,  // 30: task.CreateProjectResponse.data:type_name -> task.Project
+	15,  // 31: task.RenameProjectResponse.data:type_name -> task.Project
+	15,  // 32: task.ListProjectsResponse.data:type_name -> task.Project
+	16,  // 33: task.AddChecklistItemResponse.data:type_name -> task.ChecklistItem
+	16,  // 34: task.UpdateChecklistItemResponse.data:type_name -> task.ChecklistItem
+	19,  // 35: task.MoveTaskResponse.data:type_name -> task.Task
+	1,   // 36: task.BoardColumn.status:type_name -> task.TaskStatus
+	19,  // 37: task.BoardColumn.tasks:type_name -> task.Task
+	80,  // 38: task.Board.columns:type_name -> task.BoardColumn
+	81,  // 39: task.GetBoardResponse.data:type_name -> task.Board
+	1,   // 40: task.CreateColumnRequest.status:type_name -> task.TaskStatus
+	80,  // 41: task.CreateColumnResponse.data:type_name -> task.BoardColumn
+	80,  // 42: task.UpdateColumnResponse.data:type_name -> task.BoardColumn
+	19,  // 43: task.MoveCardResponse.data:type_name -> task.Task
+	6,   // 44: task.TaskActivity.action:type_name -> task.ActivityAction
+	7,   // 45: task.TaskActivity.source:type_name -> task.ActivitySource
+	94,  // 46: task.TaskActivity.changes:type_name -> task.FieldChange
+	95,  // 47: task.GetTaskHistoryResponse.data:type_name -> task.TaskActivity
+	19,  // 48: task.RevertTaskResponse.data:type_name -> task.Task
+	17,  // 49: task.AddCommentResponse.data:type_name -> task.Comment
+	17,  // 50: task.UpdateCommentResponse.data:type_name -> task.Comment
+	17,  // 51: task.ListCommentsResponse.data:type_name -> task.Comment
+	18,  // 52: task.UploadAttachmentResponse.data:type_name -> task.Attachment
+	18,  // 53: task.ListAttachmentsResponse.data:type_name -> task.Attachment
+	19,  // 54: task.BatchResult.data:type_name -> task.Task
+	20,  // 55: task.BatchCreateTasksRequest.tasks:type_name -> task.AddTaskRequest
+	116, // 56: task.BatchCreateTasksResponse.data:type_name -> task.BatchResult
+	1,   // 57: task.BatchUpdateStatusRequest.status:type_name -> task.TaskStatus
+	116, // 58: task.BatchUpdateStatusResponse.data:type_name -> task.BatchResult
+	116, // 59: task.BatchDeleteResponse.data:type_name -> task.BatchResult
+	116, // 60: task.BatchMoveResponse.data:type_name -> task.BatchResult
+	8,   // 61: task.ExportTasksRequest.format:type_name -> task.TransferFormat
+	8,   // 62: task.ImportTasksRequest.format:type_name -> task.TransferFormat
+	128, // 63: task.ImportTasksResponse.errors:type_name -> task.ImportRowError
+	9,   // 64: task.QuickAddToken.kind:type_name -> task.QuickAddTokenKind
+	19,  // 65: task.QuickAddTaskResponse.data:type_name -> task.Task
+	131, // 66: task.QuickAddTaskResponse.tokens:type_name -> task.QuickAddToken
+	19,  // 67: task.TaskChange.data:type_name -> task.Task
+	134, // 68: task.SyncTasksResponse.changes:type_name -> task.TaskChange
+	10,  // 69: task.ClientTaskChange.op:type_name -> task.ClientChangeOp
+	20,  // 70: task.ClientTaskChange.create:type_name -> task.AddTaskRequest
+	27,  // 71: task.ClientTaskChange.update:type_name -> task.UpdateTaskRequest
+	1,   // 72: task.ClientTaskChange.status:type_name -> task.TaskStatus
+	136, // 73: task.PushTaskChangesRequest.changes:type_name -> task.ClientTaskChange
+	116, // 74: task.PushTaskChangesResponse.data:type_name -> task.BatchResult
+	11,  // 75: task.TaskEvent.type:type_name -> task.TaskEventType
+	139, // 76: task.ListTaskEventsResponse.data:type_name -> task.TaskEvent
+	11,  // 77: task.Webhook.event_types:type_name -> task.TaskEventType
+	11,  // 78: task.CreateWebhookRequest.event_types:type_name -> task.TaskEventType
+	142, // 79: task.CreateWebhookResponse.data:type_name -> task.Webhook
+	11,  // 80: task.UpdateWebhookRequest.event_types:type_name -> task.TaskEventType
+	142, // 81: task.UpdateWebhookResponse.data:type_name -> task.Webhook
+	142, // 82: task.ListWebhooksResponse.data:type_name -> task.Webhook
+	11,  // 83: task.WebhookDelivery.event_type:type_name -> task.TaskEventType
+	12,  // 84: task.WebhookDelivery.status:type_name -> task.WebhookDeliveryStatus
+	12,  // 85: task.ListWebhookDeliveriesRequest.status:type_name -> task.WebhookDeliveryStatus
+	151, // 86: task.ListWebhookDeliveriesResponse.data:type_name -> task.WebhookDelivery
+	151, // 87: task.ReplayWebhookDeliveryResponse.data:type_name -> task.WebhookDelivery
+	20,  // 88: task.TaskService.AddTask:input_type -> task.AddTaskRequest
+	23,  // 89: task.TaskService.GetTask:input_type -> task.GetTaskRequest
+	25,  // 90: task.TaskService.ListTasks:input_type -> task.ListTasksRequest
+	27,  // 91: task.TaskService.UpdateTask:input_type -> task.UpdateTaskRequest
+	29,  // 92: task.TaskService.UpdateTaskStatus:input_type -> task.UpdateTaskStatusRequest
+	31,  // 93: task.TaskService.RecycleBin:input_type -> task.RecycleBinRequest
+	33,  // 94: task.TaskService.SearchTasks:input_type -> task.SearchTasksRequest
+	36,  // 95: task.TaskService.ListDueTasks:input_type -> task.ListDueTasksRequest
+	40,  // 96: task.TaskService.DeleteTask:input_type -> task.DeleteTaskRequest
+	42,  // 97: task.TaskService.RestoreTask:input_type -> task.RestoreTaskRequest
+	44,  // 98: task.TaskService.PurgeTask:input_type -> task.PurgeTaskRequest
+	46,  // 99: task.TaskService.EmptyRecycleBin:input_type -> task.EmptyRecycleBinRequest
+	38,  // 100: task.TaskService.PreviewOccurrences:input_type -> task.PreviewOccurrencesRequest
+	48,  // 101: task.TaskService.CreateTag:input_type -> task.CreateTagRequest
+	50,  // 102: task.TaskService.UpdateTag:input_type -> task.UpdateTagRequest
+	52,  // 103: task.TaskService.DeleteTag:input_type -> task.DeleteTagRequest
+	54,  // 104: task.TaskService.ListTags:input_type -> task.ListTagsRequest
+	56,  // 105: task.TaskService.CreateProject:input_type -> task.CreateProjectRequest
+	58,  // 106: task.TaskService.RenameProject:input_type -> task.RenameProjectRequest
+	60,  // 107: task.TaskService.ArchiveProject:input_type -> task.ArchiveProjectRequest
+	62,  // 108: task.TaskService.ReorderProjects:input_type -> task.ReorderProjectsRequest
+	64,  // 109: task.TaskService.DeleteProject:input_type -> task.DeleteProjectRequest
+	66,  // 110: task.TaskService.ListProjects:input_type -> task.ListProjectsRequest
+	68,  // 111: task.TaskService.AddChecklistItem:input_type -> task.AddChecklistItemRequest
+	70,  // 112: task.TaskService.UpdateChecklistItem:input_type -> task.UpdateChecklistItemRequest
+	72,  // 113: task.TaskService.DeleteChecklistItem:input_type -> task.DeleteChecklistItemRequest
+	74,  // 114: task.TaskService.AddDependency:input_type -> task.AddDependencyRequest
+	76,  // 115: task.TaskService.RemoveDependency:input_type -> task.RemoveDependencyRequest
+	78,  // 116: task.TaskService.MoveTask:input_type -> task.MoveTaskRequest
+	82,  // 117: task.TaskService.GetBoard:input_type -> task.GetBoardRequest
+	84,  // 118: task.TaskService.CreateColumn:input_type -> task.CreateColumnRequest
+	86,  // 119: task.TaskService.UpdateColumn:input_type -> task.UpdateColumnRequest
+	88,  // 120: task.TaskService.ReorderColumns:input_type -> task.ReorderColumnsRequest
+	90,  // 121: task.TaskService.DeleteColumn:input_type -> task.DeleteColumnRequest
+	92,  // 122: task.TaskService.MoveCard:input_type -> task.MoveCardRequest
+	96,  // 123: task.TaskService.GetTaskHistory:input_type -> task.GetTaskHistoryRequest
+	98,  // 124: task.TaskService.RevertTask:input_type -> task.RevertTaskRequest
+	100, // 125: task.TaskService.AddComment:input_type -> task.AddCommentRequest
+	102, // 126: task.TaskService.UpdateComment:input_type -> task.UpdateCommentRequest
+	104, // 127: task.TaskService.DeleteComment:input_type -> task.DeleteCommentRequest
+	106, // 128: task.TaskService.ListComments:input_type -> task.ListCommentsRequest
+	108, // 129: task.TaskService.UploadAttachment:input_type -> task.UploadAttachmentRequest
+	110, // 130: task.TaskService.ListAttachments:input_type -> task.ListAttachmentsRequest
+	112, // 131: task.TaskService.GetAttachmentURL:input_type -> task.GetAttachmentURLRequest
+	114, // 132: task.TaskService.DeleteAttachment:input_type -> task.DeleteAttachmentRequest
+	117, // 133: task.TaskService.BatchCreateTasks:input_type -> task.BatchCreateTasksRequest
+	119, // 134: task.TaskService.BatchUpdateStatus:input_type -> task.BatchUpdateStatusRequest
+	121, // 135: task.TaskService.BatchDelete:input_type -> task.BatchDeleteRequest
+	123, // 136: task.TaskService.BatchMove:input_type -> task.BatchMoveRequest
+	125, // 137: task.TaskService.ExportTasks:input_type -> task.ExportTasksRequest
+	127, // 138: task.TaskService.ImportTasks:input_type -> task.ImportTasksRequest
+	130, // 139: task.TaskService.QuickAddTask:input_type -> task.QuickAddTaskRequest
+	133, // 140: task.TaskService.SyncTasks:input_type -> task.SyncTasksRequest
+	137, // 141: task.TaskService.PushTaskChanges:input_type -> task.PushTaskChangesRequest
+	140, // 142: task.TaskService.ListTaskEvents:input_type -> task.ListTaskEventsRequest
+	143, // 143: task.TaskService.CreateWebhook:input_type -> task.CreateWebhookRequest
+	145, // 144: task.TaskService.UpdateWebhook:input_type -> task.UpdateWebhookRequest
+	147, // 145: task.TaskService.DeleteWebhook:input_type -> task.DeleteWebhookRequest
+	149, // 146: task.TaskService.ListWebhooks:input_type -> task.ListWebhooksRequest
+	152, // 147: task.TaskService.ListWebhookDeliveries:input_type -> task.ListWebhookDeliveriesRequest
+	154, // 148: task.TaskService.ReplayWebhookDelivery:input_type -> task.ReplayWebhookDeliveryRequest
+	156, // 149: task.TaskService.CreateCalendarFeed:input_type -> task.CreateCalendarFeedRequest
+	158, // 150: task.TaskService.RotateCalendarFeed:input_type -> task.RotateCalendarFeedRequest
+	160, // 151: task.TaskService.RevokeCalendarFeed:input_type -> task.RevokeCalendarFeedRequest
+	162, // 152: task.TaskService.GetCalendarFeed:input_type -> task.GetCalendarFeedRequest
+	21,  // 153: task.TaskService.AddTask:output_type -> task.AddTaskResponse
+	24,  // 154: task.TaskService.GetTask:output_type -> task.GetTaskResponse
+	26,  // 155: task.TaskService.ListTasks:output_type -> task.ListTasksResponse
+	28,  // 156: task.TaskService.UpdateTask:output_type -> task.UpdateTaskResponse
+	30,  // 157: task.TaskService.UpdateTaskStatus:output_type -> task.UpdateTaskStatusResponse
+	32,  // 158: task.TaskService.RecycleBin:output_type -> task.RecycleBinResponse
+	35,  // 159: task.TaskService.SearchTasks:output_type -> task.SearchTasksResponse
+	37,  // 160: task.TaskService.ListDueTasks:output_type -> task.ListDueTasksResponse
+	41,  // 161: task.TaskService.DeleteTask:output_type -> task.DeleteTaskResponse
+	43,  // 162: task.TaskService.RestoreTask:output_type -> task.RestoreTaskResponse
+	45,  // 163: task.TaskService.PurgeTask:output_type -> task.PurgeTaskResponse
+	47,  // 164: task.TaskService.EmptyRecycleBin:output_type -> task.EmptyRecycleBinResponse
+	39,  // 165: task.TaskService.PreviewOccurrences:output_type -> task.PreviewOccurrencesResponse
+	49,  // 166: task.TaskService.CreateTag:output_type -> task.CreateTagResponse
+	51,  // 167: task.TaskService.UpdateTag:output_type -> task.UpdateTagResponse
+	53,  // 168: task.TaskService.DeleteTag:output_type -> task.DeleteTagResponse
+	55,  // 169: task.TaskService.ListTags:output_type -> task.ListTagsResponse
+	57,  // 170: task.TaskService.CreateProject:output_type -> task.CreateProjectResponse
+	59,  // 171: task.TaskService.RenameProject:output_type -> task.RenameProjectResponse
+	61,  // 172: task.TaskService.ArchiveProject:output_type -> task.ArchiveProjectResponse
+	63,  // 173: task.TaskService.ReorderProjects:output_type -> task.ReorderProjectsResponse
+	65,  // 174: task.TaskService.DeleteProject:output_type -> task.DeleteProjectResponse
+	67,  // 175: task.TaskService.ListProjects:output_type -> task.ListProjectsResponse
+	69,  // 176: task.TaskService.AddChecklistItem:output_type -> task.AddChecklistItemResponse
+	71,  // 177: task.TaskService.UpdateChecklistItem:output_type -> task.UpdateChecklistItemResponse
+	73,  // 178: task.TaskService.DeleteChecklistItem:output_type -> task.DeleteChecklistItemResponse
+	75,  // 179: task.TaskService.AddDependency:output_type -> task.AddDependencyResponse
+	77,  // 180: task.TaskService.RemoveDependency:output_type -> task.RemoveDependencyResponse
+	79,  // 181: task.TaskService.MoveTask:output_type -> task.MoveTaskResponse
+	83,  // 182: task.TaskService.GetBoard:output_type -> task.GetBoardResponse
+	85,  // 183: task.TaskService.CreateColumn:output_type -> task.CreateColumnResponse
+	87,  // 184: task.TaskService.UpdateColumn:output_type -> task.UpdateColumnResponse
+	89,  // 185: task.TaskService.ReorderColumns:output_type -> task.ReorderColumnsResponse
+	91,  // 186: task.TaskService.DeleteColumn:output_type -> task.DeleteColumnResponse
+	93,  // 187: task.TaskService.MoveCard:output_type -> task.MoveCardResponse
+	97,  // 188: task.TaskService.GetTaskHistory:output_type -> task.GetTaskHistoryResponse
+	99,  // 189: task.TaskService.RevertTask:output_type -> task.RevertTaskResponse
+	101, // 190: task.TaskService.AddComment:output_type -> task.AddCommentResponse
+	103, // 191: task.TaskService.UpdateComment:output_type -> task.UpdateCommentResponse
+	105, // 192: task.TaskService.DeleteComment:output_type -> task.DeleteCommentResponse
+	107, // 193: task.TaskService.ListComments:output_type -> task.ListCommentsResponse
+	109, // 194: task.TaskService.UploadAttachment:output_type -> task.UploadAttachmentResponse
+	111, // 195: task.TaskService.ListAttachments:output_type -> task.ListAttachmentsResponse
+	113, // 196: task.TaskService.GetAttachmentURL:output_type -> task.GetAttachmentURLResponse
+	115, // 197: task.TaskService.DeleteAttachment:output_type -> task.DeleteAttachmentResponse
+	118, // 198: task.TaskService.BatchCreateTasks:output_type -> task.BatchCreateTasksResponse
+	120, // 199: task.TaskService.BatchUpdateStatus:output_type -> task.BatchUpdateStatusResponse
+	122, // 200: task.TaskService.BatchDelete:output_type -> task.BatchDeleteResponse
+	124, // 201: task.TaskService.BatchMove:output_type -> task.BatchMoveResponse
+	126, // 202: task.TaskService.ExportTasks:output_type -> task.ExportTasksResponse
+	129, // 203: task.TaskService.ImportTasks:output_type -> task.ImportTasksResponse
+	132, // 204: task.TaskService.QuickAddTask:output_type -> task.QuickAddTaskResponse
+	135, // 205: task.TaskService.SyncTasks:output_type -> task.SyncTasksResponse
+	138, // 206: task.TaskService.PushTaskChanges:output_type -> task.PushTaskChangesResponse
+	141, // 207: task.TaskService.ListTaskEvents:output_type -> task.ListTaskEventsResponse
+	144, // 208: task.TaskService.CreateWebhook:output_type -> task.CreateWebhookResponse
+	146, // 209: task.TaskService.UpdateWebhook:output_type -> task.UpdateWebhookResponse
+	148, // 210: task.TaskService.DeleteWebhook:output_type -> task.DeleteWebhookResponse
+	150, // 211: task.TaskService.ListWebhooks:output_type -> task.ListWebhooksResponse
+	153, // 212: task.TaskService.ListWebhookDeliveries:output_type -> task.ListWebhookDeliveriesResponse
+	155, // 213: task.TaskService.ReplayWebhookDelivery:output_type -> task.ReplayWebhookDeliveryResponse
+	157, // 214: task.TaskService.CreateCalendarFeed:output_type -> task.CreateCalendarFeedResponse
+	159, // 215: task.TaskService.RotateCalendarFeed:output_type -> task.RotateCalendarFeedResponse
+	161, // 216: task.TaskService.RevokeCalendarFeed:output_type -> task.RevokeCalendarFeedResponse
+	163, // 217: task.TaskService.GetCalendarFeed:output_type -> task.GetCalendarFeedResponse
+	153, // [153:218] is the sub-list for method output_type
+	88,  // [88:153] is the sub-list for method input_type
+	88,  // [88:88] is the sub-list for extension type_name
+	88,  // [88:88] is the sub-list for extension extendee
+	0,   // [0:88] is the sub-list for field type_name
 }
 
 func init() { file_idl_task_proto_init() }
@@ -646,19 +10186,29 @@ func file_idl_task_proto_init() {
 	if File_idl_task_proto != nil {
 		return
 	}
-	file_idl_task_proto_msgTypes[5].OneofWrappers = []any{}
+	file_idl_task_proto_msgTypes[6].OneofWrappers = []any{}
+	file_idl_task_proto_msgTypes[7].OneofWrappers = []any{}
+	file_idl_task_proto_msgTypes[14].OneofWrappers = []any{}
+	file_idl_task_proto_msgTypes[37].OneofWrappers = []any{}
+	file_idl_task_proto_msgTypes[57].OneofWrappers = []any{}
+	file_idl_task_proto_msgTypes[73].OneofWrappers = []any{}
+	file_idl_task_proto_msgTypes[81].OneofWrappers = []any{}
+	file_idl_task_proto_msgTypes[123].OneofWrappers = []any{}
+	file_idl_task_proto_msgTypes[132].OneofWrappers = []any{}
+	file_idl_task_proto_msgTypes[139].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_idl_task_proto_rawDesc), len(file_idl_task_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   11,
+			NumEnums:      13,
+			NumMessages:   151,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_idl_task_proto_goTypes,
 		DependencyIndexes: file_idl_task_proto_depIdxs,
+		EnumInfos:         file_idl_task_proto_enumTypes,
 		MessageInfos:      file_idl_task_proto_msgTypes,
 	}.Build()
 	File_idl_task_proto = out.File
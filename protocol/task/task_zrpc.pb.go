@@ -12,21 +12,141 @@ import (
 )
 
 const (
-	TaskService_AddTask_FullMethodName          = "task.TaskService/AddTask"
-	TaskService_ListTasks_FullMethodName        = "task.TaskService/ListTasks"
-	TaskService_UpdateTask_FullMethodName       = "task.TaskService/UpdateTask"
-	TaskService_UpdateTaskStatus_FullMethodName = "task.TaskService/UpdateTaskStatus"
-	TaskService_RecycleBin_FullMethodName       = "task.TaskService/RecycleBin"
+	TaskService_AddTask_FullMethodName               = "task.TaskService/AddTask"
+	TaskService_GetTask_FullMethodName               = "task.TaskService/GetTask"
+	TaskService_ListTasks_FullMethodName             = "task.TaskService/ListTasks"
+	TaskService_UpdateTask_FullMethodName            = "task.TaskService/UpdateTask"
+	TaskService_UpdateTaskStatus_FullMethodName      = "task.TaskService/UpdateTaskStatus"
+	TaskService_RecycleBin_FullMethodName            = "task.TaskService/RecycleBin"
+	TaskService_SearchTasks_FullMethodName           = "task.TaskService/SearchTasks"
+	TaskService_ListDueTasks_FullMethodName          = "task.TaskService/ListDueTasks"
+	TaskService_DeleteTask_FullMethodName            = "task.TaskService/DeleteTask"
+	TaskService_RestoreTask_FullMethodName           = "task.TaskService/RestoreTask"
+	TaskService_PurgeTask_FullMethodName             = "task.TaskService/PurgeTask"
+	TaskService_EmptyRecycleBin_FullMethodName       = "task.TaskService/EmptyRecycleBin"
+	TaskService_PreviewOccurrences_FullMethodName    = "task.TaskService/PreviewOccurrences"
+	TaskService_CreateTag_FullMethodName             = "task.TaskService/CreateTag"
+	TaskService_UpdateTag_FullMethodName             = "task.TaskService/UpdateTag"
+	TaskService_DeleteTag_FullMethodName             = "task.TaskService/DeleteTag"
+	TaskService_ListTags_FullMethodName              = "task.TaskService/ListTags"
+	TaskService_CreateProject_FullMethodName         = "task.TaskService/CreateProject"
+	TaskService_RenameProject_FullMethodName         = "task.TaskService/RenameProject"
+	TaskService_ArchiveProject_FullMethodName        = "task.TaskService/ArchiveProject"
+	TaskService_ReorderProjects_FullMethodName       = "task.TaskService/ReorderProjects"
+	TaskService_DeleteProject_FullMethodName         = "task.TaskService/DeleteProject"
+	TaskService_ListProjects_FullMethodName          = "task.TaskService/ListProjects"
+	TaskService_AddChecklistItem_FullMethodName      = "task.TaskService/AddChecklistItem"
+	TaskService_UpdateChecklistItem_FullMethodName   = "task.TaskService/UpdateChecklistItem"
+	TaskService_DeleteChecklistItem_FullMethodName   = "task.TaskService/DeleteChecklistItem"
+	TaskService_AddDependency_FullMethodName         = "task.TaskService/AddDependency"
+	TaskService_RemoveDependency_FullMethodName      = "task.TaskService/RemoveDependency"
+	TaskService_MoveTask_FullMethodName              = "task.TaskService/MoveTask"
+	TaskService_GetBoard_FullMethodName              = "task.TaskService/GetBoard"
+	TaskService_CreateColumn_FullMethodName          = "task.TaskService/CreateColumn"
+	TaskService_UpdateColumn_FullMethodName          = "task.TaskService/UpdateColumn"
+	TaskService_ReorderColumns_FullMethodName        = "task.TaskService/ReorderColumns"
+	TaskService_DeleteColumn_FullMethodName          = "task.TaskService/DeleteColumn"
+	TaskService_MoveCard_FullMethodName              = "task.TaskService/MoveCard"
+	TaskService_GetTaskHistory_FullMethodName        = "task.TaskService/GetTaskHistory"
+	TaskService_RevertTask_FullMethodName            = "task.TaskService/RevertTask"
+	TaskService_AddComment_FullMethodName            = "task.TaskService/AddComment"
+	TaskService_UpdateComment_FullMethodName         = "task.TaskService/UpdateComment"
+	TaskService_DeleteComment_FullMethodName         = "task.TaskService/DeleteComment"
+	TaskService_ListComments_FullMethodName          = "task.TaskService/ListComments"
+	TaskService_UploadAttachment_FullMethodName      = "task.TaskService/UploadAttachment"
+	TaskService_ListAttachments_FullMethodName       = "task.TaskService/ListAttachments"
+	TaskService_GetAttachmentURL_FullMethodName      = "task.TaskService/GetAttachmentURL"
+	TaskService_DeleteAttachment_FullMethodName      = "task.TaskService/DeleteAttachment"
+	TaskService_BatchCreateTasks_FullMethodName      = "task.TaskService/BatchCreateTasks"
+	TaskService_BatchUpdateStatus_FullMethodName     = "task.TaskService/BatchUpdateStatus"
+	TaskService_BatchDelete_FullMethodName           = "task.TaskService/BatchDelete"
+	TaskService_BatchMove_FullMethodName             = "task.TaskService/BatchMove"
+	TaskService_ExportTasks_FullMethodName           = "task.TaskService/ExportTasks"
+	TaskService_ImportTasks_FullMethodName           = "task.TaskService/ImportTasks"
+	TaskService_QuickAddTask_FullMethodName          = "task.TaskService/QuickAddTask"
+	TaskService_SyncTasks_FullMethodName             = "task.TaskService/SyncTasks"
+	TaskService_PushTaskChanges_FullMethodName       = "task.TaskService/PushTaskChanges"
+	TaskService_ListTaskEvents_FullMethodName        = "task.TaskService/ListTaskEvents"
+	TaskService_CreateWebhook_FullMethodName         = "task.TaskService/CreateWebhook"
+	TaskService_UpdateWebhook_FullMethodName         = "task.TaskService/UpdateWebhook"
+	TaskService_DeleteWebhook_FullMethodName         = "task.TaskService/DeleteWebhook"
+	TaskService_ListWebhooks_FullMethodName          = "task.TaskService/ListWebhooks"
+	TaskService_ListWebhookDeliveries_FullMethodName = "task.TaskService/ListWebhookDeliveries"
+	TaskService_ReplayWebhookDelivery_FullMethodName = "task.TaskService/ReplayWebhookDelivery"
+	TaskService_CreateCalendarFeed_FullMethodName    = "task.TaskService/CreateCalendarFeed"
+	TaskService_RotateCalendarFeed_FullMethodName    = "task.TaskService/RotateCalendarFeed"
+	TaskService_RevokeCalendarFeed_FullMethodName    = "task.TaskService/RevokeCalendarFeed"
+	TaskService_GetCalendarFeed_FullMethodName       = "task.TaskService/GetCalendarFeed"
 )
 
 // TaskServiceClient is the API for TaskService service.
 
 type TaskServiceClient interface {
 	AddTask(ctx context.Context, in *AddTaskRequest) (*AddTaskResponse, error)
+	GetTask(ctx context.Context, in *GetTaskRequest) (*GetTaskResponse, error)
 	ListTasks(ctx context.Context, in *ListTasksRequest) (*ListTasksResponse, error)
 	UpdateTask(ctx context.Context, in *UpdateTaskRequest) (*UpdateTaskResponse, error)
 	UpdateTaskStatus(ctx context.Context, in *UpdateTaskStatusRequest) (*UpdateTaskStatusResponse, error)
 	RecycleBin(ctx context.Context, in *RecycleBinRequest) (*RecycleBinResponse, error)
+	SearchTasks(ctx context.Context, in *SearchTasksRequest) (*SearchTasksResponse, error)
+	ListDueTasks(ctx context.Context, in *ListDueTasksRequest) (*ListDueTasksResponse, error)
+	DeleteTask(ctx context.Context, in *DeleteTaskRequest) (*DeleteTaskResponse, error)
+	RestoreTask(ctx context.Context, in *RestoreTaskRequest) (*RestoreTaskResponse, error)
+	PurgeTask(ctx context.Context, in *PurgeTaskRequest) (*PurgeTaskResponse, error)
+	EmptyRecycleBin(ctx context.Context, in *EmptyRecycleBinRequest) (*EmptyRecycleBinResponse, error)
+	PreviewOccurrences(ctx context.Context, in *PreviewOccurrencesRequest) (*PreviewOccurrencesResponse, error)
+	CreateTag(ctx context.Context, in *CreateTagRequest) (*CreateTagResponse, error)
+	UpdateTag(ctx context.Context, in *UpdateTagRequest) (*UpdateTagResponse, error)
+	DeleteTag(ctx context.Context, in *DeleteTagRequest) (*DeleteTagResponse, error)
+	ListTags(ctx context.Context, in *ListTagsRequest) (*ListTagsResponse, error)
+	CreateProject(ctx context.Context, in *CreateProjectRequest) (*CreateProjectResponse, error)
+	RenameProject(ctx context.Context, in *RenameProjectRequest) (*RenameProjectResponse, error)
+	ArchiveProject(ctx context.Context, in *ArchiveProjectRequest) (*ArchiveProjectResponse, error)
+	ReorderProjects(ctx context.Context, in *ReorderProjectsRequest) (*ReorderProjectsResponse, error)
+	DeleteProject(ctx context.Context, in *DeleteProjectRequest) (*DeleteProjectResponse, error)
+	ListProjects(ctx context.Context, in *ListProjectsRequest) (*ListProjectsResponse, error)
+	AddChecklistItem(ctx context.Context, in *AddChecklistItemRequest) (*AddChecklistItemResponse, error)
+	UpdateChecklistItem(ctx context.Context, in *UpdateChecklistItemRequest) (*UpdateChecklistItemResponse, error)
+	DeleteChecklistItem(ctx context.Context, in *DeleteChecklistItemRequest) (*DeleteChecklistItemResponse, error)
+	AddDependency(ctx context.Context, in *AddDependencyRequest) (*AddDependencyResponse, error)
+	RemoveDependency(ctx context.Context, in *RemoveDependencyRequest) (*RemoveDependencyResponse, error)
+	MoveTask(ctx context.Context, in *MoveTaskRequest) (*MoveTaskResponse, error)
+	GetBoard(ctx context.Context, in *GetBoardRequest) (*GetBoardResponse, error)
+	CreateColumn(ctx context.Context, in *CreateColumnRequest) (*CreateColumnResponse, error)
+	UpdateColumn(ctx context.Context, in *UpdateColumnRequest) (*UpdateColumnResponse, error)
+	ReorderColumns(ctx context.Context, in *ReorderColumnsRequest) (*ReorderColumnsResponse, error)
+	DeleteColumn(ctx context.Context, in *DeleteColumnRequest) (*DeleteColumnResponse, error)
+	MoveCard(ctx context.Context, in *MoveCardRequest) (*MoveCardResponse, error)
+	GetTaskHistory(ctx context.Context, in *GetTaskHistoryRequest) (*GetTaskHistoryResponse, error)
+	RevertTask(ctx context.Context, in *RevertTaskRequest) (*RevertTaskResponse, error)
+	AddComment(ctx context.Context, in *AddCommentRequest) (*AddCommentResponse, error)
+	UpdateComment(ctx context.Context, in *UpdateCommentRequest) (*UpdateCommentResponse, error)
+	DeleteComment(ctx context.Context, in *DeleteCommentRequest) (*DeleteCommentResponse, error)
+	ListComments(ctx context.Context, in *ListCommentsRequest) (*ListCommentsResponse, error)
+	UploadAttachment(ctx context.Context, in *UploadAttachmentRequest) (*UploadAttachmentResponse, error)
+	ListAttachments(ctx context.Context, in *ListAttachmentsRequest) (*ListAttachmentsResponse, error)
+	GetAttachmentURL(ctx context.Context, in *GetAttachmentURLRequest) (*GetAttachmentURLResponse, error)
+	DeleteAttachment(ctx context.Context, in *DeleteAttachmentRequest) (*DeleteAttachmentResponse, error)
+	BatchCreateTasks(ctx context.Context, in *BatchCreateTasksRequest) (*BatchCreateTasksResponse, error)
+	BatchUpdateStatus(ctx context.Context, in *BatchUpdateStatusRequest) (*BatchUpdateStatusResponse, error)
+	BatchDelete(ctx context.Context, in *BatchDeleteRequest) (*BatchDeleteResponse, error)
+	BatchMove(ctx context.Context, in *BatchMoveRequest) (*BatchMoveResponse, error)
+	ExportTasks(ctx context.Context, in *ExportTasksRequest) (*ExportTasksResponse, error)
+	ImportTasks(ctx context.Context, in *ImportTasksRequest) (*ImportTasksResponse, error)
+	QuickAddTask(ctx context.Context, in *QuickAddTaskRequest) (*QuickAddTaskResponse, error)
+	SyncTasks(ctx context.Context, in *SyncTasksRequest) (*SyncTasksResponse, error)
+	PushTaskChanges(ctx context.Context, in *PushTaskChangesRequest) (*PushTaskChangesResponse, error)
+	ListTaskEvents(ctx context.Context, in *ListTaskEventsRequest) (*ListTaskEventsResponse, error)
+	CreateWebhook(ctx context.Context, in *CreateWebhookRequest) (*CreateWebhookResponse, error)
+	UpdateWebhook(ctx context.Context, in *UpdateWebhookRequest) (*UpdateWebhookResponse, error)
+	DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest) (*DeleteWebhookResponse, error)
+	ListWebhooks(ctx context.Context, in *ListWebhooksRequest) (*ListWebhooksResponse, error)
+	ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest) (*ListWebhookDeliveriesResponse, error)
+	ReplayWebhookDelivery(ctx context.Context, in *ReplayWebhookDeliveryRequest) (*ReplayWebhookDeliveryResponse, error)
+	CreateCalendarFeed(ctx context.Context, in *CreateCalendarFeedRequest) (*CreateCalendarFeedResponse, error)
+	RotateCalendarFeed(ctx context.Context, in *RotateCalendarFeedRequest) (*RotateCalendarFeedResponse, error)
+	RevokeCalendarFeed(ctx context.Context, in *RevokeCalendarFeedRequest) (*RevokeCalendarFeedResponse, error)
+	GetCalendarFeed(ctx context.Context, in *GetCalendarFeedRequest) (*GetCalendarFeedResponse, error)
 }
 
 type taskServiceClient struct {
@@ -46,6 +166,15 @@ func (c *taskServiceClient) AddTask(ctx context.Context, in *AddTaskRequest) (*A
 	return out, nil
 }
 
+func (c *taskServiceClient) GetTask(ctx context.Context, in *GetTaskRequest) (*GetTaskResponse, error) {
+	out := new(GetTaskResponse)
+	err := c.cli.Invoke(ctx, TaskService_GetTask_FullMethodName, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *taskServiceClient) ListTasks(ctx context.Context, in *ListTasksRequest) (*ListTasksResponse, error) {
 	out := new(ListTasksResponse)
 	err := c.cli.Invoke(ctx, TaskService_ListTasks_FullMethodName, in, out)
@@ -61,168 +190,2019 @@ func (c *taskServiceClient) UpdateTask(ctx context.Context, in *UpdateTaskReques
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	return out, nil
+}
+
+func (c *taskServiceClient) UpdateTaskStatus(ctx context.Context, in *UpdateTaskStatusRequest) (*UpdateTaskStatusResponse, error) {
+	out := new(UpdateTaskStatusResponse)
+	err := c.cli.Invoke(ctx, TaskService_UpdateTaskStatus_FullMethodName, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) RecycleBin(ctx context.Context, in *RecycleBinRequest) (*RecycleBinResponse, error) {
+	out := new(RecycleBinResponse)
+	err := c.cli.Invoke(ctx, TaskService_RecycleBin_FullMethodName, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) SearchTasks(ctx context.Context, in *SearchTasksRequest) (*SearchTasksResponse, error) {
+	out := new(SearchTasksResponse)
+	err := c.cli.Invoke(ctx, TaskService_SearchTasks_FullMethodName, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) ListDueTasks(ctx context.Context, in *ListDueTasksRequest) (*ListDueTasksResponse, error) {
+	out := new(ListDueTasksResponse)
+	err := c.cli.Invoke(ctx, TaskService_ListDueTasks_FullMethodName, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) DeleteTask(ctx context.Context, in *DeleteTaskRequest) (*DeleteTaskResponse, error) {
+	out := new(DeleteTaskResponse)
+	err := c.cli.Invoke(ctx, TaskService_DeleteTask_FullMethodName, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) RestoreTask(ctx context.Context, in *RestoreTaskRequest) (*RestoreTaskResponse, error) {
+	out := new(RestoreTaskResponse)
+	err := c.cli.Invoke(ctx, TaskService_RestoreTask_FullMethodName, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) PurgeTask(ctx context.Context, in *PurgeTaskRequest) (*PurgeTaskResponse, error) {
+	out := new(PurgeTaskResponse)
+	err := c.cli.Invoke(ctx, TaskService_PurgeTask_FullMethodName, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) EmptyRecycleBin(ctx context.Context, in *EmptyRecycleBinRequest) (*EmptyRecycleBinResponse, error) {
+	out := new(EmptyRecycleBinResponse)
+	err := c.cli.Invoke(ctx, TaskService_EmptyRecycleBin_FullMethodName, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) PreviewOccurrences(ctx context.Context, in *PreviewOccurrencesRequest) (*PreviewOccurrencesResponse, error) {
+	out := new(PreviewOccurrencesResponse)
+	err := c.cli.Invoke(ctx, TaskService_PreviewOccurrences_FullMethodName, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) CreateTag(ctx context.Context, in *CreateTagRequest) (*CreateTagResponse, error) {
+	out := new(CreateTagResponse)
+	err := c.cli.Invoke(ctx, TaskService_CreateTag_FullMethodName, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) UpdateTag(ctx context.Context, in *UpdateTagRequest) (*UpdateTagResponse, error) {
+	out := new(UpdateTagResponse)
+	err := c.cli.Invoke(ctx, TaskService_UpdateTag_FullMethodName, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) DeleteTag(ctx context.Context, in *DeleteTagRequest) (*DeleteTagResponse, error) {
+	out := new(DeleteTagResponse)
+	err := c.cli.Invoke(ctx, TaskService_DeleteTag_FullMethodName, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) ListTags(ctx context.Context, in *ListTagsRequest) (*ListTagsResponse, error) {
+	out := new(ListTagsResponse)
+	err := c.cli.Invoke(ctx, TaskService_ListTags_FullMethodName, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) CreateProject(ctx context.Context, in *CreateProjectRequest) (*CreateProjectResponse, error) {
+	out := new(CreateProjectResponse)
+	err := c.cli.Invoke(ctx, TaskService_CreateProject_FullMethodName, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) RenameProject(ctx context.Context, in *RenameProjectRequest) (*RenameProjectResponse, error) {
+	out := new(RenameProjectResponse)
+	err := c.cli.Invoke(ctx, TaskService_RenameProject_FullMethodName, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) ArchiveProject(ctx context.Context, in *ArchiveProjectRequest) (*ArchiveProjectResponse, error) {
+	out := new(ArchiveProjectResponse)
+	err := c.cli.Invoke(ctx, TaskService_ArchiveProject_FullMethodName, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) ReorderProjects(ctx context.Context, in *ReorderProjectsRequest) (*ReorderProjectsResponse, error) {
+	out := new(ReorderProjectsResponse)
+	err := c.cli.Invoke(ctx, TaskService_ReorderProjects_FullMethodName, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) DeleteProject(ctx context.Context, in *DeleteProjectRequest) (*DeleteProjectResponse, error) {
+	out := new(DeleteProjectResponse)
+	err := c.cli.Invoke(ctx, TaskService_DeleteProject_FullMethodName, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) ListProjects(ctx context.Context, in *ListProjectsRequest) (*ListProjectsResponse, error) {
+	out := new(ListProjectsResponse)
+	err := c.cli.Invoke(ctx, TaskService_ListProjects_FullMethodName, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) AddChecklistItem(ctx context.Context, in *AddChecklistItemRequest) (*AddChecklistItemResponse, error) {
+	out := new(AddChecklistItemResponse)
+	err := c.cli.Invoke(ctx, TaskService_AddChecklistItem_FullMethodName, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) UpdateChecklistItem(ctx context.Context, in *UpdateChecklistItemRequest) (*UpdateChecklistItemResponse, error) {
+	out := new(UpdateChecklistItemResponse)
+	err := c.cli.Invoke(ctx, TaskService_UpdateChecklistItem_FullMethodName, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) DeleteChecklistItem(ctx context.Context, in *DeleteChecklistItemRequest) (*DeleteChecklistItemResponse, error) {
+	out := new(DeleteChecklistItemResponse)
+	err := c.cli.Invoke(ctx, TaskService_DeleteChecklistItem_FullMethodName, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) AddDependency(ctx context.Context, in *AddDependencyRequest) (*AddDependencyResponse, error) {
+	out := new(AddDependencyResponse)
+	err := c.cli.Invoke(ctx, TaskService_AddDependency_FullMethodName, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) RemoveDependency(ctx context.Context, in *RemoveDependencyRequest) (*RemoveDependencyResponse, error) {
+	out := new(RemoveDependencyResponse)
+	err := c.cli.Invoke(ctx, TaskService_RemoveDependency_FullMethodName, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) MoveTask(ctx context.Context, in *MoveTaskRequest) (*MoveTaskResponse, error) {
+	out := new(MoveTaskResponse)
+	err := c.cli.Invoke(ctx, TaskService_MoveTask_FullMethodName, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) GetBoard(ctx context.Context, in *GetBoardRequest) (*GetBoardResponse, error) {
+	out := new(GetBoardResponse)
+	err := c.cli.Invoke(ctx, TaskService_GetBoard_FullMethodName, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) CreateColumn(ctx context.Context, in *CreateColumnRequest) (*CreateColumnResponse, error) {
+	out := new(CreateColumnResponse)
+	err := c.cli.Invoke(ctx, TaskService_CreateColumn_FullMethodName, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) UpdateColumn(ctx context.Context, in *UpdateColumnRequest) (*UpdateColumnResponse, error) {
+	out := new(UpdateColumnResponse)
+	err := c.cli.Invoke(ctx, TaskService_UpdateColumn_FullMethodName, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) ReorderColumns(ctx context.Context, in *ReorderColumnsRequest) (*ReorderColumnsResponse, error) {
+	out := new(ReorderColumnsResponse)
+	err := c.cli.Invoke(ctx, TaskService_ReorderColumns_FullMethodName, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) DeleteColumn(ctx context.Context, in *DeleteColumnRequest) (*DeleteColumnResponse, error) {
+	out := new(DeleteColumnResponse)
+	err := c.cli.Invoke(ctx, TaskService_DeleteColumn_FullMethodName, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) MoveCard(ctx context.Context, in *MoveCardRequest) (*MoveCardResponse, error) {
+	out := new(MoveCardResponse)
+	err := c.cli.Invoke(ctx, TaskService_MoveCard_FullMethodName, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) GetTaskHistory(ctx context.Context, in *GetTaskHistoryRequest) (*GetTaskHistoryResponse, error) {
+	out := new(GetTaskHistoryResponse)
+	err := c.cli.Invoke(ctx, TaskService_GetTaskHistory_FullMethodName, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) RevertTask(ctx context.Context, in *RevertTaskRequest) (*RevertTaskResponse, error) {
+	out := new(RevertTaskResponse)
+	err := c.cli.Invoke(ctx, TaskService_RevertTask_FullMethodName, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) AddComment(ctx context.Context, in *AddCommentRequest) (*AddCommentResponse, error) {
+	out := new(AddCommentResponse)
+	err := c.cli.Invoke(ctx, TaskService_AddComment_FullMethodName, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) UpdateComment(ctx context.Context, in *UpdateCommentRequest) (*UpdateCommentResponse, error) {
+	out := new(UpdateCommentResponse)
+	err := c.cli.Invoke(ctx, TaskService_UpdateComment_FullMethodName, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) DeleteComment(ctx context.Context, in *DeleteCommentRequest) (*DeleteCommentResponse, error) {
+	out := new(DeleteCommentResponse)
+	err := c.cli.Invoke(ctx, TaskService_DeleteComment_FullMethodName, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) ListComments(ctx context.Context, in *ListCommentsRequest) (*ListCommentsResponse, error) {
+	out := new(ListCommentsResponse)
+	err := c.cli.Invoke(ctx, TaskService_ListComments_FullMethodName, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) UploadAttachment(ctx context.Context, in *UploadAttachmentRequest) (*UploadAttachmentResponse, error) {
+	out := new(UploadAttachmentResponse)
+	err := c.cli.Invoke(ctx, TaskService_UploadAttachment_FullMethodName, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) ListAttachments(ctx context.Context, in *ListAttachmentsRequest) (*ListAttachmentsResponse, error) {
+	out := new(ListAttachmentsResponse)
+	err := c.cli.Invoke(ctx, TaskService_ListAttachments_FullMethodName, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) GetAttachmentURL(ctx context.Context, in *GetAttachmentURLRequest) (*GetAttachmentURLResponse, error) {
+	out := new(GetAttachmentURLResponse)
+	err := c.cli.Invoke(ctx, TaskService_GetAttachmentURL_FullMethodName, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) DeleteAttachment(ctx context.Context, in *DeleteAttachmentRequest) (*DeleteAttachmentResponse, error) {
+	out := new(DeleteAttachmentResponse)
+	err := c.cli.Invoke(ctx, TaskService_DeleteAttachment_FullMethodName, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) BatchCreateTasks(ctx context.Context, in *BatchCreateTasksRequest) (*BatchCreateTasksResponse, error) {
+	out := new(BatchCreateTasksResponse)
+	err := c.cli.Invoke(ctx, TaskService_BatchCreateTasks_FullMethodName, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) BatchUpdateStatus(ctx context.Context, in *BatchUpdateStatusRequest) (*BatchUpdateStatusResponse, error) {
+	out := new(BatchUpdateStatusResponse)
+	err := c.cli.Invoke(ctx, TaskService_BatchUpdateStatus_FullMethodName, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) BatchDelete(ctx context.Context, in *BatchDeleteRequest) (*BatchDeleteResponse, error) {
+	out := new(BatchDeleteResponse)
+	err := c.cli.Invoke(ctx, TaskService_BatchDelete_FullMethodName, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) BatchMove(ctx context.Context, in *BatchMoveRequest) (*BatchMoveResponse, error) {
+	out := new(BatchMoveResponse)
+	err := c.cli.Invoke(ctx, TaskService_BatchMove_FullMethodName, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) ExportTasks(ctx context.Context, in *ExportTasksRequest) (*ExportTasksResponse, error) {
+	out := new(ExportTasksResponse)
+	err := c.cli.Invoke(ctx, TaskService_ExportTasks_FullMethodName, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) ImportTasks(ctx context.Context, in *ImportTasksRequest) (*ImportTasksResponse, error) {
+	out := new(ImportTasksResponse)
+	err := c.cli.Invoke(ctx, TaskService_ImportTasks_FullMethodName, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) QuickAddTask(ctx context.Context, in *QuickAddTaskRequest) (*QuickAddTaskResponse, error) {
+	out := new(QuickAddTaskResponse)
+	err := c.cli.Invoke(ctx, TaskService_QuickAddTask_FullMethodName, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) SyncTasks(ctx context.Context, in *SyncTasksRequest) (*SyncTasksResponse, error) {
+	out := new(SyncTasksResponse)
+	err := c.cli.Invoke(ctx, TaskService_SyncTasks_FullMethodName, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) PushTaskChanges(ctx context.Context, in *PushTaskChangesRequest) (*PushTaskChangesResponse, error) {
+	out := new(PushTaskChangesResponse)
+	err := c.cli.Invoke(ctx, TaskService_PushTaskChanges_FullMethodName, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) ListTaskEvents(ctx context.Context, in *ListTaskEventsRequest) (*ListTaskEventsResponse, error) {
+	out := new(ListTaskEventsResponse)
+	err := c.cli.Invoke(ctx, TaskService_ListTaskEvents_FullMethodName, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) CreateWebhook(ctx context.Context, in *CreateWebhookRequest) (*CreateWebhookResponse, error) {
+	out := new(CreateWebhookResponse)
+	err := c.cli.Invoke(ctx, TaskService_CreateWebhook_FullMethodName, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) UpdateWebhook(ctx context.Context, in *UpdateWebhookRequest) (*UpdateWebhookResponse, error) {
+	out := new(UpdateWebhookResponse)
+	err := c.cli.Invoke(ctx, TaskService_UpdateWebhook_FullMethodName, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest) (*DeleteWebhookResponse, error) {
+	out := new(DeleteWebhookResponse)
+	err := c.cli.Invoke(ctx, TaskService_DeleteWebhook_FullMethodName, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) ListWebhooks(ctx context.Context, in *ListWebhooksRequest) (*ListWebhooksResponse, error) {
+	out := new(ListWebhooksResponse)
+	err := c.cli.Invoke(ctx, TaskService_ListWebhooks_FullMethodName, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest) (*ListWebhookDeliveriesResponse, error) {
+	out := new(ListWebhookDeliveriesResponse)
+	err := c.cli.Invoke(ctx, TaskService_ListWebhookDeliveries_FullMethodName, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) ReplayWebhookDelivery(ctx context.Context, in *ReplayWebhookDeliveryRequest) (*ReplayWebhookDeliveryResponse, error) {
+	out := new(ReplayWebhookDeliveryResponse)
+	err := c.cli.Invoke(ctx, TaskService_ReplayWebhookDelivery_FullMethodName, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) CreateCalendarFeed(ctx context.Context, in *CreateCalendarFeedRequest) (*CreateCalendarFeedResponse, error) {
+	out := new(CreateCalendarFeedResponse)
+	err := c.cli.Invoke(ctx, TaskService_CreateCalendarFeed_FullMethodName, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) RotateCalendarFeed(ctx context.Context, in *RotateCalendarFeedRequest) (*RotateCalendarFeedResponse, error) {
+	out := new(RotateCalendarFeedResponse)
+	err := c.cli.Invoke(ctx, TaskService_RotateCalendarFeed_FullMethodName, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) RevokeCalendarFeed(ctx context.Context, in *RevokeCalendarFeedRequest) (*RevokeCalendarFeedResponse, error) {
+	out := new(RevokeCalendarFeedResponse)
+	err := c.cli.Invoke(ctx, TaskService_RevokeCalendarFeed_FullMethodName, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) GetCalendarFeed(ctx context.Context, in *GetCalendarFeedRequest) (*GetCalendarFeedResponse, error) {
+	out := new(GetCalendarFeedResponse)
+	err := c.cli.Invoke(ctx, TaskService_GetCalendarFeed_FullMethodName, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TaskServiceServer is the server API for TaskService service
+// All implementations must embed UnimplementedTaskServiceServer
+// for forward compatibility.
+type TaskServiceServer interface {
+	AddTask(context.Context, *AddTaskRequest) (*AddTaskResponse, error)
+	GetTask(context.Context, *GetTaskRequest) (*GetTaskResponse, error)
+	ListTasks(context.Context, *ListTasksRequest) (*ListTasksResponse, error)
+	UpdateTask(context.Context, *UpdateTaskRequest) (*UpdateTaskResponse, error)
+	UpdateTaskStatus(context.Context, *UpdateTaskStatusRequest) (*UpdateTaskStatusResponse, error)
+	RecycleBin(context.Context, *RecycleBinRequest) (*RecycleBinResponse, error)
+	SearchTasks(context.Context, *SearchTasksRequest) (*SearchTasksResponse, error)
+	ListDueTasks(context.Context, *ListDueTasksRequest) (*ListDueTasksResponse, error)
+	DeleteTask(context.Context, *DeleteTaskRequest) (*DeleteTaskResponse, error)
+	RestoreTask(context.Context, *RestoreTaskRequest) (*RestoreTaskResponse, error)
+	PurgeTask(context.Context, *PurgeTaskRequest) (*PurgeTaskResponse, error)
+	EmptyRecycleBin(context.Context, *EmptyRecycleBinRequest) (*EmptyRecycleBinResponse, error)
+	PreviewOccurrences(context.Context, *PreviewOccurrencesRequest) (*PreviewOccurrencesResponse, error)
+	CreateTag(context.Context, *CreateTagRequest) (*CreateTagResponse, error)
+	UpdateTag(context.Context, *UpdateTagRequest) (*UpdateTagResponse, error)
+	DeleteTag(context.Context, *DeleteTagRequest) (*DeleteTagResponse, error)
+	ListTags(context.Context, *ListTagsRequest) (*ListTagsResponse, error)
+	CreateProject(context.Context, *CreateProjectRequest) (*CreateProjectResponse, error)
+	RenameProject(context.Context, *RenameProjectRequest) (*RenameProjectResponse, error)
+	ArchiveProject(context.Context, *ArchiveProjectRequest) (*ArchiveProjectResponse, error)
+	ReorderProjects(context.Context, *ReorderProjectsRequest) (*ReorderProjectsResponse, error)
+	DeleteProject(context.Context, *DeleteProjectRequest) (*DeleteProjectResponse, error)
+	ListProjects(context.Context, *ListProjectsRequest) (*ListProjectsResponse, error)
+	AddChecklistItem(context.Context, *AddChecklistItemRequest) (*AddChecklistItemResponse, error)
+	UpdateChecklistItem(context.Context, *UpdateChecklistItemRequest) (*UpdateChecklistItemResponse, error)
+	DeleteChecklistItem(context.Context, *DeleteChecklistItemRequest) (*DeleteChecklistItemResponse, error)
+	AddDependency(context.Context, *AddDependencyRequest) (*AddDependencyResponse, error)
+	RemoveDependency(context.Context, *RemoveDependencyRequest) (*RemoveDependencyResponse, error)
+	MoveTask(context.Context, *MoveTaskRequest) (*MoveTaskResponse, error)
+	GetBoard(context.Context, *GetBoardRequest) (*GetBoardResponse, error)
+	CreateColumn(context.Context, *CreateColumnRequest) (*CreateColumnResponse, error)
+	UpdateColumn(context.Context, *UpdateColumnRequest) (*UpdateColumnResponse, error)
+	ReorderColumns(context.Context, *ReorderColumnsRequest) (*ReorderColumnsResponse, error)
+	DeleteColumn(context.Context, *DeleteColumnRequest) (*DeleteColumnResponse, error)
+	MoveCard(context.Context, *MoveCardRequest) (*MoveCardResponse, error)
+	GetTaskHistory(context.Context, *GetTaskHistoryRequest) (*GetTaskHistoryResponse, error)
+	RevertTask(context.Context, *RevertTaskRequest) (*RevertTaskResponse, error)
+	AddComment(context.Context, *AddCommentRequest) (*AddCommentResponse, error)
+	UpdateComment(context.Context, *UpdateCommentRequest) (*UpdateCommentResponse, error)
+	DeleteComment(context.Context, *DeleteCommentRequest) (*DeleteCommentResponse, error)
+	ListComments(context.Context, *ListCommentsRequest) (*ListCommentsResponse, error)
+	UploadAttachment(context.Context, *UploadAttachmentRequest) (*UploadAttachmentResponse, error)
+	ListAttachments(context.Context, *ListAttachmentsRequest) (*ListAttachmentsResponse, error)
+	GetAttachmentURL(context.Context, *GetAttachmentURLRequest) (*GetAttachmentURLResponse, error)
+	DeleteAttachment(context.Context, *DeleteAttachmentRequest) (*DeleteAttachmentResponse, error)
+	BatchCreateTasks(context.Context, *BatchCreateTasksRequest) (*BatchCreateTasksResponse, error)
+	BatchUpdateStatus(context.Context, *BatchUpdateStatusRequest) (*BatchUpdateStatusResponse, error)
+	BatchDelete(context.Context, *BatchDeleteRequest) (*BatchDeleteResponse, error)
+	BatchMove(context.Context, *BatchMoveRequest) (*BatchMoveResponse, error)
+	ExportTasks(context.Context, *ExportTasksRequest) (*ExportTasksResponse, error)
+	ImportTasks(context.Context, *ImportTasksRequest) (*ImportTasksResponse, error)
+	QuickAddTask(context.Context, *QuickAddTaskRequest) (*QuickAddTaskResponse, error)
+	SyncTasks(context.Context, *SyncTasksRequest) (*SyncTasksResponse, error)
+	PushTaskChanges(context.Context, *PushTaskChangesRequest) (*PushTaskChangesResponse, error)
+	ListTaskEvents(context.Context, *ListTaskEventsRequest) (*ListTaskEventsResponse, error)
+	CreateWebhook(context.Context, *CreateWebhookRequest) (*CreateWebhookResponse, error)
+	UpdateWebhook(context.Context, *UpdateWebhookRequest) (*UpdateWebhookResponse, error)
+	DeleteWebhook(context.Context, *DeleteWebhookRequest) (*DeleteWebhookResponse, error)
+	ListWebhooks(context.Context, *ListWebhooksRequest) (*ListWebhooksResponse, error)
+	ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesRequest) (*ListWebhookDeliveriesResponse, error)
+	ReplayWebhookDelivery(context.Context, *ReplayWebhookDeliveryRequest) (*ReplayWebhookDeliveryResponse, error)
+	CreateCalendarFeed(context.Context, *CreateCalendarFeedRequest) (*CreateCalendarFeedResponse, error)
+	RotateCalendarFeed(context.Context, *RotateCalendarFeedRequest) (*RotateCalendarFeedResponse, error)
+	RevokeCalendarFeed(context.Context, *RevokeCalendarFeedRequest) (*RevokeCalendarFeedResponse, error)
+	GetCalendarFeed(context.Context, *GetCalendarFeedRequest) (*GetCalendarFeedResponse, error)
+	mustEmbedUnimplementedTaskServiceServer()
+}
+
+// UnimplementedTaskServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTaskServiceServer struct{}
+
+func (UnimplementedTaskServiceServer) AddTask(context.Context, *AddTaskRequest) (*AddTaskResponse, error) {
+	return nil, fmt.Errorf("method AddTask not implemented")
+}
+func (UnimplementedTaskServiceServer) GetTask(context.Context, *GetTaskRequest) (*GetTaskResponse, error) {
+	return nil, fmt.Errorf("method GetTask not implemented")
+}
+func (UnimplementedTaskServiceServer) ListTasks(context.Context, *ListTasksRequest) (*ListTasksResponse, error) {
+	return nil, fmt.Errorf("method ListTasks not implemented")
+}
+func (UnimplementedTaskServiceServer) UpdateTask(context.Context, *UpdateTaskRequest) (*UpdateTaskResponse, error) {
+	return nil, fmt.Errorf("method UpdateTask not implemented")
+}
+func (UnimplementedTaskServiceServer) UpdateTaskStatus(context.Context, *UpdateTaskStatusRequest) (*UpdateTaskStatusResponse, error) {
+	return nil, fmt.Errorf("method UpdateTaskStatus not implemented")
+}
+func (UnimplementedTaskServiceServer) RecycleBin(context.Context, *RecycleBinRequest) (*RecycleBinResponse, error) {
+	return nil, fmt.Errorf("method RecycleBin not implemented")
+}
+func (UnimplementedTaskServiceServer) SearchTasks(context.Context, *SearchTasksRequest) (*SearchTasksResponse, error) {
+	return nil, fmt.Errorf("method SearchTasks not implemented")
+}
+func (UnimplementedTaskServiceServer) ListDueTasks(context.Context, *ListDueTasksRequest) (*ListDueTasksResponse, error) {
+	return nil, fmt.Errorf("method ListDueTasks not implemented")
+}
+func (UnimplementedTaskServiceServer) DeleteTask(context.Context, *DeleteTaskRequest) (*DeleteTaskResponse, error) {
+	return nil, fmt.Errorf("method DeleteTask not implemented")
+}
+func (UnimplementedTaskServiceServer) RestoreTask(context.Context, *RestoreTaskRequest) (*RestoreTaskResponse, error) {
+	return nil, fmt.Errorf("method RestoreTask not implemented")
+}
+func (UnimplementedTaskServiceServer) PurgeTask(context.Context, *PurgeTaskRequest) (*PurgeTaskResponse, error) {
+	return nil, fmt.Errorf("method PurgeTask not implemented")
+}
+func (UnimplementedTaskServiceServer) EmptyRecycleBin(context.Context, *EmptyRecycleBinRequest) (*EmptyRecycleBinResponse, error) {
+	return nil, fmt.Errorf("method EmptyRecycleBin not implemented")
+}
+func (UnimplementedTaskServiceServer) PreviewOccurrences(context.Context, *PreviewOccurrencesRequest) (*PreviewOccurrencesResponse, error) {
+	return nil, fmt.Errorf("method PreviewOccurrences not implemented")
+}
+func (UnimplementedTaskServiceServer) CreateTag(context.Context, *CreateTagRequest) (*CreateTagResponse, error) {
+	return nil, fmt.Errorf("method CreateTag not implemented")
+}
+func (UnimplementedTaskServiceServer) UpdateTag(context.Context, *UpdateTagRequest) (*UpdateTagResponse, error) {
+	return nil, fmt.Errorf("method UpdateTag not implemented")
+}
+func (UnimplementedTaskServiceServer) DeleteTag(context.Context, *DeleteTagRequest) (*DeleteTagResponse, error) {
+	return nil, fmt.Errorf("method DeleteTag not implemented")
+}
+func (UnimplementedTaskServiceServer) ListTags(context.Context, *ListTagsRequest) (*ListTagsResponse, error) {
+	return nil, fmt.Errorf("method ListTags not implemented")
+}
+func (UnimplementedTaskServiceServer) CreateProject(context.Context, *CreateProjectRequest) (*CreateProjectResponse, error) {
+	return nil, fmt.Errorf("method CreateProject not implemented")
+}
+func (UnimplementedTaskServiceServer) RenameProject(context.Context, *RenameProjectRequest) (*RenameProjectResponse, error) {
+	return nil, fmt.Errorf("method RenameProject not implemented")
+}
+func (UnimplementedTaskServiceServer) ArchiveProject(context.Context, *ArchiveProjectRequest) (*ArchiveProjectResponse, error) {
+	return nil, fmt.Errorf("method ArchiveProject not implemented")
+}
+func (UnimplementedTaskServiceServer) ReorderProjects(context.Context, *ReorderProjectsRequest) (*ReorderProjectsResponse, error) {
+	return nil, fmt.Errorf("method ReorderProjects not implemented")
+}
+func (UnimplementedTaskServiceServer) DeleteProject(context.Context, *DeleteProjectRequest) (*DeleteProjectResponse, error) {
+	return nil, fmt.Errorf("method DeleteProject not implemented")
+}
+func (UnimplementedTaskServiceServer) ListProjects(context.Context, *ListProjectsRequest) (*ListProjectsResponse, error) {
+	return nil, fmt.Errorf("method ListProjects not implemented")
+}
+func (UnimplementedTaskServiceServer) AddChecklistItem(context.Context, *AddChecklistItemRequest) (*AddChecklistItemResponse, error) {
+	return nil, fmt.Errorf("method AddChecklistItem not implemented")
+}
+func (UnimplementedTaskServiceServer) UpdateChecklistItem(context.Context, *UpdateChecklistItemRequest) (*UpdateChecklistItemResponse, error) {
+	return nil, fmt.Errorf("method UpdateChecklistItem not implemented")
+}
+func (UnimplementedTaskServiceServer) DeleteChecklistItem(context.Context, *DeleteChecklistItemRequest) (*DeleteChecklistItemResponse, error) {
+	return nil, fmt.Errorf("method DeleteChecklistItem not implemented")
+}
+func (UnimplementedTaskServiceServer) AddDependency(context.Context, *AddDependencyRequest) (*AddDependencyResponse, error) {
+	return nil, fmt.Errorf("method AddDependency not implemented")
+}
+func (UnimplementedTaskServiceServer) RemoveDependency(context.Context, *RemoveDependencyRequest) (*RemoveDependencyResponse, error) {
+	return nil, fmt.Errorf("method RemoveDependency not implemented")
+}
+func (UnimplementedTaskServiceServer) MoveTask(context.Context, *MoveTaskRequest) (*MoveTaskResponse, error) {
+	return nil, fmt.Errorf("method MoveTask not implemented")
+}
+func (UnimplementedTaskServiceServer) GetBoard(context.Context, *GetBoardRequest) (*GetBoardResponse, error) {
+	return nil, fmt.Errorf("method GetBoard not implemented")
+}
+func (UnimplementedTaskServiceServer) CreateColumn(context.Context, *CreateColumnRequest) (*CreateColumnResponse, error) {
+	return nil, fmt.Errorf("method CreateColumn not implemented")
+}
+func (UnimplementedTaskServiceServer) UpdateColumn(context.Context, *UpdateColumnRequest) (*UpdateColumnResponse, error) {
+	return nil, fmt.Errorf("method UpdateColumn not implemented")
+}
+func (UnimplementedTaskServiceServer) ReorderColumns(context.Context, *ReorderColumnsRequest) (*ReorderColumnsResponse, error) {
+	return nil, fmt.Errorf("method ReorderColumns not implemented")
+}
+func (UnimplementedTaskServiceServer) DeleteColumn(context.Context, *DeleteColumnRequest) (*DeleteColumnResponse, error) {
+	return nil, fmt.Errorf("method DeleteColumn not implemented")
+}
+func (UnimplementedTaskServiceServer) MoveCard(context.Context, *MoveCardRequest) (*MoveCardResponse, error) {
+	return nil, fmt.Errorf("method MoveCard not implemented")
+}
+func (UnimplementedTaskServiceServer) GetTaskHistory(context.Context, *GetTaskHistoryRequest) (*GetTaskHistoryResponse, error) {
+	return nil, fmt.Errorf("method GetTaskHistory not implemented")
+}
+func (UnimplementedTaskServiceServer) RevertTask(context.Context, *RevertTaskRequest) (*RevertTaskResponse, error) {
+	return nil, fmt.Errorf("method RevertTask not implemented")
+}
+func (UnimplementedTaskServiceServer) AddComment(context.Context, *AddCommentRequest) (*AddCommentResponse, error) {
+	return nil, fmt.Errorf("method AddComment not implemented")
+}
+func (UnimplementedTaskServiceServer) UpdateComment(context.Context, *UpdateCommentRequest) (*UpdateCommentResponse, error) {
+	return nil, fmt.Errorf("method UpdateComment not implemented")
+}
+func (UnimplementedTaskServiceServer) DeleteComment(context.Context, *DeleteCommentRequest) (*DeleteCommentResponse, error) {
+	return nil, fmt.Errorf("method DeleteComment not implemented")
+}
+func (UnimplementedTaskServiceServer) ListComments(context.Context, *ListCommentsRequest) (*ListCommentsResponse, error) {
+	return nil, fmt.Errorf("method ListComments not implemented")
+}
+func (UnimplementedTaskServiceServer) UploadAttachment(context.Context, *UploadAttachmentRequest) (*UploadAttachmentResponse, error) {
+	return nil, fmt.Errorf("method UploadAttachment not implemented")
+}
+func (UnimplementedTaskServiceServer) ListAttachments(context.Context, *ListAttachmentsRequest) (*ListAttachmentsResponse, error) {
+	return nil, fmt.Errorf("method ListAttachments not implemented")
+}
+func (UnimplementedTaskServiceServer) GetAttachmentURL(context.Context, *GetAttachmentURLRequest) (*GetAttachmentURLResponse, error) {
+	return nil, fmt.Errorf("method GetAttachmentURL not implemented")
+}
+func (UnimplementedTaskServiceServer) DeleteAttachment(context.Context, *DeleteAttachmentRequest) (*DeleteAttachmentResponse, error) {
+	return nil, fmt.Errorf("method DeleteAttachment not implemented")
+}
+func (UnimplementedTaskServiceServer) BatchCreateTasks(context.Context, *BatchCreateTasksRequest) (*BatchCreateTasksResponse, error) {
+	return nil, fmt.Errorf("method BatchCreateTasks not implemented")
+}
+func (UnimplementedTaskServiceServer) BatchUpdateStatus(context.Context, *BatchUpdateStatusRequest) (*BatchUpdateStatusResponse, error) {
+	return nil, fmt.Errorf("method BatchUpdateStatus not implemented")
+}
+func (UnimplementedTaskServiceServer) BatchDelete(context.Context, *BatchDeleteRequest) (*BatchDeleteResponse, error) {
+	return nil, fmt.Errorf("method BatchDelete not implemented")
+}
+func (UnimplementedTaskServiceServer) BatchMove(context.Context, *BatchMoveRequest) (*BatchMoveResponse, error) {
+	return nil, fmt.Errorf("method BatchMove not implemented")
+}
+func (UnimplementedTaskServiceServer) ExportTasks(context.Context, *ExportTasksRequest) (*ExportTasksResponse, error) {
+	return nil, fmt.Errorf("method ExportTasks not implemented")
+}
+func (UnimplementedTaskServiceServer) ImportTasks(context.Context, *ImportTasksRequest) (*ImportTasksResponse, error) {
+	return nil, fmt.Errorf("method ImportTasks not implemented")
+}
+func (UnimplementedTaskServiceServer) QuickAddTask(context.Context, *QuickAddTaskRequest) (*QuickAddTaskResponse, error) {
+	return nil, fmt.Errorf("method QuickAddTask not implemented")
+}
+func (UnimplementedTaskServiceServer) SyncTasks(context.Context, *SyncTasksRequest) (*SyncTasksResponse, error) {
+	return nil, fmt.Errorf("method SyncTasks not implemented")
+}
+func (UnimplementedTaskServiceServer) PushTaskChanges(context.Context, *PushTaskChangesRequest) (*PushTaskChangesResponse, error) {
+	return nil, fmt.Errorf("method PushTaskChanges not implemented")
+}
+func (UnimplementedTaskServiceServer) ListTaskEvents(context.Context, *ListTaskEventsRequest) (*ListTaskEventsResponse, error) {
+	return nil, fmt.Errorf("method ListTaskEvents not implemented")
+}
+func (UnimplementedTaskServiceServer) CreateWebhook(context.Context, *CreateWebhookRequest) (*CreateWebhookResponse, error) {
+	return nil, fmt.Errorf("method CreateWebhook not implemented")
+}
+func (UnimplementedTaskServiceServer) UpdateWebhook(context.Context, *UpdateWebhookRequest) (*UpdateWebhookResponse, error) {
+	return nil, fmt.Errorf("method UpdateWebhook not implemented")
+}
+func (UnimplementedTaskServiceServer) DeleteWebhook(context.Context, *DeleteWebhookRequest) (*DeleteWebhookResponse, error) {
+	return nil, fmt.Errorf("method DeleteWebhook not implemented")
+}
+func (UnimplementedTaskServiceServer) ListWebhooks(context.Context, *ListWebhooksRequest) (*ListWebhooksResponse, error) {
+	return nil, fmt.Errorf("method ListWebhooks not implemented")
+}
+func (UnimplementedTaskServiceServer) ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesRequest) (*ListWebhookDeliveriesResponse, error) {
+	return nil, fmt.Errorf("method ListWebhookDeliveries not implemented")
+}
+func (UnimplementedTaskServiceServer) ReplayWebhookDelivery(context.Context, *ReplayWebhookDeliveryRequest) (*ReplayWebhookDeliveryResponse, error) {
+	return nil, fmt.Errorf("method ReplayWebhookDelivery not implemented")
+}
+func (UnimplementedTaskServiceServer) CreateCalendarFeed(context.Context, *CreateCalendarFeedRequest) (*CreateCalendarFeedResponse, error) {
+	return nil, fmt.Errorf("method CreateCalendarFeed not implemented")
+}
+func (UnimplementedTaskServiceServer) RotateCalendarFeed(context.Context, *RotateCalendarFeedRequest) (*RotateCalendarFeedResponse, error) {
+	return nil, fmt.Errorf("method RotateCalendarFeed not implemented")
+}
+func (UnimplementedTaskServiceServer) RevokeCalendarFeed(context.Context, *RevokeCalendarFeedRequest) (*RevokeCalendarFeedResponse, error) {
+	return nil, fmt.Errorf("method RevokeCalendarFeed not implemented")
+}
+func (UnimplementedTaskServiceServer) GetCalendarFeed(context.Context, *GetCalendarFeedRequest) (*GetCalendarFeedResponse, error) {
+	return nil, fmt.Errorf("method GetCalendarFeed not implemented")
+}
+func (UnimplementedTaskServiceServer) mustEmbedUnimplementedTaskServiceServer() {}
+func (UnimplementedTaskServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeTaskServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TaskServiceServer will
+// result in compilation errors.
+type UnsafeTaskServiceServer interface {
+	mustEmbedUnimplementedTaskServiceServer()
+}
+
+func RegisterTaskServiceServer(s zrpc.ServiceRegistrar, srv TaskServiceServer) {
+	// If the following call panics, it indicates UnimplementedTaskServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TaskService_ServiceDesc, srv)
+}
+
+func _TaskService_AddTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, middleware zrpc.ServerMiddleware) (interface{}, error) {
+	in := new(AddTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if middleware == nil {
+		return srv.(TaskServiceServer).AddTask(ctx, in)
+	}
+	info := &zrpc.ServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_AddTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).AddTask(ctx, req.(*AddTaskRequest))
+	}
+	return middleware(ctx, in, info, handler)
+}
+
+func _TaskService_GetTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, middleware zrpc.ServerMiddleware) (interface{}, error) {
+	in := new(GetTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if middleware == nil {
+		return srv.(TaskServiceServer).GetTask(ctx, in)
+	}
+	info := &zrpc.ServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_GetTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).GetTask(ctx, req.(*GetTaskRequest))
+	}
+	return middleware(ctx, in, info, handler)
+}
+
+func _TaskService_ListTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, middleware zrpc.ServerMiddleware) (interface{}, error) {
+	in := new(ListTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if middleware == nil {
+		return srv.(TaskServiceServer).ListTasks(ctx, in)
+	}
+	info := &zrpc.ServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_ListTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).ListTasks(ctx, req.(*ListTasksRequest))
+	}
+	return middleware(ctx, in, info, handler)
+}
+
+func _TaskService_UpdateTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, middleware zrpc.ServerMiddleware) (interface{}, error) {
+	in := new(UpdateTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if middleware == nil {
+		return srv.(TaskServiceServer).UpdateTask(ctx, in)
+	}
+	info := &zrpc.ServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_UpdateTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).UpdateTask(ctx, req.(*UpdateTaskRequest))
+	}
+	return middleware(ctx, in, info, handler)
+}
+
+func _TaskService_UpdateTaskStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, middleware zrpc.ServerMiddleware) (interface{}, error) {
+	in := new(UpdateTaskStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if middleware == nil {
+		return srv.(TaskServiceServer).UpdateTaskStatus(ctx, in)
+	}
+	info := &zrpc.ServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_UpdateTaskStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).UpdateTaskStatus(ctx, req.(*UpdateTaskStatusRequest))
+	}
+	return middleware(ctx, in, info, handler)
+}
+
+func _TaskService_RecycleBin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, middleware zrpc.ServerMiddleware) (interface{}, error) {
+	in := new(RecycleBinRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if middleware == nil {
+		return srv.(TaskServiceServer).RecycleBin(ctx, in)
+	}
+	info := &zrpc.ServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_RecycleBin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).RecycleBin(ctx, req.(*RecycleBinRequest))
+	}
+	return middleware(ctx, in, info, handler)
+}
+
+func _TaskService_SearchTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, middleware zrpc.ServerMiddleware) (interface{}, error) {
+	in := new(SearchTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if middleware == nil {
+		return srv.(TaskServiceServer).SearchTasks(ctx, in)
+	}
+	info := &zrpc.ServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_SearchTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).SearchTasks(ctx, req.(*SearchTasksRequest))
+	}
+	return middleware(ctx, in, info, handler)
+}
+
+func _TaskService_ListDueTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, middleware zrpc.ServerMiddleware) (interface{}, error) {
+	in := new(ListDueTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if middleware == nil {
+		return srv.(TaskServiceServer).ListDueTasks(ctx, in)
+	}
+	info := &zrpc.ServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_ListDueTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).ListDueTasks(ctx, req.(*ListDueTasksRequest))
+	}
+	return middleware(ctx, in, info, handler)
+}
+
+func _TaskService_DeleteTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, middleware zrpc.ServerMiddleware) (interface{}, error) {
+	in := new(DeleteTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if middleware == nil {
+		return srv.(TaskServiceServer).DeleteTask(ctx, in)
+	}
+	info := &zrpc.ServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_DeleteTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).DeleteTask(ctx, req.(*DeleteTaskRequest))
+	}
+	return middleware(ctx, in, info, handler)
+}
+
+func _TaskService_RestoreTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, middleware zrpc.ServerMiddleware) (interface{}, error) {
+	in := new(RestoreTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if middleware == nil {
+		return srv.(TaskServiceServer).RestoreTask(ctx, in)
+	}
+	info := &zrpc.ServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_RestoreTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).RestoreTask(ctx, req.(*RestoreTaskRequest))
+	}
+	return middleware(ctx, in, info, handler)
+}
+
+func _TaskService_PurgeTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, middleware zrpc.ServerMiddleware) (interface{}, error) {
+	in := new(PurgeTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if middleware == nil {
+		return srv.(TaskServiceServer).PurgeTask(ctx, in)
+	}
+	info := &zrpc.ServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_PurgeTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).PurgeTask(ctx, req.(*PurgeTaskRequest))
+	}
+	return middleware(ctx, in, info, handler)
+}
+
+func _TaskService_EmptyRecycleBin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, middleware zrpc.ServerMiddleware) (interface{}, error) {
+	in := new(EmptyRecycleBinRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if middleware == nil {
+		return srv.(TaskServiceServer).EmptyRecycleBin(ctx, in)
+	}
+	info := &zrpc.ServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_EmptyRecycleBin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).EmptyRecycleBin(ctx, req.(*EmptyRecycleBinRequest))
+	}
+	return middleware(ctx, in, info, handler)
+}
+
+func _TaskService_PreviewOccurrences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, middleware zrpc.ServerMiddleware) (interface{}, error) {
+	in := new(PreviewOccurrencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if middleware == nil {
+		return srv.(TaskServiceServer).PreviewOccurrences(ctx, in)
+	}
+	info := &zrpc.ServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_PreviewOccurrences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).PreviewOccurrences(ctx, req.(*PreviewOccurrencesRequest))
+	}
+	return middleware(ctx, in, info, handler)
+}
+
+func _TaskService_CreateTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, middleware zrpc.ServerMiddleware) (interface{}, error) {
+	in := new(CreateTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if middleware == nil {
+		return srv.(TaskServiceServer).CreateTag(ctx, in)
+	}
+	info := &zrpc.ServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_CreateTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).CreateTag(ctx, req.(*CreateTagRequest))
+	}
+	return middleware(ctx, in, info, handler)
+}
+
+func _TaskService_UpdateTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, middleware zrpc.ServerMiddleware) (interface{}, error) {
+	in := new(UpdateTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if middleware == nil {
+		return srv.(TaskServiceServer).UpdateTag(ctx, in)
+	}
+	info := &zrpc.ServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_UpdateTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).UpdateTag(ctx, req.(*UpdateTagRequest))
+	}
+	return middleware(ctx, in, info, handler)
+}
+
+func _TaskService_DeleteTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, middleware zrpc.ServerMiddleware) (interface{}, error) {
+	in := new(DeleteTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if middleware == nil {
+		return srv.(TaskServiceServer).DeleteTag(ctx, in)
+	}
+	info := &zrpc.ServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_DeleteTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).DeleteTag(ctx, req.(*DeleteTagRequest))
+	}
+	return middleware(ctx, in, info, handler)
+}
+
+func _TaskService_ListTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, middleware zrpc.ServerMiddleware) (interface{}, error) {
+	in := new(ListTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if middleware == nil {
+		return srv.(TaskServiceServer).ListTags(ctx, in)
+	}
+	info := &zrpc.ServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_ListTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).ListTags(ctx, req.(*ListTagsRequest))
+	}
+	return middleware(ctx, in, info, handler)
+}
+
+func _TaskService_CreateProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, middleware zrpc.ServerMiddleware) (interface{}, error) {
+	in := new(CreateProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if middleware == nil {
+		return srv.(TaskServiceServer).CreateProject(ctx, in)
+	}
+	info := &zrpc.ServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_CreateProject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).CreateProject(ctx, req.(*CreateProjectRequest))
+	}
+	return middleware(ctx, in, info, handler)
+}
+
+func _TaskService_RenameProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, middleware zrpc.ServerMiddleware) (interface{}, error) {
+	in := new(RenameProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if middleware == nil {
+		return srv.(TaskServiceServer).RenameProject(ctx, in)
+	}
+	info := &zrpc.ServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_RenameProject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).RenameProject(ctx, req.(*RenameProjectRequest))
+	}
+	return middleware(ctx, in, info, handler)
+}
+
+func _TaskService_ArchiveProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, middleware zrpc.ServerMiddleware) (interface{}, error) {
+	in := new(ArchiveProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if middleware == nil {
+		return srv.(TaskServiceServer).ArchiveProject(ctx, in)
+	}
+	info := &zrpc.ServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_ArchiveProject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).ArchiveProject(ctx, req.(*ArchiveProjectRequest))
+	}
+	return middleware(ctx, in, info, handler)
+}
+
+func _TaskService_ReorderProjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, middleware zrpc.ServerMiddleware) (interface{}, error) {
+	in := new(ReorderProjectsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if middleware == nil {
+		return srv.(TaskServiceServer).ReorderProjects(ctx, in)
+	}
+	info := &zrpc.ServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_ReorderProjects_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).ReorderProjects(ctx, req.(*ReorderProjectsRequest))
+	}
+	return middleware(ctx, in, info, handler)
+}
+
+func _TaskService_DeleteProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, middleware zrpc.ServerMiddleware) (interface{}, error) {
+	in := new(DeleteProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if middleware == nil {
+		return srv.(TaskServiceServer).DeleteProject(ctx, in)
+	}
+	info := &zrpc.ServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_DeleteProject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).DeleteProject(ctx, req.(*DeleteProjectRequest))
+	}
+	return middleware(ctx, in, info, handler)
+}
+
+func _TaskService_ListProjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, middleware zrpc.ServerMiddleware) (interface{}, error) {
+	in := new(ListProjectsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if middleware == nil {
+		return srv.(TaskServiceServer).ListProjects(ctx, in)
+	}
+	info := &zrpc.ServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_ListProjects_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).ListProjects(ctx, req.(*ListProjectsRequest))
+	}
+	return middleware(ctx, in, info, handler)
+}
+
+func _TaskService_AddChecklistItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, middleware zrpc.ServerMiddleware) (interface{}, error) {
+	in := new(AddChecklistItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if middleware == nil {
+		return srv.(TaskServiceServer).AddChecklistItem(ctx, in)
+	}
+	info := &zrpc.ServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_AddChecklistItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).AddChecklistItem(ctx, req.(*AddChecklistItemRequest))
+	}
+	return middleware(ctx, in, info, handler)
+}
+
+func _TaskService_UpdateChecklistItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, middleware zrpc.ServerMiddleware) (interface{}, error) {
+	in := new(UpdateChecklistItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if middleware == nil {
+		return srv.(TaskServiceServer).UpdateChecklistItem(ctx, in)
+	}
+	info := &zrpc.ServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_UpdateChecklistItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).UpdateChecklistItem(ctx, req.(*UpdateChecklistItemRequest))
+	}
+	return middleware(ctx, in, info, handler)
+}
+
+func _TaskService_DeleteChecklistItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, middleware zrpc.ServerMiddleware) (interface{}, error) {
+	in := new(DeleteChecklistItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if middleware == nil {
+		return srv.(TaskServiceServer).DeleteChecklistItem(ctx, in)
+	}
+	info := &zrpc.ServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_DeleteChecklistItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).DeleteChecklistItem(ctx, req.(*DeleteChecklistItemRequest))
+	}
+	return middleware(ctx, in, info, handler)
+}
+
+func _TaskService_AddDependency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, middleware zrpc.ServerMiddleware) (interface{}, error) {
+	in := new(AddDependencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if middleware == nil {
+		return srv.(TaskServiceServer).AddDependency(ctx, in)
+	}
+	info := &zrpc.ServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_AddDependency_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).AddDependency(ctx, req.(*AddDependencyRequest))
+	}
+	return middleware(ctx, in, info, handler)
+}
+
+func _TaskService_RemoveDependency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, middleware zrpc.ServerMiddleware) (interface{}, error) {
+	in := new(RemoveDependencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if middleware == nil {
+		return srv.(TaskServiceServer).RemoveDependency(ctx, in)
+	}
+	info := &zrpc.ServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_RemoveDependency_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).RemoveDependency(ctx, req.(*RemoveDependencyRequest))
+	}
+	return middleware(ctx, in, info, handler)
+}
+
+func _TaskService_MoveTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, middleware zrpc.ServerMiddleware) (interface{}, error) {
+	in := new(MoveTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if middleware == nil {
+		return srv.(TaskServiceServer).MoveTask(ctx, in)
+	}
+	info := &zrpc.ServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_MoveTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).MoveTask(ctx, req.(*MoveTaskRequest))
+	}
+	return middleware(ctx, in, info, handler)
+}
+
+func _TaskService_GetBoard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, middleware zrpc.ServerMiddleware) (interface{}, error) {
+	in := new(GetBoardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if middleware == nil {
+		return srv.(TaskServiceServer).GetBoard(ctx, in)
+	}
+	info := &zrpc.ServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_GetBoard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).GetBoard(ctx, req.(*GetBoardRequest))
+	}
+	return middleware(ctx, in, info, handler)
+}
+
+func _TaskService_CreateColumn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, middleware zrpc.ServerMiddleware) (interface{}, error) {
+	in := new(CreateColumnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if middleware == nil {
+		return srv.(TaskServiceServer).CreateColumn(ctx, in)
+	}
+	info := &zrpc.ServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_CreateColumn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).CreateColumn(ctx, req.(*CreateColumnRequest))
+	}
+	return middleware(ctx, in, info, handler)
+}
+
+func _TaskService_UpdateColumn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, middleware zrpc.ServerMiddleware) (interface{}, error) {
+	in := new(UpdateColumnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if middleware == nil {
+		return srv.(TaskServiceServer).UpdateColumn(ctx, in)
+	}
+	info := &zrpc.ServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_UpdateColumn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).UpdateColumn(ctx, req.(*UpdateColumnRequest))
+	}
+	return middleware(ctx, in, info, handler)
+}
+
+func _TaskService_ReorderColumns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, middleware zrpc.ServerMiddleware) (interface{}, error) {
+	in := new(ReorderColumnsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if middleware == nil {
+		return srv.(TaskServiceServer).ReorderColumns(ctx, in)
+	}
+	info := &zrpc.ServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_ReorderColumns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).ReorderColumns(ctx, req.(*ReorderColumnsRequest))
+	}
+	return middleware(ctx, in, info, handler)
+}
+
+func _TaskService_DeleteColumn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, middleware zrpc.ServerMiddleware) (interface{}, error) {
+	in := new(DeleteColumnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if middleware == nil {
+		return srv.(TaskServiceServer).DeleteColumn(ctx, in)
+	}
+	info := &zrpc.ServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_DeleteColumn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).DeleteColumn(ctx, req.(*DeleteColumnRequest))
+	}
+	return middleware(ctx, in, info, handler)
+}
+
+func _TaskService_MoveCard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, middleware zrpc.ServerMiddleware) (interface{}, error) {
+	in := new(MoveCardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if middleware == nil {
+		return srv.(TaskServiceServer).MoveCard(ctx, in)
+	}
+	info := &zrpc.ServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_MoveCard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).MoveCard(ctx, req.(*MoveCardRequest))
+	}
+	return middleware(ctx, in, info, handler)
+}
+
+func _TaskService_GetTaskHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, middleware zrpc.ServerMiddleware) (interface{}, error) {
+	in := new(GetTaskHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if middleware == nil {
+		return srv.(TaskServiceServer).GetTaskHistory(ctx, in)
+	}
+	info := &zrpc.ServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_GetTaskHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).GetTaskHistory(ctx, req.(*GetTaskHistoryRequest))
+	}
+	return middleware(ctx, in, info, handler)
+}
+
+func _TaskService_RevertTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, middleware zrpc.ServerMiddleware) (interface{}, error) {
+	in := new(RevertTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if middleware == nil {
+		return srv.(TaskServiceServer).RevertTask(ctx, in)
+	}
+	info := &zrpc.ServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_RevertTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).RevertTask(ctx, req.(*RevertTaskRequest))
+	}
+	return middleware(ctx, in, info, handler)
+}
+
+func _TaskService_AddComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, middleware zrpc.ServerMiddleware) (interface{}, error) {
+	in := new(AddCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if middleware == nil {
+		return srv.(TaskServiceServer).AddComment(ctx, in)
+	}
+	info := &zrpc.ServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_AddComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).AddComment(ctx, req.(*AddCommentRequest))
+	}
+	return middleware(ctx, in, info, handler)
+}
+
+func _TaskService_UpdateComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, middleware zrpc.ServerMiddleware) (interface{}, error) {
+	in := new(UpdateCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if middleware == nil {
+		return srv.(TaskServiceServer).UpdateComment(ctx, in)
+	}
+	info := &zrpc.ServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_UpdateComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).UpdateComment(ctx, req.(*UpdateCommentRequest))
+	}
+	return middleware(ctx, in, info, handler)
+}
+
+func _TaskService_DeleteComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, middleware zrpc.ServerMiddleware) (interface{}, error) {
+	in := new(DeleteCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if middleware == nil {
+		return srv.(TaskServiceServer).DeleteComment(ctx, in)
+	}
+	info := &zrpc.ServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_DeleteComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).DeleteComment(ctx, req.(*DeleteCommentRequest))
+	}
+	return middleware(ctx, in, info, handler)
+}
+
+func _TaskService_ListComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, middleware zrpc.ServerMiddleware) (interface{}, error) {
+	in := new(ListCommentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if middleware == nil {
+		return srv.(TaskServiceServer).ListComments(ctx, in)
+	}
+	info := &zrpc.ServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_ListComments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).ListComments(ctx, req.(*ListCommentsRequest))
+	}
+	return middleware(ctx, in, info, handler)
+}
+
+func _TaskService_UploadAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, middleware zrpc.ServerMiddleware) (interface{}, error) {
+	in := new(UploadAttachmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if middleware == nil {
+		return srv.(TaskServiceServer).UploadAttachment(ctx, in)
+	}
+	info := &zrpc.ServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_UploadAttachment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).UploadAttachment(ctx, req.(*UploadAttachmentRequest))
+	}
+	return middleware(ctx, in, info, handler)
+}
+
+func _TaskService_ListAttachments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, middleware zrpc.ServerMiddleware) (interface{}, error) {
+	in := new(ListAttachmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if middleware == nil {
+		return srv.(TaskServiceServer).ListAttachments(ctx, in)
+	}
+	info := &zrpc.ServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_ListAttachments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).ListAttachments(ctx, req.(*ListAttachmentsRequest))
+	}
+	return middleware(ctx, in, info, handler)
+}
+
+func _TaskService_GetAttachmentURL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, middleware zrpc.ServerMiddleware) (interface{}, error) {
+	in := new(GetAttachmentURLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if middleware == nil {
+		return srv.(TaskServiceServer).GetAttachmentURL(ctx, in)
+	}
+	info := &zrpc.ServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_GetAttachmentURL_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).GetAttachmentURL(ctx, req.(*GetAttachmentURLRequest))
+	}
+	return middleware(ctx, in, info, handler)
+}
+
+func _TaskService_DeleteAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, middleware zrpc.ServerMiddleware) (interface{}, error) {
+	in := new(DeleteAttachmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if middleware == nil {
+		return srv.(TaskServiceServer).DeleteAttachment(ctx, in)
+	}
+	info := &zrpc.ServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_DeleteAttachment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).DeleteAttachment(ctx, req.(*DeleteAttachmentRequest))
+	}
+	return middleware(ctx, in, info, handler)
+}
+
+func _TaskService_BatchCreateTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, middleware zrpc.ServerMiddleware) (interface{}, error) {
+	in := new(BatchCreateTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if middleware == nil {
+		return srv.(TaskServiceServer).BatchCreateTasks(ctx, in)
+	}
+	info := &zrpc.ServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_BatchCreateTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).BatchCreateTasks(ctx, req.(*BatchCreateTasksRequest))
+	}
+	return middleware(ctx, in, info, handler)
+}
+
+func _TaskService_BatchUpdateStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, middleware zrpc.ServerMiddleware) (interface{}, error) {
+	in := new(BatchUpdateStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if middleware == nil {
+		return srv.(TaskServiceServer).BatchUpdateStatus(ctx, in)
+	}
+	info := &zrpc.ServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_BatchUpdateStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).BatchUpdateStatus(ctx, req.(*BatchUpdateStatusRequest))
+	}
+	return middleware(ctx, in, info, handler)
+}
+
+func _TaskService_BatchDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, middleware zrpc.ServerMiddleware) (interface{}, error) {
+	in := new(BatchDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if middleware == nil {
+		return srv.(TaskServiceServer).BatchDelete(ctx, in)
+	}
+	info := &zrpc.ServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_BatchDelete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).BatchDelete(ctx, req.(*BatchDeleteRequest))
+	}
+	return middleware(ctx, in, info, handler)
+}
+
+func _TaskService_BatchMove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, middleware zrpc.ServerMiddleware) (interface{}, error) {
+	in := new(BatchMoveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if middleware == nil {
+		return srv.(TaskServiceServer).BatchMove(ctx, in)
+	}
+	info := &zrpc.ServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_BatchMove_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).BatchMove(ctx, req.(*BatchMoveRequest))
+	}
+	return middleware(ctx, in, info, handler)
+}
+
+func _TaskService_ExportTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, middleware zrpc.ServerMiddleware) (interface{}, error) {
+	in := new(ExportTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if middleware == nil {
+		return srv.(TaskServiceServer).ExportTasks(ctx, in)
+	}
+	info := &zrpc.ServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_ExportTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).ExportTasks(ctx, req.(*ExportTasksRequest))
+	}
+	return middleware(ctx, in, info, handler)
+}
+
+func _TaskService_ImportTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, middleware zrpc.ServerMiddleware) (interface{}, error) {
+	in := new(ImportTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if middleware == nil {
+		return srv.(TaskServiceServer).ImportTasks(ctx, in)
+	}
+	info := &zrpc.ServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_ImportTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).ImportTasks(ctx, req.(*ImportTasksRequest))
+	}
+	return middleware(ctx, in, info, handler)
+}
+
+func _TaskService_QuickAddTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, middleware zrpc.ServerMiddleware) (interface{}, error) {
+	in := new(QuickAddTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if middleware == nil {
+		return srv.(TaskServiceServer).QuickAddTask(ctx, in)
+	}
+	info := &zrpc.ServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_QuickAddTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).QuickAddTask(ctx, req.(*QuickAddTaskRequest))
+	}
+	return middleware(ctx, in, info, handler)
+}
+
+func _TaskService_SyncTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, middleware zrpc.ServerMiddleware) (interface{}, error) {
+	in := new(SyncTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if middleware == nil {
+		return srv.(TaskServiceServer).SyncTasks(ctx, in)
+	}
+	info := &zrpc.ServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_SyncTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).SyncTasks(ctx, req.(*SyncTasksRequest))
+	}
+	return middleware(ctx, in, info, handler)
+}
+
+func _TaskService_PushTaskChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, middleware zrpc.ServerMiddleware) (interface{}, error) {
+	in := new(PushTaskChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if middleware == nil {
+		return srv.(TaskServiceServer).PushTaskChanges(ctx, in)
+	}
+	info := &zrpc.ServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_PushTaskChanges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).PushTaskChanges(ctx, req.(*PushTaskChangesRequest))
+	}
+	return middleware(ctx, in, info, handler)
+}
+
+func _TaskService_ListTaskEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, middleware zrpc.ServerMiddleware) (interface{}, error) {
+	in := new(ListTaskEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if middleware == nil {
+		return srv.(TaskServiceServer).ListTaskEvents(ctx, in)
+	}
+	info := &zrpc.ServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_ListTaskEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).ListTaskEvents(ctx, req.(*ListTaskEventsRequest))
+	}
+	return middleware(ctx, in, info, handler)
 }
 
-func (c *taskServiceClient) UpdateTaskStatus(ctx context.Context, in *UpdateTaskStatusRequest) (*UpdateTaskStatusResponse, error) {
-	out := new(UpdateTaskStatusResponse)
-	err := c.cli.Invoke(ctx, TaskService_UpdateTaskStatus_FullMethodName, in, out)
-	if err != nil {
+func _TaskService_CreateWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, middleware zrpc.ServerMiddleware) (interface{}, error) {
+	in := new(CreateWebhookRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if middleware == nil {
+		return srv.(TaskServiceServer).CreateWebhook(ctx, in)
+	}
+	info := &zrpc.ServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_CreateWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).CreateWebhook(ctx, req.(*CreateWebhookRequest))
+	}
+	return middleware(ctx, in, info, handler)
 }
 
-func (c *taskServiceClient) RecycleBin(ctx context.Context, in *RecycleBinRequest) (*RecycleBinResponse, error) {
-	out := new(RecycleBinResponse)
-	err := c.cli.Invoke(ctx, TaskService_RecycleBin_FullMethodName, in, out)
-	if err != nil {
+func _TaskService_UpdateWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, middleware zrpc.ServerMiddleware) (interface{}, error) {
+	in := new(UpdateWebhookRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-// TaskServiceServer is the server API for TaskService service
-// All implementations must embed UnimplementedTaskServiceServer
-// for forward compatibility.
-type TaskServiceServer interface {
-	AddTask(context.Context, *AddTaskRequest) (*AddTaskResponse, error)
-	ListTasks(context.Context, *ListTasksRequest) (*ListTasksResponse, error)
-	UpdateTask(context.Context, *UpdateTaskRequest) (*UpdateTaskResponse, error)
-	UpdateTaskStatus(context.Context, *UpdateTaskStatusRequest) (*UpdateTaskStatusResponse, error)
-	RecycleBin(context.Context, *RecycleBinRequest) (*RecycleBinResponse, error)
-	mustEmbedUnimplementedTaskServiceServer()
+	if middleware == nil {
+		return srv.(TaskServiceServer).UpdateWebhook(ctx, in)
+	}
+	info := &zrpc.ServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_UpdateWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).UpdateWebhook(ctx, req.(*UpdateWebhookRequest))
+	}
+	return middleware(ctx, in, info, handler)
 }
 
-// UnimplementedTaskServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedTaskServiceServer struct{}
-
-func (UnimplementedTaskServiceServer) AddTask(context.Context, *AddTaskRequest) (*AddTaskResponse, error) {
-	return nil, fmt.Errorf("method AddTask not implemented")
-}
-func (UnimplementedTaskServiceServer) ListTasks(context.Context, *ListTasksRequest) (*ListTasksResponse, error) {
-	return nil, fmt.Errorf("method ListTasks not implemented")
-}
-func (UnimplementedTaskServiceServer) UpdateTask(context.Context, *UpdateTaskRequest) (*UpdateTaskResponse, error) {
-	return nil, fmt.Errorf("method UpdateTask not implemented")
-}
-func (UnimplementedTaskServiceServer) UpdateTaskStatus(context.Context, *UpdateTaskStatusRequest) (*UpdateTaskStatusResponse, error) {
-	return nil, fmt.Errorf("method UpdateTaskStatus not implemented")
-}
-func (UnimplementedTaskServiceServer) RecycleBin(context.Context, *RecycleBinRequest) (*RecycleBinResponse, error) {
-	return nil, fmt.Errorf("method RecycleBin not implemented")
+func _TaskService_DeleteWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, middleware zrpc.ServerMiddleware) (interface{}, error) {
+	in := new(DeleteWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if middleware == nil {
+		return srv.(TaskServiceServer).DeleteWebhook(ctx, in)
+	}
+	info := &zrpc.ServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_DeleteWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).DeleteWebhook(ctx, req.(*DeleteWebhookRequest))
+	}
+	return middleware(ctx, in, info, handler)
 }
-func (UnimplementedTaskServiceServer) mustEmbedUnimplementedTaskServiceServer() {}
-func (UnimplementedTaskServiceServer) testEmbeddedByValue()                     {}
 
-// UnsafeTaskServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to TaskServiceServer will
-// result in compilation errors.
-type UnsafeTaskServiceServer interface {
-	mustEmbedUnimplementedTaskServiceServer()
+func _TaskService_ListWebhooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, middleware zrpc.ServerMiddleware) (interface{}, error) {
+	in := new(ListWebhooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if middleware == nil {
+		return srv.(TaskServiceServer).ListWebhooks(ctx, in)
+	}
+	info := &zrpc.ServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_ListWebhooks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).ListWebhooks(ctx, req.(*ListWebhooksRequest))
+	}
+	return middleware(ctx, in, info, handler)
 }
 
-func RegisterTaskServiceServer(s zrpc.ServiceRegistrar, srv TaskServiceServer) {
-	// If the following call panics, it indicates UnimplementedTaskServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _TaskService_ListWebhookDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, middleware zrpc.ServerMiddleware) (interface{}, error) {
+	in := new(ListWebhookDeliveriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&TaskService_ServiceDesc, srv)
+	if middleware == nil {
+		return srv.(TaskServiceServer).ListWebhookDeliveries(ctx, in)
+	}
+	info := &zrpc.ServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_ListWebhookDeliveries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).ListWebhookDeliveries(ctx, req.(*ListWebhookDeliveriesRequest))
+	}
+	return middleware(ctx, in, info, handler)
 }
 
-func _TaskService_AddTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, middleware zrpc.ServerMiddleware) (interface{}, error) {
-	in := new(AddTaskRequest)
+func _TaskService_ReplayWebhookDelivery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, middleware zrpc.ServerMiddleware) (interface{}, error) {
+	in := new(ReplayWebhookDeliveryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if middleware == nil {
-		return srv.(TaskServiceServer).AddTask(ctx, in)
+		return srv.(TaskServiceServer).ReplayWebhookDelivery(ctx, in)
 	}
 	info := &zrpc.ServerInfo{
 		Server:     srv,
-		FullMethod: TaskService_AddTask_FullMethodName,
+		FullMethod: TaskService_ReplayWebhookDelivery_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TaskServiceServer).AddTask(ctx, req.(*AddTaskRequest))
+		return srv.(TaskServiceServer).ReplayWebhookDelivery(ctx, req.(*ReplayWebhookDeliveryRequest))
 	}
 	return middleware(ctx, in, info, handler)
 }
 
-func _TaskService_ListTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, middleware zrpc.ServerMiddleware) (interface{}, error) {
-	in := new(ListTasksRequest)
+func _TaskService_CreateCalendarFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, middleware zrpc.ServerMiddleware) (interface{}, error) {
+	in := new(CreateCalendarFeedRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if middleware == nil {
-		return srv.(TaskServiceServer).ListTasks(ctx, in)
+		return srv.(TaskServiceServer).CreateCalendarFeed(ctx, in)
 	}
 	info := &zrpc.ServerInfo{
 		Server:     srv,
-		FullMethod: TaskService_ListTasks_FullMethodName,
+		FullMethod: TaskService_CreateCalendarFeed_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TaskServiceServer).ListTasks(ctx, req.(*ListTasksRequest))
+		return srv.(TaskServiceServer).CreateCalendarFeed(ctx, req.(*CreateCalendarFeedRequest))
 	}
 	return middleware(ctx, in, info, handler)
 }
 
-func _TaskService_UpdateTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, middleware zrpc.ServerMiddleware) (interface{}, error) {
-	in := new(UpdateTaskRequest)
+func _TaskService_RotateCalendarFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, middleware zrpc.ServerMiddleware) (interface{}, error) {
+	in := new(RotateCalendarFeedRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if middleware == nil {
-		return srv.(TaskServiceServer).UpdateTask(ctx, in)
+		return srv.(TaskServiceServer).RotateCalendarFeed(ctx, in)
 	}
 	info := &zrpc.ServerInfo{
 		Server:     srv,
-		FullMethod: TaskService_UpdateTask_FullMethodName,
+		FullMethod: TaskService_RotateCalendarFeed_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TaskServiceServer).UpdateTask(ctx, req.(*UpdateTaskRequest))
+		return srv.(TaskServiceServer).RotateCalendarFeed(ctx, req.(*RotateCalendarFeedRequest))
 	}
 	return middleware(ctx, in, info, handler)
 }
 
-func _TaskService_UpdateTaskStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, middleware zrpc.ServerMiddleware) (interface{}, error) {
-	in := new(UpdateTaskStatusRequest)
+func _TaskService_RevokeCalendarFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, middleware zrpc.ServerMiddleware) (interface{}, error) {
+	in := new(RevokeCalendarFeedRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if middleware == nil {
-		return srv.(TaskServiceServer).UpdateTaskStatus(ctx, in)
+		return srv.(TaskServiceServer).RevokeCalendarFeed(ctx, in)
 	}
 	info := &zrpc.ServerInfo{
 		Server:     srv,
-		FullMethod: TaskService_UpdateTaskStatus_FullMethodName,
+		FullMethod: TaskService_RevokeCalendarFeed_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TaskServiceServer).UpdateTaskStatus(ctx, req.(*UpdateTaskStatusRequest))
+		return srv.(TaskServiceServer).RevokeCalendarFeed(ctx, req.(*RevokeCalendarFeedRequest))
 	}
 	return middleware(ctx, in, info, handler)
 }
 
-func _TaskService_RecycleBin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, middleware zrpc.ServerMiddleware) (interface{}, error) {
-	in := new(RecycleBinRequest)
+func _TaskService_GetCalendarFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, middleware zrpc.ServerMiddleware) (interface{}, error) {
+	in := new(GetCalendarFeedRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if middleware == nil {
-		return srv.(TaskServiceServer).RecycleBin(ctx, in)
+		return srv.(TaskServiceServer).GetCalendarFeed(ctx, in)
 	}
 	info := &zrpc.ServerInfo{
 		Server:     srv,
-		FullMethod: TaskService_RecycleBin_FullMethodName,
+		FullMethod: TaskService_GetCalendarFeed_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TaskServiceServer).RecycleBin(ctx, req.(*RecycleBinRequest))
+		return srv.(TaskServiceServer).GetCalendarFeed(ctx, req.(*GetCalendarFeedRequest))
 	}
 	return middleware(ctx, in, info, handler)
 }
@@ -238,6 +2218,10 @@ var TaskService_ServiceDesc = zrpc.ServiceDesc{
 			MethodName: "AddTask",
 			Handler:    _TaskService_AddTask_Handler,
 		},
+		{
+			MethodName: "GetTask",
+			Handler:    _TaskService_GetTask_Handler,
+		},
 		{
 			MethodName: "ListTasks",
 			Handler:    _TaskService_ListTasks_Handler,
@@ -254,6 +2238,242 @@ var TaskService_ServiceDesc = zrpc.ServiceDesc{
 			MethodName: "RecycleBin",
 			Handler:    _TaskService_RecycleBin_Handler,
 		},
+		{
+			MethodName: "SearchTasks",
+			Handler:    _TaskService_SearchTasks_Handler,
+		},
+		{
+			MethodName: "ListDueTasks",
+			Handler:    _TaskService_ListDueTasks_Handler,
+		},
+		{
+			MethodName: "DeleteTask",
+			Handler:    _TaskService_DeleteTask_Handler,
+		},
+		{
+			MethodName: "RestoreTask",
+			Handler:    _TaskService_RestoreTask_Handler,
+		},
+		{
+			MethodName: "PurgeTask",
+			Handler:    _TaskService_PurgeTask_Handler,
+		},
+		{
+			MethodName: "EmptyRecycleBin",
+			Handler:    _TaskService_EmptyRecycleBin_Handler,
+		},
+		{
+			MethodName: "PreviewOccurrences",
+			Handler:    _TaskService_PreviewOccurrences_Handler,
+		},
+		{
+			MethodName: "CreateTag",
+			Handler:    _TaskService_CreateTag_Handler,
+		},
+		{
+			MethodName: "UpdateTag",
+			Handler:    _TaskService_UpdateTag_Handler,
+		},
+		{
+			MethodName: "DeleteTag",
+			Handler:    _TaskService_DeleteTag_Handler,
+		},
+		{
+			MethodName: "ListTags",
+			Handler:    _TaskService_ListTags_Handler,
+		},
+		{
+			MethodName: "CreateProject",
+			Handler:    _TaskService_CreateProject_Handler,
+		},
+		{
+			MethodName: "RenameProject",
+			Handler:    _TaskService_RenameProject_Handler,
+		},
+		{
+			MethodName: "ArchiveProject",
+			Handler:    _TaskService_ArchiveProject_Handler,
+		},
+		{
+			MethodName: "ReorderProjects",
+			Handler:    _TaskService_ReorderProjects_Handler,
+		},
+		{
+			MethodName: "DeleteProject",
+			Handler:    _TaskService_DeleteProject_Handler,
+		},
+		{
+			MethodName: "ListProjects",
+			Handler:    _TaskService_ListProjects_Handler,
+		},
+		{
+			MethodName: "AddChecklistItem",
+			Handler:    _TaskService_AddChecklistItem_Handler,
+		},
+		{
+			MethodName: "UpdateChecklistItem",
+			Handler:    _TaskService_UpdateChecklistItem_Handler,
+		},
+		{
+			MethodName: "DeleteChecklistItem",
+			Handler:    _TaskService_DeleteChecklistItem_Handler,
+		},
+		{
+			MethodName: "AddDependency",
+			Handler:    _TaskService_AddDependency_Handler,
+		},
+		{
+			MethodName: "RemoveDependency",
+			Handler:    _TaskService_RemoveDependency_Handler,
+		},
+		{
+			MethodName: "MoveTask",
+			Handler:    _TaskService_MoveTask_Handler,
+		},
+		{
+			MethodName: "GetBoard",
+			Handler:    _TaskService_GetBoard_Handler,
+		},
+		{
+			MethodName: "CreateColumn",
+			Handler:    _TaskService_CreateColumn_Handler,
+		},
+		{
+			MethodName: "UpdateColumn",
+			Handler:    _TaskService_UpdateColumn_Handler,
+		},
+		{
+			MethodName: "ReorderColumns",
+			Handler:    _TaskService_ReorderColumns_Handler,
+		},
+		{
+			MethodName: "DeleteColumn",
+			Handler:    _TaskService_DeleteColumn_Handler,
+		},
+		{
+			MethodName: "MoveCard",
+			Handler:    _TaskService_MoveCard_Handler,
+		},
+		{
+			MethodName: "GetTaskHistory",
+			Handler:    _TaskService_GetTaskHistory_Handler,
+		},
+		{
+			MethodName: "RevertTask",
+			Handler:    _TaskService_RevertTask_Handler,
+		},
+		{
+			MethodName: "AddComment",
+			Handler:    _TaskService_AddComment_Handler,
+		},
+		{
+			MethodName: "UpdateComment",
+			Handler:    _TaskService_UpdateComment_Handler,
+		},
+		{
+			MethodName: "DeleteComment",
+			Handler:    _TaskService_DeleteComment_Handler,
+		},
+		{
+			MethodName: "ListComments",
+			Handler:    _TaskService_ListComments_Handler,
+		},
+		{
+			MethodName: "UploadAttachment",
+			Handler:    _TaskService_UploadAttachment_Handler,
+		},
+		{
+			MethodName: "ListAttachments",
+			Handler:    _TaskService_ListAttachments_Handler,
+		},
+		{
+			MethodName: "GetAttachmentURL",
+			Handler:    _TaskService_GetAttachmentURL_Handler,
+		},
+		{
+			MethodName: "DeleteAttachment",
+			Handler:    _TaskService_DeleteAttachment_Handler,
+		},
+		{
+			MethodName: "BatchCreateTasks",
+			Handler:    _TaskService_BatchCreateTasks_Handler,
+		},
+		{
+			MethodName: "BatchUpdateStatus",
+			Handler:    _TaskService_BatchUpdateStatus_Handler,
+		},
+		{
+			MethodName: "BatchDelete",
+			Handler:    _TaskService_BatchDelete_Handler,
+		},
+		{
+			MethodName: "BatchMove",
+			Handler:    _TaskService_BatchMove_Handler,
+		},
+		{
+			MethodName: "ExportTasks",
+			Handler:    _TaskService_ExportTasks_Handler,
+		},
+		{
+			MethodName: "ImportTasks",
+			Handler:    _TaskService_ImportTasks_Handler,
+		},
+		{
+			MethodName: "QuickAddTask",
+			Handler:    _TaskService_QuickAddTask_Handler,
+		},
+		{
+			MethodName: "SyncTasks",
+			Handler:    _TaskService_SyncTasks_Handler,
+		},
+		{
+			MethodName: "PushTaskChanges",
+			Handler:    _TaskService_PushTaskChanges_Handler,
+		},
+		{
+			MethodName: "ListTaskEvents",
+			Handler:    _TaskService_ListTaskEvents_Handler,
+		},
+		{
+			MethodName: "CreateWebhook",
+			Handler:    _TaskService_CreateWebhook_Handler,
+		},
+		{
+			MethodName: "UpdateWebhook",
+			Handler:    _TaskService_UpdateWebhook_Handler,
+		},
+		{
+			MethodName: "DeleteWebhook",
+			Handler:    _TaskService_DeleteWebhook_Handler,
+		},
+		{
+			MethodName: "ListWebhooks",
+			Handler:    _TaskService_ListWebhooks_Handler,
+		},
+		{
+			MethodName: "ListWebhookDeliveries",
+			Handler:    _TaskService_ListWebhookDeliveries_Handler,
+		},
+		{
+			MethodName: "ReplayWebhookDelivery",
+			Handler:    _TaskService_ReplayWebhookDelivery_Handler,
+		},
+		{
+			MethodName: "CreateCalendarFeed",
+			Handler:    _TaskService_CreateCalendarFeed_Handler,
+		},
+		{
+			MethodName: "RotateCalendarFeed",
+			Handler:    _TaskService_RotateCalendarFeed_Handler,
+		},
+		{
+			MethodName: "RevokeCalendarFeed",
+			Handler:    _TaskService_RevokeCalendarFeed_Handler,
+		},
+		{
+			MethodName: "GetCalendarFeed",
+			Handler:    _TaskService_GetCalendarFeed_Handler,
+		},
 	},
 	Metadata: "idl/task.proto",
 }
@@ -23,6 +23,277 @@ CREATE TABLE IF NOT EXISTS `task` (
   `status` tinyint NOT NULL COMMENT 'Task Status',
   `created_at` bigint NOT NULL COMMENT 'Creation Time (Milliseconds)',
   `updated_at` bigint NOT NULL COMMENT 'Update Time (Milliseconds)',
+  `due_at` bigint NULL COMMENT 'Due Time (Milliseconds)',
+  `remind_at` bigint NULL COMMENT 'Reminder Time (Milliseconds)',
+  `reminded_at` bigint NULL COMMENT 'Reminder Sent Time (Milliseconds)',
+  `recurrence` varchar(255) NOT NULL DEFAULT '' COMMENT 'Recurrence Rule (RFC 5545 RRULE)',
+  `time_zone` varchar(64) NOT NULL DEFAULT '' COMMENT 'Recurrence Time Zone',
+  `series_id` bigint NOT NULL DEFAULT 0 COMMENT 'Recurring Series ID',
+  `series_start` bigint NOT NULL DEFAULT 0 COMMENT 'Series Start Time (Milliseconds)',
+  `occurrence_at` bigint NOT NULL DEFAULT 0 COMMENT 'Scheduled Occurrence Time (Milliseconds)',
+  `deleted_at` datetime(3) NULL COMMENT 'Deletion Time',
+  `version` bigint NOT NULL DEFAULT 0 COMMENT 'Optimistic Lock Version',
+  `project_id` bigint NOT NULL DEFAULT 0 COMMENT 'Project ID, 0 Means Inbox',
+  `parent_id` bigint NOT NULL DEFAULT 0 COMMENT 'Parent Task ID, 0 For Top Level Tasks',
+  `auto_complete` tinyint(1) NOT NULL DEFAULT 0 COMMENT 'Complete Once All Subtasks Are Done',
+  `priority` tinyint NOT NULL DEFAULT 0 COMMENT 'Task Priority',
+  `sort_key` varchar(255) CHARACTER SET ascii COLLATE ascii_bin NOT NULL DEFAULT '' COMMENT 'Manual Order Rank',
+  `column_id` bigint NOT NULL DEFAULT 0 COMMENT 'Board Column ID, 0 For The Default Column',
   PRIMARY KEY (`id`),
-  INDEX idx_user_status_utime (`user_id`, `status`, `updated_at`)
-) ENGINE=InnoDB CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT 'Task Table';
\ No newline at end of file
+  INDEX idx_user_status_due (`user_id`, `status`, `due_at`),
+  INDEX idx_remind_at (`remind_at`),
+  INDEX idx_series_occurrence (`series_id`, `occurrence_at`),
+  INDEX idx_deleted_at (`deleted_at`),
+  INDEX idx_user_status_utime (`user_id`, `status`, `updated_at`),
+  INDEX idx_user_status_ctime (`user_id`, `status`, `created_at`),
+  INDEX idx_user_project (`user_id`, `project_id`),
+  INDEX idx_parent_id (`parent_id`),
+  INDEX idx_user_sort_key (`user_id`, `sort_key`),
+  FULLTEXT INDEX ft_title_content (`title`, `content`) WITH PARSER ngram
+) ENGINE=InnoDB CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT 'Task Table';
+
+CREATE TABLE IF NOT EXISTS `tag` (
+  `id` bigint NOT NULL AUTO_INCREMENT COMMENT 'Tag ID',
+  `user_id` bigint NOT NULL COMMENT 'Tag OwnerID',
+  `name` varchar(64) NOT NULL COMMENT 'Tag Name',
+  `color` varchar(16) NOT NULL DEFAULT '' COMMENT 'Tag Color (#RRGGBB)',
+  `created_at` bigint NOT NULL COMMENT 'Creation Time (Milliseconds)',
+  `updated_at` bigint NOT NULL COMMENT 'Update Time (Milliseconds)',
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX uniq_user_name (`user_id`, `name`)
+) ENGINE=InnoDB CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT 'Tag Table';
+
+CREATE TABLE IF NOT EXISTS `task_tag` (
+  `task_id` bigint NOT NULL COMMENT 'Task ID',
+  `tag_id` bigint NOT NULL COMMENT 'Tag ID',
+  `created_at` bigint NOT NULL COMMENT 'Creation Time (Milliseconds)',
+  PRIMARY KEY (`task_id`, `tag_id`),
+  INDEX idx_tag_id (`tag_id`)
+) ENGINE=InnoDB CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT 'Task Tag Relation Table';
+
+CREATE TABLE IF NOT EXISTS `task_dependency` (
+  `task_id` bigint NOT NULL COMMENT 'Blocked Task ID',
+  `blocker_id` bigint NOT NULL COMMENT 'Blocking Task ID',
+  `created_at` bigint NOT NULL COMMENT 'Creation Time (Milliseconds)',
+  PRIMARY KEY (`task_id`, `blocker_id`),
+  INDEX idx_blocker_id (`blocker_id`)
+) ENGINE=InnoDB CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT 'Task Dependency Table';
+
+CREATE TABLE IF NOT EXISTS `project` (
+  `id` bigint NOT NULL AUTO_INCREMENT COMMENT 'Project ID',
+  `user_id` bigint NOT NULL COMMENT 'Project OwnerID',
+  `name` varchar(128) NOT NULL COMMENT 'Project Name',
+  `position` bigint NOT NULL DEFAULT 0 COMMENT 'Display Position',
+  `is_inbox` tinyint(1) NULL COMMENT 'Inbox Flag, 1 For The Inbox And NULL Otherwise',
+  `archived_at` bigint NULL COMMENT 'Archive Time (Milliseconds)',
+  `created_at` bigint NOT NULL COMMENT 'Creation Time (Milliseconds)',
+  `updated_at` bigint NOT NULL COMMENT 'Update Time (Milliseconds)',
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX uniq_user_inbox (`user_id`, `is_inbox`),
+  INDEX idx_user_position (`user_id`, `position`)
+) ENGINE=InnoDB CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT 'Project Table';
+
+CREATE TABLE IF NOT EXISTS `task_activity` (
+  `id` bigint NOT NULL AUTO_INCREMENT COMMENT 'Activity ID',
+  `task_id` bigint NOT NULL COMMENT 'Task ID',
+  `user_id` bigint NOT NULL COMMENT 'Task OwnerID',
+  `action` tinyint NOT NULL COMMENT 'Activity Action',
+  `source` tinyint NOT NULL COMMENT 'Activity Source',
+  `version` bigint NOT NULL COMMENT 'Task Version After The Change',
+  `changes` text NOT NULL COMMENT 'Changed Fields With Old And New Values (JSON)',
+  `created_at` bigint NOT NULL COMMENT 'Creation Time (Milliseconds)',
+  PRIMARY KEY (`id`),
+  INDEX idx_task_id (`task_id`, `id`)
+) ENGINE=InnoDB CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT 'Task Activity Table';
+
+CREATE TABLE IF NOT EXISTS `board_column` (
+  `id` bigint NOT NULL AUTO_INCREMENT COMMENT 'Board Column ID',
+  `user_id` bigint NOT NULL COMMENT 'Column OwnerID',
+  `project_id` bigint NOT NULL COMMENT 'Project ID',
+  `name` varchar(64) NOT NULL COMMENT 'Column Name',
+  `status` tinyint NOT NULL COMMENT 'Task Status Of The Column',
+  `position` bigint NOT NULL DEFAULT 0 COMMENT 'Display Position',
+  `wip_limit` int NOT NULL DEFAULT 0 COMMENT 'Work In Progress Limit, 0 Means Unlimited',
+  `created_at` bigint NOT NULL COMMENT 'Creation Time (Milliseconds)',
+  `updated_at` bigint NOT NULL COMMENT 'Update Time (Milliseconds)',
+  PRIMARY KEY (`id`),
+  INDEX idx_project_position (`project_id`, `position`)
+) ENGINE=InnoDB CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT 'Board Column Table';
+
+CREATE TABLE IF NOT EXISTS `checklist_item` (
+  `id` bigint NOT NULL AUTO_INCREMENT COMMENT 'Checklist Item ID',
+  `task_id` bigint NOT NULL COMMENT 'Task ID',
+  `content` varchar(255) NOT NULL COMMENT 'Item Content',
+  `checked` tinyint(1) NOT NULL DEFAULT 0 COMMENT 'Checked Flag',
+  `position` bigint NOT NULL DEFAULT 0 COMMENT 'Display Position',
+  `created_at` bigint NOT NULL COMMENT 'Creation Time (Milliseconds)',
+  `updated_at` bigint NOT NULL COMMENT 'Update Time (Milliseconds)',
+  PRIMARY KEY (`id`),
+  INDEX idx_task_position (`task_id`, `position`)
+) ENGINE=InnoDB CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT 'Checklist Item Table';
+
+CREATE TABLE IF NOT EXISTS `task_comment` (
+  `id` bigint NOT NULL AUTO_INCREMENT COMMENT 'Comment ID',
+  `task_id` bigint NOT NULL COMMENT 'Task ID',
+  `user_id` bigint NOT NULL COMMENT 'Comment AuthorID',
+  `content` text NOT NULL COMMENT 'Comment Content',
+  `created_at` bigint NOT NULL COMMENT 'Creation Time (Milliseconds)',
+  `updated_at` bigint NOT NULL COMMENT 'Update Time (Milliseconds)',
+  PRIMARY KEY (`id`),
+  INDEX idx_task_id (`task_id`, `id`)
+) ENGINE=InnoDB CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT 'Task Comment Table';
+
+CREATE TABLE IF NOT EXISTS `task_attachment` (
+  `id` bigint NOT NULL AUTO_INCREMENT COMMENT 'Attachment ID',
+  `task_id` bigint NOT NULL COMMENT 'Task ID',
+  `user_id` bigint NOT NULL COMMENT 'Attachment OwnerID',
+  `name` varchar(255) NOT NULL COMMENT 'Original File Name',
+  `content_type` varchar(128) NOT NULL COMMENT 'Detected Content Type',
+  `size` bigint NOT NULL COMMENT 'File Size In Bytes',
+  `object_key` varchar(255) NOT NULL COMMENT 'Storage Object Key',
+  `created_at` bigint NOT NULL COMMENT 'Creation Time (Milliseconds)',
+  PRIMARY KEY (`id`),
+  INDEX idx_task_id (`task_id`, `id`),
+  INDEX idx_user_id (`user_id`)
+) ENGINE=InnoDB CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT 'Task Attachment Table';
+
+CREATE TABLE IF NOT EXISTS `task_change` (
+  `id` bigint NOT NULL AUTO_INCREMENT COMMENT 'Change ID',
+  `user_id` bigint NOT NULL COMMENT 'Task OwnerID',
+  `seq` bigint NOT NULL COMMENT 'Change Sequence Of The User',
+  `task_id` bigint NOT NULL COMMENT 'Task ID',
+  `deleted` tinyint(1) NOT NULL DEFAULT 0 COMMENT 'Tombstone Flag, 1 If The Task Was Deleted',
+  `created_at` bigint NOT NULL COMMENT 'Creation Time (Milliseconds)',
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX uniq_user_seq (`user_id`, `seq`)
+) ENGINE=InnoDB CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT 'Task Change Log Table';
+
+CREATE TABLE IF NOT EXISTS `task_change_seq` (
+  `user_id` bigint NOT NULL COMMENT 'Task OwnerID',
+  `seq` bigint NOT NULL COMMENT 'Last Change Sequence Of The User',
+  PRIMARY KEY (`user_id`)
+) ENGINE=InnoDB CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT 'Task Change Sequence Table';
+
+CREATE TABLE IF NOT EXISTS `outbox_event` (
+  `id` bigint NOT NULL AUTO_INCREMENT COMMENT 'Event ID',
+  `topic` varchar(128) NOT NULL COMMENT 'Event Bus Topic',
+  `event_key` varchar(128) NOT NULL DEFAULT '' COMMENT 'Key Grouping Related Events',
+  `payload` blob NOT NULL COMMENT 'Event Payload',
+  `created_at` bigint NOT NULL COMMENT 'Creation Time (Milliseconds)',
+  `published_at` bigint NOT NULL DEFAULT 0 COMMENT 'Publish Time (Milliseconds), 0 While Pending',
+  PRIMARY KEY (`id`),
+  INDEX idx_published_at (`published_at`, `id`)
+) ENGINE=InnoDB CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT 'Transactional Outbox Table';
+
+CREATE TABLE IF NOT EXISTS `outbox_consumption` (
+  `consumer_group` varchar(128) NOT NULL COMMENT 'Consumer Group',
+  `event_id` varchar(64) NOT NULL COMMENT 'Event ID',
+  `consumed_at` bigint NOT NULL COMMENT 'Consumption Time (Milliseconds)',
+  PRIMARY KEY (`consumer_group`, `event_id`),
+  INDEX idx_consumed_at (`consumed_at`)
+) ENGINE=InnoDB CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT 'Outbox Event Consumption Table';
+
+CREATE TABLE IF NOT EXISTS `webhook` (
+  `id` bigint NOT NULL AUTO_INCREMENT COMMENT 'Webhook ID',
+  `user_id` bigint NOT NULL COMMENT 'Webhook OwnerID',
+  `url` varchar(2048) NOT NULL COMMENT 'Delivery URL',
+  `event_types` varchar(128) NOT NULL DEFAULT '' COMMENT 'Subscribed Event Types, Comma Separated, Empty For All',
+  `secret` varchar(128) NOT NULL COMMENT 'Signing Secret',
+  `enabled` tinyint(1) NOT NULL DEFAULT 1 COMMENT 'Enabled Flag',
+  `created_at` bigint NOT NULL COMMENT 'Creation Time (Milliseconds)',
+  `updated_at` bigint NOT NULL COMMENT 'Update Time (Milliseconds)',
+  PRIMARY KEY (`id`),
+  INDEX idx_user_id (`user_id`)
+) ENGINE=InnoDB CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT 'Webhook Table';
+
+CREATE TABLE IF NOT EXISTS `webhook_delivery` (
+  `id` bigint NOT NULL AUTO_INCREMENT COMMENT 'Delivery ID',
+  `webhook_id` bigint NOT NULL COMMENT 'Webhook ID',
+  `user_id` bigint NOT NULL COMMENT 'Webhook OwnerID',
+  `event_id` varchar(64) NOT NULL COMMENT 'Event ID',
+  `event_type` tinyint NOT NULL COMMENT 'Task Event Type',
+  `payload` text NOT NULL COMMENT 'Request Body',
+  `status` tinyint NOT NULL DEFAULT 0 COMMENT 'Delivery Status: 0 Pending, 1 Succeeded, 2 Dead',
+  `attempts` int NOT NULL DEFAULT 0 COMMENT 'Attempts Made',
+  `next_attempt_at` bigint NOT NULL DEFAULT 0 COMMENT 'Next Attempt Time (Milliseconds)',
+  `response_status` int NOT NULL DEFAULT 0 COMMENT 'HTTP Status Of The Last Attempt',
+  `last_error` varchar(512) NOT NULL DEFAULT '' COMMENT 'Error Of The Last Attempt',
+  `created_at` bigint NOT NULL COMMENT 'Creation Time (Milliseconds)',
+  `updated_at` bigint NOT NULL COMMENT 'Update Time (Milliseconds)',
+  `delivered_at` bigint NOT NULL DEFAULT 0 COMMENT 'Successful Delivery Time (Milliseconds)',
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX uniq_webhook_event (`webhook_id`, `event_id`),
+  INDEX idx_webhook_id (`webhook_id`, `id`),
+  INDEX idx_due (`status`, `next_attempt_at`)
+) ENGINE=InnoDB CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT 'Webhook Delivery Table';
+
+CREATE TABLE IF NOT EXISTS `calendar_feed` (
+  `user_id` bigint NOT NULL COMMENT 'Feed OwnerID',
+  `token_hash` char(64) NOT NULL COMMENT 'SHA-256 Of The Feed Token, Hex Encoded',
+  `created_at` bigint NOT NULL COMMENT 'Creation Time (Milliseconds)',
+  `updated_at` bigint NOT NULL COMMENT 'Update Time (Milliseconds)',
+  PRIMARY KEY (`user_id`),
+  UNIQUE INDEX uniq_token_hash (`token_hash`)
+) ENGINE=InnoDB CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT 'Calendar Feed Table';
+
+
+-- Upgrade of databases created before the columns and indexes above existed.
+-- Every step checks information_schema first, so the script can be rerun safely.
+DROP PROCEDURE IF EXISTS add_column_if_missing;
+DROP PROCEDURE IF EXISTS add_index_if_missing;
+
+DELIMITER //
+
+CREATE PROCEDURE add_column_if_missing(IN tbl varchar(64), IN col varchar(64), IN def text)
+BEGIN
+  IF NOT EXISTS (SELECT 1 FROM information_schema.COLUMNS
+                 WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = tbl AND COLUMN_NAME = col) THEN
+    SET @ddl = CONCAT('ALTER TABLE `', tbl, '` ADD COLUMN `', col, '` ', def);
+    PREPARE stmt FROM @ddl;
+    EXECUTE stmt;
+    DEALLOCATE PREPARE stmt;
+  END IF;
+END //
+
+CREATE PROCEDURE add_index_if_missing(IN tbl varchar(64), IN idx varchar(64), IN def text)
+BEGIN
+  IF NOT EXISTS (SELECT 1 FROM information_schema.STATISTICS
+                 WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = tbl AND INDEX_NAME = idx) THEN
+    SET @ddl = CONCAT('ALTER TABLE `', tbl, '` ADD ', def);
+    PREPARE stmt FROM @ddl;
+    EXECUTE stmt;
+    DEALLOCATE PREPARE stmt;
+  END IF;
+END //
+
+DELIMITER ;
+
+CALL add_column_if_missing('task', 'due_at', 'bigint NULL COMMENT ''Due Time (Milliseconds)''');
+CALL add_column_if_missing('task', 'remind_at', 'bigint NULL COMMENT ''Reminder Time (Milliseconds)''');
+CALL add_column_if_missing('task', 'reminded_at', 'bigint NULL COMMENT ''Reminder Sent Time (Milliseconds)''');
+CALL add_column_if_missing('task', 'recurrence', 'varchar(255) NOT NULL DEFAULT '''' COMMENT ''Recurrence Rule (RFC 5545 RRULE)''');
+CALL add_column_if_missing('task', 'time_zone', 'varchar(64) NOT NULL DEFAULT '''' COMMENT ''Recurrence Time Zone''');
+CALL add_column_if_missing('task', 'series_id', 'bigint NOT NULL DEFAULT 0 COMMENT ''Recurring Series ID''');
+CALL add_column_if_missing('task', 'series_start', 'bigint NOT NULL DEFAULT 0 COMMENT ''Series Start Time (Milliseconds)''');
+CALL add_column_if_missing('task', 'occurrence_at', 'bigint NOT NULL DEFAULT 0 COMMENT ''Scheduled Occurrence Time (Milliseconds)''');
+CALL add_column_if_missing('task', 'deleted_at', 'datetime(3) NULL COMMENT ''Deletion Time''');
+CALL add_column_if_missing('task', 'version', 'bigint NOT NULL DEFAULT 0 COMMENT ''Optimistic Lock Version''');
+CALL add_column_if_missing('task', 'project_id', 'bigint NOT NULL DEFAULT 0 COMMENT ''Project ID, 0 Means Inbox''');
+CALL add_column_if_missing('task', 'parent_id', 'bigint NOT NULL DEFAULT 0 COMMENT ''Parent Task ID, 0 For Top Level Tasks''');
+CALL add_column_if_missing('task', 'auto_complete', 'tinyint(1) NOT NULL DEFAULT 0 COMMENT ''Complete Once All Subtasks Are Done''');
+CALL add_column_if_missing('task', 'priority', 'tinyint NOT NULL DEFAULT 0 COMMENT ''Task Priority''');
+CALL add_column_if_missing('task', 'sort_key', 'varchar(255) CHARACTER SET ascii COLLATE ascii_bin NOT NULL DEFAULT '''' COMMENT ''Manual Order Rank''');
+CALL add_column_if_missing('task', 'column_id', 'bigint NOT NULL DEFAULT 0 COMMENT ''Board Column ID, 0 For The Default Column''');
+
+CALL add_index_if_missing('task', 'idx_user_status_due', 'INDEX idx_user_status_due (`user_id`, `status`, `due_at`)');
+CALL add_index_if_missing('task', 'idx_remind_at', 'INDEX idx_remind_at (`remind_at`)');
+CALL add_index_if_missing('task', 'idx_series_occurrence', 'INDEX idx_series_occurrence (`series_id`, `occurrence_at`)');
+CALL add_index_if_missing('task', 'idx_deleted_at', 'INDEX idx_deleted_at (`deleted_at`)');
+CALL add_index_if_missing('task', 'idx_user_status_ctime', 'INDEX idx_user_status_ctime (`user_id`, `status`, `created_at`)');
+CALL add_index_if_missing('task', 'idx_user_project', 'INDEX idx_user_project (`user_id`, `project_id`)');
+CALL add_index_if_missing('task', 'idx_parent_id', 'INDEX idx_parent_id (`parent_id`)');
+CALL add_index_if_missing('task', 'idx_user_sort_key', 'INDEX idx_user_sort_key (`user_id`, `sort_key`)');
+CALL add_index_if_missing('task', 'ft_title_content', 'FULLTEXT INDEX ft_title_content (`title`, `content`) WITH PARSER ngram');
+
+DROP PROCEDURE add_column_if_missing;
+DROP PROCEDURE add_index_if_missing;
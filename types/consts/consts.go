@@ -9,12 +9,37 @@ const (
 	MinIOEndpoint = "MINIO_ENDPOINT"
 	StorageBucket = "STORAGE_BUCKET"
 	DiscoveryType = "DISCOVERY_TYPE"
+	SearchType    = "SEARCH_TYPE"
+	EventBusType  = "EVENTBUS_TYPE"
+
+	ReminderInterval        = "REMINDER_INTERVAL"
+	RecycleBinRetentionDays = "RECYCLE_BIN_RETENTION_DAYS"
+	StreamMaxConns          = "STREAM_MAX_CONNS"
+	StreamHeartbeatInterval = "STREAM_HEARTBEAT_INTERVAL"
+	OutboxRelayInterval     = "OUTBOX_RELAY_INTERVAL"
+	WebhookDispatchInterval = "WEBHOOK_DISPATCH_INTERVAL"
 )
 
 const (
 	UserIconURI = "default_icon/user_default_icon.png"
 )
 
+const (
+	// TaskEventChannelPrefix followed by a user ID names the pub/sub channel
+	// the task events of the user are published to.
+	TaskEventChannelPrefix = "task:events:"
+	// TaskStreamConnsPrefix followed by a user ID keys the count of the task
+	// streams the user has open.
+	TaskStreamConnsPrefix = "task:stream:conns:"
+
+	// Event bus topics and the consumer groups reading them.
+	TaskEventTopic      = "task.events"
+	UserRegisteredTopic = "user.registered"
+	TaskStreamGroup     = "task-stream"
+	TaskWebhookGroup    = "task-webhooks"
+	UserMetricsGroup    = "user-metrics"
+)
+
 const (
 	UserServiceName = "zrpc-todolist-rpc-user"
 	TaskServiceName = "zrpc-todolist-rpc-task"